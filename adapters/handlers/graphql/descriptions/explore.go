@@ -23,6 +23,15 @@ const (
 	Certainty            = "Normalized Distance between the result item and the search vector. Normalized to be between 0 (identical vectors) and 1 (perfect opposite)."
 	Distance             = "The required degree of similarity between an object's characteristics and the provided filter values"
 	Vector               = "Target vector to be used in kNN search"
+	MultiVector          = "Target multi vector to be used in a late interaction search, requires exactly one target vector"
+	DistanceMetric       = "Overrides the distance metric of the vector index for this search, only supported by flat indexes"
+	SparseIndices        = "Dimensions of the non-zero entries of a sparse vector"
+	SparseValues         = "Weights of the non-zero entries of a sparse vector, one per index"
+	SparseTargetVector   = "Name of the sparse target vector, can be omitted if the class has exactly one"
+	Slop                 = "Number of other words allowed between the words of the quoted phrases in the query, defaults to 0"
+	Fuzzy                = "Also match indexed words which are within an edit distance of the words of the query"
+	FuzzyMaxEdits        = "Maximum number of inserted, deleted or substituted characters, must be 1 or 2"
+	FuzzyPrefixLength    = "Number of leading characters which have to match exactly, defaults to 0"
 	Force                = "The force to apply for a particular movements. Must be between 0 and 1 where 0 is equivalent to no movement and 1 is equivalent to largest movement possible"
 	ClassName            = "Name of the Class"
 	ID                   = "Concept identifier in the uuid format"
@@ -48,3 +48,9 @@ const ConsistencyLevel = "Determines how many replicas must acknowledge a reques
 	"before it is considered successful. Can be 'ONE', 'QUORUM', or 'ALL'"
 
 const Tenant = "The value by which a tenant is identified, specified in the class schema"
+
+const (
+	Highlights             = "Fragments of the searched text properties in which the words of the keyword query are marked"
+	HighlightsFragmentSize = "Approximate number of characters of a fragment, defaults to 100"
+	HighlightsFragments    = "Maximum number of fragments per property, defaults to 3"
+)
@@ -31,6 +31,21 @@ func ExtractBM25(source map[string]interface{}, explainScore bool) searchparams.
 		args.Query = query.(string)
 	}
 
+	if slop, ok := source["slop"]; ok {
+		args.Slop = slop.(int)
+	}
+
+	if fuzzy, ok := source["fuzzy"]; ok {
+		fuzzyMap := fuzzy.(map[string]interface{})
+		args.Fuzzy = &searchparams.Fuzziness{}
+		if maxEdits, ok := fuzzyMap["maxEdits"]; ok {
+			args.Fuzzy.MaxEdits = maxEdits.(int)
+		}
+		if prefixLength, ok := fuzzyMap["prefixLength"]; ok {
+			args.Fuzzy.PrefixLength = prefixLength.(int)
+		}
+	}
+
 	args.AdditionalExplanations = explainScore
 	args.Type = "bm25"
 
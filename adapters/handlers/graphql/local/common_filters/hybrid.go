@@ -61,6 +61,15 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 			args.NearVectorParams = &arguments
 
 		}
+
+		if namedSearches["nearSparseVector"] != nil {
+			nearSparseVector := namedSearches["nearSparseVector"].(map[string]interface{})
+			arguments, err := ExtractNearSparseVector(nearSparseVector)
+			if err != nil {
+				return nil, nil, fmt.Errorf("nearSparseVector: %w", err)
+			}
+			args.NearSparseVectorParams = &arguments
+		}
 	}
 
 	var weightedSearchResults []searchparams.WeightedSearchResult
@@ -120,6 +129,10 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		args.Query = query.(string)
 	}
 
+	if slop, ok := source["slop"]; ok {
+		args.Slop = slop.(int)
+	}
+
 	fusionType, ok := source["fusionType"]
 	if ok {
 		args.FusionAlgorithm = fusionType.(int)
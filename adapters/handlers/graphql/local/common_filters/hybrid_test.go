@@ -48,6 +48,18 @@ func TestHybrid(t *testing.T) {
 			output:            &searchparams.HybridSearch{NearVectorParams: &searchparams.NearVector{VectorPerTarget: map[string][]float32{"target1": {1.0, 2.0, 3.0}, "target2": {1.0, 2.0}}}, TargetVectors: []string{"target1", "target2"}, SubSearches: ss, Type: "hybrid", Alpha: 0.75, FusionAlgorithm: 1},
 			outputCombination: &dto.TargetCombination{Type: dto.Minimum, Weights: nilweights},
 		},
+		{
+			input:  map[string]interface{}{"searches": []interface{}{map[string]interface{}{"nearSparseVector": map[string]interface{}{"indices": []interface{}{3, 17}, "values": []interface{}{0.5, 1.5}, "targetVector": "splade"}}}},
+			output: &searchparams.HybridSearch{NearSparseVectorParams: &searchparams.NearSparseVector{Indices: []uint32{3, 17}, Values: []float32{0.5, 1.5}, TargetVector: "splade"}, SubSearches: ss, Type: "hybrid", Alpha: 0.75, FusionAlgorithm: 1},
+		},
+		{
+			input: map[string]interface{}{"searches": []interface{}{map[string]interface{}{"nearSparseVector": map[string]interface{}{"indices": []interface{}{-1}, "values": []interface{}{0.5}}}}},
+			error: true,
+		},
+		{
+			input:  map[string]interface{}{"query": `"apple pie"`, "slop": 3},
+			output: &searchparams.HybridSearch{Query: `"apple pie"`, Slop: 3, SubSearches: ss, Type: "hybrid", Alpha: 0.75, FusionAlgorithm: 1},
+		},
 	}
 
 	for _, tt := range cases {
@@ -44,6 +44,10 @@ func NearVectorFields(prefix string, addTarget bool) graphql.InputObjectConfigFi
 			Description: "Vector per target",
 			Type:        vectorPerTarget,
 		},
+		"multiVector": &graphql.InputObjectFieldConfig{
+			Description: descriptions.MultiVector,
+			Type:        graphql.NewList(graphql.NewList(graphql.Float)),
+		},
 		"certainty": &graphql.InputObjectFieldConfig{
 			Description: descriptions.Certainty,
 			Type:        graphql.Float,
@@ -52,6 +56,10 @@ func NearVectorFields(prefix string, addTarget bool) graphql.InputObjectConfigFi
 			Description: descriptions.Distance,
 			Type:        graphql.Float,
 		},
+		"distanceMetric": &graphql.InputObjectFieldConfig{
+			Description: descriptions.DistanceMetric,
+			Type:        graphql.String,
+		},
 		"targetVectors": &graphql.InputObjectFieldConfig{
 			Description: "Target vectors",
 			Type:        graphql.NewList(graphql.String),
@@ -100,6 +108,35 @@ func nearObjectFields(prefix string, addTarget bool) graphql.InputObjectConfigFi
 	return fieldMap
 }
 
+func NearSparseVectorArgument(argumentPrefix, className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("%s%s", argumentPrefix, className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sNearSparseVectorInpObj", prefix),
+				Fields: NearSparseVectorFields(),
+			},
+		),
+	}
+}
+
+func NearSparseVectorFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"indices": &graphql.InputObjectFieldConfig{
+			Description: descriptions.SparseIndices,
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.Int))),
+		},
+		"values": &graphql.InputObjectFieldConfig{
+			Description: descriptions.SparseValues,
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.Float))),
+		},
+		"targetVector": &graphql.InputObjectFieldConfig{
+			Description: descriptions.SparseTargetVector,
+			Type:        graphql.String,
+		},
+	}
+}
+
 var vectorPerTarget = graphql.NewScalar(graphql.ScalarConfig{
 	Name:        "VectorPerTarget",
 	Description: "A custom scalar type for a map with strings as keys and list of floats as values",
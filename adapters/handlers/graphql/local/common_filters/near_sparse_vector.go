@@ -0,0 +1,52 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// ExtractNearSparseVector arguments, such as "indices" and "values"
+func ExtractNearSparseVector(source map[string]interface{}) (searchparams.NearSparseVector, error) {
+	var args searchparams.NearSparseVector
+
+	indices, ok := source["indices"].([]interface{})
+	if !ok {
+		return args, fmt.Errorf("indices is a required field")
+	}
+	values, ok := source["values"].([]interface{})
+	if !ok {
+		return args, fmt.Errorf("values is a required field")
+	}
+
+	args.Indices = make([]uint32, len(indices))
+	for i, value := range indices {
+		index, ok := value.(int)
+		if !ok || index < 0 {
+			return searchparams.NearSparseVector{}, fmt.Errorf("indices must be non-negative integers")
+		}
+		args.Indices[i] = uint32(index)
+	}
+
+	args.Values = make([]float32, len(values))
+	for i, value := range values {
+		args.Values[i] = float32(value.(float64))
+	}
+
+	if targetVector, ok := source["targetVector"].(string); ok {
+		args.TargetVector = targetVector
+	}
+
+	return args, nil
+}
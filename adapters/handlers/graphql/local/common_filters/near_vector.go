@@ -25,6 +25,18 @@ func ExtractNearVector(source map[string]interface{}, targetVectorsFromOtherLeve
 
 	vectorGQL, okVec := source["vector"].([]interface{})
 	vectorPerTarget, okVecPerTarget := source["vectorPerTarget"].(map[string][]float32)
+	multiVectorGQL, okMultiVec := source["multiVector"].([]interface{})
+	if okMultiVec {
+		if okVec || okVecPerTarget {
+			return searchparams.NearVector{}, nil,
+				fmt.Errorf("multiVector cannot be combined with vector or vectorPerTarget")
+		}
+		multiVector, err := parseMultiVector(multiVectorGQL)
+		if err != nil {
+			return searchparams.NearVector{}, nil, err
+		}
+		vectorGQL, okVec = multiVector, true
+	}
 	if (!okVec && !okVecPerTarget) || (okVec && okVecPerTarget) {
 		return searchparams.NearVector{}, nil,
 			fmt.Errorf("vector or vectorPerTarget is required field")
@@ -46,6 +58,10 @@ func ExtractNearVector(source map[string]interface{}, targetVectorsFromOtherLeve
 			fmt.Errorf("cannot provide distance and certainty")
 	}
 
+	if distanceMetric, ok := source["distanceMetric"].(string); ok {
+		args.DistanceMetric = distanceMetric
+	}
+
 	var targetVectors []string
 	var combination *dto.TargetCombination
 	if targetVectorsFromOtherLevel == nil {
@@ -100,5 +116,41 @@ func ExtractNearVector(source map[string]interface{}, targetVectorsFromOtherLeve
 		args.VectorPerTarget = vectorPerTarget
 	}
 
+	if okMultiVec && len(targetVectors) != 1 {
+		return searchparams.NearVector{}, nil,
+			fmt.Errorf("multiVector requires exactly one target vector")
+	}
+
 	return args, combination, nil
 }
+
+// parseMultiVector flattens a multi vector so that it can be passed on like
+// a single vector
+func parseMultiVector(multiVectorGQL []interface{}) ([]interface{}, error) {
+	if len(multiVectorGQL) == 0 {
+		return nil, fmt.Errorf("multiVector must not be empty")
+	}
+
+	multiVector := make([][]float32, len(multiVectorGQL))
+	for i, vectorGQL := range multiVectorGQL {
+		vector, ok := vectorGQL.([]interface{})
+		if !ok || len(vector) == 0 {
+			return nil, fmt.Errorf("multiVector: vector %d must be a non-empty list of floats", i)
+		}
+		if i > 0 && len(vector) != len(multiVector[0]) {
+			return nil, fmt.Errorf("multiVector: all vectors must have the same length, "+
+				"vector %d has length %d instead of %d", i, len(vector), len(multiVector[0]))
+		}
+		multiVector[i] = make([]float32, len(vector))
+		for j, value := range vector {
+			multiVector[i][j] = float32(value.(float64))
+		}
+	}
+
+	flattened := searchparams.FlattenMultiVector(multiVector)
+	out := make([]interface{}, len(flattened))
+	for i, value := range flattened {
+		out[i] = float64(value)
+	}
+	return out, nil
+}
@@ -371,6 +371,46 @@ func TestExtractNearVector(t *testing.T) {
 		resolver := newMockResolver(t, mockParams{reportNearVector: true})
 		resolver.AssertFailToResolve(t, query)
 	})
+	t.Run("with multi vector provided", func(t *testing.T) {
+		t.Parallel()
+
+		query := `{ SomeAction(nearVector: {multiVector: [[1, 2], [3, 4]], targetVectors: ["colbert"]})}`
+		expectedparams := searchparams.NearVector{
+			VectorPerTarget: map[string][]float32{"colbert": {2, 1, 2, 3, 4}},
+			TargetVectors:   []string{"colbert"},
+		}
+
+		resolver := newMockResolver(t, mockParams{reportNearVector: true})
+
+		resolver.On("ReportNearVector", expectedparams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with multi vector and no target vector", func(t *testing.T) {
+		t.Parallel()
+
+		query := `{ SomeAction(nearVector: {multiVector: [[1, 2], [3, 4]]})}`
+		resolver := newMockResolver(t, mockParams{reportNearVector: true})
+		resolver.AssertFailToResolve(t, query)
+	})
+
+	t.Run("with multi vector of differing lengths", func(t *testing.T) {
+		t.Parallel()
+
+		query := `{ SomeAction(nearVector: {multiVector: [[1, 2], [3]], targetVectors: ["colbert"]})}`
+		resolver := newMockResolver(t, mockParams{reportNearVector: true})
+		resolver.AssertFailToResolve(t, query)
+	})
+
+	t.Run("with multi vector and vector", func(t *testing.T) {
+		t.Parallel()
+
+		query := `{ SomeAction(nearVector: {vector: [1, 2], multiVector: [[1, 2]], targetVectors: ["colbert"]})}`
+		resolver := newMockResolver(t, mockParams{reportNearVector: true})
+		resolver.AssertFailToResolve(t, query)
+	})
 }
 
 func TestExtractNearObject(t *testing.T) {
@@ -21,6 +21,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 type classBuilder struct {
@@ -176,6 +177,7 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["lastUpdateTimeUnix"] = b.additionalLastUpdateTimeUnix()
 	additionalProperties["score"] = b.additionalScoreField()
 	additionalProperties["explainScore"] = b.additionalExplainScoreField()
+	additionalProperties["highlights"] = b.additionalHighlightsField(class)
 	additionalProperties["group"] = b.additionalGroupField(classProperties, class)
 	if replicationEnabled(class) {
 		additionalProperties["isConsistent"] = b.isConsistentField()
@@ -237,10 +239,14 @@ func (b *classBuilder) additionalVectorField(class *models.Class) *graphql.Field
 func (b *classBuilder) additionalVectorsField(class *models.Class) *graphql.Field {
 	if len(class.VectorConfig) > 0 {
 		fields := graphql.Fields{}
-		for targetVector := range class.VectorConfig {
+		for targetVector, vectorConfig := range class.VectorConfig {
+			var fieldType graphql.Output = graphql.NewList(graphql.Float)
+			if hnsw.IsMultivector(vectorConfig.VectorIndexConfig) {
+				fieldType = graphql.NewList(graphql.NewList(graphql.Float))
+			}
 			fields[targetVector] = &graphql.Field{
 				Name: fmt.Sprintf("%sAdditionalVectors%s", class.Class, targetVector),
-				Type: graphql.NewList(graphql.Float),
+				Type: fieldType,
 			}
 		}
 		return &graphql.Field{
@@ -273,6 +279,29 @@ func (b *classBuilder) additionalExplainScoreField() *graphql.Field {
 	}
 }
 
+func (b *classBuilder) additionalHighlightsField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.Highlights,
+		Args: graphql.FieldConfigArgument{
+			"fragmentSize": &graphql.ArgumentConfig{
+				Description: descriptions.HighlightsFragmentSize,
+				Type:        graphql.Int,
+			},
+			"fragments": &graphql.ArgumentConfig{
+				Description: descriptions.HighlightsFragments,
+				Type:        graphql.Int,
+			},
+		},
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalHighlights", class.Class),
+			Fields: graphql.Fields{
+				"property":  &graphql.Field{Type: graphql.String},
+				"fragments": &graphql.Field{Type: graphql.NewList(graphql.String)},
+			},
+		})),
+	}
+}
+
 func (b *classBuilder) additionalLastUpdateTimeUnix() *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.String,
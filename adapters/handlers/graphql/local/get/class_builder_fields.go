@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	moduleadditional "github.com/weaviate/weaviate/usecases/modulecomponents/additional"
@@ -227,12 +228,13 @@ func buildGetClassField(classObject *graphql.Object,
 				Type:        graphql.Int,
 			},
 
-			"sort":       sortArgument(class.Class),
-			"nearVector": nearVectorArgument(class.Class),
-			"nearObject": nearObjectArgument(class.Class),
-			"where":      whereArgument(class.Class),
-			"group":      groupArgument(class.Class),
-			"groupBy":    groupByArgument(class.Class),
+			"sort":             sortArgument(class.Class),
+			"nearVector":       nearVectorArgument(class.Class),
+			"nearObject":       nearObjectArgument(class.Class),
+			"nearSparseVector": nearSparseVectorArgument(class.Class),
+			"where":            whereArgument(class.Class),
+			"group":            groupArgument(class.Class),
+			"groupBy":          groupByArgument(class.Class),
 		},
 		Resolve: newResolver(modulesProvider).makeResolveGetClass(class.Class),
 	}
@@ -392,6 +394,15 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		targetVectorCombination = targetCombination
 	}
 
+	var nearSparseVectorParams *searchparams.NearSparseVector
+	if nearSparseVector, ok := p.Args["nearSparseVector"]; ok {
+		p, err := common_filters.ExtractNearSparseVector(nearSparseVector.(map[string]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract nearSparseVector params: %s", err)
+		}
+		nearSparseVectorParams = &p
+	}
+
 	var moduleParams map[string]interface{}
 	if r.modulesProvider != nil {
 		extractedParams, extractedCombinations := r.modulesProvider.ExtractSearchParams(p.Args, className)
@@ -460,6 +471,7 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		Sort:                    sort,
 		NearVector:              nearVectorParams,
 		NearObject:              nearObjectParams,
+		NearSparseVector:        nearSparseVectorParams,
 		Group:                   group,
 		ModuleParams:            moduleParams,
 		AdditionalProperties:    addlProps,
@@ -578,7 +590,7 @@ func (ac *additionalCheck) isAdditional(parentName, name string) bool {
 			name == "distance" || name == "id" || name == "vector" || name == "vectors" ||
 			name == "creationTimeUnix" || name == "lastUpdateTimeUnix" ||
 			name == "score" || name == "explainScore" || name == "isConsistent" ||
-			name == "group" {
+			name == "group" || name == "highlights" {
 			return true
 		}
 		if ac.isModuleAdditional(name) {
@@ -588,6 +600,26 @@ func (ac *additionalCheck) isAdditional(parentName, name string) bool {
 	return false
 }
 
+func extractHighlightParams(args []*ast.Argument) *additional.HighlightParams {
+	params := &additional.HighlightParams{}
+	for _, arg := range args {
+		value, ok := arg.Value.(*ast.IntValue)
+		if !ok {
+			continue
+		}
+		asInt, _ := strconv.Atoi(value.Value)
+		switch arg.Name.Value {
+		case "fragmentSize":
+			params.FragmentSize = asInt
+		case "fragments":
+			params.Fragments = asInt
+		default:
+			// ignore what we don't recognize
+		}
+	}
+	return params
+}
+
 func (ac *additionalCheck) isModuleAdditional(name string) bool {
 	if ac.modulesProvider != nil {
 		if len(ac.modulesProvider.GraphQLAdditionalFieldNames()) > 0 {
@@ -692,6 +724,10 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.IsConsistent = true
 							continue
 						}
+						if additionalProperty == "highlights" {
+							additionalProps.Highlights = extractHighlightParams(s.Arguments)
+							continue
+						}
 						if additionalProperty == "group" {
 							additionalProps.Group = true
 							additionalGroupHitProperties, err := extractGroupHitProperties(className, additionalProps, subSelection, fragments, modulesProvider)
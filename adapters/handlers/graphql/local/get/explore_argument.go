@@ -28,6 +28,10 @@ func nearObjectArgument(className string) *graphql.ArgumentConfig {
 	return common_filters.NearObjectArgument("GetObjects", className, true)
 }
 
+func nearSparseVectorArgument(className string) *graphql.ArgumentConfig {
+	return common_filters.NearSparseVectorArgument("GetObjects", className)
+}
+
 func nearTextFields(prefix string) graphql.InputObjectConfigFieldMap {
 	nearTextFields := graphql.InputObjectConfigFieldMap{
 		"concepts": &graphql.InputObjectFieldConfig{
@@ -1624,6 +1624,41 @@ func TestGetRelation(t *testing.T) {
 	})
 }
 
+func TestNearSparseVector(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("with target vector", func(t *testing.T) {
+		query := `{ Get { SomeAction(
+								nearSparseVector: {
+									indices: [3, 17]
+									values: [0.5, 1.25]
+									targetVector: "splade"
+								}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearSparseVector: &searchparams.NearSparseVector{
+				Indices:      []uint32{3, 17},
+				Values:       []float32{0.5, 1.25},
+				TargetVector: "splade",
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with missing values", func(t *testing.T) {
+		query := `{ Get { SomeAction(nearSparseVector: { indices: [3] }) { intField } } }`
+		resolver.AssertFailToResolve(t, query)
+	})
+}
+
 func TestNearObject(t *testing.T) {
 	t.Parallel()
 
@@ -1891,6 +1926,90 @@ func TestBM25WithSort(t *testing.T) {
 	resolver.AssertFailToResolve(t, query, "bm25 search is not compatible with sort")
 }
 
+func TestBM25WithPhraseSlop(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithNoModules()
+	query := `{Get{SomeAction(bm25:{query:"\"apple pie\"",properties:["name"],slop:2}){intField}}}`
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		KeywordRanking: &searchparams.KeywordRanking{
+			Type:       "bm25",
+			Query:      `"apple pie"`,
+			Properties: []string{"name"},
+			Slop:       2,
+		},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
+}
+
+func TestBM25WithFuzzy(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithNoModules()
+	query := `{Get{SomeAction(bm25:{query:"appel",fuzzy:{maxEdits:2,prefixLength:1}}){intField}}}`
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		KeywordRanking: &searchparams.KeywordRanking{
+			Type:  "bm25",
+			Query: "appel",
+			Fuzzy: &searchparams.Fuzziness{MaxEdits: 2, PrefixLength: 1},
+		},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
+}
+
+func TestBM25WithHighlights(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithNoModules()
+	query := `{Get{SomeAction(bm25:{query:"apple"}){intField _additional{highlights(fragmentSize:50,fragments:2){property fragments}}}}}`
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		KeywordRanking: &searchparams.KeywordRanking{
+			Type:  "bm25",
+			Query: "apple",
+		},
+		AdditionalProperties: additional.Properties{
+			Highlights: &additional.HighlightParams{FragmentSize: 50, Fragments: 2},
+		},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{
+			map[string]interface{}{
+				"intField": 1,
+				"_additional": map[string]interface{}{
+					"highlights": []additional.Highlight{
+						{Property: "name", Fragments: []string{"an <em>apple</em> a day"}},
+					},
+				},
+			},
+		}, nil).Once()
+
+	result := resolver.AssertResolve(t, query)
+	expected := map[string]interface{}{
+		"intField": 1,
+		"_additional": map[string]interface{}{
+			"highlights": []interface{}{
+				map[string]interface{}{
+					"property":  "name",
+					"fragments": []interface{}{"an <em>apple</em> a day"},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, result.Get("Get", "SomeAction").Result.([]interface{})[0])
+}
+
 func TestHybridWithSort(t *testing.T) {
 	t.Parallel()
 	resolver := newMockResolverWithNoModules()
@@ -2115,6 +2234,26 @@ func TestNearVectorNoModules(t *testing.T) {
 		resolver.AssertResolve(t, query)
 	})
 
+	t.Run("for things with distance metric override", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+								vector: [0.123, 0.984]
+								distanceMetric: "dot"
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				VectorPerTarget: map[string][]float32{"": {0.123, 0.984}},
+				DistanceMetric:  "dot",
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
 	t.Run("for things with optional certainty set", func(t *testing.T) {
 		query := `{ Get { SomeThing(nearVector: {
 								vector: [0.123, 0.984]
@@ -63,6 +63,10 @@ func hybridOperands(classObject *graphql.Object,
 			Description: "Which properties should be included in the sparse search",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"slop": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Slop,
+			Type:        graphql.Int,
+		},
 		"fusionType": &graphql.InputObjectFieldConfig{
 			Description: "Algorithm used for fusing results from vector and keyword search",
 			Type:        fusionEnum,
@@ -102,6 +106,16 @@ func hybridOperands(classObject *graphql.Object,
 									},
 								),
 							},
+							"nearSparseVector": &graphql.InputObjectFieldConfig{
+								Description: "nearSparseVector element",
+								Type: graphql.NewInputObject(
+									graphql.InputObjectConfig{
+										Name:        fmt.Sprintf("%sNearSparseVectorInpObj", prefixName),
+										Description: "Sparse vector search, replaces bm25 as the keyword side",
+										Fields:      common_filters.NearSparseVectorFields(),
+									},
+								),
+							},
 						}
 						for key, fieldConfig := range fieldMap {
 							subSearchFields[key] = fieldConfig
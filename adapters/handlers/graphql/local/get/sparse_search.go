@@ -15,15 +15,35 @@ import (
 	"fmt"
 
 	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
 )
 
 func bm25Argument(className string) *graphql.ArgumentConfig {
 	prefix := fmt.Sprintf("GetObjects%s", className)
+	fields := bm25Fields(prefix)
+	fields["fuzzy"] = &graphql.InputObjectFieldConfig{
+		Description: descriptions.Fuzzy,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sBm25FuzzyInpObj", prefix),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"maxEdits": &graphql.InputObjectFieldConfig{
+						Description: descriptions.FuzzyMaxEdits,
+						Type:        graphql.NewNonNull(graphql.Int),
+					},
+					"prefixLength": &graphql.InputObjectFieldConfig{
+						Description: descriptions.FuzzyPrefixLength,
+						Type:        graphql.Int,
+					},
+				},
+			},
+		),
+	}
 	return &graphql.ArgumentConfig{
 		Type: graphql.NewInputObject(
 			graphql.InputObjectConfig{
 				Name:   fmt.Sprintf("%sHybridGetBm25InpObj", prefix),
-				Fields: bm25Fields(prefix),
+				Fields: fields,
 			},
 		),
 	}
@@ -39,5 +59,9 @@ func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: "The properties to search in",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"slop": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Slop,
+			Type:        graphql.Int,
+		},
 	}
 }
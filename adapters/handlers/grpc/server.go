@@ -53,6 +53,8 @@ func CreateGRPCServer(state *state.State) *GRPCServer {
 	if state.ServerConfig.Config.Sentry.Enabled {
 		o = append(o, grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			grpc_sentry.UnaryServerInterceptor(),
+		)), grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			grpc_sentry.StreamServerInterceptor(),
 		)))
 	}
 
@@ -20,6 +20,7 @@ import (
 
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 )
 
@@ -82,27 +83,71 @@ func batchFromProto(req *pb.BatchObjectsRequest, getClass func(string) *models.C
 		}
 
 		var vectors models.Vectors = nil
+		var multiVectors models.MultiVectors = nil
+		var sparseVectors models.SparseVectors = nil
 		if len(obj.Vectors) > 0 {
-			vectors = make(models.Vectors, len(obj.Vectors))
 			for _, vec := range obj.Vectors {
+				if vec.Type == pb.Vectors_VECTOR_TYPE_MULTI_FP32 {
+					multiVector, err := byteops.Float32FromByteMultiVector(vec.VectorBytes)
+					if err != nil {
+						objectErrors[i] = fmt.Errorf("multi vector %s: %w", vec.Name, err)
+						break
+					}
+					if multiVectors == nil {
+						multiVectors = make(models.MultiVectors)
+					}
+					multiVectors[vec.Name] = toModelMultiVector(multiVector)
+					continue
+				}
+				if vec.Type == pb.Vectors_VECTOR_TYPE_SPARSE_FP32 {
+					sparseVector, err := sparse.Decode(vec.VectorBytes)
+					if err != nil {
+						objectErrors[i] = fmt.Errorf("sparse vector %s: %w", vec.Name, err)
+						break
+					}
+					if sparseVectors == nil {
+						sparseVectors = make(models.SparseVectors)
+					}
+					sparseVectors[vec.Name] = models.SparseVector{
+						Indices: sparseVector.Indices,
+						Values:  sparseVector.Values,
+					}
+					continue
+				}
+				if vectors == nil {
+					vectors = make(models.Vectors, len(obj.Vectors))
+				}
 				vectors[vec.Name] = byteops.Float32FromByteVector(vec.VectorBytes)
 			}
+			if objectErrors[i] != nil {
+				continue
+			}
 		}
 
 		objOriginalIndex[insertCounter] = i
 		objs = append(objs, &models.Object{
-			Class:      obj.Collection,
-			Tenant:     obj.Tenant,
-			Vector:     vector,
-			Properties: props,
-			ID:         strfmt.UUID(obj.Uuid),
-			Vectors:    vectors,
+			Class:         obj.Collection,
+			Tenant:        obj.Tenant,
+			Vector:        vector,
+			Properties:    props,
+			ID:            strfmt.UUID(obj.Uuid),
+			Vectors:       vectors,
+			MultiVectors:  multiVectors,
+			SparseVectors: sparseVectors,
 		})
 		insertCounter += 1
 	}
 	return objs[:insertCounter], objOriginalIndex, objectErrors
 }
 
+func toModelMultiVector(vectors [][]float32) models.MultiVector {
+	out := make(models.MultiVector, len(vectors))
+	for i, vector := range vectors {
+		out[i] = vector
+	}
+	return out
+}
+
 func extractSingleRefTarget(class *models.Class, properties []*pb.BatchObject_SingleTargetRefProps, props map[string]interface{}) error {
 	for _, refSingle := range properties {
 		propName := refSingle.GetPropName()
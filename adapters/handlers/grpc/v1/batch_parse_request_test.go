@@ -17,7 +17,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
 )
 
 const (
@@ -137,6 +139,45 @@ func TestGRPCBatchRequest(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "Multi vector",
+			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, Vectors: []*pb.Vectors{
+				{
+					Name:        "custom",
+					VectorBytes: byteVector([]float32{0.1, 0.2, 0.3}),
+				},
+				{
+					Name:        "colbert",
+					VectorBytes: byteops.Float32ToByteMultiVector([][]float32{{0.1, 0.2}, {0.3, 0.4}}),
+					Type:        pb.Vectors_VECTOR_TYPE_MULTI_FP32,
+				},
+			}}},
+			out: []*models.Object{{
+				Class: collection, ID: UUID4, Properties: nilMap,
+				Vectors: map[string]models.Vector{
+					"custom": []float32{0.1, 0.2, 0.3},
+				},
+				MultiVectors: models.MultiVectors{
+					"colbert": {{0.1, 0.2}, {0.3, 0.4}},
+				},
+			}},
+		},
+		{
+			name: "Sparse vector",
+			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, Vectors: []*pb.Vectors{
+				{
+					Name:        "splade",
+					VectorBytes: sparse.Vector{Indices: []uint32{3, 17}, Values: []float32{0.5, 1.5}}.Encode(),
+					Type:        pb.Vectors_VECTOR_TYPE_SPARSE_FP32,
+				},
+			}}},
+			out: []*models.Object{{
+				Class: collection, ID: UUID4, Properties: nilMap,
+				SparseVectors: models.SparseVectors{
+					"splade": {Indices: []uint32{3, 17}, Values: []float32{0.5, 1.5}},
+				},
+			}},
+		},
 		{
 			name: "only mult ref",
 			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, Properties: &pb.BatchObject_Properties{
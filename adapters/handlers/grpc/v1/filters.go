@@ -70,6 +70,8 @@ func extractFilters(filterIn *pb.Filters, getClass func(string) *models.Class, c
 			returnFilter.Operator = filters.ContainsAny
 		case pb.Filters_OPERATOR_CONTAINS_ALL:
 			returnFilter.Operator = filters.ContainsAll
+		case pb.Filters_OPERATOR_FUZZY:
+			returnFilter.Operator = filters.OperatorFuzzy
 		default:
 			return filters.Clause{}, fmt.Errorf("unknown filter operator %v", filterIn.Operator)
 		}
@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+func aggregateParamsFromProto(req *pb.AggregateRequest, getClass func(string) *models.Class) (*aggregation.Params, error) {
+	// the class name is used for authorization, it has to be spelled the same
+	// way as in all other requests
+	className := schema.UppercaseClassName(req.Collection)
+	class := getClass(className)
+	if class == nil {
+		return nil, fmt.Errorf("could not find class %s in schema", className)
+	}
+
+	out := &aggregation.Params{
+		ClassName:        schema.ClassName(className),
+		Tenant:           req.Tenant,
+		IncludeMetaCount: req.ObjectsCount,
+	}
+
+	properties, err := extractAggregations(req.Aggregations, class)
+	if err != nil {
+		return nil, errors.Wrap(err, "extract aggregations")
+	}
+	out.Properties = properties
+
+	if req.GroupBy != nil {
+		out.GroupBy, err = extractAggregateGroupBy(req.GroupBy, className)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Limit != nil {
+		limit := int(*req.Limit)
+		out.Limit = &limit
+	}
+
+	if req.ObjectLimit != nil {
+		if *req.ObjectLimit == 0 {
+			return nil, fmt.Errorf("object_limit must be a positive integer")
+		}
+		objectLimit := int(*req.ObjectLimit)
+		out.ObjectLimit = &objectLimit
+	}
+
+	switch search := req.Search.(type) {
+	case *pb.AggregateRequest_Hybrid:
+		targetVectors, err := extractAggregateTargetVectors(search.Hybrid.Targets, search.Hybrid.TargetVectors, class)
+		if err != nil {
+			return nil, errors.Wrap(err, "extract target vectors")
+		}
+		out.Hybrid, err = extractHybridSearch(search.Hybrid, className, 0, targetVectors)
+		if err != nil {
+			return nil, err
+		}
+		if out.Hybrid.NearTextParams != nil && out.Hybrid.NearVectorParams != nil {
+			return nil, errors.New("cannot combine nearText and nearVector in hybrid search")
+		}
+		if out.Hybrid.NearTextParams != nil && out.Hybrid.Vector != nil {
+			return nil, errors.New("cannot combine nearText and vector in hybrid search")
+		}
+		if out.Hybrid.NearVectorParams != nil && out.Hybrid.Vector != nil {
+			return nil, errors.New("cannot combine nearVector and vector in hybrid search")
+		}
+	case *pb.AggregateRequest_NearVector:
+		targetVectors, err := extractAggregateTargetVectors(search.NearVector.Targets, search.NearVector.TargetVectors, class)
+		if err != nil {
+			return nil, errors.Wrap(err, "extract target vectors")
+		}
+		out.NearVector, err = extractNearVector(search.NearVector, targetVectors)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// we might support object_limit without a vector search later, e.g. with sort
+	if out.ObjectLimit != nil && out.NearVector == nil && out.Hybrid == nil {
+		return nil, fmt.Errorf("object_limit can only be used with a near_vector or hybrid search")
+	}
+
+	if req.Filters != nil {
+		clause, err := extractFilters(req.Filters, getClass, className)
+		if err != nil {
+			return nil, err
+		}
+		filter := &filters.LocalFilter{Root: &clause}
+		if err := filters.ValidateFilters(getClass, filter); err != nil {
+			return nil, err
+		}
+		out.Filters = filter
+	}
+
+	return out, nil
+}
+
+// extractAggregateTargetVectors resolves the target vector of a vector search.
+// Aggregations run against a single vector, so combining targets is not supported.
+func extractAggregateTargetVectors(targets *pb.Targets, deprecatedTargetVectors []string, class *models.Class) ([]string, error) {
+	targetVectors := deprecatedTargetVectors
+	if targets != nil {
+		targetVectors = targets.TargetVectors
+	}
+
+	targetVectors, _, _, err := resolveTargetVectors(class, targetVectors, targets, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(targetVectors) > 1 {
+		return nil, fmt.Errorf("aggregate supports a single target vector, received %v", targetVectors)
+	}
+
+	return targetVectors, nil
+}
+
+func extractAggregateGroupBy(groupBy *pb.AggregateRequest_GroupBy, className string) (*filters.Path, error) {
+	if groupBy.Property == "" {
+		return nil, fmt.Errorf("group_by: property is required")
+	}
+
+	collection := groupBy.Collection
+	if collection == "" {
+		collection = className
+	}
+
+	return &filters.Path{
+		Class:    schema.ClassName(schema.UppercaseClassName(collection)),
+		Property: schema.PropertyName(schema.LowercaseFirstLetter(groupBy.Property)),
+	}, nil
+}
+
+func extractAggregations(aggregations []*pb.AggregateRequest_Aggregation, class *models.Class) ([]aggregation.ParamProperty, error) {
+	out := make([]aggregation.ParamProperty, 0, len(aggregations))
+	for _, agg := range aggregations {
+		name := schema.LowercaseFirstLetter(agg.Property)
+		if _, err := schema.GetPropertyByName(class, name); err != nil {
+			return nil, err
+		}
+
+		var aggregators []aggregation.Aggregator
+		switch a := agg.Aggregation.(type) {
+		case *pb.AggregateRequest_Aggregation_Int:
+			aggregators = numericalAggregators(a.Int.Count, a.Int.Type, a.Int.Sum, a.Int.Mean,
+				a.Int.Mode, a.Int.Median, a.Int.Maximum, a.Int.Minimum)
+		case *pb.AggregateRequest_Aggregation_Number_:
+			aggregators = numericalAggregators(a.Number.Count, a.Number.Type, a.Number.Sum, a.Number.Mean,
+				a.Number.Mode, a.Number.Median, a.Number.Maximum, a.Number.Minimum)
+		case *pb.AggregateRequest_Aggregation_Text_:
+			aggregators = appendIf(aggregators, a.Text.Count, aggregation.CountAggregator)
+			aggregators = appendIf(aggregators, a.Text.Type, aggregation.TypeAggregator)
+			if a.Text.TopOccurences {
+				var limit *int
+				if a.Text.TopOccurencesLimit != nil {
+					l := int(*a.Text.TopOccurencesLimit)
+					limit = &l
+				} else {
+					// same default as the GraphQL API
+					l := 5
+					limit = &l
+				}
+				aggregators = append(aggregators, aggregation.NewTopOccurrencesAggregator(limit))
+			}
+		case *pb.AggregateRequest_Aggregation_Boolean_:
+			aggregators = appendIf(aggregators, a.Boolean.Count, aggregation.CountAggregator)
+			aggregators = appendIf(aggregators, a.Boolean.Type, aggregation.TypeAggregator)
+			aggregators = appendIf(aggregators, a.Boolean.TotalTrue, aggregation.TotalTrueAggregator)
+			aggregators = appendIf(aggregators, a.Boolean.TotalFalse, aggregation.TotalFalseAggregator)
+			aggregators = appendIf(aggregators, a.Boolean.PercentageTrue, aggregation.PercentageTrueAggregator)
+			aggregators = appendIf(aggregators, a.Boolean.PercentageFalse, aggregation.PercentageFalseAggregator)
+		case *pb.AggregateRequest_Aggregation_Date_:
+			aggregators = appendIf(aggregators, a.Date.Count, aggregation.CountAggregator)
+			aggregators = appendIf(aggregators, a.Date.Type, aggregation.TypeAggregator)
+			aggregators = appendIf(aggregators, a.Date.Median, aggregation.MedianAggregator)
+			aggregators = appendIf(aggregators, a.Date.Mode, aggregation.ModeAggregator)
+			aggregators = appendIf(aggregators, a.Date.Maximum, aggregation.MaximumAggregator)
+			aggregators = appendIf(aggregators, a.Date.Minimum, aggregation.MinimumAggregator)
+		case *pb.AggregateRequest_Aggregation_Reference_:
+			aggregators = appendIf(aggregators, a.Reference.Type, aggregation.TypeAggregator)
+			aggregators = appendIf(aggregators, a.Reference.PointingTo, aggregation.PointingToAggregator)
+		default:
+			return nil, fmt.Errorf("property %s: unknown aggregation %T", agg.Property, agg.Aggregation)
+		}
+
+		out = append(out, aggregation.ParamProperty{
+			Name:        schema.PropertyName(name),
+			Aggregators: aggregators,
+		})
+	}
+
+	return out, nil
+}
+
+func numericalAggregators(count, typ, sum, mean, mode, median, maximum, minimum bool) []aggregation.Aggregator {
+	var aggregators []aggregation.Aggregator
+	aggregators = appendIf(aggregators, count, aggregation.CountAggregator)
+	aggregators = appendIf(aggregators, typ, aggregation.TypeAggregator)
+	aggregators = appendIf(aggregators, sum, aggregation.SumAggregator)
+	aggregators = appendIf(aggregators, mean, aggregation.MeanAggregator)
+	aggregators = appendIf(aggregators, mode, aggregation.ModeAggregator)
+	aggregators = appendIf(aggregators, median, aggregation.MedianAggregator)
+	aggregators = appendIf(aggregators, maximum, aggregation.MaximumAggregator)
+	aggregators = appendIf(aggregators, minimum, aggregation.MinimumAggregator)
+	return aggregators
+}
+
+func appendIf(aggregators []aggregation.Aggregator, ok bool, agg aggregation.Aggregator) []aggregation.Aggregator {
+	if ok {
+		return append(aggregators, agg)
+	}
+	return aggregators
+}
@@ -0,0 +1,297 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	vectorIndex "github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+func TestGRPCAggregateRequest(t *testing.T) {
+	classname := "TestClass"
+	multiVecClass := "MultiVecClass"
+	certainty := 0.8
+	objectLimit := uint32(10)
+	zero := uint32(0)
+	topLimit := uint32(3)
+
+	scheme := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: classname,
+					Properties: []*models.Property{
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+						{Name: "number", DataType: schema.DataTypeInt.PropString()},
+						{Name: "price", DataType: schema.DataTypeNumber.PropString()},
+						{Name: "available", DataType: schema.DataTypeBoolean.PropString()},
+						{Name: "released", DataType: schema.DataTypeDate.PropString()},
+					},
+					VectorIndexConfig: hnsw.UserConfig{Distance: vectorIndex.DefaultDistanceMetric},
+				},
+				{
+					Class: multiVecClass,
+					Properties: []*models.Property{
+						{Name: "first", DataType: schema.DataTypeText.PropString()},
+					},
+					VectorConfig: map[string]models.VectorConfig{
+						"first": {
+							VectorIndexType:   "hnsw",
+							VectorIndexConfig: hnsw.UserConfig{Distance: vectorIndex.DistanceCosine},
+							Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+						},
+						"second": {
+							VectorIndexType:   "hnsw",
+							VectorIndexConfig: hnsw.UserConfig{Distance: vectorIndex.DistanceCosine},
+							Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		req   *pb.AggregateRequest
+		out   *aggregation.Params
+		error bool
+	}{
+		{
+			name: "objects count only",
+			req:  &pb.AggregateRequest{Collection: classname, ObjectsCount: true, Tenant: "tenant"},
+			out: &aggregation.Params{
+				ClassName: schema.ClassName(classname), IncludeMetaCount: true, Tenant: "tenant",
+				Properties: []aggregation.ParamProperty{},
+			},
+		},
+		{
+			name: "lowercase collection name",
+			req:  &pb.AggregateRequest{Collection: "testClass", ObjectsCount: true},
+			out: &aggregation.Params{
+				ClassName: schema.ClassName(classname), IncludeMetaCount: true,
+				Properties: []aggregation.ParamProperty{},
+			},
+		},
+		{
+			name: "all aggregators",
+			req: &pb.AggregateRequest{
+				Collection: classname,
+				Aggregations: []*pb.AggregateRequest_Aggregation{
+					{Property: "number", Aggregation: &pb.AggregateRequest_Aggregation_Int{Int: &pb.AggregateRequest_Aggregation_Integer{Count: true, Sum: true, Mean: true, Mode: true, Median: true, Maximum: true, Minimum: true}}},
+					{Property: "price", Aggregation: &pb.AggregateRequest_Aggregation_Number_{Number: &pb.AggregateRequest_Aggregation_Number{Type: true, Mean: true}}},
+					{Property: "name", Aggregation: &pb.AggregateRequest_Aggregation_Text_{Text: &pb.AggregateRequest_Aggregation_Text{Count: true, TopOccurences: true, TopOccurencesLimit: &topLimit}}},
+					{Property: "available", Aggregation: &pb.AggregateRequest_Aggregation_Boolean_{Boolean: &pb.AggregateRequest_Aggregation_Boolean{TotalTrue: true, PercentageFalse: true}}},
+					{Property: "released", Aggregation: &pb.AggregateRequest_Aggregation_Date_{Date: &pb.AggregateRequest_Aggregation_Date{Minimum: true, Maximum: true}}},
+				},
+			},
+			out: &aggregation.Params{
+				ClassName: schema.ClassName(classname),
+				Properties: []aggregation.ParamProperty{
+					{Name: "number", Aggregators: []aggregation.Aggregator{
+						aggregation.CountAggregator, aggregation.SumAggregator, aggregation.MeanAggregator, aggregation.ModeAggregator,
+						aggregation.MedianAggregator, aggregation.MaximumAggregator, aggregation.MinimumAggregator,
+					}},
+					{Name: "price", Aggregators: []aggregation.Aggregator{aggregation.TypeAggregator, aggregation.MeanAggregator}},
+					{Name: "name", Aggregators: []aggregation.Aggregator{aggregation.CountAggregator, aggregation.NewTopOccurrencesAggregator(ptInt(3))}},
+					{Name: "available", Aggregators: []aggregation.Aggregator{aggregation.TotalTrueAggregator, aggregation.PercentageFalseAggregator}},
+					{Name: "released", Aggregators: []aggregation.Aggregator{aggregation.MaximumAggregator, aggregation.MinimumAggregator}},
+				},
+			},
+		},
+		{
+			name: "top occurrences default limit",
+			req: &pb.AggregateRequest{
+				Collection: classname,
+				Aggregations: []*pb.AggregateRequest_Aggregation{
+					{Property: "name", Aggregation: &pb.AggregateRequest_Aggregation_Text_{Text: &pb.AggregateRequest_Aggregation_Text{TopOccurences: true}}},
+				},
+			},
+			out: &aggregation.Params{
+				ClassName: schema.ClassName(classname),
+				Properties: []aggregation.ParamProperty{
+					{Name: "name", Aggregators: []aggregation.Aggregator{aggregation.NewTopOccurrencesAggregator(ptInt(5))}},
+				},
+			},
+		},
+		{
+			name: "unknown property",
+			req: &pb.AggregateRequest{
+				Collection: classname,
+				Aggregations: []*pb.AggregateRequest_Aggregation{
+					{Property: "nope", Aggregation: &pb.AggregateRequest_Aggregation_Int{Int: &pb.AggregateRequest_Aggregation_Integer{Count: true}}},
+				},
+			},
+			error: true,
+		},
+		{
+			name:  "unknown collection",
+			req:   &pb.AggregateRequest{Collection: "Nope"},
+			error: true,
+		},
+		{
+			name: "group by",
+			req: &pb.AggregateRequest{
+				Collection:   classname,
+				ObjectsCount: true,
+				GroupBy:      &pb.AggregateRequest_GroupBy{Property: "name"},
+				Limit:        &topLimit,
+			},
+			out: &aggregation.Params{
+				ClassName: schema.ClassName(classname), IncludeMetaCount: true,
+				Properties: []aggregation.ParamProperty{},
+				GroupBy:    &filters.Path{Class: schema.ClassName(classname), Property: "name"},
+				Limit:      ptInt(3),
+			},
+		},
+		{
+			name: "group by with lowercase collection",
+			req: &pb.AggregateRequest{
+				Collection:   classname,
+				ObjectsCount: true,
+				GroupBy:      &pb.AggregateRequest_GroupBy{Property: "Name", Collection: "testClass"},
+			},
+			out: &aggregation.Params{
+				ClassName: schema.ClassName(classname), IncludeMetaCount: true,
+				Properties: []aggregation.ParamProperty{},
+				GroupBy:    &filters.Path{Class: schema.ClassName(classname), Property: "name"},
+			},
+		},
+		{
+			name: "near vector",
+			req: &pb.AggregateRequest{
+				Collection:  classname,
+				ObjectLimit: &objectLimit,
+				Search: &pb.AggregateRequest_NearVector{NearVector: &pb.NearVector{
+					VectorBytes: byteVector([]float32{1, 2, 3}),
+					Certainty:   &certainty,
+				}},
+			},
+			out: &aggregation.Params{
+				ClassName:   schema.ClassName(classname),
+				Properties:  []aggregation.ParamProperty{},
+				ObjectLimit: ptInt(10),
+				NearVector: &searchparams.NearVector{
+					VectorPerTarget: map[string][]float32{"": {1, 2, 3}},
+					Certainty:       certainty,
+				},
+			},
+		},
+		{
+			name: "near vector with single target vector",
+			req: &pb.AggregateRequest{
+				Collection: multiVecClass,
+				Search: &pb.AggregateRequest_NearVector{NearVector: &pb.NearVector{
+					VectorBytes: byteVector([]float32{1, 2, 3}),
+					Targets:     &pb.Targets{TargetVectors: []string{"second"}},
+				}},
+			},
+			out: &aggregation.Params{
+				ClassName:  schema.ClassName(multiVecClass),
+				Properties: []aggregation.ParamProperty{},
+				NearVector: &searchparams.NearVector{
+					VectorPerTarget: map[string][]float32{"second": {1, 2, 3}},
+					TargetVectors:   []string{"second"},
+				},
+			},
+		},
+		{
+			name: "near vector with multiple target vectors",
+			req: &pb.AggregateRequest{
+				Collection: multiVecClass,
+				Search: &pb.AggregateRequest_NearVector{NearVector: &pb.NearVector{
+					VectorBytes: byteVector([]float32{1, 2, 3}),
+					Targets:     &pb.Targets{TargetVectors: []string{"first", "second"}},
+				}},
+			},
+			error: true,
+		},
+		{
+			name: "hybrid",
+			req: &pb.AggregateRequest{
+				Collection:  classname,
+				ObjectLimit: &objectLimit,
+				Search: &pb.AggregateRequest_Hybrid{Hybrid: &pb.Hybrid{
+					Query: "query", Alpha: 0.5, FusionType: pb.Hybrid_FUSION_TYPE_RANKED, Properties: []string{"Name"},
+				}},
+			},
+			out: &aggregation.Params{
+				ClassName:   schema.ClassName(classname),
+				Properties:  []aggregation.ParamProperty{},
+				ObjectLimit: ptInt(10),
+				Hybrid: &searchparams.HybridSearch{
+					Query: "query", Alpha: 0.5, FusionAlgorithm: common_filters.HybridRankedFusion, Properties: []string{"name"},
+				},
+			},
+		},
+		{
+			name:  "object limit without vector search",
+			req:   &pb.AggregateRequest{Collection: classname, ObjectLimit: &objectLimit},
+			error: true,
+		},
+		{
+			name: "zero object limit",
+			req: &pb.AggregateRequest{
+				Collection:  classname,
+				ObjectLimit: &zero,
+				Search:      &pb.AggregateRequest_Hybrid{Hybrid: &pb.Hybrid{Query: "query"}},
+			},
+			error: true,
+		},
+		{
+			name: "filters",
+			req: &pb.AggregateRequest{
+				Collection: classname,
+				Filters: &pb.Filters{
+					Operator:  pb.Filters_OPERATOR_EQUAL,
+					TestValue: &pb.Filters_ValueText{ValueText: "test"},
+					Target:    &pb.FilterTarget{Target: &pb.FilterTarget_Property{Property: "name"}},
+				},
+			},
+			out: &aggregation.Params{
+				ClassName:  schema.ClassName(classname),
+				Properties: []aggregation.ParamProperty{},
+				Filters: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorEqual,
+					Value:    &filters.Value{Value: "test", Type: schema.DataTypeText},
+					On:       &filters.Path{Class: schema.ClassName(classname), Property: "name"},
+				}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := aggregateParamsFromProto(tt.req, scheme.GetClass)
+			if tt.error {
+				require.NotNil(t, err)
+			} else {
+				require.Nil(t, err)
+				require.EqualValues(t, tt.out, out)
+			}
+		})
+	}
+}
+
+func ptInt(in int) *int {
+	return &in
+}
@@ -78,30 +78,21 @@ func searchParamsFromProto(req *pb.SearchRequest, getClass func(string) *models.
 	}
 
 	if bm25 := req.Bm25Search; bm25 != nil {
-		out.KeywordRanking = &searchparams.KeywordRanking{Query: bm25.Query, Properties: schema.LowercaseFirstLetterOfStrings(bm25.Properties), Type: "bm25", AdditionalExplanations: out.AdditionalProperties.ExplainScore}
+		out.KeywordRanking = &searchparams.KeywordRanking{Query: bm25.Query, Properties: schema.LowercaseFirstLetterOfStrings(bm25.Properties), Type: "bm25", AdditionalExplanations: out.AdditionalProperties.ExplainScore, Slop: int(bm25.Slop)}
+		if fuzzy := bm25.Fuzzy; fuzzy != nil {
+			out.KeywordRanking.Fuzzy = &searchparams.Fuzziness{MaxEdits: int(fuzzy.MaxEdits), PrefixLength: int(fuzzy.PrefixLength)}
+		}
 	}
 
 	if nv := req.NearVector; nv != nil {
-		out.NearVector, err = parseNearVec(nv, targetVectors)
+		out.NearVector, err = extractNearVector(nv, targetVectors)
 		if err != nil {
 			return dto.GetParams{}, err
 		}
+	}
 
-		// The following business logic should not sit in the API. However, it is
-		// also part of the GraphQL API, so we need to duplicate it in order to get
-		// the same behavior
-		if nv.Distance != nil && nv.Certainty != nil {
-			return out, fmt.Errorf("near_vector: cannot provide distance and certainty")
-		}
-
-		if nv.Certainty != nil {
-			out.NearVector.Certainty = *nv.Certainty
-		}
-
-		if nv.Distance != nil {
-			out.NearVector.Distance = *nv.Distance
-			out.NearVector.WithDistance = true
-		}
+	if nsv := req.NearSparseVector; nsv != nil {
+		out.NearSparseVector = extractNearSparseVector(nsv)
 	}
 
 	if no := req.NearObject; no != nil {
@@ -210,60 +201,10 @@ func searchParamsFromProto(req *pb.SearchRequest, getClass func(string) *models.
 
 	// Hybrid search now has the ability to run subsearches using the real nearvector and neartext searches.  So we need to extract those settings the same way we prepare for the real searches.
 	if hs := req.HybridSearch; hs != nil {
-		fusionType := common_filters.HybridFusionDefault
-		if hs.FusionType == pb.Hybrid_FUSION_TYPE_RANKED {
-			fusionType = common_filters.HybridRankedFusion
-		} else if hs.FusionType == pb.Hybrid_FUSION_TYPE_RELATIVE_SCORE {
-			fusionType = common_filters.HybridRelativeScoreFusion
-		}
-
-		var vector []float32
-		// bytes vector has precedent for being more efficient
-		if len(hs.VectorBytes) > 0 {
-			vector = byteops.Float32FromByteVector(hs.VectorBytes)
-		} else if len(hs.Vector) > 0 {
-			vector = hs.Vector
-		}
-
-		nearTxt, err := extractNearText(out.ClassName, out.Pagination.Limit, req.HybridSearch.NearText, targetVectors)
+		out.HybridSearch, err = extractHybridSearch(hs, out.ClassName, out.Pagination.Limit, targetVectors)
 		if err != nil {
 			return dto.GetParams{}, err
 		}
-		nearVec := req.HybridSearch.NearVector
-
-		out.HybridSearch = &searchparams.HybridSearch{
-			Query:           hs.Query,
-			Properties:      schema.LowercaseFirstLetterOfStrings(hs.Properties),
-			Vector:          vector,
-			Alpha:           float64(hs.Alpha),
-			FusionAlgorithm: fusionType,
-			TargetVectors:   targetVectors,
-		}
-
-		if nearVec != nil {
-			out.HybridSearch.NearVectorParams, err = parseNearVec(nearVec, targetVectors)
-			if err != nil {
-				return dto.GetParams{}, err
-			}
-
-			if nearVec.Distance != nil {
-				out.HybridSearch.NearVectorParams.Distance = *nearVec.Distance
-				out.HybridSearch.NearVectorParams.WithDistance = true
-			}
-			if nearVec.Certainty != nil {
-				out.HybridSearch.NearVectorParams.Certainty = *nearVec.Certainty
-			}
-		}
-
-		if nearTxt != nil {
-			out.HybridSearch.NearTextParams = &searchparams.NearTextParams{
-				Values:        nearTxt.Values,
-				Limit:         nearTxt.Limit,
-				MoveAwayFrom:  searchparams.ExploreMove{Force: nearTxt.MoveAwayFrom.Force, Values: nearTxt.MoveAwayFrom.Values},
-				MoveTo:        searchparams.ExploreMove{Force: nearTxt.MoveTo.Force, Values: nearTxt.MoveTo.Values},
-				TargetVectors: targetVectors,
-			}
-		}
 	}
 
 	var nearText *nearText2.NearTextParams
@@ -309,7 +250,7 @@ func searchParamsFromProto(req *pb.SearchRequest, getClass func(string) *models.
 	}
 
 	if len(req.SortBy) > 0 {
-		if req.NearText != nil || req.NearVideo != nil || req.NearAudio != nil || req.NearImage != nil || req.NearObject != nil || req.NearVector != nil || req.HybridSearch != nil || req.Bm25Search != nil || req.Generative != nil {
+		if req.NearText != nil || req.NearVideo != nil || req.NearAudio != nil || req.NearImage != nil || req.NearObject != nil || req.NearVector != nil || req.NearSparseVector != nil || req.HybridSearch != nil || req.Bm25Search != nil || req.Generative != nil {
 			return dto.GetParams{}, errors.New("sorting cannot be combined with search")
 		}
 		out.Sort = extractSorting(req.SortBy)
@@ -401,6 +342,12 @@ func extractTargetVectors(req *pb.SearchRequest, class *models.Class) ([]string,
 		targetVectors, targets, vectorSearch = extract(nv.Targets, &nv.TargetVectors)
 	}
 
+	return resolveTargetVectors(class, targetVectors, targets, vectorSearch)
+}
+
+// resolveTargetVectors builds the target combination and falls back to the
+// class' only named vector if a vector search did not name any targets.
+func resolveTargetVectors(class *models.Class, targetVectors []string, targets *pb.Targets, vectorSearch bool) ([]string, *dto.TargetCombination, bool, error) {
 	var combination *dto.TargetCombination
 	if targets != nil {
 		var err error
@@ -811,6 +758,13 @@ func extractAdditionalPropsFromMetadata(class *models.Class, prop *pb.MetadataRe
 		Vectors:            prop.Vectors,
 	}
 
+	if prop.Highlights != nil {
+		props.Highlights = &additional.HighlightParams{
+			FragmentSize: int(prop.Highlights.FragmentSize),
+			Fragments:    int(prop.Highlights.Fragments),
+		}
+	}
+
 	if vectorSearch && configvalidation.CheckCertaintyCompatibility(class, targetVectors) != nil {
 		props.Certainty = false
 	} else {
@@ -840,7 +794,8 @@ func isIdOnlyRequest(metadata *pb.MetadataRequest) bool {
 		!metadata.Certainty &&
 		!metadata.Score &&
 		!metadata.ExplainScore &&
-		!metadata.IsConsistent)
+		!metadata.IsConsistent &&
+		metadata.Highlights == nil)
 }
 
 func getAllNonRefNonBlobProperties(getClass func(string) *models.Class, className string) ([]search.SelectProperty, error) {
@@ -1068,6 +1023,112 @@ func parseNearIMU(n *pb.NearIMUSearch, targetVectors []string) (*nearImu.NearIMU
 	return out, nil
 }
 
+// extractHybridSearch converts the hybrid message, including the nearText,
+// nearVector and nearSparseVector subsearches, into the hybrid search params used by the traverser.
+func extractHybridSearch(hs *pb.Hybrid, className string, limit int, targetVectors []string) (*searchparams.HybridSearch, error) {
+	fusionType := common_filters.HybridFusionDefault
+	if hs.FusionType == pb.Hybrid_FUSION_TYPE_RANKED {
+		fusionType = common_filters.HybridRankedFusion
+	} else if hs.FusionType == pb.Hybrid_FUSION_TYPE_RELATIVE_SCORE {
+		fusionType = common_filters.HybridRelativeScoreFusion
+	}
+
+	var vector []float32
+	// bytes vector has precedent for being more efficient
+	if len(hs.VectorBytes) > 0 {
+		vector = byteops.Float32FromByteVector(hs.VectorBytes)
+	} else if len(hs.Vector) > 0 {
+		vector = hs.Vector
+	}
+
+	nearTxt, err := extractNearText(className, limit, hs.NearText, targetVectors)
+	if err != nil {
+		return nil, err
+	}
+	nearVec := hs.NearVector
+
+	out := &searchparams.HybridSearch{
+		Query:           hs.Query,
+		Slop:            int(hs.Slop),
+		Properties:      schema.LowercaseFirstLetterOfStrings(hs.Properties),
+		Vector:          vector,
+		Alpha:           float64(hs.Alpha),
+		FusionAlgorithm: fusionType,
+		TargetVectors:   targetVectors,
+	}
+
+	if nearVec != nil {
+		out.NearVectorParams, err = parseNearVec(nearVec, targetVectors)
+		if err != nil {
+			return nil, err
+		}
+
+		if nearVec.Distance != nil {
+			out.NearVectorParams.Distance = *nearVec.Distance
+			out.NearVectorParams.WithDistance = true
+		}
+		if nearVec.Certainty != nil {
+			out.NearVectorParams.Certainty = *nearVec.Certainty
+		}
+	}
+
+	if nsv := hs.NearSparseVector; nsv != nil {
+		out.NearSparseVectorParams = extractNearSparseVector(nsv)
+	}
+
+	if nearTxt != nil {
+		out.NearTextParams = &searchparams.NearTextParams{
+			Values:        nearTxt.Values,
+			Limit:         nearTxt.Limit,
+			MoveAwayFrom:  searchparams.ExploreMove{Force: nearTxt.MoveAwayFrom.Force, Values: nearTxt.MoveAwayFrom.Values},
+			MoveTo:        searchparams.ExploreMove{Force: nearTxt.MoveTo.Force, Values: nearTxt.MoveTo.Values},
+			TargetVectors: targetVectors,
+		}
+	}
+
+	return out, nil
+}
+
+func extractNearSparseVector(nsv *pb.NearSparseVector) *searchparams.NearSparseVector {
+	out := &searchparams.NearSparseVector{
+		Indices: nsv.Indices,
+		Values:  nsv.Values,
+	}
+	if nsv.TargetVector != nil {
+		out.TargetVector = *nsv.TargetVector
+	}
+	return out
+}
+
+func extractNearVector(nv *pb.NearVector, targetVectors []string) (*searchparams.NearVector, error) {
+	out, err := parseNearVec(nv, targetVectors)
+	if err != nil {
+		return nil, err
+	}
+
+	// The following business logic should not sit in the API. However, it is
+	// also part of the GraphQL API, so we need to duplicate it in order to get
+	// the same behavior
+	if nv.Distance != nil && nv.Certainty != nil {
+		return nil, fmt.Errorf("near_vector: cannot provide distance and certainty")
+	}
+
+	if nv.Certainty != nil {
+		out.Certainty = *nv.Certainty
+	}
+
+	if nv.Distance != nil {
+		out.Distance = *nv.Distance
+		out.WithDistance = true
+	}
+
+	if nv.DistanceMetric != nil {
+		out.DistanceMetric = *nv.DistanceMetric
+	}
+
+	return out, nil
+}
+
 func parseNearVec(nv *pb.NearVector, targetVectors []string) (*searchparams.NearVector, error) {
 	var vector []float32
 	// bytes vector has precedent for being more efficient
@@ -1081,6 +1142,13 @@ func parseNearVec(nv *pb.NearVector, targetVectors []string) (*searchparams.Near
 		return nil, fmt.Errorf("near_vector: either vector or VectorPerTarget must be provided, not both")
 	}
 
+	if len(nv.Vectors) > 0 {
+		if vector != nil || nv.VectorPerTarget != nil {
+			return nil, fmt.Errorf("near_vector: either vector, VectorPerTarget or vectors must be provided, not several")
+		}
+		return parseNearVecVectors(nv.Vectors, targetVectors)
+	}
+
 	targetVectorsTmp := targetVectors
 	if len(targetVectors) == 0 {
 		targetVectorsTmp = []string{""}
@@ -1112,6 +1180,48 @@ func parseNearVec(nv *pb.NearVector, targetVectors []string) (*searchparams.Near
 	}, nil
 }
 
+// parseNearVecVectors parses typed vectors, one per target. Multi vectors are
+// flattened, the multi vector index of the target splits them again.
+func parseNearVecVectors(vectors []*pb.Vectors, targetVectors []string) (*searchparams.NearVector, error) {
+	targetsPerVector := make(map[string][]float32, len(vectors))
+	for _, vec := range vectors {
+		if _, ok := targetsPerVector[vec.Name]; ok {
+			return nil, fmt.Errorf("near_vector: multiple vectors for target %s", vec.Name)
+		}
+		switch vec.Type {
+		case pb.Vectors_VECTOR_TYPE_MULTI_FP32:
+			multiVector, err := byteops.Float32FromByteMultiVector(vec.VectorBytes)
+			if err != nil {
+				return nil, fmt.Errorf("near_vector: multi vector for target %s: %w", vec.Name, err)
+			}
+			targetsPerVector[vec.Name] = searchparams.FlattenMultiVector(multiVector)
+		default:
+			targetsPerVector[vec.Name] = byteops.Float32FromByteVector(vec.VectorBytes)
+		}
+	}
+
+	if len(targetVectors) == 0 {
+		targetVectors = make([]string, 0, len(targetsPerVector))
+		for _, vec := range vectors {
+			targetVectors = append(targetVectors, vec.Name)
+		}
+	} else {
+		if len(targetsPerVector) != len(targetVectors) {
+			return nil, fmt.Errorf("near_vector: vectors must be provided for all targets")
+		}
+		for _, target := range targetVectors {
+			if _, ok := targetsPerVector[target]; !ok {
+				return nil, fmt.Errorf("near_vector: vector for target %s is required", target)
+			}
+		}
+	}
+
+	return &searchparams.NearVector{
+		VectorPerTarget: targetsPerVector,
+		TargetVectors:   targetVectors,
+	}, nil
+}
+
 // extractPropertiesForModules extracts properties that are needed by modules but are not requested by the user
 func extractPropertiesForModules(params *dto.GetParams) {
 	var additionalProps []string
@@ -52,6 +52,7 @@ func TestGRPCRequest(t *testing.T) {
 	multiVecClass := "MultiVecClass"
 	singleNamedVecClass := "SingleNamedVecClass"
 	one := float64(1.0)
+	dotDistance := "dot"
 
 	defaultTestClassProps := search.SelectProperties{{Name: "name", IsPrimitive: true}, {Name: "number", IsPrimitive: true}, {Name: "floats", IsPrimitive: true}, {Name: "uuid", IsPrimitive: true}}
 	defaultNamedVecProps := search.SelectProperties{{Name: "first", IsPrimitive: true}}
@@ -644,6 +645,65 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "near sparse vector",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				NearSparseVector: &pb.NearSparseVector{Indices: []uint32{3, 17}, Values: []float32{0.5, 1.5}},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				NearSparseVector:     &searchparams.NearSparseVector{Indices: []uint32{3, 17}, Values: []float32{0.5, 1.5}},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+			},
+			error: false,
+		},
+		{
+			name: "bm25 with phrase slop",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Bm25Search: &pb.BM25{Query: `"query words"`, Properties: []string{"name"}, Slop: 2},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking:       &searchparams.KeywordRanking{Query: `"query words"`, Properties: []string{"name"}, Type: "bm25", Slop: 2},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+			},
+			error: false,
+		},
+		{
+			name: "bm25 with fuzzy",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Bm25Search: &pb.BM25{Query: "qeury", Properties: []string{"name"}, Fuzzy: &pb.BM25_Fuzzy{MaxEdits: 1, PrefixLength: 2}},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking:       &searchparams.KeywordRanking{Query: "qeury", Properties: []string{"name"}, Type: "bm25", Fuzzy: &searchparams.Fuzziness{MaxEdits: 1, PrefixLength: 2}},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+			},
+			error: false,
+		},
+		{
+			name: "bm25 with highlights",
+			req: &pb.SearchRequest{
+				Collection: classname,
+				Metadata:   &pb.MetadataRequest{Highlights: &pb.HighlightsRequest{FragmentSize: 50, Fragments: 2}},
+				Bm25Search: &pb.BM25{Query: "query", Properties: []string{"name"}},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking: &searchparams.KeywordRanking{Query: "query", Properties: []string{"name"}, Type: "bm25"},
+				Properties:     defaultTestClassProps,
+				AdditionalProperties: additional.Properties{
+					Highlights: &additional.HighlightParams{FragmentSize: 50, Fragments: 2},
+				},
+			},
+			error: false,
+		},
 		{
 			name: "bm25 groupby",
 			req: &pb.SearchRequest{
@@ -1644,6 +1704,47 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "Near vector with multi vector",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass,
+				NearVector: &pb.NearVector{
+					Targets: &pb.Targets{TargetVectors: []string{"first"}},
+					Vectors: []*pb.Vectors{{
+						Name:        "first",
+						VectorBytes: byteops.Float32ToByteMultiVector([][]float32{{1, 2}, {3, 4}}),
+						Type:        pb.Vectors_VECTOR_TYPE_MULTI_FP32,
+					}},
+				},
+			},
+			out: dto.GetParams{
+				ClassName: multiVecClass, Pagination: defaultPagination,
+				Properties: defaultNamedVecProps,
+				AdditionalProperties: additional.Properties{
+					NoProps: false,
+				},
+				TargetVectorCombination: &dto.TargetCombination{Type: dto.Minimum, Weights: map[string]float32{}},
+				NearVector:              &searchparams.NearVector{VectorPerTarget: map[string][]float32{"first": {2, 1, 2, 3, 4}}, TargetVectors: []string{"first"}},
+			},
+			error: false,
+		},
+		{
+			name: "Near vector with vector and multi vector",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass,
+				NearVector: &pb.NearVector{
+					VectorBytes: byteVector([]float32{1, 2, 3}),
+					Targets:     &pb.Targets{TargetVectors: []string{"first"}},
+					Vectors: []*pb.Vectors{{
+						Name:        "first",
+						VectorBytes: byteops.Float32ToByteMultiVector([][]float32{{1, 2}, {3, 4}}),
+						Type:        pb.Vectors_VECTOR_TYPE_MULTI_FP32,
+					}},
+				},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
 		{
 			name: "Near vector with vector and targets per vector",
 			req: &pb.SearchRequest{
@@ -1688,6 +1789,25 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "Near vector with distance metric override",
+			req: &pb.SearchRequest{
+				Collection: classname,
+				NearVector: &pb.NearVector{
+					VectorBytes:    byteVector([]float32{1, 2, 3}),
+					DistanceMetric: &dotDistance,
+				},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				Properties: defaultTestClassProps,
+				AdditionalProperties: additional.Properties{
+					NoProps: false,
+				},
+				NearVector: &searchparams.NearVector{VectorPerTarget: map[string][]float32{"": {1, 2, 3}}, DistanceMetric: "dot"},
+			},
+			error: false,
+		},
 		{
 			name: "Dont disable certainty for compatible parameters",
 			req: &pb.SearchRequest{
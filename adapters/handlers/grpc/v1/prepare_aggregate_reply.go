@@ -0,0 +1,293 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+func aggregateReplyFromResult(res interface{}, before time.Time, req *pb.AggregateRequest, params *aggregation.Params) (*pb.AggregateReply, error) {
+	out := &pb.AggregateReply{}
+
+	var groups []aggregation.Group
+	if res != nil {
+		result, ok := res.(*aggregation.Result)
+		if !ok {
+			return nil, fmt.Errorf("unexpected aggregate result type %T", res)
+		}
+		groups = result.Groups
+	}
+
+	if params.GroupBy != nil {
+		grouped := &pb.AggregateReply_Grouped{Groups: make([]*pb.AggregateReply_Group, len(groups))}
+		for i, group := range groups {
+			aggregations, err := extractAggregationsReply(group, req.Aggregations)
+			if err != nil {
+				return nil, err
+			}
+			groupedBy, err := extractGroupedByReply(group.GroupedBy)
+			if err != nil {
+				return nil, err
+			}
+			grouped.Groups[i] = &pb.AggregateReply_Group{
+				ObjectsCount: objectsCountReply(group, params.IncludeMetaCount),
+				Aggregations: aggregations,
+				GroupedBy:    groupedBy,
+			}
+		}
+		out.Result = &pb.AggregateReply_GroupedResults{GroupedResults: grouped}
+	} else {
+		// without grouping there is at most one group containing all matched objects
+		var group aggregation.Group
+		if len(groups) > 0 {
+			group = groups[0]
+		}
+		aggregations, err := extractAggregationsReply(group, req.Aggregations)
+		if err != nil {
+			return nil, err
+		}
+		out.Result = &pb.AggregateReply_SingleResult{SingleResult: &pb.AggregateReply_Single{
+			ObjectsCount: objectsCountReply(group, params.IncludeMetaCount),
+			Aggregations: aggregations,
+		}}
+	}
+
+	out.Took = float32(time.Since(before).Seconds())
+	return out, nil
+}
+
+func objectsCountReply(group aggregation.Group, includeMetaCount bool) *int64 {
+	if !includeMetaCount {
+		return nil
+	}
+	count := int64(group.Count)
+	return &count
+}
+
+func extractGroupedByReply(groupedBy *aggregation.GroupedBy) (*pb.AggregateReply_Group_GroupedBy, error) {
+	if groupedBy == nil {
+		return nil, nil
+	}
+
+	out := &pb.AggregateReply_Group_GroupedBy{Path: groupedBy.Path}
+	switch val := groupedBy.Value.(type) {
+	case string:
+		out.Value = &pb.AggregateReply_Group_GroupedBy_Text{Text: val}
+	case bool:
+		out.Value = &pb.AggregateReply_Group_GroupedBy_Boolean{Boolean: val}
+	case float64:
+		out.Value = &pb.AggregateReply_Group_GroupedBy_Number{Number: val}
+	case int64:
+		out.Value = &pb.AggregateReply_Group_GroupedBy_Int{Int: val}
+	case int:
+		out.Value = &pb.AggregateReply_Group_GroupedBy_Int{Int: int64(val)}
+	case fmt.Stringer:
+		out.Value = &pb.AggregateReply_Group_GroupedBy_Text{Text: val.String()}
+	default:
+		return nil, fmt.Errorf("grouped by %v: unsupported value type %T", groupedBy.Path, groupedBy.Value)
+	}
+	return out, nil
+}
+
+func extractAggregationsReply(group aggregation.Group, reqAggregations []*pb.AggregateRequest_Aggregation) (*pb.AggregateReply_Aggregations, error) {
+	if len(reqAggregations) == 0 {
+		return nil, nil
+	}
+
+	out := &pb.AggregateReply_Aggregations{
+		Aggregations: make([]*pb.AggregateReply_Aggregations_Aggregation, len(reqAggregations)),
+	}
+	for i, reqAgg := range reqAggregations {
+		prop := group.Properties[schema.LowercaseFirstLetter(reqAgg.Property)]
+		agg := &pb.AggregateReply_Aggregations_Aggregation{Property: reqAgg.Property}
+
+		switch a := reqAgg.Aggregation.(type) {
+		case *pb.AggregateRequest_Aggregation_Int:
+			agg.Aggregation = &pb.AggregateReply_Aggregations_Aggregation_Int{Int: integerAggregationReply(prop, a.Int)}
+		case *pb.AggregateRequest_Aggregation_Number_:
+			agg.Aggregation = &pb.AggregateReply_Aggregations_Aggregation_Number_{Number: numberAggregationReply(prop, a.Number)}
+		case *pb.AggregateRequest_Aggregation_Text_:
+			agg.Aggregation = &pb.AggregateReply_Aggregations_Aggregation_Text_{Text: textAggregationReply(prop, a.Text)}
+		case *pb.AggregateRequest_Aggregation_Boolean_:
+			agg.Aggregation = &pb.AggregateReply_Aggregations_Aggregation_Boolean_{Boolean: booleanAggregationReply(prop, a.Boolean)}
+		case *pb.AggregateRequest_Aggregation_Date_:
+			agg.Aggregation = &pb.AggregateReply_Aggregations_Aggregation_Date_{Date: dateAggregationReply(prop, a.Date)}
+		case *pb.AggregateRequest_Aggregation_Reference_:
+			agg.Aggregation = &pb.AggregateReply_Aggregations_Aggregation_Reference_{Reference: referenceAggregationReply(prop, a.Reference)}
+		default:
+			return nil, fmt.Errorf("property %s: unknown aggregation %T", reqAgg.Property, reqAgg.Aggregation)
+		}
+
+		out.Aggregations[i] = agg
+	}
+
+	return out, nil
+}
+
+func integerAggregationReply(prop aggregation.Property, req *pb.AggregateRequest_Aggregation_Integer) *pb.AggregateReply_Aggregations_Aggregation_Integer {
+	asInt := func(key string) *int64 {
+		if v, ok := numericalValue(prop.NumericalAggregations, key); ok {
+			i := int64(v)
+			return &i
+		}
+		return nil
+	}
+	asFloat := func(key string) *float64 {
+		if v, ok := numericalValue(prop.NumericalAggregations, key); ok {
+			return &v
+		}
+		return nil
+	}
+
+	return &pb.AggregateReply_Aggregations_Aggregation_Integer{
+		Count:   asInt(aggregation.CountAggregator.String()),
+		Type:    schemaTypeReply(prop, req.Type),
+		Mean:    asFloat(aggregation.MeanAggregator.String()),
+		Median:  asFloat(aggregation.MedianAggregator.String()),
+		Mode:    asInt(aggregation.ModeAggregator.String()),
+		Maximum: asInt(aggregation.MaximumAggregator.String()),
+		Minimum: asInt(aggregation.MinimumAggregator.String()),
+		Sum:     asInt(aggregation.SumAggregator.String()),
+	}
+}
+
+func numberAggregationReply(prop aggregation.Property, req *pb.AggregateRequest_Aggregation_Number) *pb.AggregateReply_Aggregations_Aggregation_Number {
+	asFloat := func(key string) *float64 {
+		if v, ok := numericalValue(prop.NumericalAggregations, key); ok {
+			return &v
+		}
+		return nil
+	}
+
+	var count *int64
+	if v, ok := numericalValue(prop.NumericalAggregations, aggregation.CountAggregator.String()); ok {
+		c := int64(v)
+		count = &c
+	}
+
+	return &pb.AggregateReply_Aggregations_Aggregation_Number{
+		Count:   count,
+		Type:    schemaTypeReply(prop, req.Type),
+		Mean:    asFloat(aggregation.MeanAggregator.String()),
+		Median:  asFloat(aggregation.MedianAggregator.String()),
+		Mode:    asFloat(aggregation.ModeAggregator.String()),
+		Maximum: asFloat(aggregation.MaximumAggregator.String()),
+		Minimum: asFloat(aggregation.MinimumAggregator.String()),
+		Sum:     asFloat(aggregation.SumAggregator.String()),
+	}
+}
+
+func textAggregationReply(prop aggregation.Property, req *pb.AggregateRequest_Aggregation_Text) *pb.AggregateReply_Aggregations_Aggregation_Text {
+	out := &pb.AggregateReply_Aggregations_Aggregation_Text{
+		Type: schemaTypeReply(prop, req.Type),
+	}
+	if req.Count {
+		count := int64(prop.TextAggregation.Count)
+		out.Count = &count
+	}
+	if req.TopOccurences {
+		items := make([]*pb.AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence, len(prop.TextAggregation.Items))
+		for i, item := range prop.TextAggregation.Items {
+			items[i] = &pb.AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence{
+				Value:  item.Value,
+				Occurs: int64(item.Occurs),
+			}
+		}
+		out.TopOccurences = &pb.AggregateReply_Aggregations_Aggregation_Text_TopOccurrences{Items: items}
+	}
+	return out
+}
+
+func booleanAggregationReply(prop aggregation.Property, req *pb.AggregateRequest_Aggregation_Boolean) *pb.AggregateReply_Aggregations_Aggregation_Boolean {
+	out := &pb.AggregateReply_Aggregations_Aggregation_Boolean{
+		Type: schemaTypeReply(prop, req.Type),
+	}
+	boolAgg := prop.BooleanAggregation
+	if req.Count {
+		count := int64(boolAgg.Count)
+		out.Count = &count
+	}
+	if req.TotalTrue {
+		totalTrue := int64(boolAgg.TotalTrue)
+		out.TotalTrue = &totalTrue
+	}
+	if req.TotalFalse {
+		totalFalse := int64(boolAgg.TotalFalse)
+		out.TotalFalse = &totalFalse
+	}
+	if req.PercentageTrue {
+		out.PercentageTrue = &boolAgg.PercentageTrue
+	}
+	if req.PercentageFalse {
+		out.PercentageFalse = &boolAgg.PercentageFalse
+	}
+	return out
+}
+
+func dateAggregationReply(prop aggregation.Property, req *pb.AggregateRequest_Aggregation_Date) *pb.AggregateReply_Aggregations_Aggregation_Date {
+	asString := func(key string) *string {
+		if v, ok := prop.DateAggregations[key].(string); ok {
+			return &v
+		}
+		return nil
+	}
+
+	out := &pb.AggregateReply_Aggregations_Aggregation_Date{
+		Type:    schemaTypeReply(prop, req.Type),
+		Median:  asString(aggregation.MedianAggregator.String()),
+		Mode:    asString(aggregation.ModeAggregator.String()),
+		Maximum: asString(aggregation.MaximumAggregator.String()),
+		Minimum: asString(aggregation.MinimumAggregator.String()),
+	}
+	if v, ok := numericalValue(prop.DateAggregations, aggregation.CountAggregator.String()); ok {
+		count := int64(v)
+		out.Count = &count
+	}
+	return out
+}
+
+func referenceAggregationReply(prop aggregation.Property, req *pb.AggregateRequest_Aggregation_Reference) *pb.AggregateReply_Aggregations_Aggregation_Reference {
+	out := &pb.AggregateReply_Aggregations_Aggregation_Reference{
+		Type: schemaTypeReply(prop, req.Type),
+	}
+	if req.PointingTo {
+		out.PointingTo = prop.ReferenceAggregation.PointingTo
+	}
+	return out
+}
+
+func schemaTypeReply(prop aggregation.Property, requested bool) *string {
+	if !requested {
+		return nil
+	}
+	return &prop.SchemaType
+}
+
+// numericalValue reads an aggregated value, the aggregator stores counts and
+// values with different numeric types depending on the property type.
+func numericalValue(aggs map[string]interface{}, key string) (float64, bool) {
+	switch v := aggs[key].(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
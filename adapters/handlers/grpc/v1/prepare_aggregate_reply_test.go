@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/filters"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+func TestGRPCAggregateReply(t *testing.T) {
+	aggregations := []*pb.AggregateRequest_Aggregation{
+		{Property: "number", Aggregation: &pb.AggregateRequest_Aggregation_Int{Int: &pb.AggregateRequest_Aggregation_Integer{Count: true, Mean: true, Maximum: true}}},
+		{Property: "price", Aggregation: &pb.AggregateRequest_Aggregation_Number_{Number: &pb.AggregateRequest_Aggregation_Number{Type: true, Sum: true}}},
+		{Property: "name", Aggregation: &pb.AggregateRequest_Aggregation_Text_{Text: &pb.AggregateRequest_Aggregation_Text{Count: true, TopOccurences: true}}},
+		{Property: "available", Aggregation: &pb.AggregateRequest_Aggregation_Boolean_{Boolean: &pb.AggregateRequest_Aggregation_Boolean{TotalTrue: true, PercentageTrue: true}}},
+		{Property: "released", Aggregation: &pb.AggregateRequest_Aggregation_Date_{Date: &pb.AggregateRequest_Aggregation_Date{Count: true, Minimum: true}}},
+	}
+	properties := map[string]aggregation.Property{
+		"number": {
+			Type:                  aggregation.PropertyTypeNumerical,
+			NumericalAggregations: map[string]interface{}{"count": float64(3), "mean": 2.5, "maximum": float64(4)},
+		},
+		"price": {
+			Type:                  aggregation.PropertyTypeNumerical,
+			SchemaType:            "number",
+			NumericalAggregations: map[string]interface{}{"sum": 7.5},
+		},
+		"name": {
+			Type: aggregation.PropertyTypeText,
+			TextAggregation: aggregation.Text{
+				Count: 3,
+				Items: []aggregation.TextOccurrence{{Value: "a", Occurs: 2}, {Value: "b", Occurs: 1}},
+			},
+		},
+		"available": {
+			Type:               aggregation.PropertyTypeBoolean,
+			BooleanAggregation: aggregation.Boolean{Count: 3, TotalTrue: 2, TotalFalse: 1, PercentageTrue: 0.66, PercentageFalse: 0.33},
+		},
+		"released": {
+			Type:             aggregation.PropertyTypeDate,
+			DateAggregations: map[string]interface{}{"count": int64(3), "minimum": "2024-01-01T00:00:00Z"},
+		},
+	}
+
+	count := int64(3)
+	mean := 2.5
+	maximum := int64(4)
+	numberType := "number"
+	sum := 7.5
+	totalTrue := int64(2)
+	percentageTrue := 0.66
+	minimum := "2024-01-01T00:00:00Z"
+	expectedAggregations := &pb.AggregateReply_Aggregations{Aggregations: []*pb.AggregateReply_Aggregations_Aggregation{
+		{Property: "number", Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Int{Int: &pb.AggregateReply_Aggregations_Aggregation_Integer{Count: &count, Mean: &mean, Maximum: &maximum}}},
+		{Property: "price", Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Number_{Number: &pb.AggregateReply_Aggregations_Aggregation_Number{Type: &numberType, Sum: &sum}}},
+		{Property: "name", Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Text_{Text: &pb.AggregateReply_Aggregations_Aggregation_Text{
+			Count: &count,
+			TopOccurences: &pb.AggregateReply_Aggregations_Aggregation_Text_TopOccurrences{Items: []*pb.AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence{
+				{Value: "a", Occurs: 2}, {Value: "b", Occurs: 1},
+			}},
+		}}},
+		{Property: "available", Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Boolean_{Boolean: &pb.AggregateReply_Aggregations_Aggregation_Boolean{TotalTrue: &totalTrue, PercentageTrue: &percentageTrue}}},
+		{Property: "released", Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Date_{Date: &pb.AggregateReply_Aggregations_Aggregation_Date{Count: &count, Minimum: &minimum}}},
+	}}
+
+	t.Run("single result", func(t *testing.T) {
+		req := &pb.AggregateRequest{Collection: "TestClass", ObjectsCount: true, Aggregations: aggregations}
+		params := &aggregation.Params{IncludeMetaCount: true}
+		res := &aggregation.Result{Groups: []aggregation.Group{{Count: 3, Properties: properties}}}
+
+		out, err := aggregateReplyFromResult(res, time.Now(), req, params)
+		require.Nil(t, err)
+
+		single := out.GetSingleResult()
+		require.NotNil(t, single)
+		require.Equal(t, count, single.GetObjectsCount())
+		require.Equal(t, expectedAggregations, single.Aggregations)
+	})
+
+	t.Run("grouped results", func(t *testing.T) {
+		req := &pb.AggregateRequest{Collection: "TestClass", GroupBy: &pb.AggregateRequest_GroupBy{Property: "name"}}
+		params := &aggregation.Params{GroupBy: &filters.Path{Property: "name"}}
+		res := &aggregation.Result{Groups: []aggregation.Group{
+			{Count: 2, GroupedBy: &aggregation.GroupedBy{Path: []string{"name"}, Value: "a"}},
+			{Count: 1, GroupedBy: &aggregation.GroupedBy{Path: []string{"available"}, Value: true}},
+			{Count: 1, GroupedBy: &aggregation.GroupedBy{Path: []string{"price"}, Value: 2.5}},
+		}}
+
+		out, err := aggregateReplyFromResult(res, time.Now(), req, params)
+		require.Nil(t, err)
+
+		groups := out.GetGroupedResults().GetGroups()
+		require.Len(t, groups, 3)
+		require.Nil(t, groups[0].ObjectsCount)
+		require.Nil(t, groups[0].Aggregations)
+		require.Equal(t, "a", groups[0].GroupedBy.GetText())
+		require.Equal(t, []string{"name"}, groups[0].GroupedBy.Path)
+		require.True(t, groups[1].GroupedBy.GetBoolean())
+		require.Equal(t, 2.5, groups[2].GroupedBy.GetNumber())
+	})
+
+	t.Run("empty result", func(t *testing.T) {
+		req := &pb.AggregateRequest{Collection: "TestClass", ObjectsCount: true}
+		params := &aggregation.Params{IncludeMetaCount: true}
+
+		out, err := aggregateReplyFromResult(nil, time.Now(), req, params)
+		require.Nil(t, err)
+		require.Equal(t, int64(0), out.GetSingleResult().GetObjectsCount())
+	})
+}
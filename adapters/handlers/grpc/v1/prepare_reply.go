@@ -248,8 +248,8 @@ func (r *Replier) extractAdditionalProps(asMap map[string]any, additionalPropsPa
 	if len(additionalPropsParams.Vectors) > 0 {
 		vectors, ok := additionalPropertiesMap["vectors"]
 		if ok {
-			vectorfmt, ok2 := vectors.(map[string][]float32)
-			if ok2 {
+			switch vectorfmt := vectors.(type) {
+			case map[string][]float32:
 				metadata.Vectors = make([]*pb.Vectors, 0, len(vectorfmt))
 				for name, vector := range vectorfmt {
 					metadata.Vectors = append(metadata.Vectors, &pb.Vectors{
@@ -257,8 +257,29 @@ func (r *Replier) extractAdditionalProps(asMap map[string]any, additionalPropsPa
 						Name:        name,
 					})
 				}
+			case map[string]interface{}:
+				metadata.Vectors = make([]*pb.Vectors, 0, len(vectorfmt))
+				for name, vector := range vectorfmt {
+					switch vector := vector.(type) {
+					case []float32:
+						metadata.Vectors = append(metadata.Vectors, &pb.Vectors{
+							VectorBytes: byteops.Float32ToByteVector(vector),
+							Name:        name,
+							Type:        pb.Vectors_VECTOR_TYPE_SINGLE_FP32,
+						})
+					case models.MultiVector:
+						multiVector := make([][]float32, len(vector))
+						for i := range vector {
+							multiVector[i] = vector[i]
+						}
+						metadata.Vectors = append(metadata.Vectors, &pb.Vectors{
+							VectorBytes: byteops.Float32ToByteMultiVector(multiVector),
+							Name:        name,
+							Type:        pb.Vectors_VECTOR_TYPE_MULTI_FP32,
+						})
+					}
+				}
 			}
-
 		}
 	}
 
@@ -322,6 +343,24 @@ func (r *Replier) extractAdditionalProps(asMap map[string]any, additionalPropsPa
 		}
 	}
 
+	if additionalPropsParams.Highlights != nil {
+		metadata.HighlightsPresent = false
+		highlights, ok := additionalPropertiesMap["highlights"]
+		if ok {
+			highlightsfmt, ok2 := highlights.([]additional.Highlight)
+			if ok2 {
+				metadata.Highlights = make([]*pb.HighlightResult, len(highlightsfmt))
+				for i, highlight := range highlightsfmt {
+					metadata.Highlights[i] = &pb.HighlightResult{
+						Property:  highlight.Property,
+						Fragments: highlight.Fragments,
+					}
+				}
+				metadata.HighlightsPresent = true
+			}
+		}
+	}
+
 	if additionalPropsParams.Score {
 		metadata.ScorePresent = false
 		score, ok := additionalPropertiesMap["score"]
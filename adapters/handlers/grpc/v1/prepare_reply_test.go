@@ -29,6 +29,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/byteops"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/require"
@@ -229,6 +230,27 @@ func TestGRPCReply(t *testing.T) {
 			},
 			usesWeaviateStruct: true,
 		},
+		{
+			name: "multi vector",
+			res: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{"vectors": map[string]interface{}{
+						"colbert": models.MultiVector{{1, 2}, {3, 4}},
+					}},
+				},
+			},
+			searchParams: dto.GetParams{AdditionalProperties: additional.Properties{Vectors: []string{"colbert"}}},
+			outSearch: []*pb.SearchResult{
+				{Metadata: &pb.MetadataResult{Vectors: []*pb.Vectors{
+					{
+						Name:        "colbert",
+						VectorBytes: byteops.Float32ToByteMultiVector([][]float32{{1, 2}, {3, 4}}),
+						Type:        pb.Vectors_VECTOR_TYPE_MULTI_FP32,
+					},
+				}}, Properties: &pb.PropertiesResult{}},
+			},
+			usesWeaviateStruct: true,
+		},
 		{
 			name: "all additional",
 			res: []interface{}{
@@ -309,6 +331,44 @@ func TestGRPCReply(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "highlights",
+			res: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{
+						"highlights": []additional.Highlight{
+							{Property: "word", Fragments: []string{"a <em>word</em>", "<em>word</em> again"}},
+						},
+					},
+				},
+				map[string]interface{}{
+					"_additional": map[string]interface{}{
+						"highlights": []additional.Highlight{},
+					},
+				},
+			},
+			searchParams: dto.GetParams{AdditionalProperties: additional.Properties{
+				Highlights: &additional.HighlightParams{},
+			}},
+			outSearch: []*pb.SearchResult{
+				{
+					Metadata: &pb.MetadataResult{
+						Highlights: []*pb.HighlightResult{
+							{Property: "word", Fragments: []string{"a <em>word</em>", "<em>word</em> again"}},
+						},
+						HighlightsPresent: true,
+					},
+					Properties: &pb.PropertiesResult{},
+				},
+				{
+					Metadata: &pb.MetadataResult{
+						Highlights:        []*pb.HighlightResult{},
+						HighlightsPresent: true,
+					},
+					Properties: &pb.PropertiesResult{},
+				},
+			},
+		},
 		{
 			name: "primitive properties deprecated",
 			res: []interface{}{
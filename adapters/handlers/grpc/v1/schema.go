@@ -0,0 +1,293 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// The schema RPCs call the same schema manager methods as the REST handlers,
+// authorization is therefore enforced by the manager for both APIs.
+
+// schemaHandler is the part of the schema manager used by the schema RPCs
+type schemaHandler interface {
+	GetConsistentSchema(principal *models.Principal, consistency bool) (schema.Schema, error)
+	AddClass(ctx context.Context, principal *models.Principal, class *models.Class) (*models.Class, uint64, error)
+	UpdateClass(ctx context.Context, principal *models.Principal, className string, updated *models.Class) error
+	DeleteClass(ctx context.Context, principal *models.Principal, className string) error
+	AddClassProperty(ctx context.Context, principal *models.Principal, class *models.Class,
+		merge bool, newProps ...*models.Property) (*models.Class, uint64, error)
+	ReadOnlyClass(name string) *models.Class
+	AddTenants(ctx context.Context, principal *models.Principal, className string, tenants []*models.Tenant) (uint64, error)
+	UpdateTenants(ctx context.Context, principal *models.Principal, className string, tenants []*models.Tenant) ([]*models.Tenant, error)
+	DeleteTenants(ctx context.Context, principal *models.Principal, className string, tenants []string) error
+}
+
+func (s *Service) GetSchema(ctx context.Context, req *pb.GetSchemaRequest) (*pb.GetSchemaReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	consistency := true
+	if req.Consistency != nil {
+		consistency = *req.Consistency
+	}
+
+	dbSchema, err := s.schemaHandler.GetConsistentSchema(principal, consistency)
+	if err != nil {
+		return nil, fmt.Errorf("get schema: %w", err)
+	}
+
+	var collections []*structpb.Struct
+	if dbSchema.Objects != nil {
+		collections = make([]*structpb.Struct, len(dbSchema.Objects.Classes))
+		for i, class := range dbSchema.Objects.Classes {
+			collections[i], err = modelToStruct(class)
+			if err != nil {
+				return nil, fmt.Errorf("collection %s: %w", class.Class, err)
+			}
+		}
+	}
+
+	return &pb.GetSchemaReply{
+		Took:        float32(time.Since(before).Seconds()),
+		Collections: collections,
+	}, nil
+}
+
+func (s *Service) CreateClass(ctx context.Context, req *pb.CreateClassRequest) (*pb.CreateClassReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	class := &models.Class{}
+	if err := structToModel(req.Collection, class); err != nil {
+		return nil, fmt.Errorf("parse collection: %w", err)
+	}
+
+	created, _, err := s.schemaHandler.AddClass(ctx, principal, class)
+	if err != nil {
+		return nil, fmt.Errorf("create collection: %w", err)
+	}
+
+	collection, err := modelToStruct(created)
+	if err != nil {
+		return nil, fmt.Errorf("collection %s: %w", created.Class, err)
+	}
+
+	return &pb.CreateClassReply{
+		Took:       float32(time.Since(before).Seconds()),
+		Collection: collection,
+	}, nil
+}
+
+func (s *Service) UpdateClass(ctx context.Context, req *pb.UpdateClassRequest) (*pb.UpdateClassReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	if req.Collection == "" {
+		return nil, fmt.Errorf("missing collection")
+	}
+
+	class := &models.Class{}
+	if err := structToModel(req.Updated, class); err != nil {
+		return nil, fmt.Errorf("parse collection: %w", err)
+	}
+
+	if err := s.schemaHandler.UpdateClass(ctx, principal, req.Collection, class); err != nil {
+		return nil, fmt.Errorf("update collection: %w", err)
+	}
+
+	return &pb.UpdateClassReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Service) DeleteClass(ctx context.Context, req *pb.DeleteClassRequest) (*pb.DeleteClassReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	if req.Collection == "" {
+		return nil, fmt.Errorf("missing collection")
+	}
+
+	if err := s.schemaHandler.DeleteClass(ctx, principal, req.Collection); err != nil {
+		return nil, fmt.Errorf("delete collection: %w", err)
+	}
+
+	return &pb.DeleteClassReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Service) AddProperty(ctx context.Context, req *pb.AddPropertyRequest) (*pb.AddPropertyReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	property := &models.Property{}
+	if err := structToModel(req.Property, property); err != nil {
+		return nil, fmt.Errorf("parse property: %w", err)
+	}
+
+	if _, _, err := s.schemaHandler.AddClassProperty(ctx, principal,
+		s.schemaHandler.ReadOnlyClass(req.Collection), false, property); err != nil {
+		return nil, fmt.Errorf("add property: %w", err)
+	}
+
+	return &pb.AddPropertyReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Service) CreateTenants(ctx context.Context, req *pb.CreateTenantsRequest) (*pb.CreateTenantsReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	tenants, err := tenantsFromGRPC(req.Tenants)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.schemaHandler.AddTenants(ctx, principal, req.Collection, tenants); err != nil {
+		return nil, fmt.Errorf("create tenants: %w", err)
+	}
+
+	return &pb.CreateTenantsReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Service) UpdateTenants(ctx context.Context, req *pb.UpdateTenantsRequest) (*pb.UpdateTenantsReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	tenants, err := tenantsFromGRPC(req.Tenants)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.schemaHandler.UpdateTenants(ctx, principal, req.Collection, tenants)
+	if err != nil {
+		return nil, fmt.Errorf("update tenants: %w", err)
+	}
+
+	retTenants := make([]*pb.Tenant, len(updated))
+	for i, tenant := range updated {
+		retTenants[i], err = tenantToGRPC(tenant)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.UpdateTenantsReply{
+		Took:    float32(time.Since(before).Seconds()),
+		Tenants: retTenants,
+	}, nil
+}
+
+func (s *Service) DeleteTenants(ctx context.Context, req *pb.DeleteTenantsRequest) (*pb.DeleteTenantsReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	if err := s.schemaHandler.DeleteTenants(ctx, principal, req.Collection, req.Tenants); err != nil {
+		return nil, fmt.Errorf("delete tenants: %w", err)
+	}
+
+	return &pb.DeleteTenantsReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func tenantsFromGRPC(in []*pb.Tenant) ([]*models.Tenant, error) {
+	tenants := make([]*models.Tenant, len(in))
+	for i, tenant := range in {
+		var status string
+		if tenant.ActivityStatus != pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_UNSPECIFIED {
+			name, ok := pb.TenantActivityStatus_name[int32(tenant.ActivityStatus)]
+			if !ok {
+				return nil, fmt.Errorf("tenant %s: unknown activity status %v", tenant.Name, tenant.ActivityStatus)
+			}
+			status = strings.TrimPrefix(name, "TENANT_ACTIVITY_STATUS_")
+		}
+
+		tenants[i] = &models.Tenant{Name: tenant.Name, ActivityStatus: status}
+		if err := tenants[i].Validate(strfmt.Default); err != nil {
+			return nil, fmt.Errorf("tenant %s: %w", tenant.Name, err)
+		}
+	}
+	return tenants, nil
+}
+
+type validatable interface {
+	Validate(formats strfmt.Registry) error
+}
+
+// structToModel decodes the JSON representation of a REST model and runs the
+// same validation the REST API applies to request bodies.
+func structToModel(in *structpb.Struct, out validatable) error {
+	if in == nil {
+		return fmt.Errorf("empty definition")
+	}
+
+	raw, err := in.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw, out); err != nil {
+		return err
+	}
+
+	return out.Validate(strfmt.Default)
+}
+
+func modelToStruct(in interface{}) (*structpb.Struct, error) {
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &structpb.Struct{}
+	if err := out.UnmarshalJSON(raw); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
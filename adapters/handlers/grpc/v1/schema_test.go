@@ -0,0 +1,379 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestGRPCSchemaConversion(t *testing.T) {
+	t.Run("class round trip", func(t *testing.T) {
+		class := &models.Class{
+			Class:      "TestClass",
+			Vectorizer: "none",
+			Properties: []*models.Property{
+				{Name: "name", DataType: []string{"text"}, Tokenization: "word"},
+			},
+			MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+		}
+
+		asStruct, err := modelToStruct(class)
+		require.Nil(t, err)
+		require.Equal(t, "TestClass", asStruct.Fields["class"].GetStringValue())
+
+		parsed := &models.Class{}
+		require.Nil(t, structToModel(asStruct, parsed))
+		require.Equal(t, class, parsed)
+	})
+
+	t.Run("invalid property", func(t *testing.T) {
+		property, err := structpb.NewStruct(map[string]interface{}{
+			"name":         "name",
+			"dataType":     []interface{}{"text"},
+			"tokenization": "unknown",
+		})
+		require.Nil(t, err)
+		require.NotNil(t, structToModel(property, &models.Property{}))
+	})
+
+	t.Run("missing definition", func(t *testing.T) {
+		require.NotNil(t, structToModel(nil, &models.Class{}))
+	})
+}
+
+func TestGRPCTenantsFromGRPC(t *testing.T) {
+	tenants, err := tenantsFromGRPC([]*pb.Tenant{
+		{Name: "hot", ActivityStatus: pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_HOT},
+		{Name: "cold", ActivityStatus: pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_COLD},
+		{Name: "default"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []*models.Tenant{
+		{Name: "hot", ActivityStatus: models.TenantActivityStatusHOT},
+		{Name: "cold", ActivityStatus: models.TenantActivityStatusCOLD},
+		{Name: "default"},
+	}, tenants)
+
+	_, err = tenantsFromGRPC([]*pb.Tenant{{Name: "t", ActivityStatus: pb.TenantActivityStatus(100)}})
+	require.NotNil(t, err)
+}
+
+func TestGRPCSchemaErrors(t *testing.T) {
+	newStruct := func(t *testing.T, in map[string]interface{}) *structpb.Struct {
+		out, err := structpb.NewStruct(in)
+		require.Nil(t, err)
+		return out
+	}
+	validClass := map[string]interface{}{"class": "Unknown", "vectorizer": "none"}
+
+	tests := []struct {
+		name      string
+		anonymous bool
+		call      func(t *testing.T, s *Service) error
+		// whether the request is rejected before it reaches the schema manager
+		rejected    bool
+		expectedErr string
+		notFound    bool
+	}{
+		{
+			name: "anonymous access disabled",
+			call: func(t *testing.T, s *Service) error {
+				_, err := s.GetSchema(context.Background(), &pb.GetSchemaRequest{})
+				return err
+			},
+			rejected:    true,
+			expectedErr: "extract auth",
+		},
+		{
+			name:      "create collection without definition",
+			anonymous: true,
+			call: func(t *testing.T, s *Service) error {
+				_, err := s.CreateClass(context.Background(), &pb.CreateClassRequest{})
+				return err
+			},
+			rejected:    true,
+			expectedErr: "parse collection: empty definition",
+		},
+		{
+			name:      "create collection with an invalid tokenization",
+			anonymous: true,
+			call: func(t *testing.T, s *Service) error {
+				_, err := s.CreateClass(context.Background(), &pb.CreateClassRequest{
+					Collection: newStruct(t, map[string]interface{}{
+						"class": "Invalid",
+						"properties": []interface{}{map[string]interface{}{
+							"name": "name", "dataType": []interface{}{"text"}, "tokenization": "unknown",
+						}},
+					}),
+				})
+				return err
+			},
+			rejected:    true,
+			expectedErr: "parse collection",
+		},
+		{
+			name:      "create collection with a mistyped field",
+			anonymous: true,
+			call: func(t *testing.T, s *Service) error {
+				_, err := s.CreateClass(context.Background(), &pb.CreateClassRequest{
+					Collection: newStruct(t, map[string]interface{}{"class": "Invalid", "properties": "name"}),
+				})
+				return err
+			},
+			rejected:    true,
+			expectedErr: "parse collection",
+		},
+		{
+			name:      "create collection rejected by the schema manager",
+			anonymous: true,
+			call: func(t *testing.T, s *Service) error {
+				_, err := s.CreateClass(context.Background(), &pb.CreateClassRequest{
+					Collection: newStruct(t, map[string]interface{}{"class": "Existing"}),
+				})
+				return err
+			},
+			expectedErr: "create collection: class name \"Existing\" already exists",
+		},
+		{
+			name:      "update collection without name",
+			anonymous: true,
+			call: func(t *testing.T, s *Service) error {
+				_, err := s.UpdateClass(context.Background(), &pb.UpdateClassRequest{Updated: newStruct(t, validClass)})
+				return err
+			},
+			rejected:    true,
+			expectedErr: "missing collection",
+		},
+		{
+			name:      "update collection with an invalid definition",
+			anonymous: true,
+			call: func(t *testing.T, s *Service) error {
+				_, err := s.UpdateClass(context.Background(), &pb.UpdateClassRequest{
+					Collection: "Existing",
+					Updated:    newStruct(t, map[string]interface{}{"class": "Existing", "replicationConfig": 3}),
+				})
+				return err
+			},
+			rejected:    true,
+			expectedErr: "parse collection",
+		},
+		{
+			name:      "update unknown collection",
+			anonymous: true,
+			call: func(t *testing.T, s *Service) error {
+				_, err := s.UpdateClass(context.Background(), &pb.UpdateClassRequest{
+					Collection: "Unknown",
+					Updated:    newStruct(t, validClass),
+				})
+				return err
+			},
+			expectedErr: "update collection",
+			notFound:    true,
+		},
+		{
+			name:      "delete collection without name",
+			anonymous: true,
+			call: func(t *testing.T, s *Service) error {
+				_, err := s.DeleteClass(context.Background(), &pb.DeleteClassRequest{})
+				return err
+			},
+			rejected:    true,
+			expectedErr: "missing collection",
+		},
+		{
+			name:      "add an invalid property",
+			anonymous: true,
+			call: func(t *testing.T, s *Service) error {
+				_, err := s.AddProperty(context.Background(), &pb.AddPropertyRequest{
+					Collection: "Existing",
+					Property:   newStruct(t, map[string]interface{}{"name": "name", "dataType": "text"}),
+				})
+				return err
+			},
+			rejected:    true,
+			expectedErr: "parse property",
+		},
+		{
+			name:      "add property to unknown collection",
+			anonymous: true,
+			call: func(t *testing.T, s *Service) error {
+				_, err := s.AddProperty(context.Background(), &pb.AddPropertyRequest{
+					Collection: "Unknown",
+					Property: newStruct(t, map[string]interface{}{
+						"name": "name", "dataType": []interface{}{"text"},
+					}),
+				})
+				return err
+			},
+			expectedErr: "add property",
+			notFound:    true,
+		},
+		{
+			name:      "create tenants with an unknown activity status",
+			anonymous: true,
+			call: func(t *testing.T, s *Service) error {
+				_, err := s.CreateTenants(context.Background(), &pb.CreateTenantsRequest{
+					Collection: "Existing",
+					Tenants:    []*pb.Tenant{{Name: "t", ActivityStatus: pb.TenantActivityStatus(100)}},
+				})
+				return err
+			},
+			rejected:    true,
+			expectedErr: "unknown activity status",
+		},
+		{
+			name:      "create tenants of unknown collection",
+			anonymous: true,
+			call: func(t *testing.T, s *Service) error {
+				_, err := s.CreateTenants(context.Background(), &pb.CreateTenantsRequest{
+					Collection: "Unknown",
+					Tenants:    []*pb.Tenant{{Name: "t"}},
+				})
+				return err
+			},
+			expectedErr: "create tenants",
+			notFound:    true,
+		},
+		{
+			name:      "update tenants of unknown collection",
+			anonymous: true,
+			call: func(t *testing.T, s *Service) error {
+				_, err := s.UpdateTenants(context.Background(), &pb.UpdateTenantsRequest{
+					Collection: "Unknown",
+					Tenants:    []*pb.Tenant{{Name: "t", ActivityStatus: pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_COLD}},
+				})
+				return err
+			},
+			expectedErr: "update tenants",
+			notFound:    true,
+		},
+		{
+			name:      "delete tenants of unknown collection",
+			anonymous: true,
+			call: func(t *testing.T, s *Service) error {
+				_, err := s.DeleteTenants(context.Background(), &pb.DeleteTenantsRequest{
+					Collection: "Unknown",
+					Tenants:    []string{"t"},
+				})
+				return err
+			},
+			expectedErr: "delete tenants",
+			notFound:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := &fakeSchemaHandler{classes: map[string]*models.Class{
+				"Existing": {Class: "Existing"},
+			}}
+			s := &Service{
+				allowAnonymousAccess: test.anonymous,
+				authComposer: func(token string, scopes []string) (*models.Principal, error) {
+					return nil, fmt.Errorf("not allowed")
+				},
+				schemaHandler: handler,
+			}
+
+			err := test.call(t, s)
+			require.ErrorContains(t, err, test.expectedErr)
+			assert.Equal(t, test.notFound, errors.Is(err, schemaUC.ErrNotFound))
+			assert.Equal(t, test.rejected, handler.calls == 0)
+		})
+	}
+}
+
+// fakeSchemaHandler only knows the classes it was created with
+type fakeSchemaHandler struct {
+	classes map[string]*models.Class
+	calls   int
+}
+
+func (f *fakeSchemaHandler) class(name string) (*models.Class, error) {
+	f.calls++
+	class, ok := f.classes[name]
+	if !ok {
+		return nil, fmt.Errorf("class %q: %w", name, schemaUC.ErrNotFound)
+	}
+	return class, nil
+}
+
+func (f *fakeSchemaHandler) GetConsistentSchema(principal *models.Principal, consistency bool) (schema.Schema, error) {
+	f.calls++
+	classes := make([]*models.Class, 0, len(f.classes))
+	for _, class := range f.classes {
+		classes = append(classes, class)
+	}
+	return schema.Schema{Objects: &models.Schema{Classes: classes}}, nil
+}
+
+func (f *fakeSchemaHandler) AddClass(ctx context.Context, principal *models.Principal, class *models.Class) (*models.Class, uint64, error) {
+	f.calls++
+	if _, ok := f.classes[class.Class]; ok {
+		return nil, 0, fmt.Errorf("class name %q already exists", class.Class)
+	}
+	f.classes[class.Class] = class
+	return class, 1, nil
+}
+
+func (f *fakeSchemaHandler) UpdateClass(ctx context.Context, principal *models.Principal, className string, updated *models.Class) error {
+	_, err := f.class(className)
+	return err
+}
+
+func (f *fakeSchemaHandler) DeleteClass(ctx context.Context, principal *models.Principal, className string) error {
+	f.calls++
+	delete(f.classes, className)
+	return nil
+}
+
+func (f *fakeSchemaHandler) AddClassProperty(ctx context.Context, principal *models.Principal, class *models.Class,
+	merge bool, newProps ...*models.Property,
+) (*models.Class, uint64, error) {
+	f.calls++
+	if class == nil {
+		return nil, 0, fmt.Errorf("class is nil: %w", schemaUC.ErrNotFound)
+	}
+	return class, 1, nil
+}
+
+func (f *fakeSchemaHandler) ReadOnlyClass(name string) *models.Class {
+	return f.classes[name]
+}
+
+func (f *fakeSchemaHandler) AddTenants(ctx context.Context, principal *models.Principal, className string, tenants []*models.Tenant) (uint64, error) {
+	_, err := f.class(className)
+	return 1, err
+}
+
+func (f *fakeSchemaHandler) UpdateTenants(ctx context.Context, principal *models.Principal, className string, tenants []*models.Tenant) ([]*models.Tenant, error) {
+	if _, err := f.class(className); err != nil {
+		return nil, err
+	}
+	return tenants, nil
+}
+
+func (f *fakeSchemaHandler) DeleteTenants(ctx context.Context, principal *models.Principal, className string, tenants []string) error {
+	_, err := f.class(className)
+	return err
+}
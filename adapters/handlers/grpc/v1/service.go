@@ -14,6 +14,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -28,6 +29,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
 	schemaManager "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/traverser"
 )
@@ -38,6 +40,7 @@ type Service struct {
 	authComposer         composer.TokenFunc
 	allowAnonymousAccess bool
 	schemaManager        *schemaManager.Manager
+	schemaHandler        schemaHandler
 	batchManager         *objects.BatchManager
 	config               *config.Config
 	logger               logrus.FieldLogger
@@ -52,6 +55,7 @@ func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
 		authComposer:         authComposer,
 		allowAnonymousAccess: allowAnonymousAccess,
 		schemaManager:        schemaManager,
+		schemaHandler:        schemaManager,
 		batchManager:         batchManager,
 		config:               config,
 		logger:               logger,
@@ -220,6 +224,81 @@ func (s *Service) search(ctx context.Context, req *pb.SearchRequest) (*pb.Search
 	return replier.Search(res, before, searchParams, scheme)
 }
 
+// SearchStream runs a search like Search, but streams the chunks of
+// generative results while they are generated. The complete search reply is
+// sent last.
+func (s *Service) SearchStream(req *pb.SearchRequest, stream pb.Weaviate_SearchStreamServer) error {
+	var errInner error
+
+	if err := enterrors.GoWrapperWithBlock(func() {
+		errInner = s.searchStream(req, stream)
+	}, s.logger); err != nil {
+		return err
+	}
+
+	return errInner
+}
+
+func (s *Service) searchStream(req *pb.SearchRequest, stream pb.Weaviate_SearchStreamServer) error {
+	// results of different objects are generated concurrently, but a stream
+	// must not be written to concurrently
+	var mu sync.Mutex
+	ctx := generate.WithStream(stream.Context(), func(chunk generate.StreamChunk) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return stream.Send(&pb.SearchStreamReply{
+			Reply: &pb.SearchStreamReply_GenerativeChunk{GenerativeChunk: &pb.GenerativeChunk{
+				Uuid:    chunk.ID.String(),
+				Grouped: chunk.Grouped,
+				Text:    chunk.Text,
+			}},
+		})
+	})
+
+	reply, err := s.search(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(&pb.SearchStreamReply{
+		Reply: &pb.SearchStreamReply_SearchReply{SearchReply: reply},
+	})
+}
+
+func (s *Service) Aggregate(ctx context.Context, req *pb.AggregateRequest) (*pb.AggregateReply, error) {
+	var result *pb.AggregateReply
+	var errInner error
+
+	if err := enterrors.GoWrapperWithBlock(func() {
+		result, errInner = s.aggregate(ctx, req)
+	}, s.logger); err != nil {
+		return nil, err
+	}
+
+	return result, errInner
+}
+
+func (s *Service) aggregate(ctx context.Context, req *pb.AggregateRequest) (*pb.AggregateReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	params, err := aggregateParamsFromProto(req, s.schemaManager.ReadOnlyClass)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate params: %w", err)
+	}
+
+	res, err := s.traverser.Aggregate(ctx, principal, params)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate: %w", err)
+	}
+
+	return aggregateReplyFromResult(res, before, req, params)
+}
+
 func (s *Service) validateClassAndProperty(searchParams dto.GetParams) error {
 	class := s.schemaManager.ReadOnlyClass(searchParams.ClassName)
 	if class == nil {
@@ -437,6 +437,7 @@ func (p searchParamsPayload) Marshal(vectors [][]float32, targetVectors []string
 	filter *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
 	sort []filters.Sort, cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 	addP additional.Properties, targetCombination *dto.TargetCombination, properties []string,
+	distanceMetric string,
 ) ([]byte, error) {
 	type params struct {
 		SearchVector      []float32                    `json:"searchVector"`
@@ -452,6 +453,7 @@ func (p searchParamsPayload) Marshal(vectors [][]float32, targetVectors []string
 		TargetVectors     []string                     `json:"targetVectors"`
 		TargetCombination *dto.TargetCombination       `json:"targetCombination"`
 		Properties        []string                     `json:"properties"`
+		DistanceMetric    string                       `json:"distanceMetric,omitempty"`
 	}
 	var vector []float32
 	var targetVector string
@@ -461,13 +463,13 @@ func (p searchParamsPayload) Marshal(vectors [][]float32, targetVectors []string
 		targetVector = targetVectors[0]
 	}
 
-	par := params{vector, targetVector, limit, filter, keywordRanking, sort, cursor, groupBy, addP, vectors, targetVectors, targetCombination, properties}
+	par := params{vector, targetVector, limit, filter, keywordRanking, sort, cursor, groupBy, addP, vectors, targetVectors, targetCombination, properties, distanceMetric}
 	return json.Marshal(par)
 }
 
 func (p searchParamsPayload) Unmarshal(in []byte) ([][]float32, []string, float32, int,
 	*filters.LocalFilter, *searchparams.KeywordRanking, []filters.Sort,
-	*filters.Cursor, *searchparams.GroupBy, additional.Properties, *dto.TargetCombination, []string, error,
+	*filters.Cursor, *searchparams.GroupBy, additional.Properties, *dto.TargetCombination, []string, string, error,
 ) {
 	type searchParametersPayload struct {
 		SearchVector      []float32                    `json:"searchVector"`
@@ -484,6 +486,7 @@ func (p searchParamsPayload) Unmarshal(in []byte) ([][]float32, []string, float3
 		TargetVectors     []string                     `json:"targetVectors"`
 		TargetCombination *dto.TargetCombination       `json:"targetCombination"`
 		Properties        []string                     `json:"properties"`
+		DistanceMetric    string                       `json:"distanceMetric,omitempty"`
 	}
 	var par searchParametersPayload
 	err := json.Unmarshal(in, &par)
@@ -493,7 +496,7 @@ func (p searchParamsPayload) Unmarshal(in []byte) ([][]float32, []string, float3
 	}
 
 	return par.SearchVectors, par.TargetVectors, par.Distance, par.Limit,
-		par.Filters, par.KeywordRanking, par.Sort, par.Cursor, par.GroupBy, par.Additional, par.TargetCombination, par.Properties, err
+		par.Filters, par.KeywordRanking, par.Sort, par.Cursor, par.GroupBy, par.Additional, par.TargetCombination, par.Properties, par.DistanceMetric, err
 }
 
 func (p searchParamsPayload) MIME() string {
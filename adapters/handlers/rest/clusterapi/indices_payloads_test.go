@@ -167,10 +167,10 @@ func TestBackwardCompatibilitySearch(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run("test", func(t *testing.T) {
-			b126, err := payload.Marshal(tt.SearchVectors, tt.Targets, 10, nil, nil, nil, nil, nil, additional.Properties{}, nil, nil)
+			b126, err := payload.Marshal(tt.SearchVectors, tt.Targets, 10, nil, nil, nil, nil, nil, additional.Properties{}, nil, nil, "")
 			require.Nil(t, err)
 
-			vecs, targets, _, _, _, _, _, _, _, _, _, _, err := payload.Unmarshal(b126)
+			vecs, targets, _, _, _, _, _, _, _, _, _, _, _, err := payload.Unmarshal(b126)
 			require.Nil(t, err)
 			assert.Equal(t, tt.SearchVectors, vecs)
 			assert.Equal(t, tt.Targets, targets)
@@ -184,7 +184,7 @@ func TestBackwardCompatibilitySearch(t *testing.T) {
 				assert.Equal(t, tt.SearchVectors[0], vecsOld)
 				assert.Equal(t, tt.Targets[0], targetsOld)
 
-				vecs, targets, _, _, _, _, _, _, _, _, _, _, err := payload.Unmarshal(b125)
+				vecs, targets, _, _, _, _, _, _, _, _, _, _, _, err := payload.Unmarshal(b125)
 				require.Nil(t, err)
 				assert.Equal(t, tt.SearchVectors, vecs)
 				assert.Equal(t, tt.Targets, targets)
@@ -193,3 +193,15 @@ func TestBackwardCompatibilitySearch(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchParamsPayloadDistanceMetric(t *testing.T) {
+	payload := searchParamsPayload{}
+
+	b, err := payload.Marshal([][]float32{{1, 2, 3}}, []string{"target1"}, 10, nil, nil, nil, nil, nil,
+		additional.Properties{}, nil, nil, "manhattan")
+	require.Nil(t, err)
+
+	_, _, _, _, _, _, _, _, _, _, _, _, distanceMetric, err := payload.Unmarshal(b)
+	require.Nil(t, err)
+	assert.Equal(t, "manhattan", distanceMetric)
+}
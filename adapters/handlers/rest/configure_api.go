@@ -96,7 +96,9 @@ import (
 	modtext2vecpalm "github.com/weaviate/weaviate/modules/text2vec-palm"
 	modtransformers "github.com/weaviate/weaviate/modules/text2vec-transformers"
 	modvoyageai "github.com/weaviate/weaviate/modules/text2vec-voyageai"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/backup"
 	"github.com/weaviate/weaviate/usecases/classification"
 	"github.com/weaviate/weaviate/usecases/cluster"
@@ -336,7 +338,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	appState.ClassificationRepo = classifierRepo
 
 	scaler := scaler.New(appState.Cluster, vectorRepo,
-		remoteIndexClient, appState.Logger, appState.ServerConfig.Config.Persistence.DataPath)
+		remoteIndexClient, replicationClient, appState.Logger, appState.ServerConfig.Config.Persistence.DataPath)
 	appState.Scaler = scaler
 
 	server2port, err := parseNode2Port(appState)
@@ -388,6 +390,11 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 
 	appState.ClusterService = rCluster.New(rConfig)
 	migrator.SetCluster(appState.ClusterService.Raft)
+	// the authorizer needs the cluster service as rbac roles are stored in raft
+	appState.Authorizer = configureAuthorizer(appState)
+	if appState.ServerConfig.Config.Authentication.APIKey.DynamicEnabled() {
+		appState.APIKey.SetKeyStore(appState.ClusterService.Raft)
+	}
 
 	executor := schema.NewExecutor(migrator,
 		appState.ClusterService.SchemaReader(),
@@ -589,6 +596,17 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Logger)
 	setupBackupHandlers(api, backupScheduler, appState.Metrics, appState.Logger)
 	setupNodesHandlers(api, appState.SchemaManager, appState.DB, appState)
+	// the authorizer is only an rbac authorizer if rbac is enabled
+	if rbacAuthorizer, ok := appState.Authorizer.(*rbac.Authorizer); ok {
+		setupAuthzHandlers(api, rbac.NewManager(rbacAuthorizer,
+			appState.ClusterService.Raft, appState.ClusterService.Raft),
+			appState.Metrics, appState.Logger)
+	}
+	if appState.ServerConfig.Config.Authentication.APIKey.DynamicEnabled() {
+		setupAPIKeyHandlers(api, apikey.NewManager(appState.Authorizer,
+			appState.ClusterService.Raft, appState.ClusterService.Raft),
+			appState.Metrics, appState.Logger)
+	}
 
 	grpcServer := createGrpcServer(appState)
 	setupMiddlewares := makeSetupMiddlewares(appState)
@@ -695,7 +713,6 @@ func startupRoutine(ctx context.Context, options *swag.CommandLineOptionsGroup)
 	appState.OIDC = configureOIDC(appState)
 	appState.APIKey = configureAPIKey(appState)
 	appState.AnonymousAccess = configureAnonymousAccess(appState)
-	appState.Authorizer = configureAuthorizer(appState)
 
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
 		Debug("configured OIDC and anonymous access client")
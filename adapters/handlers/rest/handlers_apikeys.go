@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/apikeys"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+type apiKeyHandlers struct {
+	manager             *apikey.Manager
+	metricRequestsTotal restApiRequestsTotal
+}
+
+func (h *apiKeyHandlers) listKeys(params apikeys.ApikeysListParams, principal *models.Principal) middleware.Responder {
+	keys, err := h.manager.List(principal)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return apikeys.NewApikeysListForbidden().WithPayload(errPayloadFromSingleErr(err))
+		}
+		return apikeys.NewApikeysListInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return apikeys.NewApikeysListOK().WithPayload(keys)
+}
+
+func (h *apiKeyHandlers) createKey(params apikeys.ApikeysCreateParams, principal *models.Principal) middleware.Responder {
+	key, err := h.manager.Create(principal, params.Body)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return apikeys.NewApikeysCreateForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, apikey.ErrUnprocessable):
+			return apikeys.NewApikeysCreateUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return apikeys.NewApikeysCreateInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return apikeys.NewApikeysCreateCreated().WithPayload(key)
+}
+
+func (h *apiKeyHandlers) rotateKey(params apikeys.ApikeysRotateParams, principal *models.Principal) middleware.Responder {
+	key, err := h.manager.Rotate(principal, params.ID)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return apikeys.NewApikeysRotateForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, apikey.ErrNotFound):
+			return apikeys.NewApikeysRotateNotFound()
+		default:
+			return apikeys.NewApikeysRotateInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return apikeys.NewApikeysRotateOK().WithPayload(key)
+}
+
+func (h *apiKeyHandlers) revokeKey(params apikeys.ApikeysRevokeParams, principal *models.Principal) middleware.Responder {
+	err := h.manager.Revoke(principal, params.ID)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return apikeys.NewApikeysRevokeForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, apikey.ErrNotFound):
+			return apikeys.NewApikeysRevokeNotFound()
+		default:
+			return apikeys.NewApikeysRevokeInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return apikeys.NewApikeysRevokeNoContent()
+}
+
+// setupAPIKeyHandlers registers the API key management endpoints, without
+// dynamic API keys they keep responding with 501 Not Implemented
+func setupAPIKeyHandlers(api *operations.WeaviateAPI,
+	manager *apikey.Manager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+) {
+	h := &apiKeyHandlers{manager, newAPIKeyRequestsTotal(metrics, logger)}
+	api.ApikeysApikeysListHandler = apikeys.ApikeysListHandlerFunc(h.listKeys)
+	api.ApikeysApikeysCreateHandler = apikeys.ApikeysCreateHandlerFunc(h.createKey)
+	api.ApikeysApikeysRotateHandler = apikeys.ApikeysRotateHandlerFunc(h.rotateKey)
+	api.ApikeysApikeysRevokeHandler = apikeys.ApikeysRevokeHandlerFunc(h.revokeKey)
+}
+
+type apiKeyRequestsTotal struct {
+	*restApiRequestsTotalImpl
+}
+
+func newAPIKeyRequestsTotal(metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) restApiRequestsTotal {
+	return &apiKeyRequestsTotal{
+		restApiRequestsTotalImpl: &restApiRequestsTotalImpl{newRequestsTotalMetric(metrics, "rest"), "rest", "apikeys", logger},
+	}
+}
+
+func (e *apiKeyRequestsTotal) logError(className string, err error) {
+	switch {
+	case errors.As(err, &autherrs.Forbidden{}):
+		e.logUserError(className)
+	case errors.Is(err, apikey.ErrNotFound), errors.Is(err, apikey.ErrUnprocessable):
+		e.logUserError(className)
+	default:
+		e.logServerError(className, err)
+	}
+}
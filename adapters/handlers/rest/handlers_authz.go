@@ -0,0 +1,246 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/authz"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+type authzHandlers struct {
+	manager             *rbac.Manager
+	metricRequestsTotal restApiRequestsTotal
+}
+
+func (h *authzHandlers) listRoles(params authz.AuthzRolesListParams, principal *models.Principal) middleware.Responder {
+	roles, err := h.manager.GetRoles(principal)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return authz.NewAuthzRolesListForbidden().WithPayload(errPayloadFromSingleErr(err))
+		}
+		return authz.NewAuthzRolesListInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzRolesListOK().WithPayload(roles)
+}
+
+func (h *authzHandlers) createRole(params authz.AuthzRolesCreateParams, principal *models.Principal) middleware.Responder {
+	err := h.manager.CreateRole(principal, params.Body)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return authz.NewAuthzRolesCreateForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, rbac.ErrRoleExists):
+			return authz.NewAuthzRolesCreateConflict().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, rbac.ErrUnprocessable):
+			return authz.NewAuthzRolesCreateUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzRolesCreateInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzRolesCreateCreated()
+}
+
+func (h *authzHandlers) getRole(params authz.AuthzRolesGetParams, principal *models.Principal) middleware.Responder {
+	role, err := h.manager.GetRole(principal, params.ID)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return authz.NewAuthzRolesGetForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, rbac.ErrRoleNotFound):
+			return authz.NewAuthzRolesGetNotFound()
+		default:
+			return authz.NewAuthzRolesGetInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzRolesGetOK().WithPayload(role)
+}
+
+func (h *authzHandlers) updateRole(params authz.AuthzRolesUpdateParams, principal *models.Principal) middleware.Responder {
+	err := h.manager.UpdateRole(principal, params.ID, params.Body)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return authz.NewAuthzRolesUpdateForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, rbac.ErrRoleNotFound):
+			return authz.NewAuthzRolesUpdateNotFound()
+		case errors.Is(err, rbac.ErrUnprocessable):
+			return authz.NewAuthzRolesUpdateUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzRolesUpdateInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzRolesUpdateOK()
+}
+
+func (h *authzHandlers) deleteRole(params authz.AuthzRolesDeleteParams, principal *models.Principal) middleware.Responder {
+	err := h.manager.DeleteRole(principal, params.ID)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return authz.NewAuthzRolesDeleteForbidden().WithPayload(errPayloadFromSingleErr(err))
+		}
+		return authz.NewAuthzRolesDeleteInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzRolesDeleteNoContent()
+}
+
+func (h *authzHandlers) getRolesForUser(params authz.AuthzUsersRolesGetParams, principal *models.Principal) middleware.Responder {
+	roles, err := h.manager.GetRolesForUser(principal, params.ID)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return authz.NewAuthzUsersRolesGetForbidden().WithPayload(errPayloadFromSingleErr(err))
+		}
+		return authz.NewAuthzUsersRolesGetInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzUsersRolesGetOK().WithPayload(roles)
+}
+
+func (h *authzHandlers) assignRolesToUser(params authz.AuthzUsersAssignParams, principal *models.Principal) middleware.Responder {
+	err := h.manager.AssignRolesToUser(principal, params.ID, params.Body.Roles)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return authz.NewAuthzUsersAssignForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, rbac.ErrRoleNotFound):
+			return authz.NewAuthzUsersAssignNotFound().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, rbac.ErrUnprocessable):
+			return authz.NewAuthzUsersAssignUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzUsersAssignInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzUsersAssignOK()
+}
+
+func (h *authzHandlers) revokeRolesFromUser(params authz.AuthzUsersRevokeParams, principal *models.Principal) middleware.Responder {
+	err := h.manager.RevokeRolesFromUser(principal, params.ID, params.Body.Roles)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return authz.NewAuthzUsersRevokeForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, rbac.ErrUnprocessable):
+			return authz.NewAuthzUsersRevokeUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzUsersRevokeInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzUsersRevokeOK()
+}
+
+func (h *authzHandlers) assignRolesToGroup(params authz.AuthzGroupsAssignParams, principal *models.Principal) middleware.Responder {
+	err := h.manager.AssignRolesToGroup(principal, params.ID, params.Body.Roles)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return authz.NewAuthzGroupsAssignForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, rbac.ErrRoleNotFound):
+			return authz.NewAuthzGroupsAssignNotFound().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, rbac.ErrUnprocessable):
+			return authz.NewAuthzGroupsAssignUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzGroupsAssignInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzGroupsAssignOK()
+}
+
+func (h *authzHandlers) revokeRolesFromGroup(params authz.AuthzGroupsRevokeParams, principal *models.Principal) middleware.Responder {
+	err := h.manager.RevokeRolesFromGroup(principal, params.ID, params.Body.Roles)
+	if err != nil {
+		h.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return authz.NewAuthzGroupsRevokeForbidden().WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, rbac.ErrUnprocessable):
+			return authz.NewAuthzGroupsRevokeUnprocessableEntity().WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return authz.NewAuthzGroupsRevokeInternalServerError().WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk("")
+	return authz.NewAuthzGroupsRevokeOK()
+}
+
+// setupAuthzHandlers registers the role management endpoints, without rbac
+// they keep responding with 501 Not Implemented
+func setupAuthzHandlers(api *operations.WeaviateAPI,
+	manager *rbac.Manager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+) {
+	h := &authzHandlers{manager, newAuthzRequestsTotal(metrics, logger)}
+	api.AuthzAuthzRolesListHandler = authz.AuthzRolesListHandlerFunc(h.listRoles)
+	api.AuthzAuthzRolesCreateHandler = authz.AuthzRolesCreateHandlerFunc(h.createRole)
+	api.AuthzAuthzRolesGetHandler = authz.AuthzRolesGetHandlerFunc(h.getRole)
+	api.AuthzAuthzRolesUpdateHandler = authz.AuthzRolesUpdateHandlerFunc(h.updateRole)
+	api.AuthzAuthzRolesDeleteHandler = authz.AuthzRolesDeleteHandlerFunc(h.deleteRole)
+	api.AuthzAuthzUsersRolesGetHandler = authz.AuthzUsersRolesGetHandlerFunc(h.getRolesForUser)
+	api.AuthzAuthzUsersAssignHandler = authz.AuthzUsersAssignHandlerFunc(h.assignRolesToUser)
+	api.AuthzAuthzUsersRevokeHandler = authz.AuthzUsersRevokeHandlerFunc(h.revokeRolesFromUser)
+	api.AuthzAuthzGroupsAssignHandler = authz.AuthzGroupsAssignHandlerFunc(h.assignRolesToGroup)
+	api.AuthzAuthzGroupsRevokeHandler = authz.AuthzGroupsRevokeHandlerFunc(h.revokeRolesFromGroup)
+}
+
+type authzRequestsTotal struct {
+	*restApiRequestsTotalImpl
+}
+
+func newAuthzRequestsTotal(metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) restApiRequestsTotal {
+	return &authzRequestsTotal{
+		restApiRequestsTotalImpl: &restApiRequestsTotalImpl{newRequestsTotalMetric(metrics, "rest"), "rest", "authz", logger},
+	}
+}
+
+func (e *authzRequestsTotal) logError(className string, err error) {
+	switch {
+	case errors.As(err, &autherrs.Forbidden{}):
+		e.logUserError(className)
+	case errors.Is(err, rbac.ErrRoleNotFound), errors.Is(err, rbac.ErrRoleExists),
+		errors.Is(err, rbac.ErrUnprocessable):
+		e.logUserError(className)
+	default:
+		e.logServerError(className, err)
+	}
+}
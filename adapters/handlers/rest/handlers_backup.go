@@ -92,11 +92,12 @@ func (s *backupHandlers) createBackup(params backups.BackupsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
 	meta, err := s.manager.Backup(params.HTTPRequest.Context(), principal, &ubak.BackupRequest{
-		ID:          params.Body.ID,
-		Backend:     params.Backend,
-		Include:     params.Body.Include,
-		Exclude:     params.Body.Exclude,
-		Compression: compressionFromBCfg(params.Body.Config),
+		ID:           params.Body.ID,
+		Backend:      params.Backend,
+		Include:      params.Body.Include,
+		Exclude:      params.Body.Exclude,
+		Compression:  compressionFromBCfg(params.Body.Config),
+		BaseBackupID: params.Body.BaseBackupID,
 	})
 	if err != nil {
 		s.metricRequestsTotal.logError("", err)
@@ -151,6 +152,55 @@ func (s *backupHandlers) createBackupStatus(params backups.BackupsCreateStatusPa
 	return backups.NewBackupsCreateStatusOK().WithPayload(&payload)
 }
 
+func (s *backupHandlers) listBackups(params backups.BackupsListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	payload, err := s.manager.List(params.HTTPRequest.Context(), principal, params.Backend)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return backups.NewBackupsListUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsListOK().WithPayload(payload)
+}
+
+func (s *backupHandlers) cancelBackup(params backups.BackupsCancelParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.Cancel(params.HTTPRequest.Context(), principal, params.Backend, params.ID)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsCancelForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return backups.NewBackupsCancelNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return backups.NewBackupsCancelUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsCancelInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsCancelNoContent()
+}
+
 func (s *backupHandlers) restoreBackup(params backups.BackupsRestoreParams,
 	principal *models.Principal,
 ) middleware.Responder {
@@ -226,6 +276,10 @@ func setupBackupHandlers(api *operations.WeaviateAPI,
 		BackupsCreateHandlerFunc(h.createBackup)
 	api.BackupsBackupsCreateStatusHandler = backups.
 		BackupsCreateStatusHandlerFunc(h.createBackupStatus)
+	api.BackupsBackupsListHandler = backups.
+		BackupsListHandlerFunc(h.listBackups)
+	api.BackupsBackupsCancelHandler = backups.
+		BackupsCancelHandlerFunc(h.cancelBackup)
 	api.BackupsBackupsRestoreHandler = backups.
 		BackupsRestoreHandlerFunc(h.restoreBackup)
 	api.BackupsBackupsRestoreStatusHandler = backups.
@@ -99,6 +99,75 @@ func (n *nodesHandlers) getNodesStatistics(params cluster.ClusterGetStatisticsPa
 	return cluster.NewClusterGetStatisticsOK().WithPayload(statistics)
 }
 
+func (n *nodesHandlers) requantize(params nodes.NodesRequantizeParams, principal *models.Principal) middleware.Responder {
+	targetVector := ""
+	if params.TargetVector != nil {
+		targetVector = *params.TargetVector
+	}
+
+	// The vectors are re-encoded in the background, the progress is
+	// reported in the verbose output of the nodes API
+	err := n.manager.RequantizeShard(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ShardName, targetVector)
+	if err != nil {
+		n.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return nodes.NewNodesRequantizeNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return nodes.NewNodesRequantizeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return nodes.NewNodesRequantizeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesRequantizeInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	n.metricRequestsTotal.logOk(params.ClassName)
+	return nodes.NewNodesRequantizeAccepted()
+}
+
+func (n *nodesHandlers) vectorIndexHealth(params nodes.NodesVectorIndexHealthParams, principal *models.Principal) middleware.Responder {
+	targetVector := ""
+	if params.TargetVector != nil {
+		targetVector = *params.TargetVector
+	}
+
+	// estimating the recall requires a pass over all vectors, so it is
+	// only done if samples are requested
+	recallSamples := 0
+	if params.RecallSamples != nil {
+		recallSamples = int(*params.RecallSamples)
+	}
+
+	health, err := n.manager.GetVectorIndexHealth(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ShardName, targetVector, recallSamples)
+	if err != nil {
+		n.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &enterrors.ErrNotFound{}):
+			return nodes.NewNodesVectorIndexHealthNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &autherrs.Forbidden{}):
+			return nodes.NewNodesVectorIndexHealthForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &enterrors.ErrUnprocessable{}):
+			return nodes.NewNodesVectorIndexHealthUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return nodes.NewNodesVectorIndexHealthInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	n.metricRequestsTotal.logOk(params.ClassName)
+	return nodes.NewNodesVectorIndexHealthOK().WithPayload(health)
+}
+
 func (n *nodesHandlers) handleGetNodesError(err error) middleware.Responder {
 	n.metricRequestsTotal.logError("", err)
 	if errors.As(err, &enterrors.ErrNotFound{}) {
@@ -128,6 +197,10 @@ func setupNodesHandlers(api *operations.WeaviateAPI,
 		NodesGetHandlerFunc(h.getNodesStatus)
 	api.NodesNodesGetClassHandler = nodes.
 		NodesGetClassHandlerFunc(h.getNodesStatusByClass)
+	api.NodesNodesRequantizeHandler = nodes.
+		NodesRequantizeHandlerFunc(h.requantize)
+	api.NodesNodesVectorIndexHealthHandler = nodes.
+		NodesVectorIndexHealthHandlerFunc(h.vectorIndexHealth)
 	api.ClusterClusterGetStatisticsHandler = cluster.
 		ClusterGetStatisticsHandlerFunc(h.getNodesStatistics)
 }
@@ -31,6 +31,8 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/apikeys"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/authz"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/backups"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/classifications"
@@ -70,12 +72,60 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		WellKnownGetWellKnownOpenidConfigurationHandler: well_known.GetWellKnownOpenidConfigurationHandlerFunc(func(params well_known.GetWellKnownOpenidConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation well_known.GetWellKnownOpenidConfiguration has not yet been implemented")
 		}),
+		ApikeysApikeysCreateHandler: apikeys.ApikeysCreateHandlerFunc(func(params apikeys.ApikeysCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.ApikeysCreate has not yet been implemented")
+		}),
+		ApikeysApikeysListHandler: apikeys.ApikeysListHandlerFunc(func(params apikeys.ApikeysListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.ApikeysList has not yet been implemented")
+		}),
+		ApikeysApikeysRevokeHandler: apikeys.ApikeysRevokeHandlerFunc(func(params apikeys.ApikeysRevokeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.ApikeysRevoke has not yet been implemented")
+		}),
+		ApikeysApikeysRotateHandler: apikeys.ApikeysRotateHandlerFunc(func(params apikeys.ApikeysRotateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation apikeys.ApikeysRotate has not yet been implemented")
+		}),
+		AuthzAuthzGroupsAssignHandler: authz.AuthzGroupsAssignHandlerFunc(func(params authz.AuthzGroupsAssignParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzGroupsAssign has not yet been implemented")
+		}),
+		AuthzAuthzGroupsRevokeHandler: authz.AuthzGroupsRevokeHandlerFunc(func(params authz.AuthzGroupsRevokeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzGroupsRevoke has not yet been implemented")
+		}),
+		AuthzAuthzRolesCreateHandler: authz.AuthzRolesCreateHandlerFunc(func(params authz.AuthzRolesCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzRolesCreate has not yet been implemented")
+		}),
+		AuthzAuthzRolesDeleteHandler: authz.AuthzRolesDeleteHandlerFunc(func(params authz.AuthzRolesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzRolesDelete has not yet been implemented")
+		}),
+		AuthzAuthzRolesGetHandler: authz.AuthzRolesGetHandlerFunc(func(params authz.AuthzRolesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzRolesGet has not yet been implemented")
+		}),
+		AuthzAuthzRolesListHandler: authz.AuthzRolesListHandlerFunc(func(params authz.AuthzRolesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzRolesList has not yet been implemented")
+		}),
+		AuthzAuthzRolesUpdateHandler: authz.AuthzRolesUpdateHandlerFunc(func(params authz.AuthzRolesUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzRolesUpdate has not yet been implemented")
+		}),
+		AuthzAuthzUsersAssignHandler: authz.AuthzUsersAssignHandlerFunc(func(params authz.AuthzUsersAssignParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzUsersAssign has not yet been implemented")
+		}),
+		AuthzAuthzUsersRevokeHandler: authz.AuthzUsersRevokeHandlerFunc(func(params authz.AuthzUsersRevokeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzUsersRevoke has not yet been implemented")
+		}),
+		AuthzAuthzUsersRolesGetHandler: authz.AuthzUsersRolesGetHandlerFunc(func(params authz.AuthzUsersRolesGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation authz.AuthzUsersRolesGet has not yet been implemented")
+		}),
+		BackupsBackupsCancelHandler: backups.BackupsCancelHandlerFunc(func(params backups.BackupsCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsCancel has not yet been implemented")
+		}),
 		BackupsBackupsCreateHandler: backups.BackupsCreateHandlerFunc(func(params backups.BackupsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsCreate has not yet been implemented")
 		}),
 		BackupsBackupsCreateStatusHandler: backups.BackupsCreateStatusHandlerFunc(func(params backups.BackupsCreateStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsCreateStatus has not yet been implemented")
 		}),
+		BackupsBackupsListHandler: backups.BackupsListHandlerFunc(func(params backups.BackupsListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsList has not yet been implemented")
+		}),
 		BackupsBackupsRestoreHandler: backups.BackupsRestoreHandlerFunc(func(params backups.BackupsRestoreParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsRestore has not yet been implemented")
 		}),
@@ -115,6 +165,12 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		NodesNodesGetClassHandler: nodes.NodesGetClassHandlerFunc(func(params nodes.NodesGetClassParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation nodes.NodesGetClass has not yet been implemented")
 		}),
+		NodesNodesRequantizeHandler: nodes.NodesRequantizeHandlerFunc(func(params nodes.NodesRequantizeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesRequantize has not yet been implemented")
+		}),
+		NodesNodesVectorIndexHealthHandler: nodes.NodesVectorIndexHealthHandlerFunc(func(params nodes.NodesVectorIndexHealthParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation nodes.NodesVectorIndexHealth has not yet been implemented")
+		}),
 		ObjectsObjectsClassDeleteHandler: objects.ObjectsClassDeleteHandlerFunc(func(params objects.ObjectsClassDeleteParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsClassDelete has not yet been implemented")
 		}),
@@ -274,10 +330,42 @@ type WeaviateAPI struct {
 
 	// WellKnownGetWellKnownOpenidConfigurationHandler sets the operation handler for the get well known openid configuration operation
 	WellKnownGetWellKnownOpenidConfigurationHandler well_known.GetWellKnownOpenidConfigurationHandler
+	// ApikeysApikeysCreateHandler sets the operation handler for the apikeys create operation
+	ApikeysApikeysCreateHandler apikeys.ApikeysCreateHandler
+	// ApikeysApikeysListHandler sets the operation handler for the apikeys list operation
+	ApikeysApikeysListHandler apikeys.ApikeysListHandler
+	// ApikeysApikeysRevokeHandler sets the operation handler for the apikeys revoke operation
+	ApikeysApikeysRevokeHandler apikeys.ApikeysRevokeHandler
+	// ApikeysApikeysRotateHandler sets the operation handler for the apikeys rotate operation
+	ApikeysApikeysRotateHandler apikeys.ApikeysRotateHandler
+	// AuthzAuthzGroupsAssignHandler sets the operation handler for the authz groups assign operation
+	AuthzAuthzGroupsAssignHandler authz.AuthzGroupsAssignHandler
+	// AuthzAuthzGroupsRevokeHandler sets the operation handler for the authz groups revoke operation
+	AuthzAuthzGroupsRevokeHandler authz.AuthzGroupsRevokeHandler
+	// AuthzAuthzRolesCreateHandler sets the operation handler for the authz roles create operation
+	AuthzAuthzRolesCreateHandler authz.AuthzRolesCreateHandler
+	// AuthzAuthzRolesDeleteHandler sets the operation handler for the authz roles delete operation
+	AuthzAuthzRolesDeleteHandler authz.AuthzRolesDeleteHandler
+	// AuthzAuthzRolesGetHandler sets the operation handler for the authz roles get operation
+	AuthzAuthzRolesGetHandler authz.AuthzRolesGetHandler
+	// AuthzAuthzRolesListHandler sets the operation handler for the authz roles list operation
+	AuthzAuthzRolesListHandler authz.AuthzRolesListHandler
+	// AuthzAuthzRolesUpdateHandler sets the operation handler for the authz roles update operation
+	AuthzAuthzRolesUpdateHandler authz.AuthzRolesUpdateHandler
+	// AuthzAuthzUsersAssignHandler sets the operation handler for the authz users assign operation
+	AuthzAuthzUsersAssignHandler authz.AuthzUsersAssignHandler
+	// AuthzAuthzUsersRevokeHandler sets the operation handler for the authz users revoke operation
+	AuthzAuthzUsersRevokeHandler authz.AuthzUsersRevokeHandler
+	// AuthzAuthzUsersRolesGetHandler sets the operation handler for the authz users roles get operation
+	AuthzAuthzUsersRolesGetHandler authz.AuthzUsersRolesGetHandler
+	// BackupsBackupsCancelHandler sets the operation handler for the backups cancel operation
+	BackupsBackupsCancelHandler backups.BackupsCancelHandler
 	// BackupsBackupsCreateHandler sets the operation handler for the backups create operation
 	BackupsBackupsCreateHandler backups.BackupsCreateHandler
 	// BackupsBackupsCreateStatusHandler sets the operation handler for the backups create status operation
 	BackupsBackupsCreateStatusHandler backups.BackupsCreateStatusHandler
+	// BackupsBackupsListHandler sets the operation handler for the backups list operation
+	BackupsBackupsListHandler backups.BackupsListHandler
 	// BackupsBackupsRestoreHandler sets the operation handler for the backups restore operation
 	BackupsBackupsRestoreHandler backups.BackupsRestoreHandler
 	// BackupsBackupsRestoreStatusHandler sets the operation handler for the backups restore status operation
@@ -304,6 +392,10 @@ type WeaviateAPI struct {
 	NodesNodesGetHandler nodes.NodesGetHandler
 	// NodesNodesGetClassHandler sets the operation handler for the nodes get class operation
 	NodesNodesGetClassHandler nodes.NodesGetClassHandler
+	// NodesNodesRequantizeHandler sets the operation handler for the nodes requantize operation
+	NodesNodesRequantizeHandler nodes.NodesRequantizeHandler
+	// NodesNodesVectorIndexHealthHandler sets the operation handler for the nodes vector index health operation
+	NodesNodesVectorIndexHealthHandler nodes.NodesVectorIndexHealthHandler
 	// ObjectsObjectsClassDeleteHandler sets the operation handler for the objects class delete operation
 	ObjectsObjectsClassDeleteHandler objects.ObjectsClassDeleteHandler
 	// ObjectsObjectsClassGetHandler sets the operation handler for the objects class get operation
@@ -461,12 +553,60 @@ func (o *WeaviateAPI) Validate() error {
 	if o.WellKnownGetWellKnownOpenidConfigurationHandler == nil {
 		unregistered = append(unregistered, "well_known.GetWellKnownOpenidConfigurationHandler")
 	}
+	if o.ApikeysApikeysCreateHandler == nil {
+		unregistered = append(unregistered, "apikeys.ApikeysCreateHandler")
+	}
+	if o.ApikeysApikeysListHandler == nil {
+		unregistered = append(unregistered, "apikeys.ApikeysListHandler")
+	}
+	if o.ApikeysApikeysRevokeHandler == nil {
+		unregistered = append(unregistered, "apikeys.ApikeysRevokeHandler")
+	}
+	if o.ApikeysApikeysRotateHandler == nil {
+		unregistered = append(unregistered, "apikeys.ApikeysRotateHandler")
+	}
+	if o.AuthzAuthzGroupsAssignHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzGroupsAssignHandler")
+	}
+	if o.AuthzAuthzGroupsRevokeHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzGroupsRevokeHandler")
+	}
+	if o.AuthzAuthzRolesCreateHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzRolesCreateHandler")
+	}
+	if o.AuthzAuthzRolesDeleteHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzRolesDeleteHandler")
+	}
+	if o.AuthzAuthzRolesGetHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzRolesGetHandler")
+	}
+	if o.AuthzAuthzRolesListHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzRolesListHandler")
+	}
+	if o.AuthzAuthzRolesUpdateHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzRolesUpdateHandler")
+	}
+	if o.AuthzAuthzUsersAssignHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzUsersAssignHandler")
+	}
+	if o.AuthzAuthzUsersRevokeHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzUsersRevokeHandler")
+	}
+	if o.AuthzAuthzUsersRolesGetHandler == nil {
+		unregistered = append(unregistered, "authz.AuthzUsersRolesGetHandler")
+	}
+	if o.BackupsBackupsCancelHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsCancelHandler")
+	}
 	if o.BackupsBackupsCreateHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsCreateHandler")
 	}
 	if o.BackupsBackupsCreateStatusHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsCreateStatusHandler")
 	}
+	if o.BackupsBackupsListHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsListHandler")
+	}
 	if o.BackupsBackupsRestoreHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsRestoreHandler")
 	}
@@ -506,6 +646,12 @@ func (o *WeaviateAPI) Validate() error {
 	if o.NodesNodesGetClassHandler == nil {
 		unregistered = append(unregistered, "nodes.NodesGetClassHandler")
 	}
+	if o.NodesNodesRequantizeHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesRequantizeHandler")
+	}
+	if o.NodesNodesVectorIndexHealthHandler == nil {
+		unregistered = append(unregistered, "nodes.NodesVectorIndexHealthHandler")
+	}
 	if o.ObjectsObjectsClassDeleteHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsClassDeleteHandler")
 	}
@@ -718,11 +864,75 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/apikeys"] = apikeys.NewApikeysCreate(o.context, o.ApikeysApikeysCreateHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/apikeys"] = apikeys.NewApikeysList(o.context, o.ApikeysApikeysListHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/apikeys/{id}"] = apikeys.NewApikeysRevoke(o.context, o.ApikeysApikeysRevokeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/apikeys/{id}/rotate"] = apikeys.NewApikeysRotate(o.context, o.ApikeysApikeysRotateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/authz/groups/{id}/assign"] = authz.NewAuthzGroupsAssign(o.context, o.AuthzAuthzGroupsAssignHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/authz/groups/{id}/revoke"] = authz.NewAuthzGroupsRevoke(o.context, o.AuthzAuthzGroupsRevokeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/authz/roles"] = authz.NewAuthzRolesCreate(o.context, o.AuthzAuthzRolesCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/authz/roles/{id}"] = authz.NewAuthzRolesDelete(o.context, o.AuthzAuthzRolesDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/authz/roles/{id}"] = authz.NewAuthzRolesGet(o.context, o.AuthzAuthzRolesGetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/authz/roles"] = authz.NewAuthzRolesList(o.context, o.AuthzAuthzRolesListHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/authz/roles/{id}"] = authz.NewAuthzRolesUpdate(o.context, o.AuthzAuthzRolesUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/authz/users/{id}/assign"] = authz.NewAuthzUsersAssign(o.context, o.AuthzAuthzUsersAssignHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/authz/users/{id}/revoke"] = authz.NewAuthzUsersRevoke(o.context, o.AuthzAuthzUsersRevokeHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/authz/users/{id}/roles"] = authz.NewAuthzUsersRolesGet(o.context, o.AuthzAuthzUsersRolesGetHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/backups/{backend}/{id}"] = backups.NewBackupsCancel(o.context, o.BackupsBackupsCancelHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/backups/{backend}"] = backups.NewBackupsCreate(o.context, o.BackupsBackupsCreateHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/backups/{backend}/{id}"] = backups.NewBackupsCreateStatus(o.context, o.BackupsBackupsCreateStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/backups/{backend}"] = backups.NewBackupsList(o.context, o.BackupsBackupsListHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -775,6 +985,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/nodes/{className}"] = nodes.NewNodesGetClass(o.context, o.NodesNodesGetClassHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/nodes/{className}/shards/{shardName}/requantize"] = nodes.NewNodesRequantize(o.context, o.NodesNodesRequantizeHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/nodes/{className}/shards/{shardName}/health"] = nodes.NewNodesVectorIndexHealth(o.context, o.NodesNodesVectorIndexHealthHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func SetupPhraseClass(t require.TestingT, repo *DB, schemaGetter *fakeSchemaGetter, logger logrus.FieldLogger,
+) []string {
+	vTrue := true
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "none"),
+		Class:               "PhraseClass",
+
+		Properties: []*models.Property{
+			{
+				Name:           "title",
+				DataType:       schema.DataTypeText.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:           "tags",
+				DataType:       schema.DataTypeTextArray.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:         "body",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	props := make([]string, len(class.Properties))
+	for i, prop := range class.Properties {
+		props[i] = prop.Name
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	migrator := NewMigrator(repo, logger)
+	migrator.AddClass(context.Background(), class, schemaGetter.shardState)
+
+	testData := []map[string]interface{}{
+		{"title": "machine learning in practice"},
+		{"title": "learning about machine tools"},
+		{"title": "the machine that keeps learning"},
+		{"tags": []string{"deep machine", "learning rate"}},
+		{"title": "machine learning machine learning"},
+		{"body": "machine learning without positions"},
+	}
+	for i, data := range testData {
+		putPhraseObject(t, repo, i, data)
+	}
+	return props
+}
+
+func putPhraseObject(t require.TestingT, repo *DB, i int, data map[string]interface{}) {
+	obj := &models.Object{Class: "PhraseClass", ID: phraseObjectID(i), Properties: data}
+	require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil, nil, 0))
+}
+
+func phraseObjectID(i int) strfmt.UUID {
+	return strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+}
+
+func TestBM25FPhrases(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	props := SetupPhraseClass(t, repo, schemaGetter, logger)
+
+	idx := repo.GetIndex("PhraseClass")
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, query string, slop int, properties ...string) []strfmt.UUID {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Query: query, Slop: slop, Properties: properties}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID()
+		}
+		return ids
+	}
+
+	t.Run("without quotes all terms are matched independently", func(t *testing.T) {
+		ids := search(t, "machine learning", 0, "title", "tags")
+		assert.ElementsMatch(t, []strfmt.UUID{
+			phraseObjectID(0), phraseObjectID(1), phraseObjectID(2), phraseObjectID(3), phraseObjectID(4),
+		}, ids)
+	})
+
+	t.Run("exact phrase", func(t *testing.T) {
+		ids := search(t, `"machine learning"`, 0, "title", "tags")
+		assert.Equal(t, []strfmt.UUID{phraseObjectID(4), phraseObjectID(0)}, ids)
+	})
+
+	t.Run("phrase with slop", func(t *testing.T) {
+		ids := search(t, `"machine learning"`, 2, "title", "tags")
+		assert.ElementsMatch(t, []strfmt.UUID{phraseObjectID(0), phraseObjectID(2), phraseObjectID(4)}, ids)
+	})
+
+	t.Run("phrase order is respected", func(t *testing.T) {
+		ids := search(t, `"learning machine"`, 0, "title", "tags")
+		assert.Equal(t, []strfmt.UUID{phraseObjectID(4)}, ids)
+
+		ids = search(t, `"learning machine"`, 1, "title", "tags")
+		assert.ElementsMatch(t, []strfmt.UUID{phraseObjectID(1), phraseObjectID(4)}, ids)
+	})
+
+	t.Run("phrases don't match across array elements", func(t *testing.T) {
+		ids := search(t, `"machine learning"`, 50, "tags")
+		assert.Empty(t, ids)
+	})
+
+	t.Run("phrase combined with other terms", func(t *testing.T) {
+		ids := search(t, `"machine learning" practice`, 0, "title")
+		assert.Equal(t, []strfmt.UUID{phraseObjectID(0), phraseObjectID(4)}, ids)
+	})
+
+	t.Run("phrase without any positions indexed", func(t *testing.T) {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Query: `"machine learning"`, Properties: []string{"body"}}
+		_, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "indexPositions")
+	})
+
+	t.Run("slop out of range", func(t *testing.T) {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Query: `"machine learning"`, Slop: 1000, Properties: []string{"title"}}
+		_, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+		require.NotNil(t, err)
+	})
+
+	t.Run("positions are updated", func(t *testing.T) {
+		putPhraseObject(t, repo, 0, map[string]interface{}{"title": "learning in practice about machine"})
+		ids := search(t, `"machine learning"`, 0, "title", "tags")
+		assert.Equal(t, []strfmt.UUID{phraseObjectID(4)}, ids)
+
+		putPhraseObject(t, repo, 0, map[string]interface{}{"title": "practice machine learning"})
+		ids = search(t, `"machine learning"`, 0, "title", "tags")
+		assert.ElementsMatch(t, []strfmt.UUID{phraseObjectID(0), phraseObjectID(4)}, ids)
+	})
+
+	t.Run("positions are deleted", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), "PhraseClass", phraseObjectID(4), nil, "", 0))
+		ids := search(t, `"machine learning"`, 0, "title", "tags")
+		assert.Equal(t, []strfmt.UUID{phraseObjectID(0)}, ids)
+	})
+}
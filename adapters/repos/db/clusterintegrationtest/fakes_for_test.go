@@ -377,6 +377,18 @@ func (f *fakeBackupBackend) Initialize(ctx context.Context, backupID string) err
 	return nil
 }
 
+func (f *fakeBackupBackend) AllBackupIDs(ctx context.Context) ([]string, error) {
+	f.Lock()
+	defer f.Unlock()
+	return []string{f.backupID}, nil
+}
+
+func (f *fakeBackupBackend) Delete(ctx context.Context, backupID string) error {
+	f.Lock()
+	defer f.Unlock()
+	return nil
+}
+
 func (f *fakeBackupBackend) successGlobalMeta() backup.DistributedBackupDescriptor {
 	return backup.DistributedBackupDescriptor{
 		StartedAt: f.startedAt,
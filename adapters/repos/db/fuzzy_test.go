@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func SetupFuzzyClass(t require.TestingT, repo *DB, schemaGetter *fakeSchemaGetter, logger logrus.FieldLogger,
+) []string {
+	vFalse := false
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "none"),
+		Class:               "FuzzyClass",
+
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				// only searchable, so that filters are served by the map bucket
+				Name:            "body",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexFilterable: &vFalse,
+			},
+		},
+	}
+	props := make([]string, len(class.Properties))
+	for i, prop := range class.Properties {
+		props[i] = prop.Name
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	migrator := NewMigrator(repo, logger)
+	migrator.AddClass(context.Background(), class, schemaGetter.shardState)
+
+	testData := []map[string]interface{}{
+		{"title": "apple pie", "body": "delicious apple pie"},
+		{"title": "apples and pears", "body": "fresh apples"},
+		{"title": "maple syrup", "body": "sweet maple syrup"},
+		{"title": "pineapple", "body": "tropical pineapple"},
+		{"title": "grape juice", "body": "grapes"},
+	}
+	for i, data := range testData {
+		obj := &models.Object{Class: "FuzzyClass", ID: fuzzyObjectID(i), Properties: data}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil, nil, 0))
+	}
+	return props
+}
+
+func fuzzyObjectID(i int) strfmt.UUID {
+	return strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+}
+
+func TestFuzzyMatching(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	props := SetupFuzzyClass(t, repo, schemaGetter, logger)
+
+	idx := repo.GetIndex("FuzzyClass")
+	require.NotNil(t, idx)
+
+	ids := func(res []*storobj.Object) []strfmt.UUID {
+		out := make([]strfmt.UUID, len(res))
+		for i := range res {
+			out[i] = res[i].ID()
+		}
+		return out
+	}
+
+	search := func(t *testing.T, query string, fuzzy *searchparams.Fuzziness, properties ...string) []strfmt.UUID {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Query: query, Properties: properties, Fuzzy: fuzzy}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+		require.Nil(t, err)
+		return ids(res)
+	}
+
+	filter := func(t *testing.T, property, value string) []strfmt.UUID {
+		f := &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorFuzzy,
+				On:       &filters.Path{Class: "FuzzyClass", Property: schema.PropertyName(property)},
+				Value:    &filters.Value{Value: value, Type: schema.DataTypeText},
+			},
+		}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, f, nil, nil, nil, additional.Properties{}, nil, "", 0, props)
+		require.Nil(t, err)
+		return ids(res)
+	}
+
+	t.Run("bm25 without fuzziness requires exact terms", func(t *testing.T) {
+		assert.Empty(t, search(t, "aple", nil, "title"))
+	})
+
+	t.Run("bm25 with a single edit", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{fuzzyObjectID(0), fuzzyObjectID(2)},
+			search(t, "aple", &searchparams.Fuzziness{MaxEdits: 1}, "title"))
+	})
+
+	t.Run("bm25 with two edits", func(t *testing.T) {
+		// apples is two edits away, as is pie
+		assert.ElementsMatch(t, []strfmt.UUID{fuzzyObjectID(0), fuzzyObjectID(1), fuzzyObjectID(2)},
+			search(t, "aple", &searchparams.Fuzziness{MaxEdits: 2}, "title"))
+	})
+
+	t.Run("bm25 ranks exact matches first", func(t *testing.T) {
+		res := search(t, "apples", &searchparams.Fuzziness{MaxEdits: 1}, "title")
+		assert.Equal(t, []strfmt.UUID{fuzzyObjectID(1), fuzzyObjectID(0)}, res)
+	})
+
+	t.Run("bm25 with prefix length", func(t *testing.T) {
+		// maple is an insertion away, but doesn't start with the same character
+		assert.ElementsMatch(t, []strfmt.UUID{fuzzyObjectID(0)},
+			search(t, "aple", &searchparams.Fuzziness{MaxEdits: 1, PrefixLength: 1}, "title"))
+	})
+
+	t.Run("fuzzy filter on filterable property", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{fuzzyObjectID(0), fuzzyObjectID(2)}, filter(t, "title", "aple"))
+	})
+
+	t.Run("fuzzy filter on searchable property", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{fuzzyObjectID(1)}, filter(t, "body", "aples"))
+	})
+
+	t.Run("fuzzy filter requires exact matches of short values", func(t *testing.T) {
+		assert.Empty(t, filter(t, "title", "ap"))
+	})
+
+	t.Run("fuzzy filter requires all tokens", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{fuzzyObjectID(0)}, filter(t, "title", "aple pye"))
+	})
+}
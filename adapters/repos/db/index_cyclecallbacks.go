@@ -36,8 +36,14 @@ type indexCycleCallbacks struct {
 	geoPropsCommitLoggerCycle         cyclemanager.CycleManager
 	geoPropsTombstoneCleanupCallbacks cyclemanager.CycleCallbackGroup
 	geoPropsTombstoneCleanupCycle     cyclemanager.CycleManager
+
+	objectTTLCallbacks cyclemanager.CycleCallbackGroup
+	objectTTLCycle     cyclemanager.CycleManager
 }
 
+// objectTTLCycleInterval is how often shards look for expired objects
+const objectTTLCycleInterval = time.Minute
+
 func (index *Index) initCycleCallbacks() {
 	vectorTombstoneCleanupIntervalSeconds := hnsw.DefaultCleanupIntervalSeconds
 	if hnswUserConfig, ok := index.vectorIndexUserConfig.(hnsw.UserConfig); ok {
@@ -95,6 +101,11 @@ func (index *Index) initCycleCallbacks() {
 		cyclemanager.NewFixedTicker(enthnsw.DefaultCleanupIntervalSeconds*time.Second),
 		geoPropsTombstoneCleanupCallbacks.CycleCallback, index.logger)
 
+	objectTTLCallbacks := cyclemanager.NewCallbackGroup(id("object_ttl"), index.logger, _NUMCPU)
+	objectTTLCycle := cyclemanager.NewManager(
+		cyclemanager.NewFixedTicker(objectTTLCycleInterval),
+		objectTTLCallbacks.CycleCallback, index.logger)
+
 	index.cycleCallbacks = &indexCycleCallbacks{
 		compactionCallbacks: compactionCallbacks,
 		compactionCycle:     compactionCycle,
@@ -110,6 +121,9 @@ func (index *Index) initCycleCallbacks() {
 		geoPropsCommitLoggerCycle:         geoPropsCommitLoggerCycle,
 		geoPropsTombstoneCleanupCallbacks: geoPropsTombstoneCleanupCallbacks,
 		geoPropsTombstoneCleanupCycle:     geoPropsTombstoneCleanupCycle,
+
+		objectTTLCallbacks: objectTTLCallbacks,
+		objectTTLCycle:     objectTTLCycle,
 	}
 }
 
@@ -129,5 +143,8 @@ func (index *Index) initCycleCallbacksNoop() {
 		geoPropsCommitLoggerCycle:         cyclemanager.NewManagerNoop(),
 		geoPropsTombstoneCleanupCallbacks: cyclemanager.NewCallbackGroupNoop(),
 		geoPropsTombstoneCleanupCycle:     cyclemanager.NewManagerNoop(),
+
+		objectTTLCallbacks: cyclemanager.NewCallbackGroupNoop(),
+		objectTTLCycle:     cyclemanager.NewManagerNoop(),
 	}
 }
@@ -125,12 +125,29 @@ type batchIndexer interface {
 	ValidateBeforeInsert(vector []float32) error
 }
 
+var errDistanceMetricOverride = errors.New("the distance metric can only be overridden on flat indexes")
+
+// distancerOverridableIndexer is implemented by brute force indexes, which
+// can search with a different distance metric than the one they were
+// configured with
+type distancerOverridableIndexer interface {
+	SearchByVectorWithDistancer(vector []float32, k int, allow helpers.AllowList,
+		provider distancer.Provider) ([]uint64, []float32, error)
+	SearchByVectorDistanceWithDistancer(vector []float32, targetDistance float32,
+		maxLimit int64, allow helpers.AllowList, provider distancer.Provider) ([]uint64, []float32, error)
+}
+
 type upgradableIndexer interface {
 	Upgraded() bool
 	Upgrade(callback func()) error
 	ShouldUpgrade() (bool, int)
 }
 
+type downgradableIndexer interface {
+	ShouldDowngrade() bool
+	Downgrade(callback func()) error
+}
+
 type shardStatusUpdater interface {
 	compareAndSwapStatus(old, new string) (storagestate.Status, error)
 	Name() string
@@ -429,6 +446,24 @@ func (q *IndexQueue) PreloadShard(shard ShardLike) error {
 			if vectorIndex.ContainsNode(id) {
 				continue
 			}
+			// multi and sparse vectors are not queued, objects which were stored but not
+			// yet added to the index are added directly
+			if multiIndex, ok := vectorIndex.(multiVectorIndex); ok {
+				if vectors := obj.MultiVectors[q.targetVector]; len(vectors) > 0 {
+					if err := multiIndex.AddMulti(id, vectors); err != nil {
+						return errors.Wrap(err, "add multi vector")
+					}
+				}
+				continue
+			}
+			if sparseIndex, ok := vectorIndex.(sparseVectorIndex); ok {
+				if vector, ok := obj.SparseVectors[q.targetVector]; ok {
+					if err := sparseIndex.AddSparse(id, vector); err != nil {
+						return errors.Wrap(err, "add sparse vector")
+					}
+				}
+				continue
+			}
 			if len(obj.Vectors) == 0 {
 				continue
 			}
@@ -603,23 +638,54 @@ func (q *IndexQueue) logStats(vectorsSent int64) {
 // SearchByVector performs the search through the index first, then uses brute force to
 // query unindexed vectors.
 func (q *IndexQueue) SearchByVector(vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
-	return q.search(vector, -1, k, allowList)
+	return q.search(vector, -1, k, allowList, nil)
 }
 
 // SearchByVectorDistance performs the search through the index first, then uses brute force to
 // query unindexed vectors.
 func (q *IndexQueue) SearchByVectorDistance(vector []float32, dist float32, maxLimit int64, allowList helpers.AllowList) ([]uint64, []float32, error) {
-	return q.search(vector, dist, int(maxLimit), allowList)
+	return q.search(vector, dist, int(maxLimit), allowList, nil)
+}
+
+// SearchByVectorWithDistancer is the equivalent of SearchByVector with the
+// distance metric of the index replaced by the given one. It fails for
+// indexes which don't search by brute force.
+func (q *IndexQueue) SearchByVectorWithDistancer(vector []float32, k int,
+	allowList helpers.AllowList, provider distancer.Provider,
+) ([]uint64, []float32, error) {
+	return q.search(vector, -1, k, allowList, provider)
+}
+
+// SearchByVectorDistanceWithDistancer is the equivalent of
+// SearchByVectorDistance with the distance metric of the index replaced by the
+// given one. It fails for indexes which don't search by brute force.
+func (q *IndexQueue) SearchByVectorDistanceWithDistancer(vector []float32, dist float32,
+	maxLimit int64, allowList helpers.AllowList, provider distancer.Provider,
+) ([]uint64, []float32, error) {
+	return q.search(vector, dist, int(maxLimit), allowList, provider)
 }
 
-func (q *IndexQueue) search(vector []float32, dist float32, maxLimit int, allowList helpers.AllowList) ([]uint64, []float32, error) {
+// search uses the distance metric of the index unless a provider is given
+func (q *IndexQueue) search(vector []float32, dist float32, maxLimit int,
+	allowList helpers.AllowList, provider distancer.Provider,
+) ([]uint64, []float32, error) {
 	start := time.Now()
 	defer q.metrics.Search(start)
 
 	var indexedResults []uint64
 	var distances []float32
 	var err error
-	if dist == -1 {
+	if provider != nil {
+		index, ok := q.Index.(distancerOverridableIndexer)
+		if !ok {
+			return nil, nil, errDistanceMetricOverride
+		}
+		if dist == -1 {
+			indexedResults, distances, err = index.SearchByVectorWithDistancer(vector, maxLimit, allowList, provider)
+		} else {
+			indexedResults, distances, err = index.SearchByVectorDistanceWithDistancer(vector, dist, int64(maxLimit), allowList, provider)
+		}
+	} else if dist == -1 {
 		indexedResults, distances, err = q.Index.SearchByVector(vector, maxLimit, allowList)
 	} else {
 		indexedResults, distances, err = q.Index.SearchByVectorDistance(vector, dist, int64(maxLimit), allowList)
@@ -632,7 +698,14 @@ func (q *IndexQueue) search(vector []float32, dist float32, maxLimit int, allowL
 		return indexedResults, distances, nil
 	}
 
-	if q.Index.DistancerProvider().Type() == "cosine-dot" {
+	distanceBetweenVectors := q.Index.DistanceBetweenVectors
+	if provider != nil {
+		distanceBetweenVectors = provider.SingleDist
+	} else {
+		provider = q.Index.DistancerProvider()
+	}
+
+	if provider.Type() == "cosine-dot" {
 		// cosine-dot requires normalized vectors, as the dot product and cosine
 		// similarity are only identical if the vector is normalized
 		vector = distancer.Normalize(vector)
@@ -651,7 +724,8 @@ func (q *IndexQueue) search(vector []float32, dist float32, maxLimit int, allowL
 			}
 		}
 
-		return q.bruteForce(vector, objects, maxLimit, results, allowList, dist, seen)
+		return q.bruteForce(vector, objects, maxLimit, results, allowList, dist, seen,
+			provider, distanceBetweenVectors)
 	})
 	if results != nil {
 		defer q.pqMaxPool.Put(results)
@@ -678,6 +752,16 @@ func (q *IndexQueue) search(vector []float32, dist float32, maxLimit int, allowL
 }
 
 func (q *IndexQueue) checkCompressionSettings() bool {
+	if di, ok := q.Index.(downgradableIndexer); ok && di.ShouldDowngrade() {
+		q.PauseIndexing()
+		err := di.Downgrade(q.ResumeIndexing)
+		if err != nil {
+			q.Logger.WithError(err).Error("failed to downgrade")
+		}
+
+		return true
+	}
+
 	ci, ok := q.Index.(upgradableIndexer)
 	if !ok {
 		return false
@@ -726,7 +810,8 @@ func (q *IndexQueue) ResumeIndexing() {
 
 func (q *IndexQueue) bruteForce(vector []float32, snapshot []vectorDescriptor, k int,
 	results *priorityqueue.Queue[any], allowList helpers.AllowList,
-	maxDistance float32, seen map[uint64]struct{},
+	maxDistance float32, seen map[uint64]struct{}, provider distancer.Provider,
+	distanceBetweenVectors func(x, y []float32) (float32, error),
 ) error {
 	for i := range snapshot {
 		// skip indexed data
@@ -740,13 +825,13 @@ func (q *IndexQueue) bruteForce(vector []float32, snapshot []vectorDescriptor, k
 		}
 
 		v := snapshot[i].vector
-		if q.Index.DistancerProvider().Type() == "cosine-dot" {
+		if provider.Type() == "cosine-dot" {
 			// cosine-dot requires normalized vectors, as the dot product and cosine
 			// similarity are only identical if the vector is normalized
 			v = distancer.Normalize(v)
 		}
 
-		dist, err := q.Index.DistanceBetweenVectors(vector, v)
+		dist, err := distanceBetweenVectors(vector, v)
 		if err != nil {
 			return err
 		}
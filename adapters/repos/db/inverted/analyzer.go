@@ -16,7 +16,6 @@ import (
 	"encoding/binary"
 
 	"github.com/google/uuid"
-	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -25,6 +24,9 @@ type IsFallbackToSearchable func() bool
 type Countable struct {
 	Data          []byte
 	TermFrequency float32
+	// Positions of the term in the property, only set if the property has a
+	// position index
+	Positions []uint32
 }
 
 type Property struct {
@@ -34,6 +36,7 @@ type Property struct {
 	HasFilterableIndex bool // roaring set index
 	HasSearchableIndex bool // map index (with frequencies)
 	HasRangeableIndex  bool // roaring set index for ranged queries
+	HasPositionIndex   bool // replace index with the positions of the searchable terms
 }
 
 type NilProperty struct {
@@ -74,9 +77,15 @@ func (a *Analyzer) Text(tokenization, in string) []Countable {
 // TextArray tokenizes given input according to selected tokenization,
 // then aggregates duplicates
 func (a *Analyzer) TextArray(tokenization string, inArr []string) []Countable {
+	return a.AnalyzedTextArray(tokenizingAnalyzer(tokenization), inArr)
+}
+
+// AnalyzedTextArray runs given input through the text analyzer, then
+// aggregates duplicates
+func (a *Analyzer) AnalyzedTextArray(textAnalyzer *TextAnalyzer, inArr []string) []Countable {
 	var terms []string
 	for _, in := range inArr {
-		terms = append(terms, helpers.Tokenize(tokenization, in)...)
+		terms = append(terms, textAnalyzer.Tokenize(in)...)
 	}
 
 	counts := map[string]uint64{}
@@ -96,6 +105,47 @@ func (a *Analyzer) TextArray(tokenization string, inArr []string) []Countable {
 	return countable
 }
 
+// TextArrayWithPositions works like TextArray, but additionally records the
+// positions at which each term occurs. The elements of the array are separated
+// by PositionIncrementGap, so that phrases can not match across elements.
+func (a *Analyzer) TextArrayWithPositions(tokenization string, inArr []string) []Countable {
+	return a.AnalyzedTextArrayWithPositions(tokenizingAnalyzer(tokenization), inArr)
+}
+
+// AnalyzedTextArrayWithPositions works like TextArrayWithPositions with the
+// given text analyzer. Tokens removed by the analyzer still take up a position.
+func (a *Analyzer) AnalyzedTextArrayWithPositions(textAnalyzer *TextAnalyzer, inArr []string) []Countable {
+	positions := map[string][]uint32{}
+	var order []string
+	pos := uint32(0)
+	for i, in := range inArr {
+		if i > 0 {
+			pos += PositionIncrementGap
+		}
+		for _, term := range textAnalyzer.TokenizeWithGaps(in) {
+			if term == "" {
+				pos++
+				continue
+			}
+			if _, ok := positions[term]; !ok {
+				order = append(order, term)
+			}
+			positions[term] = append(positions[term], pos)
+			pos++
+		}
+	}
+
+	countable := make([]Countable, len(order))
+	for i, term := range order {
+		countable[i] = Countable{
+			Data:          []byte(term),
+			TermFrequency: float32(len(positions[term])),
+			Positions:     positions[term],
+		}
+	}
+	return countable
+}
+
 // Int requires no analysis, so it's actually just a simple conversion to a
 // string-formatted byte slice of the int
 func (a *Analyzer) Int(in int64) ([]Countable, error) {
@@ -0,0 +1,255 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// blockImpactSlack inflates the block upper bounds, so that differences in
+// rounding between the bounds and the actual scores can never lead to a doc
+// being skipped which would have made it into the results
+const blockImpactSlack = 1e-6
+
+// blockBound bounds the contribution of a term to the score of any doc within
+// [minID, maxID], see "Faster Top-k Document Retrieval Using Block-Max
+// Indexes" (Ding, Suel)
+type blockBound struct {
+	minID      uint64
+	maxID      uint64
+	frequency  float64 // upper bound
+	propLength float64 // lower bound
+	impact     float64
+}
+
+// propertyBlockMax returns the block-max metadata of a term in the given
+// property. The frequencies are boosted just like the ones of the postings.
+// If the bucket does not provide block-max metadata (yet) or it can't be used
+// to bound the score, false is returned.
+func propertyBlockMax(ctx context.Context, bucket *lsmkv.Bucket, query string,
+	propBoost float32,
+) ([]lsmkv.BlockMax, bool, error) {
+	if propBoost < 0 {
+		// the highest frequency would result in the lowest score
+		return nil, false, nil
+	}
+
+	blocks, err := bucket.MapListBlockMax(ctx, []byte(query))
+	if err != nil {
+		if errors.Is(err, lsmkv.ErrBlockMaxDisabled) ||
+			errors.Is(err, lsmkv.ErrBlockMaxUnavailable) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	for i := range blocks {
+		blocks[i].MaxFrequency *= propBoost
+	}
+	return blocks, true, nil
+}
+
+// newBlockBounds turns the blocks of all segments and properties of a term
+// into disjoint bounds sorted by id. Blocks of different segments can
+// overlap, but a doc only has a single (the latest) posting per property, so
+// the highest frequency of the overlapping blocks of a property is used. The
+// frequencies of the properties are summed up, just like the property
+// lengths, of which the shortest is a lower bound.
+//
+// The blocks are only an approximation of the postings, as writes can happen
+// in between reading both of them. Therefore, the bounds are verified against
+// the postings and nil is returned if they don't cover all of them, which
+// includes postings that aren't sorted by id.
+func newBlockBounds(propBlocks [][]lsmkv.BlockMax, postings []docPointerWithScore) []blockBound {
+	type propBlock struct {
+		lsmkv.BlockMax
+		prop int
+	}
+
+	var blocks []propBlock
+	for prop := range propBlocks {
+		for _, block := range propBlocks[prop] {
+			blocks = append(blocks, propBlock{BlockMax: block, prop: prop})
+		}
+	}
+	if len(blocks) == 0 || len(postings) == 0 {
+		return nil
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].MinID < blocks[j].MinID
+	})
+
+	points := make([]uint64, 0, 2*len(blocks))
+	for _, block := range blocks {
+		points = append(points, block.MinID)
+		if block.MaxID < math.MaxUint64 {
+			points = append(points, block.MaxID+1)
+		}
+	}
+	slices.Sort(points)
+	points = slices.Compact(points)
+
+	bounds := make([]blockBound, 0, len(points))
+	active := make([]propBlock, 0, 16)
+	propFrequencies := make([]float64, len(propBlocks))
+	next := 0
+	for i, start := range points {
+		n := 0
+		for _, block := range active {
+			if block.MaxID >= start {
+				active[n] = block
+				n++
+			}
+		}
+		active = active[:n]
+		for next < len(blocks) && blocks[next].MinID == start {
+			active = append(active, blocks[next])
+			next++
+		}
+		if len(active) == 0 {
+			continue
+		}
+
+		bound := blockBound{minID: start, maxID: math.MaxUint64, propLength: math.Inf(1)}
+		if i+1 < len(points) {
+			bound.maxID = points[i+1] - 1
+		}
+		clear(propFrequencies)
+		for _, block := range active {
+			propFrequencies[block.prop] = max(propFrequencies[block.prop], float64(block.MaxFrequency))
+			bound.propLength = min(bound.propLength, float64(block.MinPropLength))
+		}
+		for _, frequency := range propFrequencies {
+			bound.frequency += frequency
+		}
+		bounds = append(bounds, bound)
+	}
+
+	pos := 0
+	for _, posting := range postings {
+		for pos < len(bounds) && bounds[pos].maxID < posting.id {
+			pos++
+		}
+		if pos == len(bounds) || bounds[pos].minID > posting.id ||
+			float64(posting.frequency) > bounds[pos].frequency*(1+blockImpactSlack/10) ||
+			float64(posting.propLength) < bounds[pos].propLength {
+			return nil
+		}
+	}
+
+	return bounds
+}
+
+// initImpacts sets the upper bounds of the score contributions of the term.
+// Without block bounds, the idf is used, as the term frequency part of the
+// score is always below 1.
+func (t *term) initImpacts(averagePropLength float64, config schema.BM25Config) {
+	t.maxImpact = t.idf
+	t.blockPos = 0
+
+	// the block bounds rely on the score increasing with the frequency and
+	// decreasing with the property length
+	if t.idf <= 0 || config.K1 <= 0 || config.B < 0 || config.B > 1 ||
+		averagePropLength <= 0 {
+		t.blocks = nil
+	}
+	if len(t.blocks) == 0 {
+		return
+	}
+
+	t.maxImpact = 0
+	for i := range t.blocks {
+		block := &t.blocks[i]
+		tf := block.frequency / (block.frequency +
+			config.K1*(1-config.B+config.B*block.propLength/averagePropLength))
+		block.impact = tf * t.idf * (1 + blockImpactSlack)
+		t.maxImpact = max(t.maxImpact, block.impact)
+	}
+}
+
+// blockImpact returns the upper bound of the score contribution of the term
+// to the doc with the given id, as well as the highest id up to which this
+// bound holds
+func (t *term) blockImpact(id uint64) (float64, uint64) {
+	if len(t.blocks) == 0 {
+		// a negative idf lowers the score, the least by not matching at all
+		return max(t.maxImpact, 0), math.MaxUint64
+	}
+
+	if t.blockPos > 0 && t.blocks[t.blockPos-1].maxID >= id {
+		// ids are usually increasing, start over if not
+		t.blockPos = 0
+	}
+	// the next block is typically close, only search if it isn't
+	for steps := 0; t.blockPos < len(t.blocks) && t.blocks[t.blockPos].maxID < id; steps++ {
+		if steps == 4 {
+			t.blockPos += sort.Search(len(t.blocks)-t.blockPos, func(i int) bool {
+				return t.blocks[t.blockPos+i].maxID >= id
+			})
+			break
+		}
+		t.blockPos++
+	}
+
+	if t.blockPos == len(t.blocks) {
+		return 0, math.MaxUint64
+	}
+	block := t.blocks[t.blockPos]
+	if block.minID > id {
+		// there are no postings in between blocks
+		return 0, block.minID - 1
+	}
+	return block.impact, block.maxID
+}
+
+// skipBlocks is the block-max part of the pivoting. The pivot doc minID can
+// only make it into the results if the block bounds of the terms at the
+// pivot add up to minScore. If they don't, no doc until the end of any of
+// these blocks (or the next posting of any term) can, so all of them are
+// skipped. It returns false if there is nothing to skip and true if the
+// remaining docs can't make it into the results at all.
+func (t terms) skipBlocks(minID uint64, minScore float64) (bool, bool) {
+	bound := float64(0)
+	upTo := uint64(math.MaxUint64)
+	for i := range t {
+		if t[i].exhausted {
+			continue
+		}
+		if t[i].idPointer > minID {
+			upTo = min(upTo, t[i].idPointer-1)
+			continue
+		}
+
+		impact, validUntil := t[i].blockImpact(minID)
+		bound += impact
+		upTo = min(upTo, validUntil)
+	}
+
+	if bound >= minScore {
+		return false, false
+	}
+	if upTo == math.MaxUint64 {
+		return true, true
+	}
+
+	t.advanceAllAtLeast(upTo + 1)
+	sort.Sort(t)
+	return true, false
+}
@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func BenchmarkWand(b *testing.B) {
+	config := schema.BM25Config{K1: 1.2, B: 0.75}
+	searcher := &BM25Searcher{config: config}
+	numDocs := 1_000_000
+
+	// the probability of each query term to be contained in a doc
+	queries := []struct {
+		name          string
+		probabilities []float64
+	}{
+		{name: "common and rare terms", probabilities: []float64{0.6, 0.1, 0.01}},
+		{name: "common terms", probabilities: []float64{0.5, 0.4, 0.3, 0.2, 0.1}},
+	}
+
+	for _, query := range queries {
+		rnd := rand.New(rand.NewSource(42))
+		var results terms
+		for _, probability := range query.probabilities {
+			results = append(results, randomTestTerm(rnd, numDocs, probability, 4))
+		}
+
+		for _, limit := range []int{10, 100} {
+			for _, withBlocks := range []bool{false, true} {
+				bounds := "idf bounds"
+				if withBlocks {
+					bounds = "block-max bounds"
+				}
+
+				b.Run(fmt.Sprintf("%s, limit %d, %s", query.name, limit, bounds), func(b *testing.B) {
+					for i := 0; i < b.N; i++ {
+						b.StopTimer()
+						// getTopKHeap advances the terms, start over with copies
+						queryTerms := make(terms, len(results))
+						for j, term := range results {
+							if !withBlocks {
+								term.blocks = nil
+							}
+							queryTerms[j] = term
+						}
+						b.StartTimer()
+
+						if _, err := searcher.getTopKHeap(limit, queryTerms, 40, nil); err != nil {
+							b.Fatal(err)
+						}
+					}
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,225 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestNewBlockBounds(t *testing.T) {
+	postings := []docPointerWithScore{
+		{id: 1, frequency: 2, propLength: 10},
+		{id: 4, frequency: 5, propLength: 3},
+		{id: 6, frequency: 1, propLength: 8},
+		{id: 12, frequency: 1, propLength: 20},
+	}
+
+	t.Run("overlapping blocks of segments", func(t *testing.T) {
+		blocks := []lsmkv.BlockMax{
+			{MinID: 4, MaxID: 12, MaxFrequency: 1, MinPropLength: 8},
+			{MinID: 1, MaxID: 6, MaxFrequency: 5, MinPropLength: 3},
+		}
+
+		bounds := newBlockBounds([][]lsmkv.BlockMax{blocks}, postings)
+		assert.Equal(t, []blockBound{
+			{minID: 1, maxID: 3, frequency: 5, propLength: 3},
+			{minID: 4, maxID: 6, frequency: 5, propLength: 3},
+			{minID: 7, maxID: 12, frequency: 1, propLength: 8},
+		}, bounds)
+	})
+
+	t.Run("overlapping blocks of properties", func(t *testing.T) {
+		propBlocks := [][]lsmkv.BlockMax{
+			{{MinID: 4, MaxID: 12, MaxFrequency: 1, MinPropLength: 8}},
+			{{MinID: 1, MaxID: 6, MaxFrequency: 5, MinPropLength: 3}},
+		}
+
+		bounds := newBlockBounds(propBlocks, postings)
+		assert.Equal(t, []blockBound{
+			{minID: 1, maxID: 3, frequency: 5, propLength: 3},
+			{minID: 4, maxID: 6, frequency: 6, propLength: 3},
+			{minID: 7, maxID: 12, frequency: 1, propLength: 8},
+		}, bounds)
+	})
+
+	t.Run("gap between blocks", func(t *testing.T) {
+		blocks := []lsmkv.BlockMax{
+			{MinID: 1, MaxID: 6, MaxFrequency: 5, MinPropLength: 3},
+			{MinID: 12, MaxID: math.MaxUint64, MaxFrequency: 1, MinPropLength: 20},
+		}
+
+		bounds := newBlockBounds([][]lsmkv.BlockMax{blocks}, postings)
+		assert.Equal(t, []blockBound{
+			{minID: 1, maxID: 6, frequency: 5, propLength: 3},
+			{minID: 12, maxID: math.MaxUint64, frequency: 1, propLength: 20},
+		}, bounds)
+	})
+
+	t.Run("posting outside of blocks", func(t *testing.T) {
+		blocks := []lsmkv.BlockMax{
+			{MinID: 1, MaxID: 6, MaxFrequency: 5, MinPropLength: 3},
+		}
+		assert.Nil(t, newBlockBounds([][]lsmkv.BlockMax{blocks}, postings))
+	})
+
+	t.Run("posting with higher frequency", func(t *testing.T) {
+		blocks := []lsmkv.BlockMax{
+			{MinID: 1, MaxID: 12, MaxFrequency: 4, MinPropLength: 3},
+		}
+		assert.Nil(t, newBlockBounds([][]lsmkv.BlockMax{blocks}, postings))
+	})
+
+	t.Run("posting with shorter property", func(t *testing.T) {
+		blocks := []lsmkv.BlockMax{
+			{MinID: 1, MaxID: 12, MaxFrequency: 5, MinPropLength: 4},
+		}
+		assert.Nil(t, newBlockBounds([][]lsmkv.BlockMax{blocks}, postings))
+	})
+}
+
+func TestBlockMaxWand(t *testing.T) {
+	config := schema.BM25Config{K1: 1.2, B: 0.75}
+	searcher := &BM25Searcher{config: config}
+	averagePropLength := 40.
+
+	for seed := int64(0); seed < 20; seed++ {
+		rnd := rand.New(rand.NewSource(seed))
+		numDocs := 20000
+
+		for _, limit := range []int{1, 10, 100} {
+			// a mix of common, regular and rare terms
+			var withoutBlocks, withBlocks terms
+			for _, probability := range []float64{0.5, 0.2, 0.05, 0.001} {
+				term := randomTestTerm(rnd, numDocs, probability, 1+rnd.Intn(4))
+				withBlocks = append(withBlocks, term)
+				term.blocks = nil
+				withoutBlocks = append(withoutBlocks, term)
+			}
+			expected := exhaustiveTopK(withoutBlocks, limit, averagePropLength, config)
+
+			heap, err := searcher.getTopKHeap(limit, withoutBlocks, averagePropLength, nil)
+			require.Nil(t, err)
+			resultsWithoutBlocks := heapResults(heap)
+
+			heap, err = searcher.getTopKHeap(limit, withBlocks, averagePropLength, nil)
+			require.Nil(t, err)
+			resultsWithBlocks := heapResults(heap)
+
+			assert.Equal(t, resultsWithoutBlocks, resultsWithBlocks, "seed %d, limit %d", seed, limit)
+			require.Len(t, resultsWithBlocks, len(expected))
+			for i := range expected {
+				assert.Equal(t, expected[i].Dist, resultsWithBlocks[i].Dist, "seed %d, limit %d", seed, limit)
+			}
+		}
+	}
+}
+
+// randomTestTerm creates the postings of a term, which are contained in each
+// doc with the given probability. Its blocks are built as if the postings
+// were spread randomly across the given number of segments.
+func randomTestTerm(rnd *rand.Rand, numDocs int, probability float64, segments int) term {
+	var postings []docPointerWithScore
+	segmentBlocks := make([][]lsmkv.BlockMax, segments)
+	segmentCounts := make([]int, segments)
+
+	for id := uint64(0); id < uint64(numDocs); id++ {
+		if rnd.Float64() >= probability {
+			continue
+		}
+
+		// most docs contain a term only a few times
+		posting := docPointerWithScore{
+			id:         id,
+			frequency:  float32(1 + int(rnd.ExpFloat64()/2)),
+			propLength: float32(20 + rnd.Intn(100)),
+		}
+		postings = append(postings, posting)
+
+		segment := rnd.Intn(segments)
+		if segmentCounts[segment]%lsmkv.BlockMaxSize == 0 {
+			segmentBlocks[segment] = append(segmentBlocks[segment], lsmkv.BlockMax{
+				MinID:         id,
+				MaxFrequency:  posting.frequency,
+				MinPropLength: posting.propLength,
+			})
+		}
+		block := &segmentBlocks[segment][len(segmentBlocks[segment])-1]
+		block.MaxID = id
+		block.MaxFrequency = max(block.MaxFrequency, posting.frequency)
+		block.MinPropLength = min(block.MinPropLength, posting.propLength)
+		segmentCounts[segment]++
+	}
+
+	var blocks []lsmkv.BlockMax
+	for i := range segmentBlocks {
+		blocks = append(blocks, segmentBlocks[i]...)
+	}
+
+	n := float64(len(postings))
+	term := term{
+		idf:    math.Log(float64(1) + (float64(numDocs)-n+0.5)/(n+0.5)),
+		data:   postings,
+		blocks: newBlockBounds([][]lsmkv.BlockMax{blocks}, postings),
+	}
+	if len(postings) == 0 {
+		term.exhausted = true
+	} else {
+		term.idPointer = postings[0].id
+	}
+	return term
+}
+
+func exhaustiveTopK(results terms, limit int, averagePropLength float64,
+	config schema.BM25Config,
+) []priorityqueue.Item[any] {
+	scores := map[uint64]float64{}
+	for _, term := range results {
+		for _, posting := range term.data {
+			freq := float64(posting.frequency)
+			tf := freq / (freq + config.K1*(1-config.B+config.B*float64(posting.propLength)/averagePropLength))
+			scores[posting.id] += tf * term.idf
+		}
+	}
+
+	out := make([]priorityqueue.Item[any], 0, len(scores))
+	for id, score := range scores {
+		out = append(out, priorityqueue.Item[any]{ID: id, Dist: float32(score)})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Dist != out[j].Dist {
+			return out[i].Dist > out[j].Dist
+		}
+		return out[i].ID < out[j].ID
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+// heapResults returns the results with the highest score first
+func heapResults(heap *priorityqueue.Queue[any]) []priorityqueue.Item[any] {
+	out := make([]priorityqueue.Item[any], heap.Len())
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = heap.Pop()
+	}
+	return out
+}
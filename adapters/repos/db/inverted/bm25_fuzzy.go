@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// MaxFuzzyExpansions is the largest number of indexed terms a fuzzy query
+// term is expanded to. If there are more candidates, the closest are kept.
+const MaxFuzzyExpansions = 50
+
+// fuzzyTerm is an indexed term matching a fuzzy query term
+type fuzzyTerm struct {
+	term     string
+	distance int
+	// boost scales the score of the term down the more edits it takes to get
+	// from the query term to it, relative to the length of the shorter one
+	boost float64
+}
+
+// expandFuzzy looks up the terms within the configured edit distance of the
+// query term in the searchable buckets of the given properties
+func (b *BM25Searcher) expandFuzzy(ctx context.Context, queryTerm string,
+	propNames []string, fuzziness *searchparams.Fuzziness,
+) ([]fuzzyTerm, error) {
+	fuzzy := newLevenshteinAutomaton([]byte(queryTerm), fuzziness.MaxEdits,
+		fuzziness.PrefixLength)
+	queryLen := utf8.RuneCountInString(queryTerm)
+
+	distances := map[string]int{}
+	for _, propName := range propNames {
+		bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+		if bucket == nil {
+			return nil, fmt.Errorf("could not find bucket for property %v", propName)
+		}
+
+		if err := func() error {
+			// the key-only map cursor skips all keys as it can't tell deleted
+			// ones apart, so the values have to be read
+			c := bucket.MapCursor()
+			defer c.Close()
+
+			for k, _ := c.Seek(ctx, fuzzy.seekKey()); k != nil; {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
+				distance, ok, next := fuzzy.match(k)
+				if !ok {
+					if next == nil {
+						return nil
+					}
+					k, _ = c.Seek(ctx, next)
+					continue
+				}
+
+				distances[string(k)] = distance
+				k, _ = c.Next(ctx)
+			}
+			return nil
+		}(); err != nil {
+			return nil, err
+		}
+	}
+
+	expanded := make([]fuzzyTerm, 0, len(distances))
+	for term, distance := range distances {
+		shorter := min(queryLen, utf8.RuneCountInString(term))
+		boost := 1 - float64(distance)/float64(shorter)
+		if shorter == 0 || boost <= 0 {
+			// e.g. a single character term which is entirely different
+			continue
+		}
+		expanded = append(expanded, fuzzyTerm{term: term, distance: distance, boost: boost})
+	}
+
+	slices.SortFunc(expanded, func(a, b fuzzyTerm) int {
+		if a.distance != b.distance {
+			return a.distance - b.distance
+		}
+		return strings.Compare(a.term, b.term)
+	})
+	if len(expanded) > MaxFuzzyExpansions {
+		expanded = expanded[:MaxFuzzyExpansions]
+	}
+
+	return expanded, nil
+}
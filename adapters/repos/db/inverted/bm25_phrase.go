@@ -0,0 +1,246 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+const (
+	// MaxPhraseSlop is the largest number of other tokens that may appear
+	// between the terms of a phrase
+	MaxPhraseSlop = 100
+	// PositionIncrementGap is added to the positions between the elements of
+	// a text array, so that a phrase never matches across two elements
+	PositionIncrementGap = MaxPhraseSlop + 1
+)
+
+// PositionKey is the key of the positions of a term in a single document. The
+// term is followed by the big endian doc id.
+func PositionKey(term []byte, docID uint64) []byte {
+	key := make([]byte, len(term)+8)
+	copy(key, term)
+	binary.BigEndian.PutUint64(key[len(term):], docID)
+	return key
+}
+
+// EncodePositions stores the positions as little endian uint32
+func EncodePositions(positions []uint32) []byte {
+	out := make([]byte, 4*len(positions))
+	for i, pos := range positions {
+		binary.LittleEndian.PutUint32(out[i*4:], pos)
+	}
+	return out
+}
+
+// DecodePositions is the inverse of EncodePositions
+func DecodePositions(in []byte) ([]uint32, error) {
+	if len(in)%4 != 0 {
+		return nil, fmt.Errorf("positions have invalid length %d", len(in))
+	}
+	out := make([]uint32, len(in)/4)
+	for i := range out {
+		out[i] = binary.LittleEndian.Uint32(in[i*4:])
+	}
+	return out, nil
+}
+
+// parsePhrases extracts the quoted phrases from a bm25 query. It returns the
+// phrases and the query without quotes, so that the terms of the phrases are
+// still scored like every other term. An unmatched quote is ignored.
+func parsePhrases(query string) ([]string, string) {
+	if strings.Count(query, `"`) < 2 {
+		return nil, query
+	}
+
+	var phrases []string
+	rest := query
+	for {
+		start := strings.Index(rest, `"`)
+		if start < 0 {
+			break
+		}
+		end := strings.Index(rest[start+1:], `"`)
+		if end < 0 {
+			break
+		}
+		if phrase := strings.TrimSpace(rest[start+1 : start+1+end]); phrase != "" {
+			phrases = append(phrases, phrase)
+		}
+		rest = rest[start+1+end+1:]
+	}
+
+	return phrases, strings.ReplaceAll(query, `"`, " ")
+}
+
+// phraseVerifier checks whether a document contains every phrase of a query,
+// using the positions of the searched properties
+type phraseVerifier struct {
+	slop    int
+	phrases []verifiedPhrase
+}
+
+type verifiedPhrase struct {
+	props []phraseProp
+}
+
+// phraseProp is a property the phrase is looked up in, together with the
+// phrase analyzed as the property is. Gaps is the number of tokens removed
+// from the phrase by the analyzer, which still take up a position.
+type phraseProp struct {
+	bucket *lsmkv.Bucket
+	tokens []string
+	gaps   int
+}
+
+func (b *BM25Searcher) newPhraseVerifier(class *models.Class, phrases []string,
+	propGroups []*analyzedProps, slop int,
+) (*phraseVerifier, error) {
+	if len(phrases) == 0 {
+		return nil, nil
+	}
+	if slop < 0 || slop > MaxPhraseSlop {
+		return nil, fmt.Errorf("slop must be between 0 and %d, got %d", MaxPhraseSlop, slop)
+	}
+
+	verifier := &phraseVerifier{slop: slop, phrases: make([]verifiedPhrase, len(phrases))}
+	hasPositions := false
+	for _, group := range propGroups {
+		for _, propName := range group.propNames {
+			prop, err := schema.GetPropertyByName(class, propName)
+			if err != nil {
+				return nil, err
+			}
+			if !HasPositionIndex(prop) {
+				continue
+			}
+			bucket := b.store.Bucket(helpers.BucketPositionsFromPropNameLSM(propName))
+			if bucket == nil {
+				return nil, fmt.Errorf("could not find positions bucket for property %v", propName)
+			}
+			hasPositions = true
+
+			for i, phrase := range phrases {
+				tokens, gaps := phraseTokens(group.analyzer, phrase)
+				if len(tokens) == 0 {
+					continue
+				}
+				verifier.phrases[i].props = append(verifier.phrases[i].props,
+					phraseProp{bucket: bucket, tokens: tokens, gaps: gaps})
+			}
+		}
+	}
+
+	if !hasPositions {
+		return nil, fmt.Errorf("phrase queries require at least one of the searched " +
+			"properties to have indexPositions enabled")
+	}
+	return verifier, nil
+}
+
+// matches is true if every phrase occurs in at least one of the properties
+func (v *phraseVerifier) matches(docID uint64) (bool, error) {
+	for _, phrase := range v.phrases {
+		found := false
+		for _, prop := range phrase.props {
+			ok, err := v.matchesProp(prop, docID)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (v *phraseVerifier) matchesProp(prop phraseProp, docID uint64) (bool, error) {
+	positions := make([][]uint32, len(prop.tokens))
+	for i, token := range prop.tokens {
+		raw, err := prop.bucket.Get(PositionKey([]byte(token), docID))
+		if err != nil {
+			return false, err
+		}
+		if len(raw) == 0 {
+			return false, nil
+		}
+		positions[i], err = DecodePositions(raw)
+		if err != nil {
+			return false, err
+		}
+	}
+	return phraseMatches(positions, v.slop+prop.gaps), nil
+}
+
+// phraseTokens analyzes the phrase and counts the tokens removed in between
+// the remaining ones
+func phraseTokens(analyzer *TextAnalyzer, phrase string) ([]string, int) {
+	var tokens []string
+	gaps, pending := 0, 0
+	for _, token := range analyzer.TokenizeWithGaps(phrase) {
+		if token == "" {
+			pending++
+			continue
+		}
+		if len(tokens) > 0 {
+			gaps += pending
+		}
+		pending = 0
+		tokens = append(tokens, token)
+	}
+	return tokens, gaps
+}
+
+// phraseMatches is true if there is one position per term, in the order of the
+// terms, with at most slop other positions in between them in total. The
+// positions of each term have to be sorted ascending.
+func phraseMatches(positions [][]uint32, slop int) bool {
+	if len(positions) == 0 {
+		return false
+	}
+
+	maxSpan := uint64(len(positions)-1) + uint64(slop)
+StartLoop:
+	for _, start := range positions[0] {
+		// picking the closest following position for every term minimizes the
+		// distance between the first and the last term
+		prev := start
+		for _, termPositions := range positions[1:] {
+			next := sort.Search(len(termPositions), func(i int) bool {
+				return termPositions[i] > prev
+			})
+			if next == len(termPositions) {
+				// no later occurrence, a later start can't match either
+				return false
+			}
+			prev = termPositions[next]
+			if uint64(prev-start) > maxSpan {
+				continue StartLoop
+			}
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestParsePhrases(t *testing.T) {
+	testCases := []struct {
+		query           string
+		expectedPhrases []string
+		expectedQuery   string
+	}{
+		{query: "machine learning", expectedQuery: "machine learning"},
+		{query: `"machine learning"`, expectedPhrases: []string{"machine learning"}, expectedQuery: " machine learning "},
+		{
+			query:           `"machine learning" in "production systems"`,
+			expectedPhrases: []string{"machine learning", "production systems"},
+			expectedQuery:   " machine learning  in  production systems ",
+		},
+		{query: `unmatched "quote`, expectedQuery: `unmatched "quote`},
+		{query: `"a b" "c`, expectedPhrases: []string{"a b"}, expectedQuery: " a b   c"},
+		{query: `"  " empty`, expectedQuery: "     empty"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.query, func(t *testing.T) {
+			phrases, query := parsePhrases(tc.query)
+			assert.Equal(t, tc.expectedPhrases, phrases)
+			assert.Equal(t, tc.expectedQuery, query)
+		})
+	}
+}
+
+func TestPhraseMatches(t *testing.T) {
+	testCases := []struct {
+		name      string
+		positions [][]uint32
+		slop      int
+		expected  bool
+	}{
+		{name: "adjacent", positions: [][]uint32{{3}, {4}}, expected: true},
+		{name: "wrong order", positions: [][]uint32{{4}, {3}}, expected: false},
+		{name: "gap without slop", positions: [][]uint32{{3}, {5}}, expected: false},
+		{name: "gap with slop", positions: [][]uint32{{3}, {5}}, slop: 1, expected: true},
+		{name: "slop is shared by all terms", positions: [][]uint32{{0}, {2}, {4}}, slop: 1, expected: false},
+		{name: "slop is shared by all terms, enough", positions: [][]uint32{{0}, {2}, {4}}, slop: 2, expected: true},
+		{name: "later start matches", positions: [][]uint32{{0, 10}, {11}}, expected: true},
+		{name: "repeated term", positions: [][]uint32{{1, 2}, {1, 2}}, expected: true},
+		{name: "repeated term only once", positions: [][]uint32{{1}, {1}}, expected: false},
+		{name: "single term", positions: [][]uint32{{7}}, expected: true},
+		{name: "no terms", positions: nil, expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, phraseMatches(tc.positions, tc.slop))
+		})
+	}
+}
+
+func TestPositionsEncoding(t *testing.T) {
+	positions := []uint32{0, 1, 101, 1 << 31}
+	decoded, err := DecodePositions(EncodePositions(positions))
+	require.Nil(t, err)
+	assert.Equal(t, positions, decoded)
+
+	_, err = DecodePositions([]byte{1, 2, 3})
+	assert.NotNil(t, err)
+}
+
+func TestTextArrayWithPositions(t *testing.T) {
+	a := NewAnalyzer(nil)
+
+	items := a.TextArrayWithPositions(models.PropertyTokenizationWord, []string{"Hello world hello", "world"})
+	require.Len(t, items, 2)
+
+	assert.Equal(t, Countable{
+		Data: []byte("hello"), TermFrequency: 2, Positions: []uint32{0, 2},
+	}, items[0])
+	assert.Equal(t, Countable{
+		Data: []byte("world"), TermFrequency: 2, Positions: []uint32{1, 3 + PositionIncrementGap},
+	}, items[1])
+
+	withoutPositions := a.TextArray(models.PropertyTokenizationWord, []string{"Hello world hello", "world"})
+	assert.Len(t, withoutPositions, len(items))
+}
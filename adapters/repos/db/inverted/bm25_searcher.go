@@ -16,6 +16,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -111,31 +112,18 @@ func (b *BM25Searcher) wand(
 		}
 	}
 
-	// There are currently cases, for different tokenization:
-	// word, lowercase, whitespace and field.
-	// Query is tokenized and respective properties are then searched for the search terms,
-	// results at the end are combined using WAND
+	// Properties are grouped by the way their text is analyzed, i.e. their
+	// tokenization (word, lowercase, whitespace, field, ...) and token filters.
+	// Query is analyzed once per group and respective properties are then searched
+	// for the search terms, results at the end are combined using WAND
 
-	queryTermsByTokenization := map[string][]string{}
-	duplicateBoostsByTokenization := map[string][]int{}
-	propNamesByTokenization := map[string][]string{}
-	propertyBoosts := make(map[string]float32, len(params.Properties))
-
-	for _, tokenization := range helpers.Tokenizations {
-		queryTerms, dupBoosts := helpers.TokenizeAndCountDuplicates(tokenization, params.Query)
-		queryTermsByTokenization[tokenization] = queryTerms
-		duplicateBoostsByTokenization[tokenization] = dupBoosts
+	// quoted phrases are scored like the rest of the query, but candidates
+	// have to contain each of them to be accepted
+	phrases, query := parsePhrases(params.Query)
 
-		// stopword filtering for word tokenization
-		if tokenization == models.PropertyTokenizationWord {
-			queryTerms, dupBoosts = b.removeStopwordsFromQueryTerms(queryTermsByTokenization[tokenization],
-				duplicateBoostsByTokenization[tokenization], stopWordDetector)
-			queryTermsByTokenization[tokenization] = queryTerms
-			duplicateBoostsByTokenization[tokenization] = dupBoosts
-		}
-
-		propNamesByTokenization[tokenization] = make([]string, 0)
-	}
+	var propGroups []*analyzedProps
+	propGroupsByKey := map[string]*analyzedProps{}
+	propertyBoosts := make(map[string]float32, len(params.Properties))
 
 	averagePropLength := 0.
 	for _, propertyWithBoost := range params.Properties {
@@ -161,11 +149,21 @@ func (b *BM25Searcher) wand(
 
 		switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
 		case schema.DataTypeText, schema.DataTypeTextArray:
-			if _, exists := propNamesByTokenization[prop.Tokenization]; !exists {
+			if !slices.Contains(helpers.Tokenizations, prop.Tokenization) {
 				return nil, nil, fmt.Errorf("cannot handle tokenization '%v' of property '%s'",
 					prop.Tokenization, prop.Name)
 			}
-			propNamesByTokenization[prop.Tokenization] = append(propNamesByTokenization[prop.Tokenization], property)
+			textAnalyzer, err := NewPropertyTextAnalyzer(prop)
+			if err != nil {
+				return nil, nil, err
+			}
+			group, ok := propGroupsByKey[textAnalyzer.Key()]
+			if !ok {
+				group = &analyzedProps{analyzer: textAnalyzer}
+				propGroupsByKey[textAnalyzer.Key()] = group
+				propGroups = append(propGroups, group)
+			}
+			group.propNames = append(group.propNames, property)
 		default:
 			return nil, nil, fmt.Errorf("cannot handle datatype '%v' of property '%s'", dt, prop.Name)
 		}
@@ -173,6 +171,11 @@ func (b *BM25Searcher) wand(
 
 	averagePropLength = averagePropLength / float64(len(params.Properties))
 
+	verifier, err := b.newPhraseVerifier(class, phrases, propGroups, params.Slop)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// 100 is a reasonable expected capacity for the total number of terms to query.
 	results := make(terms, 0, 100)
 	indices := make([]map[uint64]int, 0, 100)
@@ -182,23 +185,34 @@ func (b *BM25Searcher) wand(
 
 	var resultsLock sync.Mutex
 
-	for _, tokenization := range helpers.Tokenizations {
-		propNames := propNamesByTokenization[tokenization]
-		if len(propNames) > 0 {
-			queryTerms, duplicateBoosts := helpers.TokenizeAndCountDuplicates(tokenization, params.Query)
+	for _, group := range propGroups {
+		propNames := group.propNames
+		queryTerms, duplicateBoosts := group.analyzer.TokenizeAndCountDuplicates(query)
 
-			// stopword filtering for word tokenization
-			if tokenization == models.PropertyTokenizationWord {
-				queryTerms, duplicateBoosts = b.removeStopwordsFromQueryTerms(
-					queryTerms, duplicateBoosts, stopWordDetector)
-			}
+		// stopword filtering for word tokenization
+		if group.analyzer.Tokenization() == models.PropertyTokenizationWord {
+			queryTerms, duplicateBoosts = b.removeStopwordsFromQueryTerms(
+				queryTerms, duplicateBoosts, stopWordDetector)
+		}
 
-			for i := range queryTerms {
-				j := i
+		for i := range queryTerms {
+			j := i
+
+			eg.Go(func() (err error) {
+				// without fuzziness the query term is looked up as is, otherwise it
+				// is replaced by all similar indexed terms, each of which is scored
+				// like a regular query term
+				searchTerms := []fuzzyTerm{{term: queryTerms[j], boost: 1}}
+				if params.Fuzzy != nil {
+					searchTerms, err = b.expandFuzzy(ctx, queryTerms[j], propNames, params.Fuzzy)
+					if err != nil {
+						return
+					}
+				}
 
-				eg.Go(func() (err error) {
-					termResult, docIndices, termErr := b.createTerm(ctx, N, filterDocIds, queryTerms[j], propNames,
-						propertyBoosts, duplicateBoosts[j], params.AdditionalExplanations)
+				for _, searchTerm := range searchTerms {
+					termResult, docIndices, termErr := b.createTerm(ctx, N, filterDocIds, searchTerm.term, propNames,
+						propertyBoosts, float64(duplicateBoosts[j])*searchTerm.boost, params.AdditionalExplanations)
 					if termErr != nil {
 						err = termErr
 						return
@@ -207,9 +221,9 @@ func (b *BM25Searcher) wand(
 					results = append(results, termResult)
 					indices = append(indices, docIndices)
 					resultsLock.Unlock()
-					return
-				}, "query_term", queryTerms[j], "prop_names", propNames, "has_filter", filterDocIds != nil)
-			}
+				}
+				return
+			}, "query_term", queryTerms[j], "prop_names", propNames, "has_filter", filterDocIds != nil)
 		}
 	}
 
@@ -227,10 +241,20 @@ func (b *BM25Searcher) wand(
 	resultsOriginalOrder := make(terms, len(results))
 	copy(resultsOriginalOrder, results)
 
-	topKHeap := b.getTopKHeap(limit, results, averagePropLength)
+	topKHeap, err := b.getTopKHeap(limit, results, averagePropLength, verifier)
+	if err != nil {
+		return nil, nil, err
+	}
 	return b.getTopKObjects(topKHeap, resultsOriginalOrder, indices, params.AdditionalExplanations)
 }
 
+// analyzedProps are searched properties which analyze text identically, so
+// that the query only needs to be analyzed once for all of them
+type analyzedProps struct {
+	analyzer  *TextAnalyzer
+	propNames []string
+}
+
 func (b *BM25Searcher) removeStopwordsFromQueryTerms(queryTerms []string,
 	duplicateBoost []int, detector *stopwords.Detector,
 ) ([]string, []int) {
@@ -320,17 +344,33 @@ func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue[any],
 }
 
 func (b *BM25Searcher) getTopKHeap(limit int, results terms, averagePropLength float64,
-) *priorityqueue.Queue[any] {
+	verifier *phraseVerifier,
+) (*priorityqueue.Queue[any], error) {
 	topKHeap := priorityqueue.NewMin[any](limit)
 	worstDist := float64(-10000) // tf score can be negative
+	for i := range results {
+		results[i].initImpacts(averagePropLength, b.config)
+	}
 	sort.Sort(results)
 	for {
 		if results.completelyExhausted() || results.pivot(worstDist) {
-			return topKHeap
+			return topKHeap, nil
 		}
 
 		id, score := results.scoreNext(averagePropLength, b.config)
 
+		if verifier != nil {
+			// positional verification is only done for candidates which made it
+			// through the pivoting, as it requires a lookup per phrase term
+			ok, err := verifier.matches(id)
+			if err != nil {
+				return nil, errors.Wrap(err, "verify phrases")
+			}
+			if !ok {
+				continue
+			}
+		}
+
 		if topKHeap.Len() < limit || topKHeap.Top().Dist < float32(score) {
 			topKHeap.Insert(id, float32(score))
 			for topKHeap.Len() > limit {
@@ -346,13 +386,15 @@ func (b *BM25Searcher) getTopKHeap(limit int, results terms, averagePropLength f
 }
 
 func (b *BM25Searcher) createTerm(ctx context.Context, N float64, filterDocIds helpers.AllowList, query string,
-	propertyNames []string, propertyBoosts map[string]float32, duplicateTextBoost int,
+	propertyNames []string, propertyBoosts map[string]float32, termBoost float64,
 	additionalExplanations bool,
 ) (term, map[uint64]int, error) {
 	termResult := term{queryTerm: query}
 	filteredDocIDs := sroar.NewBitmap() // to build the global n if there is a filter
 
 	allMsAndProps := make(AllMapPairsAndPropName, 0, len(propertyNames))
+	var propBlocks [][]lsmkv.BlockMax
+	useBlocks := true
 	for _, propName := range propertyNames {
 
 		bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
@@ -382,6 +424,15 @@ func (b *BM25Searcher) createTerm(ctx context.Context, N float64, filterDocIds h
 			continue
 		}
 
+		if useBlocks {
+			blocks, ok, err := propertyBlockMax(ctx, bucket, query, propertyBoosts[propName])
+			if err != nil {
+				return termResult, nil, err
+			}
+			propBlocks = append(propBlocks, blocks)
+			useBlocks = ok
+		}
+
 		allMsAndProps = append(allMsAndProps, MapPairsAndPropName{MapPairs: m, propname: propName})
 	}
 
@@ -417,7 +468,7 @@ func (b *BM25Searcher) createTerm(ctx context.Context, N float64, filterDocIds h
 		if docMapPairs == nil {
 			docMapPairs = make([]docPointerWithScore, 0, len(m))
 			docMapPairsIndices = make(map[uint64]int, len(m))
-			for k, val := range m {
+			for _, val := range m {
 				if len(val.Value) < 8 {
 					b.logger.Warnf("Skipping pair in BM25: MapPair.Value should be 8 bytes long, but is %d.", len(val.Value))
 					continue
@@ -431,7 +482,7 @@ func (b *BM25Searcher) createTerm(ctx context.Context, N float64, filterDocIds h
 						propLength: math.Float32frombits(propLenBits),
 					})
 				if includeIndicesForLastElement {
-					docMapPairsIndices[binary.BigEndian.Uint64(val.Key)] = k
+					docMapPairsIndices[binary.BigEndian.Uint64(val.Key)] = len(docMapPairs) - 1
 				}
 			}
 		} else {
@@ -475,13 +526,27 @@ func (b *BM25Searcher) createTerm(ctx context.Context, N float64, filterDocIds h
 		termResult.exhausted = true
 		return termResult, docMapPairsIndices, nil
 	}
+
+	// the pairs of each property are sorted by id, but the combination of
+	// multiple properties is not
+	if len(allMsAndProps) > 1 {
+		sort.Slice(docMapPairs, func(i, j int) bool {
+			return docMapPairs[i].id < docMapPairs[j].id
+		})
+		for i := range docMapPairs {
+			docMapPairsIndices[docMapPairs[i].id] = i
+		}
+	}
 	termResult.data = docMapPairs
+	if useBlocks {
+		termResult.blocks = newBlockBounds(propBlocks, docMapPairs)
+	}
 
 	n := float64(len(docMapPairs))
 	if filterDocIds != nil {
 		n += float64(filteredDocIDs.GetCardinality())
 	}
-	termResult.idf = math.Log(float64(1)+(N-n+0.5)/(n+0.5)) * float64(duplicateTextBoost)
+	termResult.idf = math.Log(float64(1)+(N-n+0.5)/(n+0.5)) * termBoost
 
 	// catch special case where there are no results and would panic termResult.data[0].id
 	// related to #4125
@@ -498,9 +563,13 @@ func (b *BM25Searcher) createTerm(ctx context.Context, N float64, filterDocIds h
 }
 
 type term struct {
-	// doubles as max impact (with tf=1, the max impact would be 1*idf), if there
-	// is a boost for a queryTerm, simply apply it here once
+	// if there is a boost for a queryTerm, simply apply it here once
 	idf float64
+	// the upper bound of the score contribution to any doc, see initImpacts
+	maxImpact float64
+	// optional, bound the score contribution in ranges of docs
+	blocks   []blockBound
+	blockPos int
 
 	idPointer  uint64
 	posPointer uint64
@@ -549,17 +618,28 @@ func (t terms) completelyExhausted() bool {
 }
 
 func (t terms) pivot(minScore float64) bool {
-	minID, pivotPoint, abort := t.findMinID(minScore)
-	if abort {
-		return true
-	}
-	if pivotPoint == 0 {
+	for {
+		minID, pivotPoint, abort := t.findMinID(minScore)
+		if abort {
+			return true
+		}
+
+		skipped, abort := t.skipBlocks(minID, minScore)
+		if abort {
+			return true
+		}
+		if skipped {
+			continue
+		}
+
+		if pivotPoint == 0 {
+			return false
+		}
+
+		t.advanceAllAtLeast(minID)
+		sort.Sort(t)
 		return false
 	}
-
-	t.advanceAllAtLeast(minID)
-	sort.Sort(t)
-	return false
 }
 
 func (t terms) advanceAllAtLeast(minID uint64) {
@@ -575,7 +655,7 @@ func (t terms) findMinID(minScore float64) (uint64, int, bool) {
 		if term.exhausted {
 			continue
 		}
-		cumScore += term.idf
+		cumScore += term.maxImpact
 		if cumScore >= minScore {
 			return term.idPointer, i, false
 		}
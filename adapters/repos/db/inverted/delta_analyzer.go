@@ -11,7 +11,10 @@
 
 package inverted
 
-import "bytes"
+import (
+	"bytes"
+	"slices"
+)
 
 type DeltaResults struct {
 	ToDelete []Property
@@ -60,6 +63,7 @@ func Delta(previous, next []Property) DeltaResults {
 				HasFilterableIndex: nextProp.HasFilterableIndex,
 				HasSearchableIndex: nextProp.HasSearchableIndex,
 				HasRangeableIndex:  nextProp.HasRangeableIndex,
+				HasPositionIndex:   nextProp.HasPositionIndex,
 			})
 		}
 		if len(toDelete) > 0 {
@@ -70,6 +74,7 @@ func Delta(previous, next []Property) DeltaResults {
 				HasFilterableIndex: nextProp.HasFilterableIndex,
 				HasSearchableIndex: nextProp.HasSearchableIndex,
 				HasRangeableIndex:  nextProp.HasRangeableIndex,
+				HasPositionIndex:   nextProp.HasPositionIndex,
 			})
 		}
 		// special case to update optional length/nil indexes on
@@ -83,6 +88,7 @@ func Delta(previous, next []Property) DeltaResults {
 				HasFilterableIndex: nextProp.HasFilterableIndex,
 				HasSearchableIndex: nextProp.HasSearchableIndex,
 				HasRangeableIndex:  nextProp.HasRangeableIndex,
+				HasPositionIndex:   nextProp.HasPositionIndex,
 			})
 		}
 	}
@@ -111,7 +117,8 @@ func countableDelta(prev, next []Countable) ([]Countable, []Countable) {
 
 	for _, nextItem := range next {
 		prev, ok := seenInPrev[string(nextItem.Data)]
-		if ok && prev.TermFrequency == nextItem.TermFrequency {
+		if ok && prev.TermFrequency == nextItem.TermFrequency &&
+			slices.Equal(prev.Positions, nextItem.Positions) {
 			// we have an identical overlap, delete from old list
 			delete(seenInPrev, string(nextItem.Data))
 			// don't add to new list
@@ -142,7 +149,8 @@ func listsIdentical(a []Countable, b []Countable) bool {
 
 	for i := range a {
 		if !bytes.Equal(a[i].Data, b[i].Data) ||
-			a[i].TermFrequency != b[i].TermFrequency {
+			a[i].TermFrequency != b[i].TermFrequency ||
+			!slices.Equal(a[i].Positions, b[i].Positions) {
 			// return as soon as an item didn't match
 			return false
 		}
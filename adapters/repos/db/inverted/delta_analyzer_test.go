@@ -15,6 +15,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDeltaAnalyzer(t *testing.T) {
@@ -276,6 +277,39 @@ func TestDeltaAnalyzer(t *testing.T) {
 		assert.Equal(t, expectedAdd, res.ToAdd)
 		assert.Equal(t, expectedDelete, res.ToDelete)
 	})
+
+	t.Run("with previous indexing and only positions changed", func(t *testing.T) {
+		previous := []Property{
+			{
+				Name: "prop1",
+				Items: []Countable{
+					{Data: []byte("value1"), TermFrequency: 1, Positions: []uint32{0}},
+					{Data: []byte("value2"), TermFrequency: 1, Positions: []uint32{1}},
+				},
+				HasSearchableIndex: true,
+				HasPositionIndex:   true,
+			},
+		}
+		next := []Property{
+			{
+				Name: "prop1",
+				Items: []Countable{
+					{Data: []byte("value2"), TermFrequency: 1, Positions: []uint32{0}},
+					{Data: []byte("value1"), TermFrequency: 1, Positions: []uint32{1}},
+				},
+				HasSearchableIndex: true,
+				HasPositionIndex:   true,
+			},
+		}
+
+		res := Delta(previous, next)
+		require.Len(t, res.ToAdd, 1)
+		require.Len(t, res.ToDelete, 1)
+		assert.Equal(t, next[0].Items, res.ToAdd[0].Items)
+		assert.True(t, res.ToAdd[0].HasPositionIndex)
+		assert.ElementsMatch(t, previous[0].Items, res.ToDelete[0].Items)
+		assert.True(t, res.ToDelete[0].HasPositionIndex)
+	})
 }
 
 func TestDeltaAnalyzer_Arrays(t *testing.T) {
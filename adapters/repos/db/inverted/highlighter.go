@@ -0,0 +1,351 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"html"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// The tags the matched terms are wrapped in. The text of a fragment around
+// and within the tags is HTML-escaped, so that the fragments can be rendered
+// as markup without the document text being interpreted as such.
+const (
+	HighlightPreTag  = "<em>"
+	HighlightPostTag = "</em>"
+)
+
+// Highlighter marks the terms of a keyword query in the text of the searched
+// properties. The text is analyzed like it was at index time, so a word is
+// marked exactly if it produced one of the terms the query was looked up by.
+type Highlighter struct {
+	props        []*highlightProp
+	fragmentSize int
+	fragments    int
+}
+
+type highlightProp struct {
+	name     string
+	analyzer *TextAnalyzer
+	terms    map[string]struct{}
+	fuzzy    []*fuzzyQueryTerm
+}
+
+type fuzzyQueryTerm struct {
+	automaton *levenshteinAutomaton
+	length    int
+}
+
+// span is a part of the text given by its byte offsets
+type span struct {
+	start, end int
+}
+
+// NewHighlighter prepares the highlighting of the given properties for the
+// query. Without properties all properties with a searchable index are used,
+// just like the bm25 search does.
+func NewHighlighter(class *models.Class, propNames []string, query string,
+	fuzziness *searchparams.Fuzziness, params additional.HighlightParams,
+) (*Highlighter, error) {
+	var stopWordDetector *stopwords.Detector
+	if class.InvertedIndexConfig != nil && class.InvertedIndexConfig.Stopwords != nil {
+		var err error
+		stopWordDetector, err = stopwords.NewDetectorFromConfig(*(class.InvertedIndexConfig.Stopwords))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(propNames) == 0 {
+		for _, prop := range class.Properties {
+			if HasSearchableIndex(prop) {
+				propNames = append(propNames, prop.Name)
+			}
+		}
+	}
+
+	_, query = parsePhrases(query)
+
+	h := &Highlighter{
+		fragmentSize: params.FragmentSize,
+		fragments:    params.Fragments,
+	}
+	if h.fragmentSize == 0 {
+		h.fragmentSize = additional.DefaultHighlightFragmentSize
+	}
+	if h.fragments == 0 {
+		h.fragments = additional.DefaultHighlightFragments
+	}
+
+	seen := map[string]struct{}{}
+	for _, propName := range propNames {
+		propName = strings.Split(propName, "^")[0]
+		if _, ok := seen[propName]; ok {
+			continue
+		}
+		seen[propName] = struct{}{}
+
+		prop, err := schema.GetPropertyByName(class, propName)
+		if err != nil || !HasSearchableIndex(prop) {
+			continue
+		}
+		analyzer, err := NewPropertyTextAnalyzer(prop)
+		if err != nil {
+			return nil, err
+		}
+
+		hp := &highlightProp{name: propName, analyzer: analyzer, terms: map[string]struct{}{}}
+		for _, term := range analyzer.Tokenize(query) {
+			if analyzer.Tokenization() == models.PropertyTokenizationWord &&
+				stopWordDetector != nil && stopWordDetector.IsStopword(term) {
+				continue
+			}
+			if _, ok := hp.terms[term]; ok {
+				continue
+			}
+			hp.terms[term] = struct{}{}
+			if fuzziness != nil {
+				hp.fuzzy = append(hp.fuzzy, &fuzzyQueryTerm{
+					automaton: newLevenshteinAutomaton([]byte(term), fuzziness.MaxEdits, fuzziness.PrefixLength),
+					length:    utf8.RuneCountInString(term),
+				})
+			}
+		}
+		if len(hp.terms) > 0 {
+			h.props = append(h.props, hp)
+		}
+	}
+
+	return h, nil
+}
+
+// Highlight returns the fragments of the given property values which contain
+// query terms. Properties without any match are left out.
+func (h *Highlighter) Highlight(properties map[string]interface{}) []additional.Highlight {
+	var out []additional.Highlight
+	for _, prop := range h.props {
+		var texts []string
+		switch v := properties[prop.name].(type) {
+		case string:
+			texts = []string{v}
+		case []string:
+			texts = v
+		case []interface{}:
+			for _, elem := range v {
+				if s, ok := elem.(string); ok {
+					texts = append(texts, s)
+				}
+			}
+		default:
+			continue
+		}
+
+		var candidates []fragment
+		for i, text := range texts {
+			candidates = append(candidates, h.fragmentsOf(i, text, prop.matches(text))...)
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+
+		// the best fragments are kept, but returned in the order of the text
+		slices.SortStableFunc(candidates, func(a, b fragment) int {
+			if a.distinctTerms != b.distinctTerms {
+				return b.distinctTerms - a.distinctTerms
+			}
+			return b.matches - a.matches
+		})
+		candidates = candidates[:min(len(candidates), h.fragments)]
+		slices.SortFunc(candidates, func(a, b fragment) int {
+			if a.elem != b.elem {
+				return a.elem - b.elem
+			}
+			return a.start - b.start
+		})
+
+		fragments := make([]string, len(candidates))
+		for i := range candidates {
+			fragments[i] = candidates[i].text
+		}
+		out = append(out, additional.Highlight{Property: prop.name, Fragments: fragments})
+	}
+	return out
+}
+
+// matches returns the spans of the text which produce a query term
+func (p *highlightProp) matches(text string) []span {
+	var matches []span
+	for _, s := range tokenSpans(p.analyzer.Tokenization(), text) {
+		for _, term := range p.analyzer.Tokenize(text[s.start:s.end]) {
+			if p.matchesTerm(term) {
+				matches = append(matches, s)
+				break
+			}
+		}
+	}
+	return matches
+}
+
+func (p *highlightProp) matchesTerm(term string) bool {
+	if _, ok := p.terms[term]; ok {
+		return true
+	}
+	for _, fuzzy := range p.fuzzy {
+		distance, ok, _ := fuzzy.automaton.match([]byte(term))
+		// same cutoff as for the expansion of fuzzy terms in the bm25 search
+		if ok && distance < min(fuzzy.length, utf8.RuneCountInString(term)) {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenSpans splits the text into the parts the tokenization produces its
+// tokens from. Tokenizations which don't map back to single words, like
+// trigrams, are split into words, so that a word is marked if any of its
+// tokens matches.
+func tokenSpans(tokenization, text string) []span {
+	switch tokenization {
+	case models.PropertyTokenizationField:
+		start := strings.IndexFunc(text, func(r rune) bool { return !unicode.IsSpace(r) })
+		if start < 0 {
+			return nil
+		}
+		end := strings.LastIndexFunc(text, func(r rune) bool { return !unicode.IsSpace(r) })
+		_, size := utf8.DecodeRuneInString(text[end:])
+		return []span{{start: start, end: end + size}}
+	case models.PropertyTokenizationLowercase, models.PropertyTokenizationWhitespace:
+		return splitSpans(text, unicode.IsSpace)
+	default:
+		return splitSpans(text, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		})
+	}
+}
+
+// splitSpans works like strings.FieldsFunc, but returns the offsets of the
+// fields
+func splitSpans(text string, isSeparator func(rune) bool) []span {
+	var spans []span
+	start := -1
+	for i, r := range text {
+		if isSeparator(r) {
+			if start >= 0 {
+				spans = append(spans, span{start: start, end: i})
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, span{start: start, end: len(text)})
+	}
+	return spans
+}
+
+type fragment struct {
+	elem          int
+	start         int
+	text          string
+	matches       int
+	distinctTerms int
+}
+
+// fragmentsOf cuts a fragment of about fragmentSize characters around each
+// match which is not yet part of a previous fragment. Fragments end at
+// whitespace where possible, so that words are not cut in half.
+func (h *Highlighter) fragmentsOf(elem int, text string, matches []span) []fragment {
+	if len(matches) == 0 {
+		return nil
+	}
+
+	// byte offsets of the runes, to count characters rather than bytes
+	offsets := make([]int, 0, len(text)+1)
+	for i := range text {
+		offsets = append(offsets, i)
+	}
+	offsets = append(offsets, len(text))
+	runeIndex := func(byteOffset int) int {
+		return sort.SearchInts(offsets, byteOffset)
+	}
+	length := len(offsets) - 1
+
+	var fragments []fragment
+	for i := 0; i < len(matches); {
+		matchStart, matchEnd := runeIndex(matches[i].start), runeIndex(matches[i].end)
+		size := max(h.fragmentSize, matchEnd-matchStart)
+
+		start := max(matchStart-(size-(matchEnd-matchStart))/2, 0)
+		end := start + size
+		if end > length {
+			end = length
+			start = max(end-size, 0)
+		}
+
+		if start > 0 && !isSpaceAt(text, offsets[start-1]) {
+			for pos := start; pos < matchStart; pos++ {
+				if isSpaceAt(text, offsets[pos]) {
+					start = pos + 1
+					break
+				}
+			}
+		}
+		if end < length && !isSpaceAt(text, offsets[end]) {
+			for pos := end - 1; pos >= matchEnd; pos-- {
+				if isSpaceAt(text, offsets[pos]) {
+					end = pos
+					break
+				}
+			}
+		}
+		from, to := offsets[start], offsets[end]
+
+		var sb strings.Builder
+		terms := map[string]struct{}{}
+		pos, count := from, 0
+		for ; i < len(matches) && matches[i].end <= to; i++ {
+			sb.WriteString(html.EscapeString(text[pos:matches[i].start]))
+			sb.WriteString(HighlightPreTag)
+			sb.WriteString(html.EscapeString(text[matches[i].start:matches[i].end]))
+			sb.WriteString(HighlightPostTag)
+			pos = matches[i].end
+			terms[strings.ToLower(text[matches[i].start:matches[i].end])] = struct{}{}
+			count++
+		}
+		sb.WriteString(html.EscapeString(text[pos:to]))
+
+		fragments = append(fragments, fragment{
+			elem:          elem,
+			start:         from,
+			text:          strings.TrimFunc(sb.String(), unicode.IsSpace),
+			matches:       count,
+			distinctTerms: len(terms),
+		})
+	}
+	return fragments
+}
+
+func isSpaceAt(text string, offset int) bool {
+	r, _ := utf8.DecodeRuneInString(text[offset:])
+	return unicode.IsSpace(r)
+}
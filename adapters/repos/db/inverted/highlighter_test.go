@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestHighlighter(t *testing.T) {
+	vFalse := false
+	class := &models.Class{
+		Class: "HighlightClass",
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "body",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+				TextAnalyzer: &models.TextAnalyzerConfig{Lowercase: true, Stemmer: "en"},
+			},
+			{
+				Name:         "tags",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+			{
+				Name:            "notSearchable",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexSearchable: &vFalse,
+			},
+		},
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			Stopwords: &models.StopwordConfig{Preset: "en"},
+		},
+	}
+
+	highlight := func(t *testing.T, props []string, query string, fuzziness *searchparams.Fuzziness,
+		params additional.HighlightParams, object map[string]interface{},
+	) []additional.Highlight {
+		h, err := NewHighlighter(class, props, query, fuzziness, params)
+		require.Nil(t, err)
+		return h.Highlight(object)
+	}
+
+	t.Run("marks the query terms", func(t *testing.T) {
+		res := highlight(t, []string{"title"}, "the Journey", nil, additional.HighlightParams{},
+			map[string]interface{}{"title": "The journey to the end of the night"})
+		assert.Equal(t, []additional.Highlight{
+			{Property: "title", Fragments: []string{"The <em>journey</em> to the end of the night"}},
+		}, res)
+	})
+
+	t.Run("uses the analyzer of the property", func(t *testing.T) {
+		res := highlight(t, []string{"body^2"}, "running dogs", nil, additional.HighlightParams{},
+			map[string]interface{}{"body": "A dog runs, two dogs were running."})
+		assert.Equal(t, []additional.Highlight{
+			{Property: "body", Fragments: []string{"A <em>dog</em> <em>runs</em>, two <em>dogs</em> were <em>running</em>."}},
+		}, res)
+	})
+
+	t.Run("defaults to all searchable properties", func(t *testing.T) {
+		res := highlight(t, nil, "night", nil, additional.HighlightParams{},
+			map[string]interface{}{
+				"title":         "night",
+				"body":          "day and night",
+				"tags":          []interface{}{"night", "night owl"},
+				"notSearchable": "night",
+			})
+		assert.Equal(t, []additional.Highlight{
+			{Property: "title", Fragments: []string{"<em>night</em>"}},
+			{Property: "body", Fragments: []string{"day and <em>night</em>"}},
+			{Property: "tags", Fragments: []string{"<em>night</em>"}},
+		}, res)
+	})
+
+	t.Run("leaves out properties without matches", func(t *testing.T) {
+		res := highlight(t, []string{"title", "body"}, "night", nil, additional.HighlightParams{},
+			map[string]interface{}{"title": "day", "body": "night"})
+		assert.Equal(t, []additional.Highlight{
+			{Property: "body", Fragments: []string{"<em>night</em>"}},
+		}, res)
+	})
+
+	t.Run("marks fuzzy matches", func(t *testing.T) {
+		res := highlight(t, []string{"title"}, "jorney", &searchparams.Fuzziness{MaxEdits: 1},
+			additional.HighlightParams{}, map[string]interface{}{"title": "A long journey"})
+		assert.Equal(t, []additional.Highlight{
+			{Property: "title", Fragments: []string{"A long <em>journey</em>"}},
+		}, res)
+	})
+
+	t.Run("terms of phrases are marked", func(t *testing.T) {
+		res := highlight(t, []string{"title"}, `"end of the night"`, nil, additional.HighlightParams{},
+			map[string]interface{}{"title": "The journey to the end of the night"})
+		assert.Equal(t, []additional.Highlight{
+			{Property: "title", Fragments: []string{"The journey to the <em>end</em> of the <em>night</em>"}},
+		}, res)
+	})
+
+	t.Run("fragments are cut at whitespace", func(t *testing.T) {
+		text := strings.Repeat("filler ", 10) + "needle" + strings.Repeat(" filler", 10)
+		res := highlight(t, []string{"title"}, "needle", nil,
+			additional.HighlightParams{FragmentSize: 30},
+			map[string]interface{}{"title": text})
+		require.Len(t, res, 1)
+		assert.Equal(t, []string{"filler <em>needle</em> filler"}, res[0].Fragments)
+	})
+
+	t.Run("the best fragments are returned in order of the text", func(t *testing.T) {
+		filler := strings.Repeat(" filler", 10) + " "
+		text := "cat" + filler + "cat dog" + filler + "dog" + filler + "cat dog cat"
+		res := highlight(t, []string{"title"}, "cat dog", nil,
+			additional.HighlightParams{FragmentSize: 20, Fragments: 2},
+			map[string]interface{}{"title": text})
+		require.Len(t, res, 1)
+		assert.Equal(t, []string{
+			"filler <em>cat</em> <em>dog</em>",
+			"filler <em>cat</em> <em>dog</em> <em>cat</em>",
+		}, res[0].Fragments)
+	})
+
+	t.Run("the text around and within the tags is escaped", func(t *testing.T) {
+		res := highlight(t, []string{"title"}, "script", nil, additional.HighlightParams{},
+			map[string]interface{}{"title": `<script>alert("x")</script> & <em>fun</em>`})
+		require.Len(t, res, 1)
+		assert.Equal(t, []string{
+			"&lt;<em>script</em>&gt;alert(&#34;x&#34;)&lt;/<em>script</em>&gt; &amp; &lt;em&gt;fun&lt;/em&gt;",
+		}, res[0].Fragments)
+	})
+
+	t.Run("fragments of array elements", func(t *testing.T) {
+		res := highlight(t, []string{"tags"}, "night owl", nil, additional.HighlightParams{},
+			map[string]interface{}{"tags": []string{"early bird", "night owl", "night"}})
+		assert.Equal(t, []additional.Highlight{
+			{Property: "tags", Fragments: []string{"<em>night owl</em>"}},
+		}, res)
+	})
+}
@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"bytes"
+	"slices"
+	"unicode"
+	"unicode/utf8"
+)
+
+// levenshteinAutomaton accepts the terms which are at most maxEdits
+// insertions, deletions or substitutions away from a query term and share its
+// first runes (the prefix). Instead of compiling a DFA, the states are the rows
+// of the edit distance matrix, one row per consumed rune. That is enough to
+// walk a sorted term dictionary efficiently: for any key it can tell whether it
+// matches and otherwise which is the next key that could possibly match, so
+// that whole sections of the dictionary can be skipped by seeking.
+type levenshteinAutomaton struct {
+	prefix   []byte
+	term     []rune
+	alphabet []rune // distinct runes of term in ascending order
+	maxEdits int
+}
+
+// noRune stands for any rune which is not contained in the query term
+const noRune = rune(-1)
+
+func newLevenshteinAutomaton(term []byte, maxEdits, prefixLength int) *levenshteinAutomaton {
+	runes := []rune(string(term))
+	prefixLength = min(max(prefixLength, 0), len(runes))
+
+	alphabet := slices.Clone(runes[prefixLength:])
+	slices.Sort(alphabet)
+
+	return &levenshteinAutomaton{
+		prefix:   []byte(string(runes[:prefixLength])),
+		term:     runes[prefixLength:],
+		alphabet: slices.Compact(alphabet),
+		maxEdits: maxEdits,
+	}
+}
+
+// autoMaxEdits picks the allowed edit distance based on the length of the
+// term, short terms would match too many other terms otherwise
+func autoMaxEdits(term []byte) int {
+	switch n := utf8.RuneCount(term); {
+	case n <= 2:
+		return 0
+	case n <= 5:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// seekKey is the first key of the dictionary which can match
+func (a *levenshteinAutomaton) seekKey() []byte {
+	return a.prefix
+}
+
+// match checks key against the automaton. If it matches, its edit distance to
+// the query term is returned. Otherwise next is the smallest key greater than
+// key which could still match, it is nil if there is no such key. As keys are
+// visited in ascending order, a nil next ends the iteration.
+func (a *levenshteinAutomaton) match(key []byte) (distance int, ok bool, next []byte) {
+	if !bytes.HasPrefix(key, a.prefix) {
+		return 0, false, nil
+	}
+
+	runes := []rune(string(key[len(a.prefix):]))
+	rows := make([][]int, 1, len(runes)+1)
+	rows[0] = a.start()
+	for _, r := range runes {
+		row := a.step(rows[len(rows)-1], r)
+		if !a.alive(row) {
+			break
+		}
+		rows = append(rows, row)
+	}
+
+	if len(rows) == len(runes)+1 {
+		if d := rows[len(runes)][len(a.term)]; d <= a.maxEdits {
+			return d, true, nil
+		}
+	}
+
+	// rows[pos] is the state after consuming runes[:pos]. Starting at the
+	// longest live prefix of the key, look for the smallest rune following it
+	// which leads to a live state. If the whole key is live that is an
+	// extension of the key, otherwise it has to be greater than the rune which
+	// led to the dead state. Without any, backtrack to the shorter prefixes.
+	for pos := len(rows) - 1; pos >= 0; pos-- {
+		after := noRune
+		if pos < len(runes) {
+			after = runes[pos]
+		}
+		if r, ok := a.nextRune(rows[pos], after); ok {
+			next = append(slices.Clip(a.prefix), string(runes[:pos])...)
+			return 0, false, utf8.AppendRune(next, r)
+		}
+	}
+
+	return 0, false, nil
+}
+
+func (a *levenshteinAutomaton) start() []int {
+	row := make([]int, len(a.term)+1)
+	for i := range row {
+		row[i] = min(i, a.maxEdits+1)
+	}
+	return row
+}
+
+// step computes the next row of the edit distance matrix after consuming r.
+// Distances are capped at maxEdits+1, as any larger distance is a dead end
+// anyway.
+func (a *levenshteinAutomaton) step(row []int, r rune) []int {
+	next := make([]int, len(row))
+	next[0] = min(row[0]+1, a.maxEdits+1)
+	for i := 1; i < len(row); i++ {
+		cost := 1
+		if a.term[i-1] == r {
+			cost = 0
+		}
+		next[i] = min(row[i-1]+cost, row[i]+1, next[i-1]+1, a.maxEdits+1)
+	}
+	return next
+}
+
+// alive indicates whether any continuation of the consumed runes can match
+func (a *levenshteinAutomaton) alive(row []int) bool {
+	return slices.Min(row) <= a.maxEdits
+}
+
+// nextRune returns the smallest rune greater than after which leads from row
+// to a live state
+func (a *levenshteinAutomaton) nextRune(row []int, after rune) (rune, bool) {
+	if a.alive(a.step(row, noRune)) {
+		// any rune works, runes of the term can only lower the distances
+		r := after + 1
+		if r >= 0xD800 && r <= 0xDFFF {
+			// surrogates can't be encoded in UTF-8
+			r = 0xE000
+		}
+		return r, r <= unicode.MaxRune
+	}
+
+	for _, r := range a.alphabet {
+		if r > after && a.alive(a.step(row, r)) {
+			return r, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,142 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevenshteinAutomaton(t *testing.T) {
+	t.Run("matching single keys", func(t *testing.T) {
+		type test struct {
+			key      string
+			distance int
+			ok       bool
+		}
+
+		a := newLevenshteinAutomaton([]byte("kitten"), 2, 1)
+		tests := []test{
+			{key: "kitten", distance: 0, ok: true},
+			{key: "kittens", distance: 1, ok: true},
+			{key: "sitten", ok: false}, // prefix differs
+			{key: "kitchen", distance: 2, ok: true},
+			{key: "kit", ok: false},
+			{key: "kittn", distance: 1, ok: true},
+			{key: "knitting", ok: false},
+			{key: "kätten", distance: 1, ok: true},
+		}
+
+		for _, test := range tests {
+			t.Run(test.key, func(t *testing.T) {
+				distance, ok, _ := a.match([]byte(test.key))
+				assert.Equal(t, test.ok, ok)
+				if test.ok {
+					assert.Equal(t, test.distance, distance)
+				}
+			})
+		}
+	})
+
+	t.Run("enumerating a sorted dictionary", func(t *testing.T) {
+		r := rand.New(rand.NewSource(7))
+		alphabet := []rune("abcdeä")
+		randomTerm := func() string {
+			term := make([]rune, 1+r.Intn(7))
+			for i := range term {
+				term[i] = alphabet[r.Intn(len(alphabet))]
+			}
+			return string(term)
+		}
+
+		dict := make([]string, 20000)
+		for i := range dict {
+			dict[i] = randomTerm()
+		}
+		sort.Strings(dict)
+
+		for _, maxEdits := range []int{0, 1, 2} {
+			for _, prefixLength := range []int{0, 2} {
+				for i := 0; i < 20; i++ {
+					term := randomTerm()
+					t.Run(fmt.Sprintf("%s edits=%d prefix=%d", term, maxEdits, prefixLength), func(t *testing.T) {
+						a := newLevenshteinAutomaton([]byte(term), maxEdits, prefixLength)
+						prefix := string([]rune(term)[:min(prefixLength, len([]rune(term)))])
+
+						var expected []string
+						for _, key := range dict {
+							if len(expected) > 0 && key == expected[len(expected)-1] {
+								continue
+							}
+							if bytes.HasPrefix([]byte(key), []byte(prefix)) && editDistance(term, key) <= maxEdits {
+								expected = append(expected, key)
+							}
+						}
+
+						var actual []string
+						visited := 0
+						seek := func(key []byte) int {
+							return sort.SearchStrings(dict, string(key))
+						}
+						for pos := seek(a.seekKey()); pos < len(dict); {
+							visited++
+							key := []byte(dict[pos])
+							distance, ok, next := a.match(key)
+							if !ok {
+								if next == nil {
+									break
+								}
+								require.Positive(t, bytes.Compare(next, key))
+								pos = seek(next)
+								continue
+							}
+							assert.Equal(t, editDistance(term, string(key)), distance)
+							if len(actual) == 0 || actual[len(actual)-1] != string(key) {
+								actual = append(actual, string(key))
+							}
+							pos++
+						}
+
+						assert.Equal(t, expected, actual)
+						assert.Less(t, visited, len(dict)/2)
+					})
+				}
+			}
+		}
+	})
+}
+
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	row := make([]int, len(rb)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			prev, row[j] = row[j], min(row[j]+1, row[j-1]+1, prev+cost)
+		}
+	}
+	return row[len(rb)]
+}
@@ -14,9 +14,11 @@ package inverted
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/sroar"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 
 	"github.com/pkg/errors"
@@ -54,7 +56,7 @@ func newPropValuePair(class *models.Class, logger logrus.FieldLogger) (*propValu
 }
 
 func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
-	if pv.operator.OnValue() {
+	if pv.operator.OnValue() && !pv.isContains() {
 
 		// TODO text_rbm_inverted_index find better way check whether prop len
 		if strings.HasSuffix(pv.prop, filters.InternalPropertyLength) &&
@@ -122,6 +124,10 @@ func (pv *propValuePair) fetchDocIDs(s *Searcher, limit int) error {
 }
 
 func (pv *propValuePair) mergeDocIDs() (*docBitmap, error) {
+	if pv.isContains() {
+		return pv.mergeContainsDocIDs()
+	}
+
 	if pv.operator.OnValue() {
 		return &pv.docIDs, nil
 	}
@@ -157,6 +163,67 @@ func (pv *propValuePair) mergeDocIDs() (*docBitmap, error) {
 	}, nil
 }
 
+// isContains indicates a ContainsAny/ContainsAll pair. Its values are
+// extracted into one child per value, but unlike a nested And/Or filter all
+// children are merged in a single pass, see mergeContainsDocIDs
+func (pv *propValuePair) isContains() bool {
+	return pv.operator == filters.ContainsAny || pv.operator == filters.ContainsAll
+}
+
+func (pv *propValuePair) mergeContainsDocIDs() (*docBitmap, error) {
+	if len(pv.children) == 0 {
+		return nil, fmt.Errorf("no values for operator: %s", pv.operator.Name())
+	}
+
+	bitmaps := make([]*sroar.Bitmap, len(pv.children))
+	for i, child := range pv.children {
+		dbm, err := child.mergeDocIDs()
+		if err != nil {
+			return nil, errors.Wrapf(err, "retrieve doc bitmap of value %d", i)
+		}
+		bitmaps[i] = dbm.docIDs
+	}
+
+	var merged *sroar.Bitmap
+	if pv.operator == filters.ContainsAny {
+		merged = unionBitmaps(bitmaps)
+	} else {
+		merged = intersectBitmaps(bitmaps)
+	}
+
+	return &docBitmap{
+		docIDs: roaringset.Condense(merged),
+	}, nil
+}
+
+// unionBitmaps merges all bitmaps at once, which is considerably faster than
+// or-ing them one by one for a large number of values. The given bitmaps are
+// never modified.
+func unionBitmaps(bitmaps []*sroar.Bitmap) *sroar.Bitmap {
+	if len(bitmaps) == 1 {
+		// FastOr would return the single input as is
+		return bitmaps[0].Clone()
+	}
+	return sroar.FastOr(bitmaps...)
+}
+
+// intersectBitmaps starts with the smallest bitmap, so that every subsequent
+// intersection is as cheap as possible, and stops as soon as the result is
+// empty. The given bitmaps are never modified.
+func intersectBitmaps(bitmaps []*sroar.Bitmap) *sroar.Bitmap {
+	sorted := make([]*sroar.Bitmap, len(bitmaps))
+	copy(sorted, bitmaps)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].GetCardinality() < sorted[j].GetCardinality()
+	})
+
+	merged := sorted[0].Clone()
+	for i := 1; i < len(sorted) && !merged.IsEmpty(); i++ {
+		merged.And(sorted[i])
+	}
+	return merged
+}
+
 func (pv *propValuePair) getBucketName() string {
 	if pv.hasRangeableIndex {
 		switch pv.operator {
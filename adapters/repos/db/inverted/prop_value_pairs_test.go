@@ -79,6 +79,54 @@ func TestPropValuePairs_Merging(t *testing.T) {
 				},
 				operator: filters.OperatorOr,
 
+				expectedIds: []uint64{7, 8, 9, 10, 11},
+			},
+			{
+				name: "ContainsAll; different sets",
+
+				bitmaps: []*sroar.Bitmap{
+					roaringset.NewBitmap(7, 8, 9, 10, 11),
+					roaringset.NewBitmap(1, 3, 5, 7, 9, 11),
+					roaringset.NewBitmap(1, 3, 5, 7, 9),
+				},
+				operator: filters.ContainsAll,
+
+				expectedIds: []uint64{7, 9},
+			},
+			{
+				name: "ContainsAll; disjoint sets",
+
+				bitmaps: []*sroar.Bitmap{
+					roaringset.NewBitmap(1, 2, 3),
+					roaringset.NewBitmap(4, 5, 6),
+					roaringset.NewBitmap(1, 2, 3, 4, 5, 6),
+				},
+				operator: filters.ContainsAll,
+
+				expectedIds: []uint64{},
+			},
+			{
+				name: "ContainsAny; different sets",
+
+				bitmaps: []*sroar.Bitmap{
+					roaringset.NewBitmap(7, 8, 9, 10, 11),
+					roaringset.NewBitmap(1, 3, 5, 7, 9, 11),
+					roaringset.NewBitmap(1, 3, 5, 7, 9),
+				},
+				operator: filters.ContainsAny,
+
+				expectedIds: []uint64{1, 3, 5, 7, 8, 9, 10, 11},
+			},
+			{
+				name: "ContainsAny; same sets",
+
+				bitmaps: []*sroar.Bitmap{
+					roaringset.NewBitmap(7, 8, 9, 10, 11),
+					roaringset.NewBitmap(7, 8, 9, 10, 11),
+					roaringset.NewBitmap(7, 8, 9, 10, 11),
+				},
+				operator: filters.ContainsAny,
+
 				expectedIds: []uint64{7, 8, 9, 10, 11},
 			},
 		}
@@ -108,4 +156,50 @@ func TestPropValuePairs_Merging(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("contains operators do not modify underlying bitmaps", func(t *testing.T) {
+		for _, operator := range []filters.Operator{filters.ContainsAny, filters.ContainsAll} {
+			t.Run(operator.Name(), func(t *testing.T) {
+				bitmaps := []*sroar.Bitmap{
+					roaringset.NewBitmap(1, 2, 3, 4),
+					roaringset.NewBitmap(3, 4, 5),
+				}
+				pv := &propValuePair{
+					operator: operator,
+					children: make([]*propValuePair, len(bitmaps)),
+				}
+				for i := range bitmaps {
+					pv.children[i] = &propValuePair{
+						operator: filters.OperatorEqual,
+						docIDs:   docBitmap{docIDs: bitmaps[i]},
+					}
+				}
+
+				_, err := pv.mergeDocIDs()
+				require.Nil(t, err)
+				assert.ElementsMatch(t, []uint64{1, 2, 3, 4}, bitmaps[0].ToArray())
+				assert.ElementsMatch(t, []uint64{3, 4, 5}, bitmaps[1].ToArray())
+			})
+		}
+	})
+
+	t.Run("contains operator with a single value", func(t *testing.T) {
+		for _, operator := range []filters.Operator{filters.ContainsAny, filters.ContainsAll} {
+			t.Run(operator.Name(), func(t *testing.T) {
+				bm := roaringset.NewBitmap(1, 2, 3)
+				pv := &propValuePair{
+					operator: operator,
+					children: []*propValuePair{{
+						operator: filters.OperatorEqual,
+						docIDs:   docBitmap{docIDs: bm},
+					}},
+				}
+
+				dbm, err := pv.mergeDocIDs()
+				require.Nil(t, err)
+				assert.ElementsMatch(t, []uint64{1, 2, 3}, dbm.IDs())
+				assert.False(t, bm == dbm.docIDs)
+			})
+		}
+	})
 }
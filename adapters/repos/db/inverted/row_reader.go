@@ -66,6 +66,8 @@ func (rr *RowReader) Read(ctx context.Context, readFn ReadFn) error {
 		return rr.lessThan(ctx, readFn, true)
 	case filters.OperatorLike:
 		return rr.like(ctx, readFn)
+	case filters.OperatorFuzzy:
+		return rr.fuzzy(ctx, readFn)
 	case filters.OperatorIsNull: // we need to fetch a row with a given value (there is only nil and !nil) and can reuse equal to get the correct row
 		return rr.equal(ctx, readFn)
 	default:
@@ -214,6 +216,42 @@ func (rr *RowReader) like(ctx context.Context, readFn ReadFn) error {
 	return nil
 }
 
+// fuzzy reads all rows with keys within an edit distance of the value. The
+// allowed distance depends on the length of the value, see autoMaxEdits. Keys
+// which can't match are skipped by seeking, see levenshteinAutomaton.
+func (rr *RowReader) fuzzy(ctx context.Context, readFn ReadFn) error {
+	fuzzy := newLevenshteinAutomaton(rr.value, autoMaxEdits(rr.value), 0)
+
+	c := rr.newCursor()
+	defer c.Close()
+
+	for k, v := c.Seek(fuzzy.seekKey()); k != nil; {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if _, ok, next := fuzzy.match(k); !ok {
+			if next == nil {
+				break
+			}
+			k, v = c.Seek(next)
+			continue
+		}
+
+		continueReading, err := readFn(k, rr.transformToBitmap(v))
+		if err != nil {
+			return err
+		}
+
+		if !continueReading {
+			break
+		}
+		k, v = c.Next()
+	}
+
+	return nil
+}
+
 // newCursor will either return a regular cursor - or a key-only cursor if
 // keyOnly==true
 func (rr *RowReader) newCursor() *lsmkv.CursorSet {
@@ -63,6 +63,8 @@ func (rr *RowReaderFrequency) Read(ctx context.Context, readFn ReadFn) error {
 		return rr.lessThan(ctx, readFn, true)
 	case filters.OperatorLike:
 		return rr.like(ctx, readFn)
+	case filters.OperatorFuzzy:
+		return rr.fuzzy(ctx, readFn)
 	default:
 		return fmt.Errorf("operator %v supported", rr.operator)
 	}
@@ -211,6 +213,42 @@ func (rr *RowReaderFrequency) like(ctx context.Context, readFn ReadFn) error {
 	return nil
 }
 
+// fuzzy reads all rows with keys within an edit distance of the value. The
+// allowed distance depends on the length of the value, see autoMaxEdits. Keys
+// which can't match are skipped by seeking, see levenshteinAutomaton.
+func (rr *RowReaderFrequency) fuzzy(ctx context.Context, readFn ReadFn) error {
+	fuzzy := newLevenshteinAutomaton(rr.value, autoMaxEdits(rr.value), 0)
+
+	c := rr.newCursor(lsmkv.MapListAcceptDuplicates())
+	defer c.Close()
+
+	for k, v := c.Seek(ctx, fuzzy.seekKey()); k != nil; {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if _, ok, next := fuzzy.match(k); !ok {
+			if next == nil {
+				break
+			}
+			k, v = c.Seek(ctx, next)
+			continue
+		}
+
+		continueReading, err := readFn(k, rr.transformToBitmap(v))
+		if err != nil {
+			return err
+		}
+
+		if !continueReading {
+			break
+		}
+		k, v = c.Next(ctx)
+	}
+
+	return nil
+}
+
 // newCursor will either return a regular cursor - or a key-only cursor if
 // keyOnly==true
 func (rr *RowReaderFrequency) newCursor(
@@ -87,6 +87,8 @@ func (rr *RowReaderRoaringSet) Read(ctx context.Context, readFn ReadFn) error {
 		return rr.lessThan(ctx, readFn, true)
 	case filters.OperatorLike:
 		return rr.like(ctx, readFn)
+	case filters.OperatorFuzzy:
+		return rr.fuzzy(ctx, readFn)
 	default:
 		return fmt.Errorf("operator %v not supported", rr.operator)
 	}
@@ -230,6 +232,41 @@ func (rr *RowReaderRoaringSet) like(ctx context.Context,
 	return nil
 }
 
+// fuzzy reads all rows with keys within an edit distance of the value. The
+// allowed distance depends on the length of the value, see autoMaxEdits. Keys
+// which can't match are skipped by seeking, see levenshteinAutomaton.
+func (rr *RowReaderRoaringSet) fuzzy(ctx context.Context,
+	readFn ReadFn,
+) error {
+	fuzzy := newLevenshteinAutomaton(rr.value, autoMaxEdits(rr.value), 0)
+
+	c := rr.newCursor()
+	defer c.Close()
+
+	for k, v := c.Seek(fuzzy.seekKey()); k != nil; {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if _, ok, next := fuzzy.match(k); !ok {
+			if next == nil {
+				break
+			}
+			k, v = c.Seek(next)
+			continue
+		}
+
+		if continueReading, err := readFn(k, v); err != nil {
+			return err
+		} else if !continueReading {
+			break
+		}
+		k, v = c.Next()
+	}
+
+	return nil
+}
+
 // equalHelper exists, because the Equal and NotEqual operators share this functionality
 func (rr *RowReaderRoaringSet) equalHelper(ctx context.Context) (*sroar.Bitmap, error) {
 	if err := ctx.Err(); err != nil {
@@ -180,6 +180,20 @@ func TestRowReaderRoaringSet(t *testing.T) {
 				{"hhh", []uint64{11111111, 2222222, 33333333}},
 			},
 		},
+		{
+			name:     "fuzzy 'eef' value",
+			value:    "eef",
+			operator: filters.OperatorFuzzy,
+			expected: []kvData{
+				{"eee", []uint64{11111, 22222, 33333}},
+			},
+		},
+		{
+			name:     "fuzzy 'ee' value requires an exact match",
+			value:    "ee",
+			operator: filters.OperatorFuzzy,
+			expected: []kvData{},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -562,12 +562,16 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 
 	switch propType {
 	case schema.DataTypeText:
+		textAnalyzer, err := NewPropertyTextAnalyzer(prop)
+		if err != nil {
+			return nil, err
+		}
 		// if the operator is like, we cannot apply the regular text-splitting
 		// logic as it would remove all wildcard symbols
 		if operator == filters.OperatorLike {
-			terms = helpers.TokenizeWithWildcards(prop.Tokenization, valueString)
+			terms = textAnalyzer.TokenizeWithWildcards(valueString)
 		} else {
-			terms = helpers.Tokenize(prop.Tokenization, valueString)
+			terms = textAnalyzer.Tokenize(valueString)
 		}
 	default:
 		return nil, fmt.Errorf("expected value type to be text, got %v", propType)
@@ -708,12 +712,10 @@ func (s *Searcher) extractContains(path *filters.Path, propType schema.DataType,
 	if err != nil {
 		return nil, fmt.Errorf("new prop value pair: %w", err)
 	}
+	// the operator is kept as is, so that the children are merged in a single
+	// union/intersection rather than being treated as a nested Or/And filter
 	out.children = children
-	// filters.ContainsAny
-	out.operator = filters.OperatorOr
-	if operator == filters.ContainsAll {
-		out.operator = filters.OperatorAnd
-	}
+	out.operator = operator
 	out.Class = class
 	return out, nil
 }
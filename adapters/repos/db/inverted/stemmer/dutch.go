@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmer
+
+// dutch implements the Snowball Dutch stemmer
+
+const dutchVowels vowels = "aeiouyè"
+
+var dutchStep1 = []string{"heden", "ene", "en", "se", "s"}
+
+var dutchAccents = map[rune]rune{
+	'ä': 'a', 'ë': 'e', 'ï': 'i', 'ö': 'o', 'ü': 'u',
+	'á': 'a', 'é': 'e', 'í': 'i', 'ó': 'o', 'ú': 'u',
+}
+
+func dutch(word string) string {
+	w := replaceRunes([]rune(word), dutchAccents)
+	if len(w) > 0 && w[0] == 'y' {
+		w[0] = 'Y'
+	}
+	for i := 1; i < len(w); i++ {
+		switch {
+		case w[i] == 'y' && dutchVowels.has(w[i-1]):
+			w[i] = 'Y'
+		case w[i] == 'i' && dutchVowels.has(w[i-1]) && i+1 < len(w) && dutchVowels.has(w[i+1]):
+			w[i] = 'I'
+		}
+	}
+
+	r1 := dutchVowels.region(w, 0)
+	r2 := dutchVowels.region(w, r1)
+	if r1 < 3 && len(w) >= 3 {
+		r1 = 3
+	}
+
+	// step 1
+	switch suffix, pos := longestSuffix(w, 0, dutchStep1); suffix {
+	case "heden":
+		if pos >= r1 {
+			w = replaceSuffix(w, pos, "heid")
+		}
+	case "en", "ene":
+		if pos >= r1 && dutchValidEnEnding(w, pos) {
+			w = dutchUndouble(w[:pos])
+		}
+	case "s", "se":
+		if pos >= r1 && pos > 0 && !dutchVowels.has(w[pos-1]) && w[pos-1] != 'j' {
+			w = w[:pos]
+		}
+	}
+
+	// step 2
+	var eRemoved bool
+	w, eRemoved = dutchRemoveE(w, r1)
+
+	// step 3a
+	if pos := suffixStart(w, "heid"); hasSuffix(w, "heid") && pos >= r2 && !precededBy(w, pos, 0, "c") {
+		w = w[:pos]
+		if pos := suffixStart(w, "en"); hasSuffix(w, "en") && pos >= r1 && dutchValidEnEnding(w, pos) {
+			w = dutchUndouble(w[:pos])
+		}
+	}
+
+	// step 3b: derivational suffixes
+	switch suffix, pos := longestSuffix(w, 0, []string{"end", "ing", "ig", "lijk", "baar", "bar"}); suffix {
+	case "end", "ing":
+		if pos >= r2 {
+			w = w[:pos]
+			if p := suffixStart(w, "ig"); hasSuffix(w, "ig") && p >= r2 && !precededBy(w, p, 0, "e") {
+				w = w[:p]
+			} else {
+				w = dutchUndouble(w)
+			}
+		}
+	case "ig":
+		if pos >= r2 && !precededBy(w, pos, 0, "e") {
+			w = w[:pos]
+		}
+	case "lijk":
+		if pos >= r2 {
+			w, _ = dutchRemoveE(w[:pos], r1)
+		}
+	case "baar":
+		if pos >= r2 {
+			w = w[:pos]
+		}
+	case "bar":
+		if pos >= r2 && eRemoved {
+			w = w[:pos]
+		}
+	}
+
+	// step 4: undouble vowel
+	if n := len(w); n >= 4 {
+		c, v1, v2, d := w[n-4], w[n-3], w[n-2], w[n-1]
+		if !dutchVowels.has(c) && v1 == v2 && (v1 == 'a' || v1 == 'e' || v1 == 'o' || v1 == 'u') &&
+			!dutchVowels.has(d) && d != 'I' {
+			w = append(w[:n-2], d)
+		}
+	}
+
+	return string(replaceRunes(w, map[rune]rune{'Y': 'y', 'I': 'i'}))
+}
+
+// dutchValidEnEnding is true if the en ending at pos is preceded by a
+// non-vowel and not by gem
+func dutchValidEnEnding(w []rune, pos int) bool {
+	return pos > 0 && !dutchVowels.has(w[pos-1]) && !hasSuffix(w[:pos], "gem")
+}
+
+func dutchUndouble(w []rune) []rune {
+	if hasSuffix(w, "kk") || hasSuffix(w, "dd") || hasSuffix(w, "tt") {
+		return w[:len(w)-1]
+	}
+	return w
+}
+
+// dutchRemoveE removes a final e in R1 which is preceded by a non-vowel
+func dutchRemoveE(w []rune, r1 int) ([]rune, bool) {
+	n := len(w)
+	if n < 2 || w[n-1] != 'e' || n-1 < r1 || dutchVowels.has(w[n-2]) {
+		return w, false
+	}
+	return dutchUndouble(w[:n-1]), true
+}
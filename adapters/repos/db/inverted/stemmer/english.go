@@ -0,0 +1,210 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmer
+
+import "unicode/utf8"
+
+// english implements the Snowball English (Porter2) stemmer
+
+const englishVowels vowels = "aeiouy"
+
+// englishExceptions are stemmed irregularly or left alone entirely
+var englishExceptions = map[string]string{
+	"skis": "ski", "skies": "sky", "dying": "die", "lying": "lie", "tying": "tie",
+	"idly": "idl", "gently": "gentl", "ugly": "ugli", "early": "earli", "only": "onli",
+	"singly": "singl", "sky": "sky", "news": "news", "howe": "howe", "atlas": "atlas",
+	"cosmos": "cosmos", "bias": "bias", "andes": "andes",
+}
+
+// englishInvariants are left alone once step 1a is done
+var englishInvariants = map[string]struct{}{
+	"inning": {}, "outing": {}, "canning": {}, "herring": {}, "earring": {},
+	"proceed": {}, "exceed": {}, "succeed": {},
+}
+
+var (
+	englishStep1b = []string{"eedly", "ingly", "edly", "eed", "ing", "ed"}
+	englishStep2  = map[string]string{
+		"tional": "tion", "enci": "ence", "anci": "ance", "abli": "able", "entli": "ent",
+		"izer": "ize", "ization": "ize", "ational": "ate", "ation": "ate", "ator": "ate",
+		"alism": "al", "aliti": "al", "alli": "al", "fulness": "ful", "ousli": "ous",
+		"ousness": "ous", "iveness": "ive", "iviti": "ive", "biliti": "ble", "bli": "ble",
+		"ogi": "og", "fulli": "ful", "lessli": "less", "li": "",
+	}
+	englishStep3 = map[string]string{
+		"tional": "tion", "ational": "ate", "alize": "al", "icate": "ic", "iciti": "ic",
+		"ical": "ic", "ful": "", "ness": "", "ative": "",
+	}
+	englishStep4 = []string{
+		"al", "ance", "ence", "er", "ic", "able", "ible", "ant", "ement", "ment",
+		"ent", "ism", "ate", "iti", "ous", "ive", "ize", "ion",
+	}
+	englishStep2Suffixes = keys(englishStep2)
+	englishStep3Suffixes = keys(englishStep3)
+)
+
+func english(word string) string {
+	if utf8.RuneCountInString(word) <= 2 {
+		return word
+	}
+	if stem, ok := englishExceptions[word]; ok {
+		return stem
+	}
+
+	w := []rune(word)
+	if w[0] == '\'' {
+		w = w[1:]
+	}
+	if len(w) > 0 && w[0] == 'y' {
+		w[0] = 'Y'
+	}
+	for i := 1; i < len(w); i++ {
+		if w[i] == 'y' && englishVowels.has(w[i-1]) {
+			w[i] = 'Y'
+		}
+	}
+
+	r1 := -1
+	for _, prefix := range []string{"gener", "commun", "arsen"} {
+		if hasPrefix(w, prefix) {
+			r1 = len(prefix)
+		}
+	}
+	if r1 < 0 {
+		r1 = englishVowels.region(w, 0)
+	}
+	r2 := englishVowels.region(w, r1)
+
+	// step 0: possessives
+	if suffix, _ := longestSuffix(w, 0, []string{"'s'", "'s", "'"}); suffix != "" {
+		w = trimSuffix(w, suffix)
+	}
+
+	// step 1a: plurals
+	switch suffix, pos := longestSuffix(w, 0, []string{"sses", "ied", "ies", "us", "ss", "s"}); suffix {
+	case "sses":
+		w = replaceSuffix(w, pos, "ss")
+	case "ied", "ies":
+		if pos > 1 {
+			w = replaceSuffix(w, pos, "i")
+		} else {
+			w = replaceSuffix(w, pos, "ie")
+		}
+	case "s":
+		if englishVowels.containsVowel(w[:max(pos-1, 0)]) {
+			w = w[:pos]
+		}
+	}
+	if _, ok := englishInvariants[string(w)]; ok {
+		return string(w)
+	}
+
+	// step 1b: past tense and gerund
+	switch suffix, pos := longestSuffix(w, 0, englishStep1b); suffix {
+	case "eed", "eedly":
+		if pos >= r1 {
+			w = replaceSuffix(w, pos, "ee")
+		}
+	case "ed", "edly", "ing", "ingly":
+		if englishVowels.containsVowel(w[:pos]) {
+			w = w[:pos]
+			switch {
+			case hasSuffix(w, "at"), hasSuffix(w, "bl"), hasSuffix(w, "iz"):
+				w = append(w, 'e')
+			case englishEndsWithDouble(w):
+				w = w[:len(w)-1]
+			case r1 >= len(w) && englishEndsWithShortSyllable(w):
+				w = append(w, 'e')
+			}
+		}
+	}
+
+	// step 1c: terminal y
+	if n := len(w); n > 2 && (w[n-1] == 'y' || w[n-1] == 'Y') && !englishVowels.has(w[n-2]) {
+		w[n-1] = 'i'
+	}
+
+	// step 2
+	if suffix, pos := longestSuffix(w, 0, englishStep2Suffixes); suffix != "" && pos >= r1 {
+		switch suffix {
+		case "ogi":
+			if precededBy(w, pos, 0, "l") {
+				w = replaceSuffix(w, pos, englishStep2[suffix])
+			}
+		case "li":
+			if precededBy(w, pos, 0, "cdeghkmnrt") {
+				w = w[:pos]
+			}
+		default:
+			w = replaceSuffix(w, pos, englishStep2[suffix])
+		}
+	}
+
+	// step 3
+	if suffix, pos := longestSuffix(w, 0, englishStep3Suffixes); suffix != "" && pos >= r1 {
+		if suffix != "ative" || pos >= r2 {
+			w = replaceSuffix(w, pos, englishStep3[suffix])
+		}
+	}
+
+	// step 4
+	if suffix, pos := longestSuffix(w, 0, englishStep4); suffix != "" && pos >= r2 {
+		if suffix != "ion" || precededBy(w, pos, 0, "st") {
+			w = w[:pos]
+		}
+	}
+
+	// step 5
+	if n := len(w); n > 0 {
+		switch w[n-1] {
+		case 'e':
+			if n-1 >= r2 || (n-1 >= r1 && !englishEndsWithShortSyllable(w[:n-1])) {
+				w = w[:n-1]
+			}
+		case 'l':
+			if n-1 >= r2 && precededBy(w, n-1, 0, "l") {
+				w = w[:n-1]
+			}
+		}
+	}
+
+	return string(replaceRunes(w, map[rune]rune{'Y': 'y'}))
+}
+
+func englishEndsWithDouble(w []rune) bool {
+	for _, double := range []string{"bb", "dd", "ff", "gg", "mm", "nn", "pp", "rr", "tt"} {
+		if hasSuffix(w, double) {
+			return true
+		}
+	}
+	return false
+}
+
+// englishEndsWithShortSyllable is true for a vowel followed by a non-vowel
+// other than w, x or Y and preceded by a non-vowel, or a vowel at the
+// beginning of the word followed by a non-vowel
+func englishEndsWithShortSyllable(w []rune) bool {
+	n := len(w)
+	if n == 2 {
+		return englishVowels.has(w[0]) && !englishVowels.has(w[1])
+	}
+	return n >= 3 && !englishVowels.has(w[n-3]) && englishVowels.has(w[n-2]) &&
+		!englishVowels.has(w[n-1]) && w[n-1] != 'w' && w[n-1] != 'x' && w[n-1] != 'Y'
+}
+
+func keys(m map[string]string) []string {
+	out := make([]string, 0, len(m))
+	for key := range m {
+		out = append(out, key)
+	}
+	return out
+}
@@ -0,0 +1,286 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmer
+
+// french implements the Snowball French stemmer
+
+const frenchVowels vowels = "aeiouyâàëéêèïîôûù"
+
+var (
+	frenchStep1 = []string{
+		"ance", "iqUe", "isme", "able", "iste", "eux", "ances", "iqUes", "ismes", "ables",
+		"istes", "atrice", "ateur", "ation", "atrices", "ateurs", "ations", "logie", "logies",
+		"usion", "ution", "usions", "utions", "ence", "ences", "ement", "ements", "ité", "ités",
+		"if", "ive", "ifs", "ives", "eaux", "aux", "euse", "euses", "issement", "issements",
+		"amment", "emment", "ment", "ments",
+	}
+	frenchStep2a = []string{
+		"îmes", "ît", "îtes", "i", "ie", "ies", "ir", "ira", "irai", "iraIent", "irais", "irait",
+		"iras", "irent", "irez", "iriez", "irions", "irons", "iront", "is", "issaIent", "issais",
+		"issait", "issant", "issante", "issantes", "issants", "isse", "issent", "isses", "issez",
+		"issiez", "issions", "issons", "it",
+	}
+	frenchStep2b = []string{
+		"ions",
+		"é", "ée", "ées", "és", "èrent", "er", "era", "erai", "eraIent", "erais", "erait", "eras",
+		"erez", "eriez", "erions", "erons", "eront", "ez", "iez",
+		"âmes", "ât", "âtes", "a", "ai", "aIent", "ais", "ait", "ant", "ante", "antes", "ants",
+		"as", "asse", "assent", "asses", "assiez", "assions",
+	}
+	frenchStep2bWithE = map[string]struct{}{
+		"âmes": {}, "ât": {}, "âtes": {}, "a": {}, "ai": {}, "aIent": {}, "ais": {}, "ait": {},
+		"ant": {}, "ante": {}, "antes": {}, "ants": {}, "as": {}, "asse": {}, "assent": {},
+		"asses": {}, "assiez": {}, "assions": {},
+	}
+)
+
+func french(word string) string {
+	w := []rune(word)
+	for i := range w {
+		prevVowel := i > 0 && frenchVowels.has(w[i-1])
+		nextVowel := i+1 < len(w) && frenchVowels.has(w[i+1])
+		switch {
+		case w[i] == 'u' && i > 0 && w[i-1] == 'q':
+			w[i] = 'U'
+		case w[i] == 'u' && prevVowel && nextVowel:
+			w[i] = 'U'
+		case w[i] == 'i' && prevVowel && nextVowel:
+			w[i] = 'I'
+		case w[i] == 'y' && (prevVowel || nextVowel):
+			w[i] = 'Y'
+		}
+	}
+
+	rv := frenchRV(w)
+	r1 := frenchVowels.region(w, 0)
+	r2 := frenchVowels.region(w, r1)
+
+	w, altered := frenchStep1Suffix(w, rv, r1, r2)
+	if !altered {
+		w, altered = frenchIVerbSuffix(w, rv)
+	}
+	if !altered {
+		w, altered = frenchVerbSuffix(w, rv, r2)
+	}
+
+	if altered {
+		// step 3
+		switch last := len(w) - 1; {
+		case last < 0:
+		case w[last] == 'Y':
+			w[last] = 'i'
+		case w[last] == 'ç':
+			w[last] = 'c'
+		}
+	} else {
+		// step 4: residual suffix
+		if last := len(w) - 1; last > 0 && w[last] == 's' && !precededBy(w, last, 0, "aiouès") {
+			w = w[:last]
+		}
+		switch suffix, pos := longestSuffix(w, rv, []string{"ion", "ier", "ière", "Ier", "Ière", "e"}); suffix {
+		case "ion":
+			if pos >= r2 && precededBy(w, pos, rv, "st") {
+				w = w[:pos]
+			}
+		case "ier", "ière", "Ier", "Ière":
+			w = replaceSuffix(w, pos, "i")
+		case "e":
+			w = w[:pos]
+		}
+	}
+
+	// step 5: undouble
+	for _, double := range []string{"enn", "onn", "ett", "ell", "eill"} {
+		if hasSuffix(w, double) {
+			w = w[:len(w)-1]
+			break
+		}
+	}
+
+	// step 6: un-accent
+	i := len(w) - 1
+	for i >= 0 && !frenchVowels.has(w[i]) {
+		i--
+	}
+	if i >= 0 && i < len(w)-1 && (w[i] == 'é' || w[i] == 'è') {
+		w[i] = 'e'
+	}
+
+	return string(replaceRunes(w, map[rune]rune{'I': 'i', 'U': 'u', 'Y': 'y'}))
+}
+
+// frenchRV is after the third letter if the word starts with two vowels or
+// one of the prefixes par, col or tap, after the first vowel not at the
+// beginning of the word otherwise
+func frenchRV(w []rune) int {
+	if len(w) >= 2 && frenchVowels.has(w[0]) && frenchVowels.has(w[1]) {
+		return min(3, len(w))
+	}
+	for _, prefix := range []string{"par", "col", "tap"} {
+		if hasPrefix(w, prefix) {
+			return 3
+		}
+	}
+	for i := 1; i < len(w); i++ {
+		if frenchVowels.has(w[i]) {
+			return i + 1
+		}
+	}
+	return len(w)
+}
+
+// frenchStep1Suffix removes the standard suffixes. It is considered not to
+// alter the word for the adverb endings, which are followed by the verb
+// suffix steps regardless.
+func frenchStep1Suffix(w []rune, rv, r1, r2 int) ([]rune, bool) {
+	suffix, pos := longestSuffix(w, 0, frenchStep1)
+	switch suffix {
+	case "ance", "iqUe", "isme", "able", "iste", "eux", "ances", "iqUes", "ismes", "ables", "istes":
+		if pos >= r2 {
+			return w[:pos], true
+		}
+	case "atrice", "ateur", "ation", "atrices", "ateurs", "ations":
+		if pos >= r2 {
+			return frenchTrimOrReplace(w[:pos], r2, "ic", "iqU"), true
+		}
+	case "logie", "logies":
+		if pos >= r2 {
+			return replaceSuffix(w, pos, "log"), true
+		}
+	case "usion", "ution", "usions", "utions":
+		if pos >= r2 {
+			return replaceSuffix(w, pos, "u"), true
+		}
+	case "ence", "ences":
+		if pos >= r2 {
+			return replaceSuffix(w, pos, "ent"), true
+		}
+	case "ement", "ements":
+		if pos < rv {
+			return w, false
+		}
+		w = w[:pos]
+		switch {
+		case hasSuffix(w, "iv"):
+			if p := suffixStart(w, "iv"); p >= r2 {
+				w = trimInRegion(w[:p], r2, "at")
+			}
+		case hasSuffix(w, "eus"):
+			if p := suffixStart(w, "eus"); p >= r2 {
+				w = w[:p]
+			} else if p >= r1 {
+				w = replaceSuffix(w, p, "eux")
+			}
+		case hasSuffix(w, "abl"), hasSuffix(w, "iqU"):
+			w = trimInRegion(w, r2, "abl", "iqU")
+		case hasSuffix(w, "ièr"), hasSuffix(w, "Ièr"):
+			if p := len(w) - 3; p >= rv {
+				w = replaceSuffix(w, p, "i")
+			}
+		}
+		return w, true
+	case "ité", "ités":
+		if pos < r2 {
+			return w, false
+		}
+		w = w[:pos]
+		switch {
+		case hasSuffix(w, "abil"):
+			w = frenchTrimOrReplace(w, r2, "abil", "abl")
+		case hasSuffix(w, "ic"):
+			w = frenchTrimOrReplace(w, r2, "ic", "iqU")
+		case hasSuffix(w, "iv"):
+			w = trimInRegion(w, r2, "iv")
+		}
+		return w, true
+	case "if", "ive", "ifs", "ives":
+		if pos < r2 {
+			return w, false
+		}
+		w = w[:pos]
+		if p := suffixStart(w, "at"); hasSuffix(w, "at") && p >= r2 {
+			w = frenchTrimOrReplace(w[:p], r2, "ic", "iqU")
+		}
+		return w, true
+	case "eaux":
+		return replaceSuffix(w, pos, "eau"), true
+	case "aux":
+		if pos >= r1 {
+			return replaceSuffix(w, pos, "al"), true
+		}
+	case "euse", "euses":
+		if pos >= r2 {
+			return w[:pos], true
+		}
+		if pos >= r1 {
+			return replaceSuffix(w, pos, "eux"), true
+		}
+	case "issement", "issements":
+		if pos >= r1 && pos > 0 && !frenchVowels.has(w[pos-1]) {
+			return w[:pos], true
+		}
+	case "amment":
+		if pos >= rv {
+			return replaceSuffix(w, pos, "ant"), false
+		}
+	case "emment":
+		if pos >= rv {
+			return replaceSuffix(w, pos, "ent"), false
+		}
+	case "ment", "ments":
+		if pos-1 >= rv && frenchVowels.has(w[pos-1]) {
+			return w[:pos], false
+		}
+	}
+	return w, false
+}
+
+// frenchTrimOrReplace removes the suffix if it is in R2 and replaces it
+// otherwise, if w ends with it
+func frenchTrimOrReplace(w []rune, r2 int, suffix, replacement string) []rune {
+	if !hasSuffix(w, suffix) {
+		return w
+	}
+	if pos := suffixStart(w, suffix); pos >= r2 {
+		return w[:pos]
+	}
+	return replaceSuffix(w, suffixStart(w, suffix), replacement)
+}
+
+// frenchIVerbSuffix removes the verb suffixes beginning with i, step 2a
+func frenchIVerbSuffix(w []rune, rv int) ([]rune, bool) {
+	suffix, pos := longestSuffix(w, rv, frenchStep2a)
+	if suffix != "" && pos-1 >= rv && !frenchVowels.has(w[pos-1]) {
+		return w[:pos], true
+	}
+	return w, false
+}
+
+// frenchVerbSuffix removes the other verb suffixes, step 2b
+func frenchVerbSuffix(w []rune, rv, r2 int) ([]rune, bool) {
+	suffix, pos := longestSuffix(w, rv, frenchStep2b)
+	switch {
+	case suffix == "":
+		return w, false
+	case suffix == "ions":
+		if pos >= r2 {
+			return w[:pos], true
+		}
+		return w, false
+	default:
+		w = w[:pos]
+		if _, ok := frenchStep2bWithE[suffix]; ok && len(w) > 0 && len(w)-1 >= rv && w[len(w)-1] == 'e' {
+			w = w[:len(w)-1]
+		}
+		return w, true
+	}
+}
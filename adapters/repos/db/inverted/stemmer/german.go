@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmer
+
+import "strings"
+
+// german implements the Snowball German stemmer
+
+const germanVowels vowels = "aeiouyäöü"
+
+const (
+	germanSEndings  = "bdfghklmnrt"
+	germanStEndings = "bdfghklmnt"
+)
+
+var (
+	germanStep1 = []string{"em", "ern", "er", "e", "en", "es", "s"}
+	germanStep2 = []string{"en", "er", "est", "st"}
+	germanStep3 = []string{"end", "ung", "ig", "ik", "isch", "lich", "heit", "keit"}
+)
+
+func german(word string) string {
+	w := []rune(strings.ReplaceAll(word, "ß", "ss"))
+	for i := 1; i < len(w)-1; i++ {
+		if !germanVowels.has(w[i-1]) || !germanVowels.has(w[i+1]) {
+			continue
+		}
+		switch w[i] {
+		case 'u':
+			w[i] = 'U'
+		case 'y':
+			w[i] = 'Y'
+		}
+	}
+
+	r1 := germanVowels.region(w, 0)
+	r2 := germanVowels.region(w, r1)
+	if r1 < 3 && len(w) >= 3 {
+		r1 = 3
+	}
+
+	// step 1
+	switch suffix, pos := longestSuffix(w, 0, germanStep1); suffix {
+	case "em", "ern", "er":
+		if pos >= r1 {
+			w = w[:pos]
+		}
+	case "e", "en", "es":
+		if pos >= r1 {
+			w = w[:pos]
+			if hasSuffix(w, "niss") {
+				w = w[:len(w)-1]
+			}
+		}
+	case "s":
+		if pos >= r1 && precededBy(w, pos, 0, germanSEndings) {
+			w = w[:pos]
+		}
+	}
+
+	// step 2
+	switch suffix, pos := longestSuffix(w, 0, germanStep2); suffix {
+	case "en", "er", "est":
+		if pos >= r1 {
+			w = w[:pos]
+		}
+	case "st":
+		if pos >= r1 && pos-1 >= 3 && precededBy(w, pos, 0, germanStEndings) {
+			w = w[:pos]
+		}
+	}
+
+	// step 3: derivational suffixes
+	switch suffix, pos := longestSuffix(w, 0, germanStep3); suffix {
+	case "end", "ung":
+		if pos >= r2 {
+			w = w[:pos]
+			if p := suffixStart(w, "ig"); hasSuffix(w, "ig") && p >= r2 && !precededBy(w, p, 0, "e") {
+				w = w[:p]
+			}
+		}
+	case "ig", "ik", "isch":
+		if pos >= r2 && !precededBy(w, pos, 0, "e") {
+			w = w[:pos]
+		}
+	case "lich", "heit":
+		if pos >= r2 {
+			w = w[:pos]
+			if hasSuffix(w, "er") || hasSuffix(w, "en") {
+				if p := len(w) - 2; p >= r1 {
+					w = w[:p]
+				}
+			}
+		}
+	case "keit":
+		if pos >= r2 {
+			w = w[:pos]
+			for _, s := range []string{"lich", "ig"} {
+				if p := suffixStart(w, s); hasSuffix(w, s) && p >= r2 {
+					w = w[:p]
+					break
+				}
+			}
+		}
+	}
+
+	return string(replaceRunes(w, map[rune]rune{
+		'U': 'u', 'Y': 'y', 'ä': 'a', 'ö': 'o', 'ü': 'u',
+	}))
+}
@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmer
+
+// italian implements the Snowball Italian stemmer
+
+const italianVowels vowels = "aeiouàèìòù"
+
+var (
+	italianPronouns = []string{
+		"ci", "gli", "la", "le", "li", "lo", "mi", "ne", "si", "ti", "vi", "sene", "gliela",
+		"gliele", "glieli", "glielo", "gliene", "mela", "mele", "meli", "melo", "mene", "tela",
+		"tele", "teli", "telo", "tene", "cela", "cele", "celi", "celo", "cene", "vela", "vele",
+		"veli", "velo", "vene",
+	}
+	italianStep1 = []string{
+		"anza", "anze", "ico", "ici", "ica", "ice", "iche", "ichi", "ismo", "ismi", "abile",
+		"abili", "ibile", "ibili", "ista", "iste", "isti", "istà", "istè", "istì", "oso", "osi",
+		"osa", "ose", "mente", "atrice", "atrici", "ante", "anti", "azione", "azioni", "atore",
+		"atori", "logia", "logie", "uzione", "uzioni", "usione", "usioni", "enza", "enze",
+		"amento", "amenti", "imento", "imenti", "amente", "ità", "ivo", "ivi", "iva", "ive",
+	}
+	italianStep2 = []string{
+		"ammo", "ando", "ano", "are", "arono", "asse", "assero", "assi", "assimo", "ata", "ate",
+		"ati", "ato", "ava", "avamo", "avano", "avate", "avi", "avo", "emmo", "enda", "ende",
+		"endi", "endo", "erà", "erai", "eranno", "ere", "erebbe", "erebbero", "erei", "eremmo",
+		"eremo", "ereste", "eresti", "erete", "erò", "erono", "essero", "ete", "eva", "evamo",
+		"evano", "evate", "evi", "evo", "iamo", "immo", "irà", "irai", "iranno", "ire", "irebbe",
+		"irebbero", "irei", "iremmo", "iremo", "ireste", "iresti", "irete", "irò", "irono",
+		"isca", "iscano", "isce", "isci", "isco", "iscono", "issero", "ita", "ite", "iti", "ito",
+		"iva", "ivamo", "ivano", "ivate", "ivi", "ivo", "ar", "ir",
+	}
+)
+
+func italian(word string) string {
+	w := replaceRunes([]rune(word), map[rune]rune{'á': 'à', 'é': 'è', 'í': 'ì', 'ó': 'ò', 'ú': 'ù'})
+	for i := 1; i < len(w); i++ {
+		if w[i] == 'u' && w[i-1] == 'q' {
+			w[i] = 'U'
+		}
+	}
+	for i := 1; i < len(w)-1; i++ {
+		if !italianVowels.has(w[i-1]) || !italianVowels.has(w[i+1]) {
+			continue
+		}
+		switch w[i] {
+		case 'u':
+			w[i] = 'U'
+		case 'i':
+			w[i] = 'I'
+		}
+	}
+
+	rv := italianVowels.rv(w)
+	r1 := italianVowels.region(w, 0)
+	r2 := italianVowels.region(w, r1)
+
+	// step 0: attached pronoun
+	if suffix, pos := longestSuffix(w, rv, italianPronouns); suffix != "" {
+		switch ending, _ := longestSuffix(w[:pos], rv, []string{"ando", "endo", "ar", "er", "ir"}); ending {
+		case "ando", "endo":
+			w = w[:pos]
+		case "ar", "er", "ir":
+			w = replaceSuffix(w, pos, "e")
+		}
+	}
+
+	// step 1: standard suffix removal
+	removed := true
+	switch suffix, pos := longestSuffix(w, 0, italianStep1); suffix {
+	case "anza", "anze", "ico", "ici", "ica", "ice", "iche", "ichi", "ismo", "ismi", "abile",
+		"abili", "ibile", "ibili", "ista", "iste", "isti", "istà", "istè", "istì", "oso", "osi",
+		"osa", "ose", "mente", "atrice", "atrici", "ante", "anti":
+		if removed = pos >= r2; removed {
+			w = w[:pos]
+		}
+	case "azione", "azioni", "atore", "atori":
+		if removed = pos >= r2; removed {
+			w = trimInRegion(w[:pos], r2, "ic")
+		}
+	case "logia", "logie":
+		if removed = pos >= r2; removed {
+			w = replaceSuffix(w, pos, "log")
+		}
+	case "uzione", "uzioni", "usione", "usioni":
+		if removed = pos >= r2; removed {
+			w = replaceSuffix(w, pos, "u")
+		}
+	case "enza", "enze":
+		if removed = pos >= r2; removed {
+			w = replaceSuffix(w, pos, "ente")
+		}
+	case "amento", "amenti", "imento", "imenti":
+		if removed = pos >= rv; removed {
+			w = w[:pos]
+		}
+	case "amente":
+		if removed = pos >= r1; removed {
+			w = w[:pos]
+			if p := suffixStart(w, "iv"); hasSuffix(w, "iv") && p >= r2 {
+				w = trimInRegion(w[:p], r2, "at")
+			} else {
+				w = trimInRegion(w, r2, "os", "ic", "abil")
+			}
+		}
+	case "ità":
+		if removed = pos >= r2; removed {
+			w = trimInRegion(w[:pos], r2, "abil", "ic", "iv")
+		}
+	case "ivo", "ivi", "iva", "ive":
+		if removed = pos >= r2; removed {
+			w = w[:pos]
+			if p := suffixStart(w, "at"); hasSuffix(w, "at") && p >= r2 {
+				w = trimInRegion(w[:p], r2, "ic")
+			}
+		}
+	default:
+		removed = false
+	}
+
+	// step 2: verb suffixes
+	if !removed {
+		if suffix, pos := longestSuffix(w, rv, italianStep2); suffix != "" {
+			w = w[:pos]
+		}
+	}
+
+	// step 3a
+	if n := len(w); n > 0 && n-1 >= rv && italianVowels.has(w[n-1]) && w[n-1] != 'u' && w[n-1] != 'ù' {
+		w = w[:n-1]
+		if n := len(w); n > 0 && n-1 >= rv && w[n-1] == 'i' {
+			w = w[:n-1]
+		}
+	}
+
+	// step 3b
+	if n := len(w); n >= 2 && n-2 >= rv && w[n-1] == 'h' && (w[n-2] == 'c' || w[n-2] == 'g') {
+		w = w[:n-1]
+	}
+
+	return string(replaceRunes(w, map[rune]rune{'I': 'i', 'U': 'u'}))
+}
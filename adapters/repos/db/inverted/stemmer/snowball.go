@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmer
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// The helpers in this file implement the concepts shared by the Snowball
+// algorithms (http://snowball.tartarus.org). Words are handled as rune slices,
+// regions are the index of the first rune belonging to them.
+
+type vowels string
+
+func (v vowels) has(r rune) bool {
+	return strings.ContainsRune(string(v), r)
+}
+
+// region returns the start of the region after the first non-vowel following
+// a vowel, where the vowel has to be at or after from. R1 is region(w, 0), R2
+// is region(w, R1).
+func (v vowels) region(w []rune, from int) int {
+	for i := from + 1; i < len(w); i++ {
+		if v.has(w[i-1]) && !v.has(w[i]) {
+			return i + 1
+		}
+	}
+	return len(w)
+}
+
+// rv returns the start of the RV region as defined by the romance language
+// stemmers: after the next vowel if the second letter is a consonant, after
+// the next consonant if the word starts with two vowels and after the third
+// letter otherwise.
+func (v vowels) rv(w []rune) int {
+	if len(w) < 2 {
+		return len(w)
+	}
+	switch {
+	case !v.has(w[1]):
+		for i := 2; i < len(w); i++ {
+			if v.has(w[i]) {
+				return i + 1
+			}
+		}
+		return len(w)
+	case v.has(w[0]):
+		for i := 2; i < len(w); i++ {
+			if !v.has(w[i]) {
+				return i + 1
+			}
+		}
+		return len(w)
+	default:
+		return min(3, len(w))
+	}
+}
+
+// containsVowel is true if any rune of w is a vowel
+func (v vowels) containsVowel(w []rune) bool {
+	for _, r := range w {
+		if v.has(r) {
+			return true
+		}
+	}
+	return false
+}
+
+// precededBy is true if the rune before pos exists, is at or after from and
+// is one of the given runes
+func precededBy(w []rune, pos, from int, runes string) bool {
+	return pos-1 >= from && pos-1 >= 0 && strings.ContainsRune(runes, w[pos-1])
+}
+
+func hasSuffix(w []rune, suffix string) bool {
+	n := utf8.RuneCountInString(suffix)
+	if n > len(w) {
+		return false
+	}
+	return string(w[len(w)-n:]) == suffix
+}
+
+func hasPrefix(w []rune, prefix string) bool {
+	n := utf8.RuneCountInString(prefix)
+	if n > len(w) {
+		return false
+	}
+	return string(w[:n]) == prefix
+}
+
+// longestSuffix returns the longest of the given suffixes that w ends with
+// and that starts at or after from, together with the position it starts at.
+// If there is none, the suffix is empty and the position is len(w).
+func longestSuffix(w []rune, from int, suffixes []string) (string, int) {
+	found, start := "", len(w)
+	for _, suffix := range suffixes {
+		pos := suffixStart(w, suffix)
+		if pos >= from && pos < start && hasSuffix(w, suffix) {
+			found, start = suffix, pos
+		}
+	}
+	return found, start
+}
+
+// replaceSuffix cuts w at pos and appends the replacement
+func replaceSuffix(w []rune, pos int, replacement string) []rune {
+	return append(w[:pos], []rune(replacement)...)
+}
+
+// trimSuffix removes the suffix, which w is known to end with
+func trimSuffix(w []rune, suffix string) []rune {
+	return w[:len(w)-utf8.RuneCountInString(suffix)]
+}
+
+// suffixStart is the position the given suffix would start at in w
+func suffixStart(w []rune, suffix string) int {
+	return len(w) - utf8.RuneCountInString(suffix)
+}
+
+func replaceRunes(w []rune, replacer map[rune]rune) []rune {
+	for i, r := range w {
+		if replacement, ok := replacer[r]; ok {
+			w[i] = replacement
+		}
+	}
+	return w
+}
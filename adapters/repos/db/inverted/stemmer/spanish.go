@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmer
+
+// spanish implements the Snowball Spanish stemmer
+
+const spanishVowels vowels = "aeiouáéíóúü"
+
+var spanishAccents = map[rune]rune{'á': 'a', 'é': 'e', 'í': 'i', 'ó': 'o', 'ú': 'u'}
+
+var (
+	spanishPronouns = []string{
+		"me", "se", "sela", "selo", "selas", "selos", "la", "le", "lo", "las", "les", "los", "nos",
+	}
+	spanishStep1 = []string{
+		"anza", "anzas", "ico", "ica", "icos", "icas", "ismo", "ismos", "able", "ables", "ible",
+		"ibles", "ista", "istas", "oso", "osa", "osos", "osas", "amiento", "amientos", "imiento",
+		"imientos", "adora", "ador", "ación", "adoras", "adores", "aciones", "ante", "antes",
+		"ancia", "ancias", "logía", "logías", "ución", "uciones", "encia", "encias", "amente",
+		"mente", "idad", "idades", "iva", "ivo", "ivas", "ivos",
+	}
+	spanishStep2a = []string{
+		"ya", "ye", "yan", "yen", "yeron", "yendo", "yo", "yó", "yas", "yes", "yais", "yamos",
+	}
+	spanishStep2b = []string{
+		"en", "es", "éis", "emos",
+		"arían", "arías", "arán", "arás", "aríais", "aría", "aréis", "aríamos", "aremos", "ará",
+		"aré", "erían", "erías", "erán", "erás", "eríais", "ería", "eréis", "eríamos", "eremos",
+		"erá", "eré", "irían", "irías", "irán", "irás", "iríais", "iría", "iréis", "iríamos",
+		"iremos", "irá", "iré", "aba", "ada", "ida", "ía", "ara", "iera", "ad", "ed", "id", "ase",
+		"iese", "aste", "iste", "an", "aban", "ían", "aran", "ieran", "asen", "iesen", "aron",
+		"ieron", "ado", "ido", "ando", "iendo", "ió", "ar", "er", "ir", "as", "abas", "adas",
+		"idas", "ías", "aras", "ieras", "ases", "ieses", "ís", "áis", "abais", "íais", "arais",
+		"ierais", "aseis", "ieseis", "asteis", "isteis", "ados", "idos", "amos", "ábamos",
+		"íamos", "imos", "áramos", "iéramos", "iésemos", "ásemos",
+	}
+)
+
+func spanish(word string) string {
+	w := []rune(word)
+	rv := spanishVowels.rv(w)
+	r1 := spanishVowels.region(w, 0)
+	r2 := spanishVowels.region(w, r1)
+
+	// step 0: attached pronoun
+	if suffix, pos := longestSuffix(w, rv, spanishPronouns); suffix != "" {
+		verb := w[:pos]
+		if ending, p := longestSuffix(verb, rv, []string{"iéndo", "ándo", "ár", "ér", "ír"}); ending != "" {
+			replaceRunes(verb[p:], spanishAccents)
+			w = verb
+		} else if ending, _ := longestSuffix(verb, rv, []string{"ando", "iendo", "ar", "er", "ir"}); ending != "" {
+			w = verb
+		} else if p := suffixStart(verb, "yendo"); hasSuffix(verb, "yendo") && p >= rv && precededBy(verb, p, 0, "u") {
+			w = verb
+		}
+	}
+
+	// step 1: standard suffix removal
+	removed := true
+	switch suffix, pos := longestSuffix(w, 0, spanishStep1); suffix {
+	case "anza", "anzas", "ico", "ica", "icos", "icas", "ismo", "ismos", "able", "ables", "ible",
+		"ibles", "ista", "istas", "oso", "osa", "osos", "osas", "amiento", "amientos", "imiento",
+		"imientos":
+		if removed = pos >= r2; removed {
+			w = w[:pos]
+		}
+	case "adora", "ador", "ación", "adoras", "adores", "aciones", "ante", "antes", "ancia", "ancias":
+		if removed = pos >= r2; removed {
+			w = trimInRegion(w[:pos], r2, "ic")
+		}
+	case "logía", "logías":
+		if removed = pos >= r2; removed {
+			w = replaceSuffix(w, pos, "log")
+		}
+	case "ución", "uciones":
+		if removed = pos >= r2; removed {
+			w = replaceSuffix(w, pos, "u")
+		}
+	case "encia", "encias":
+		if removed = pos >= r2; removed {
+			w = replaceSuffix(w, pos, "ente")
+		}
+	case "amente":
+		if removed = pos >= r1; removed {
+			w = w[:pos]
+			if p := suffixStart(w, "iv"); hasSuffix(w, "iv") && p >= r2 {
+				w = trimInRegion(w[:p], r2, "at")
+			} else {
+				w = trimInRegion(w, r2, "os", "ic", "ad")
+			}
+		}
+	case "mente":
+		if removed = pos >= r2; removed {
+			w = trimInRegion(w[:pos], r2, "ante", "able", "ible")
+		}
+	case "idad", "idades":
+		if removed = pos >= r2; removed {
+			w = trimInRegion(w[:pos], r2, "abil", "ic", "iv")
+		}
+	case "iva", "ivo", "ivas", "ivos":
+		if removed = pos >= r2; removed {
+			w = trimInRegion(w[:pos], r2, "at")
+		}
+	default:
+		removed = false
+	}
+
+	if !removed {
+		// step 2a: verb suffixes beginning with y
+		if suffix, pos := longestSuffix(w, rv, spanishStep2a); suffix != "" && precededBy(w, pos, 0, "u") {
+			w = w[:pos]
+		} else {
+			// step 2b: other verb suffixes
+			switch suffix, pos := longestSuffix(w, rv, spanishStep2b); suffix {
+			case "":
+			case "en", "es", "éis", "emos":
+				w = w[:pos]
+				if hasSuffix(w, "gu") {
+					w = w[:len(w)-1]
+				}
+			default:
+				w = w[:pos]
+			}
+		}
+	}
+
+	// step 3: residual suffix
+	switch suffix, pos := longestSuffix(w, rv, []string{"os", "a", "o", "á", "í", "ó", "e", "é"}); suffix {
+	case "":
+	case "e", "é":
+		w = w[:pos]
+		if p := len(w) - 1; hasSuffix(w, "gu") && p >= rv {
+			w = w[:p]
+		}
+	default:
+		w = w[:pos]
+	}
+
+	return string(replaceRunes(w, spanishAccents))
+}
+
+// trimInRegion removes the longest of the suffixes if it starts within the
+// region
+func trimInRegion(w []rune, region int, suffixes ...string) []rune {
+	if suffix, pos := longestSuffix(w, 0, suffixes); suffix != "" && pos >= region {
+		return w[:pos]
+	}
+	return w
+}
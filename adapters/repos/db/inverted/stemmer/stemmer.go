@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmer
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	English = "en"
+	German  = "de"
+	French  = "fr"
+	Spanish = "es"
+	Italian = "it"
+	Dutch   = "nl"
+)
+
+// Stemmer reduces a lowercased word to its stem. The stems are not
+// necessarily words themselves, they are only meant to be identical for the
+// inflections of a word.
+type Stemmer func(word string) string
+
+var stemmers = map[string]Stemmer{
+	English: english,
+	German:  german,
+	French:  french,
+	Spanish: spanish,
+	Italian: italian,
+	Dutch:   dutch,
+}
+
+// Languages returns the languages a stemmer exists for, sorted
+func Languages() []string {
+	languages := make([]string, 0, len(stemmers))
+	for language := range stemmers {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// Get returns the Snowball stemmer for the given language
+func Get(language string) (Stemmer, error) {
+	stemmer, ok := stemmers[language]
+	if !ok {
+		return nil, fmt.Errorf("no stemmer for language %q, available languages are %s",
+			language, strings.Join(Languages(), ", "))
+	}
+	return stemmer, nil
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package stemmer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStemmers(t *testing.T) {
+	tests := map[string]map[string]string{
+		English: {
+			"running": "run", "generously": "generous", "caresses": "caress", "ponies": "poni",
+			"ties": "tie", "cats": "cat", "gas": "gas", "kiwis": "kiwi", "happiness": "happi",
+			"connection": "connect", "relational": "relat", "hopeful": "hope", "hoping": "hope",
+			"agreed": "agre", "feed": "feed", "generalization": "general", "skies": "sky",
+			"controlling": "control", "sensational": "sensat", "beautiful": "beauti",
+			"beauty": "beauti", "says": "say", "playing": "play",
+		},
+		German: {
+			"häuser": "haus", "katzen": "katz", "laufen": "lauf", "schönheit": "schonheit",
+			"kindern": "kind", "freundlichkeit": "freundlich", "möglichkeiten": "moglich",
+			"wichtigsten": "wichtig", "straße": "strass", "zeitung": "zeitung",
+		},
+		French: {
+			"chevaux": "cheval", "finissons": "fin", "maisons": "maison",
+			"continuellement": "continuel", "nationalité": "national", "heureuse": "heureux",
+			"heureux": "heureux", "parlaient": "parl", "mangé": "mang", "évidemment": "évident",
+		},
+		Spanish: {
+			"chicas": "chic", "corriendo": "corr", "libros": "libr", "rápidamente": "rapid",
+			"canciones": "cancion", "comiéndolo": "com", "nacionalidad": "nacional",
+			"trabajadores": "trabaj", "hablaban": "habl",
+		},
+		Italian: {
+			"gatti": "gatt", "abbandonata": "abbandon", "abbandonare": "abbandon",
+			"rapidamente": "rapid", "parlando": "parl", "parlandogli": "parl", "felicità": "felic",
+			"amiche": "amic", "mangiare": "mang",
+		},
+		Dutch: {
+			"boeken": "boek", "maan": "man", "katten": "kat", "huizen": "huiz",
+			"kinderen": "kinder", "vriendelijkheid": "vriendelijk", "mogelijkheden": "mogelijk",
+		},
+	}
+
+	for language, words := range tests {
+		t.Run(language, func(t *testing.T) {
+			stem, err := Get(language)
+			require.Nil(t, err)
+			for word, expected := range words {
+				assert.Equal(t, expected, stem(word), word)
+			}
+		})
+	}
+}
+
+func TestStemmerShortWords(t *testing.T) {
+	for _, language := range Languages() {
+		stem, err := Get(language)
+		require.Nil(t, err)
+		for _, word := range []string{"", "a", "ab", "é"} {
+			assert.NotPanics(t, func() { stem(word) }, "%s: %q", language, word)
+		}
+	}
+}
+
+func TestUnknownLanguage(t *testing.T) {
+	_, err := Get("xx")
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "no stemmer for language \"xx\"")
+}
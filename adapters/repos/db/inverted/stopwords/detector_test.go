@@ -156,4 +156,36 @@ func TestStopwordDetector(t *testing.T) {
 
 		runTest(t, tests)
 	})
+
+	t.Run("with language presets", func(t *testing.T) {
+		tests := []testcase{
+			{
+				cfg:               models.StopwordConfig{Preset: "de"},
+				input:             []string{"der", "hund", "und", "die", "katze"},
+				expectedCountable: 2,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "fr"},
+				input:             []string{"le", "chien", "et", "le", "chat"},
+				expectedCountable: 2,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "es"},
+				input:             []string{"el", "perro", "y", "el", "gato"},
+				expectedCountable: 2,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "it"},
+				input:             []string{"il", "cane", "e", "il", "gatto"},
+				expectedCountable: 2,
+			},
+			{
+				cfg:               models.StopwordConfig{Preset: "nl"},
+				input:             []string{"de", "hond", "en", "de", "kat"},
+				expectedCountable: 2,
+			},
+		}
+
+		runTest(t, tests)
+	})
 }
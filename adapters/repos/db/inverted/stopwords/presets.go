@@ -13,6 +13,11 @@ package stopwords
 
 const (
 	EnglishPreset = "en"
+	GermanPreset  = "de"
+	FrenchPreset  = "fr"
+	SpanishPreset = "es"
+	ItalianPreset = "it"
+	DutchPreset   = "nl"
 	NoPreset      = "none"
 )
 
@@ -23,5 +28,38 @@ var Presets = map[string][]string{
 		"the", "their", "then", "there", "these", "they", "this", "to", "was", "will",
 		"with",
 	},
+	GermanPreset: {
+		"aber", "als", "am", "an", "auch", "auf", "aus", "bei", "bin", "bis", "da", "damit",
+		"dann", "das", "dass", "dem", "den", "der", "des", "die", "dies", "doch", "du", "durch",
+		"ein", "eine", "einem", "einen", "einer", "eines", "er", "es", "für", "hat", "ich", "ihr",
+		"im", "in", "ist", "ja", "kein", "mit", "nach", "nicht", "noch", "nur", "oder", "sein",
+		"sich", "sie", "sind", "so", "und", "uns", "vom", "von", "vor", "war", "was", "wenn",
+		"wie", "wir", "wird", "zu", "zum", "zur",
+	},
+	FrenchPreset: {
+		"au", "aux", "avec", "ce", "ces", "dans", "de", "des", "du", "elle", "en", "est", "et",
+		"eux", "il", "ils", "je", "la", "le", "les", "leur", "lui", "ma", "mais", "me", "mes",
+		"moi", "mon", "ne", "nos", "notre", "nous", "on", "ou", "par", "pas", "pour", "qu", "que",
+		"qui", "sa", "se", "ses", "son", "sur", "ta", "te", "tes", "toi", "ton", "tu", "un", "une",
+		"vos", "votre", "vous", "été", "être",
+	},
+	SpanishPreset: {
+		"a", "al", "como", "con", "de", "del", "el", "ella", "en", "entre", "es", "esta", "este",
+		"fue", "ha", "la", "las", "le", "les", "lo", "los", "más", "me", "mi", "muy", "no", "nos",
+		"o", "para", "pero", "por", "que", "se", "sin", "sobre", "su", "sus", "también", "te",
+		"tu", "un", "una", "y", "ya", "yo",
+	},
+	ItalianPreset: {
+		"a", "al", "alla", "alle", "che", "ci", "con", "da", "dal", "dalla", "degli", "dei",
+		"del", "della", "delle", "di", "e", "gli", "ha", "i", "il", "in", "la", "le", "lo", "ma",
+		"mi", "nel", "nella", "non", "o", "per", "più", "se", "si", "su", "sua", "suo", "sul",
+		"sulla", "ti", "tra", "un", "una", "uno", "è",
+	},
+	DutchPreset: {
+		"aan", "al", "als", "bij", "dan", "dat", "de", "die", "dit", "door", "een", "en", "er",
+		"het", "hij", "hoe", "ik", "in", "is", "je", "maar", "met", "naar", "niet", "nog", "of",
+		"om", "onder", "ook", "op", "over", "te", "tot", "uit", "van", "voor", "was", "wat",
+		"we", "wel", "zijn", "ze", "zich", "zo",
+	},
 	NoPreset: {},
 }
@@ -0,0 +1,249 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stemmer"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// TokenFilter transforms a single token after tokenization. Returning an
+// empty string removes the token.
+type TokenFilter interface {
+	Filter(token string) string
+}
+
+// TextAnalyzer tokenizes text and passes the tokens through the filters
+// configured for a property. Text has to be analyzed identically at index and
+// at query time, otherwise the terms would not match.
+type TextAnalyzer struct {
+	tokenization string
+	filters      []TokenFilter
+	key          string
+}
+
+// NewTextAnalyzer builds the analyzer chain for the given tokenization and
+// filter config. The filters run in the order lowercase, stopwords, stemmer,
+// ascii folding: the stopword lists and stemmers expect the original
+// spelling, so folding comes last.
+func NewTextAnalyzer(tokenization string, cfg *models.TextAnalyzerConfig) (*TextAnalyzer, error) {
+	a := &TextAnalyzer{tokenization: tokenization, key: tokenization}
+	if cfg == nil {
+		return a, nil
+	}
+
+	if cfg.Lowercase {
+		a.filters = append(a.filters, lowercaseFilter{})
+	}
+	if cfg.StopwordPreset != "" {
+		set, ok := stopwordPresetSets()[cfg.StopwordPreset]
+		if !ok {
+			return nil, fmt.Errorf("stopword preset %q not known", cfg.StopwordPreset)
+		}
+		a.filters = append(a.filters, stopwordFilter{stopwords: set})
+	}
+	if cfg.Stemmer != "" {
+		stem, err := stemmer.Get(cfg.Stemmer)
+		if err != nil {
+			return nil, err
+		}
+		a.filters = append(a.filters, stemmerFilter{stem: stem})
+	}
+	if cfg.ASCIIFold {
+		a.filters = append(a.filters, asciiFoldFilter{})
+	}
+
+	a.key = fmt.Sprintf("%s/lowercase=%t/stopwords=%s/stemmer=%s/asciiFold=%t", tokenization,
+		cfg.Lowercase, cfg.StopwordPreset, cfg.Stemmer, cfg.ASCIIFold)
+	return a, nil
+}
+
+// NewPropertyTextAnalyzer builds the analyzer chain of a text or text[]
+// property
+func NewPropertyTextAnalyzer(prop *models.Property) (*TextAnalyzer, error) {
+	a, err := NewTextAnalyzer(prop.Tokenization, prop.TextAnalyzer)
+	if err != nil {
+		return nil, fmt.Errorf("text analyzer of property %q: %w", prop.Name, err)
+	}
+	return a, nil
+}
+
+// tokenizingAnalyzer applies no filters on top of the tokenization
+func tokenizingAnalyzer(tokenization string) *TextAnalyzer {
+	return &TextAnalyzer{tokenization: tokenization, key: tokenization}
+}
+
+func (a *TextAnalyzer) Tokenization() string {
+	return a.tokenization
+}
+
+// Key is identical for analyzers which produce the same terms
+func (a *TextAnalyzer) Key() string {
+	return a.key
+}
+
+// Tokenize returns the analyzed terms of the input
+func (a *TextAnalyzer) Tokenize(in string) []string {
+	return a.filter(helpers.Tokenize(a.tokenization, in), false)
+}
+
+// TokenizeWithWildcards returns the analyzed terms of a Like filter pattern
+func (a *TextAnalyzer) TokenizeWithWildcards(in string) []string {
+	return a.filter(helpers.TokenizeWithWildcards(a.tokenization, in), false)
+}
+
+// TokenizeWithGaps works like Tokenize, but keeps an empty string in place of
+// each removed token, so that the position of the remaining terms is known
+func (a *TextAnalyzer) TokenizeWithGaps(in string) []string {
+	return a.filter(helpers.Tokenize(a.tokenization, in), true)
+}
+
+// TokenizeAndCountDuplicates returns the unique analyzed terms of the input
+// together with the number of times each of them occurs
+func (a *TextAnalyzer) TokenizeAndCountDuplicates(in string) ([]string, []int) {
+	counts := map[string]int{}
+	var unique []string
+	for _, term := range a.Tokenize(in) {
+		if _, ok := counts[term]; !ok {
+			unique = append(unique, term)
+		}
+		counts[term]++
+	}
+
+	boosts := make([]int, len(unique))
+	for i, term := range unique {
+		boosts[i] = counts[term]
+	}
+	return unique, boosts
+}
+
+// filter passes the tokens through the filters. Removed tokens are dropped,
+// unless keepGaps is set, then they are replaced by an empty string.
+func (a *TextAnalyzer) filter(tokens []string, keepGaps bool) []string {
+	if len(a.filters) == 0 {
+		return tokens
+	}
+	out := tokens[:0]
+	for _, token := range tokens {
+		removed := false
+		for _, filter := range a.filters {
+			if token == "" {
+				break
+			}
+			if token = filter.Filter(token); token == "" {
+				removed = true
+			}
+		}
+		if !removed || keepGaps {
+			out = append(out, token)
+		}
+	}
+	return out
+}
+
+type lowercaseFilter struct{}
+
+func (lowercaseFilter) Filter(token string) string {
+	return strings.ToLower(token)
+}
+
+type stopwordFilter struct {
+	stopwords map[string]struct{}
+}
+
+func (f stopwordFilter) Filter(token string) string {
+	if _, ok := f.stopwords[token]; ok {
+		return ""
+	}
+	return token
+}
+
+type stemmerFilter struct {
+	stem stemmer.Stemmer
+}
+
+func (f stemmerFilter) Filter(token string) string {
+	// tokens with wildcards are Like patterns, which can not be stemmed
+	if strings.ContainsAny(token, "*?") {
+		return token
+	}
+	return f.stem(token)
+}
+
+type asciiFoldFilter struct{}
+
+func (asciiFoldFilter) Filter(token string) string {
+	return FoldToASCII(token)
+}
+
+// asciiFoldReplacements are the latin letters which do not decompose into an
+// ASCII letter and combining marks
+var asciiFoldReplacements = map[rune]string{
+	'ß': "ss", 'æ': "ae", 'Æ': "AE", 'ø': "o", 'Ø': "O", 'œ': "oe", 'Œ': "OE", 'ł': "l",
+	'Ł': "L", 'đ': "d", 'Đ': "D", 'ð': "d", 'Ð': "D", 'þ': "th", 'Þ': "TH", 'ı': "i",
+}
+
+// FoldToASCII removes the diacritics from latin letters and replaces ligatures
+// and other special latin letters by their ASCII equivalent. Other scripts
+// are left unchanged.
+func FoldToASCII(in string) string {
+	ascii := true
+	for i := 0; i < len(in); i++ {
+		if in[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return in
+	}
+
+	var sb strings.Builder
+	latinBase := false
+	for _, r := range norm.NFD.String(in) {
+		if unicode.Is(unicode.Mn, r) {
+			if !latinBase {
+				sb.WriteRune(r)
+			}
+			continue
+		}
+		latinBase = unicode.Is(unicode.Latin, r)
+		if replacement, ok := asciiFoldReplacements[r]; ok {
+			sb.WriteString(replacement)
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return norm.NFC.String(sb.String())
+}
+
+var stopwordPresetSets = sync.OnceValue(func() map[string]map[string]struct{} {
+	sets := make(map[string]map[string]struct{}, len(stopwords.Presets))
+	for preset, words := range stopwords.Presets {
+		set := make(map[string]struct{}, len(words))
+		for _, word := range words {
+			set[word] = struct{}{}
+		}
+		sets[preset] = set
+	}
+	return sets
+})
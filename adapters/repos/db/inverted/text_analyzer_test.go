@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestFoldToASCII(t *testing.T) {
+	tests := map[string]string{
+		"plain":      "plain",
+		"café":       "cafe",
+		"Ångström":   "Angstrom",
+		"straße":     "strasse",
+		"smørrebrød": "smorrebrod",
+		"œuvre":      "oeuvre",
+		"łódź":       "lodz",
+		"naïve":      "naive",
+		"日本語":        "日本語",
+		"がぎ":         "がぎ",
+		"한국어":        "한국어",
+	}
+	for in, expected := range tests {
+		assert.Equal(t, expected, FoldToASCII(in), in)
+	}
+}
+
+func TestTextAnalyzer(t *testing.T) {
+	t.Run("without config only tokenizes", func(t *testing.T) {
+		a, err := NewTextAnalyzer(models.PropertyTokenizationWord, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"die", "häuser", "sind", "groß"}, a.Tokenize("Die Häuser sind groß"))
+		assert.Equal(t, models.PropertyTokenizationWord, a.Key())
+	})
+
+	t.Run("filters run in order", func(t *testing.T) {
+		a, err := NewTextAnalyzer(models.PropertyTokenizationWord, &models.TextAnalyzerConfig{
+			StopwordPreset: "de",
+			Stemmer:        "de",
+			ASCIIFold:      true,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"haus", "gross"}, a.Tokenize("Die Häuser sind groß"))
+		assert.Equal(t, []string{"", "haus", "", "gross"}, a.TokenizeWithGaps("Die Häuser sind groß"))
+	})
+
+	t.Run("lowercase for whitespace tokenization", func(t *testing.T) {
+		a, err := NewTextAnalyzer(models.PropertyTokenizationWhitespace, &models.TextAnalyzerConfig{
+			Lowercase: true,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"hello", "world!"}, a.Tokenize("Hello WORLD!"))
+	})
+
+	t.Run("wildcards are not stemmed", func(t *testing.T) {
+		a, err := NewTextAnalyzer(models.PropertyTokenizationWord, &models.TextAnalyzerConfig{
+			Stemmer: "en",
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"running*", "jump"}, a.TokenizeWithWildcards("running* jumping"))
+	})
+
+	t.Run("field tokenization keeps empty values", func(t *testing.T) {
+		a, err := NewTextAnalyzer(models.PropertyTokenizationField, &models.TextAnalyzerConfig{
+			ASCIIFold: true,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{""}, a.Tokenize(""))
+	})
+
+	t.Run("duplicates are counted after analysis", func(t *testing.T) {
+		a, err := NewTextAnalyzer(models.PropertyTokenizationWord, &models.TextAnalyzerConfig{
+			Stemmer: "en",
+		})
+		require.Nil(t, err)
+		terms, boosts := a.TokenizeAndCountDuplicates("running runs ran")
+		assert.Equal(t, []string{"run", "ran"}, terms)
+		assert.Equal(t, []int{2, 1}, boosts)
+	})
+
+	t.Run("keys differ by config", func(t *testing.T) {
+		a, err := NewTextAnalyzer(models.PropertyTokenizationWord, &models.TextAnalyzerConfig{Stemmer: "en"})
+		require.Nil(t, err)
+		b, err := NewTextAnalyzer(models.PropertyTokenizationWord, &models.TextAnalyzerConfig{Stemmer: "de"})
+		require.Nil(t, err)
+		c, err := NewTextAnalyzer(models.PropertyTokenizationWord, &models.TextAnalyzerConfig{Stemmer: "en"})
+		require.Nil(t, err)
+		assert.NotEqual(t, a.Key(), b.Key())
+		assert.Equal(t, a.Key(), c.Key())
+	})
+
+	t.Run("unknown config", func(t *testing.T) {
+		_, err := NewTextAnalyzer(models.PropertyTokenizationWord, &models.TextAnalyzerConfig{StopwordPreset: "xx"})
+		assert.ErrorContains(t, err, "stopword preset \"xx\" not known")
+		_, err = NewTextAnalyzer(models.PropertyTokenizationWord, &models.TextAnalyzerConfig{Stemmer: "xx"})
+		assert.ErrorContains(t, err, "no stemmer for language \"xx\"")
+	})
+}
+
+func TestAnalyzedTextArrayWithPositions(t *testing.T) {
+	a, err := NewTextAnalyzer(models.PropertyTokenizationWord, &models.TextAnalyzerConfig{
+		StopwordPreset: "en",
+		Stemmer:        "en",
+	})
+	require.Nil(t, err)
+
+	// removed stopwords still take up a position
+	countable := NewAnalyzer(nil).AnalyzedTextArrayWithPositions(a, []string{"the king of the hills"})
+	require.Len(t, countable, 2)
+	assert.Equal(t, "king", string(countable[0].Data))
+	assert.Equal(t, []uint32{1}, countable[0].Positions)
+	assert.Equal(t, "hill", string(countable[1].Data))
+	assert.Equal(t, []uint32{4}, countable[1].Positions)
+
+	tokens, gaps := phraseTokens(a, "king of the hill")
+	assert.Equal(t, []string{"king", "hill"}, tokens)
+	assert.Equal(t, 2, gaps)
+	assert.True(t, phraseMatches([][]uint32{countable[0].Positions, countable[1].Positions}, gaps))
+}
@@ -113,6 +113,12 @@ type Bucket struct {
 	// ON by default
 	calcCountNetAdditions bool
 
+	// Block-max metadata summarizes the map pairs of every row of a segment in
+	// blocks, which allows bm25 to skip postings which can't make it into the
+	// results. Only applicable to map collections.
+	// OFF by default
+	useBlockMax bool
+
 	forceCompaction bool
 
 	// optionally supplied to prevent starting memory-intensive
@@ -182,6 +188,7 @@ func (*Bucket) NewBucket(ctx context.Context, dir, rootDir string, logger logrus
 			forceCompaction:       b.forceCompaction,
 			useBloomFilter:        b.useBloomFilter,
 			calcCountNetAdditions: b.calcCountNetAdditions,
+			useBlockMax:           b.useBlockMax,
 			maxSegmentSize:        b.maxSegmentSize,
 		}, b.allocChecker)
 	if err != nil {
@@ -759,6 +766,47 @@ func (b *Bucket) MapList(ctx context.Context, key []byte, cfgs ...MapListOption)
 	return newSortedMapMerger().do(ctx, segments)
 }
 
+// MapListBlockMax returns the block-max metadata of all pairs which are
+// returned by MapList for the same row key, including pairs which have been
+// overwritten or deleted since. Blocks originate from different segments, so
+// they may overlap and are not in any specific order. A doc which is
+// returned by MapList is contained in at least one block whose range covers
+// it. Buckets which were not created using WithBlockMax return
+// ErrBlockMaxDisabled, ErrBlockMaxUnavailable is returned while the metadata
+// of existing segments is still being built.
+func (b *Bucket) MapListBlockMax(ctx context.Context, key []byte) ([]BlockMax, error) {
+	if !b.useBlockMax || b.strategy != StrategyMapCollection {
+		return nil, ErrBlockMaxDisabled
+	}
+
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	blocks, err := b.disk.getBlockMax(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	if b.flushing != nil {
+		v, err := b.flushing.getMap(key)
+		if err != nil && !errors.Is(err, lsmkv.NotFound) {
+			return nil, err
+		}
+		blocks = append(blocks, blockMaxOf(v)...)
+	}
+
+	v, err := b.active.getMap(key)
+	if err != nil && !errors.Is(err, lsmkv.NotFound) {
+		return nil, err
+	}
+
+	return append(blocks, blockMaxOf(v)...), nil
+}
+
 // MapSet writes one [MapPair] into the map for the given row key. It is
 // agnostic of whether the row key already exists, as well as agnostic of
 // whether the map key already exists. In both cases it will create the entry
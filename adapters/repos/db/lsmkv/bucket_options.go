@@ -145,6 +145,17 @@ func WithCalcCountNetAdditions(calcCountNetAdditions bool) BucketOption {
 	}
 }
 
+// WithBlockMax persists block-max metadata (see [BlockMax]) next to every
+// segment of a map collection bucket, so it can be retrieved using
+// [Bucket.MapListBlockMax]. Segments which were written without it get their
+// metadata built in the background as part of the compaction cycle.
+func WithBlockMax(useBlockMax bool) BucketOption {
+	return func(b *Bucket) error {
+		b.useBlockMax = useBlockMax
+		return nil
+	}
+}
+
 func WithMaxSegmentSize(maxSegmentSize int64) BucketOption {
 	return func(b *Bucket) error {
 		b.maxSegmentSize = maxSegmentSize
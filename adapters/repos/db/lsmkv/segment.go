@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sync/atomic"
 
 	"github.com/edsrzf/mmap-go"
 	"github.com/sirupsen/logrus"
@@ -53,6 +54,12 @@ type segment struct {
 	// the net addition this segment adds with respect to all previous segments
 	calcCountNetAdditions bool // see bucket for more datails
 	countNetAdditions     int
+
+	useBlockMax bool // see bucket for more datails
+	blockMax    atomic.Pointer[blockMaxMeta]
+	// blockMaxFailed is set if building missing block max metadata failed, it
+	// is not built again until the next restart
+	blockMaxFailed atomic.Bool
 }
 
 type diskIndex interface {
@@ -75,7 +82,8 @@ type diskIndex interface {
 
 func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 	existsLower existsOnLowerSegmentsFn, mmapContents bool,
-	useBloomFilter bool, calcCountNetAdditions bool, overwriteDerived bool,
+	useBloomFilter bool, calcCountNetAdditions bool, useBlockMax bool,
+	overwriteDerived bool,
 ) (_ *segment, err error) {
 	defer func() {
 		p := recover()
@@ -135,6 +143,7 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		mmapContents:          mmapContents,
 		useBloomFilter:        useBloomFilter,
 		calcCountNetAdditions: calcCountNetAdditions,
+		useBlockMax:           useBlockMax,
 	}
 
 	// Using pread strategy requires file to remain open for segment lifetime
@@ -165,6 +174,11 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 			return nil, err
 		}
 	}
+	if seg.useBlockMax {
+		if err := seg.initBlockMax(overwriteDerived); err != nil {
+			return nil, fmt.Errorf("init block max: %w", err)
+		}
+	}
 
 	return seg, nil
 }
@@ -204,6 +218,10 @@ func (s *segment) drop() error {
 		return fmt.Errorf("drop count net additions file: %w", err)
 	}
 
+	if err := os.RemoveAll(s.blockMaxPath()); err != nil {
+		return fmt.Errorf("drop block max file: %w", err)
+	}
+
 	// for the segment itself, we're not using RemoveAll, but Remove. If there
 	// was a NotExists error here, something would be seriously wrong, and we
 	// don't want to ignore it.
@@ -0,0 +1,401 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/lsmkv"
+)
+
+// BlockMaxSize is the number of map pairs summarized by a single block of
+// block-max metadata.
+const BlockMaxSize = 128
+
+// minID, maxID, maxFrequency, minPropLength
+const blockMaxEncodedSize = 8 + 8 + 4 + 4
+
+// ErrBlockMaxDisabled is returned when block-max metadata is requested from a
+// bucket which was not created using WithBlockMax.
+var ErrBlockMaxDisabled = errors.New("block max metadata is not enabled for this bucket")
+
+// ErrBlockMaxUnavailable is returned when the block-max metadata of some
+// segments is still being built.
+var ErrBlockMaxUnavailable = errors.New("block max metadata is not available for all segments yet")
+
+// BlockMax summarizes a block of consecutive map pairs of a row. It is meant
+// for the searchable inverted index, where the key of a pair is the big
+// endian doc id and its value starts with the term frequency and the property
+// length as little endian float32. Every doc of the block lies within
+// [MinID, MaxID] and has at most MaxFrequency and at least MinPropLength,
+// which allows to bound the score of a doc without reading its pair.
+type BlockMax struct {
+	MinID         uint64
+	MaxID         uint64
+	MaxFrequency  float32
+	MinPropLength float32
+}
+
+// blockMaxOf summarizes the pairs in blocks of BlockMaxSize in the order they
+// are given. Tombstones and pairs which are not shaped like a posting are
+// skipped, as they can't contribute to any score.
+func blockMaxOf(pairs []MapPair) []BlockMax {
+	var blocks []BlockMax
+	count := 0
+	for _, pair := range pairs {
+		if pair.Tombstone || len(pair.Key) < 8 || len(pair.Value) < 8 {
+			continue
+		}
+
+		id := binary.BigEndian.Uint64(pair.Key)
+		frequency := math.Float32frombits(binary.LittleEndian.Uint32(pair.Value[0:4]))
+		propLength := math.Float32frombits(binary.LittleEndian.Uint32(pair.Value[4:8]))
+
+		if count%BlockMaxSize == 0 {
+			blocks = append(blocks, BlockMax{
+				MinID:         id,
+				MaxID:         id,
+				MaxFrequency:  frequency,
+				MinPropLength: propLength,
+			})
+		} else {
+			block := &blocks[len(blocks)-1]
+			if id < block.MinID {
+				block.MinID = id
+			}
+			if id > block.MaxID {
+				block.MaxID = id
+			}
+			if frequency > block.MaxFrequency {
+				block.MaxFrequency = frequency
+			}
+			if propLength < block.MinPropLength {
+				block.MinPropLength = propLength
+			}
+		}
+		count++
+	}
+
+	return blocks
+}
+
+func (s *segment) blockMaxPath() string {
+	extless := strings.TrimSuffix(s.path, filepath.Ext(s.path))
+	return fmt.Sprintf("%s.blockmax", extless)
+}
+
+// blockMaxMeta is the parsed content of a block max file, it is swapped in
+// atomically as it can be built while the segment is already in use
+type blockMaxMeta struct {
+	blocks []byte
+	index  *segmentindex.DiskTree
+}
+
+// initBlockMax loads the block max metadata of the segment. For new segments
+// (overwrite) it is computed right away. Segments which were created before
+// block max metadata was enabled don't have it yet, which would require a
+// full scan of each segment at startup. Instead, their metadata is built in
+// the background, see SegmentGroup.buildMissingBlockMax. Until then the
+// metadata is reported as unavailable.
+func (s *segment) initBlockMax(overwrite bool) error {
+	if s.strategy != segmentindex.StrategyMapCollection {
+		// block-max metadata is only meaningful for map pairs
+		return nil
+	}
+
+	path := s.blockMaxPath()
+
+	ok, err := fileExists(path)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		if overwrite {
+			err := os.Remove(path)
+			if err != nil {
+				return fmt.Errorf("delete existing block max file %s: %w", path, err)
+			}
+		} else {
+			err = s.loadBlockMaxFromDisk()
+			if err == nil {
+				return nil
+			}
+
+			if !errors.Is(err, ErrInvalidChecksum) {
+				// not a recoverable error
+				return err
+			}
+
+			// leave it to the background build to re-calculate it
+			return nil
+		}
+	}
+
+	if !overwrite {
+		return nil
+	}
+
+	return s.computeAndStoreBlockMax()
+}
+
+func (s *segment) needsBlockMax() bool {
+	return s.useBlockMax && s.strategy == segmentindex.StrategyMapCollection &&
+		s.blockMax.Load() == nil && !s.blockMaxFailed.Load()
+}
+
+func (s *segment) computeAndStoreBlockMax() error {
+	before := time.Now()
+
+	data, err := s.computeBlockMax()
+	if err != nil {
+		return fmt.Errorf("compute block max: %w", err)
+	}
+
+	if err := writeWithChecksum(data, s.blockMaxPath()); err != nil {
+		return fmt.Errorf("store block max on disk: %w", err)
+	}
+
+	if err := s.parseBlockMax(data); err != nil {
+		return err
+	}
+
+	took := time.Since(before)
+
+	s.logger.WithField("action", "lsm_init_disk_segment_build_block_max").
+		WithField("path", s.path).
+		WithField("took", took).
+		Debugf("building block max metadata took %s\n", took)
+
+	return nil
+}
+
+// computeBlockMax summarizes the pairs of every row of the segment. The
+// result contains the encoded blocks of all rows, followed by an index of
+// the rows pointing into the blocks and the position of said index.
+func (s *segment) computeBlockMax() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	var nodes []segmentindex.Node
+
+	if s.dataStartPos < s.dataEndPos {
+		c := s.newCollectionCursor()
+		for key, values, err := c.first(); ; key, values, err = c.next() {
+			if errors.Is(err, lsmkv.NotFound) {
+				break
+			}
+			if err != nil && !errors.Is(err, lsmkv.Deleted) {
+				return nil, err
+			}
+
+			pairs := make([]MapPair, len(values))
+			for i, v := range values {
+				if err := pairs[i].FromBytes(v.value, false); err != nil {
+					return nil, err
+				}
+				// see Bucket.MapList for the "broken" tombstones of length 12
+				pairs[i].Tombstone = v.tombstone || len(v.value) == 12
+			}
+
+			blocks := blockMaxOf(pairs)
+			if len(blocks) == 0 {
+				continue
+			}
+
+			start := uint64(buf.Len())
+			for _, block := range blocks {
+				writeBlockMax(buf, block)
+			}
+			nodes = append(nodes, segmentindex.Node{
+				Key:   key,
+				Start: start,
+				End:   uint64(buf.Len()),
+			})
+		}
+	}
+
+	indexStart := uint64(buf.Len())
+	index := segmentindex.NewBalanced(nodes)
+	indexBytes, err := index.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("marshal block max index: %w", err)
+	}
+	buf.Write(indexBytes)
+
+	if err := binary.Write(buf, binary.LittleEndian, indexStart); err != nil {
+		return nil, fmt.Errorf("write block max index position: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeBlockMax(buf *bytes.Buffer, block BlockMax) {
+	var b [blockMaxEncodedSize]byte
+	binary.LittleEndian.PutUint64(b[0:8], block.MinID)
+	binary.LittleEndian.PutUint64(b[8:16], block.MaxID)
+	binary.LittleEndian.PutUint32(b[16:20], math.Float32bits(block.MaxFrequency))
+	binary.LittleEndian.PutUint32(b[20:24], math.Float32bits(block.MinPropLength))
+	buf.Write(b[:])
+}
+
+func (s *segment) loadBlockMaxFromDisk() error {
+	data, err := loadWithChecksum(s.blockMaxPath(), -1)
+	if err != nil {
+		return err
+	}
+
+	return s.parseBlockMax(data)
+}
+
+func (s *segment) parseBlockMax(data []byte) error {
+	if len(data) < 8 {
+		return ErrInvalidChecksum
+	}
+
+	indexStart := binary.LittleEndian.Uint64(data[len(data)-8:])
+	if indexStart > uint64(len(data)-8) || indexStart%blockMaxEncodedSize != 0 {
+		return ErrInvalidChecksum
+	}
+
+	s.blockMax.Store(&blockMaxMeta{
+		blocks: data[:indexStart],
+		index:  segmentindex.NewDiskTree(data[indexStart : len(data)-8]),
+	})
+	return nil
+}
+
+// getBlockMax returns lsmkv.NotFound if the segment does not contain any
+// pairs for the key
+func (s *segment) getBlockMax(key []byte) ([]BlockMax, error) {
+	meta := s.blockMax.Load()
+	if meta == nil {
+		return nil, ErrBlockMaxUnavailable
+	}
+
+	node, err := meta.index.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if node.Start > node.End || node.End > uint64(len(meta.blocks)) {
+		return nil, fmt.Errorf("block max of segment %s: invalid position [%d, %d)",
+			s.path, node.Start, node.End)
+	}
+
+	data := meta.blocks[node.Start:node.End]
+	blocks := make([]BlockMax, len(data)/blockMaxEncodedSize)
+	for i := range blocks {
+		b := data[i*blockMaxEncodedSize : (i+1)*blockMaxEncodedSize]
+		blocks[i] = BlockMax{
+			MinID:         binary.LittleEndian.Uint64(b[0:8]),
+			MaxID:         binary.LittleEndian.Uint64(b[8:16]),
+			MaxFrequency:  math.Float32frombits(binary.LittleEndian.Uint32(b[16:20])),
+			MinPropLength: math.Float32frombits(binary.LittleEndian.Uint32(b[20:24])),
+		}
+	}
+
+	return blocks, nil
+}
+
+func (s *segment) precomputeBlockMax() ([]string, error) {
+	if s.strategy != segmentindex.StrategyMapCollection {
+		return []string{}, nil
+	}
+
+	data, err := s.computeBlockMax()
+	if err != nil {
+		return nil, fmt.Errorf("compute block max: %w", err)
+	}
+
+	path := fmt.Sprintf("%s.tmp", s.blockMaxPath())
+	if err := writeWithChecksum(data, path); err != nil {
+		return nil, fmt.Errorf("store block max on disk: %w", err)
+	}
+
+	return []string{path}, nil
+}
+
+func (sg *SegmentGroup) getBlockMax(key []byte) ([]BlockMax, error) {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	var out []BlockMax
+	for _, segment := range sg.segments {
+		blocks, err := segment.getBlockMax(key)
+		if err != nil {
+			if errors.Is(err, lsmkv.NotFound) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		out = append(out, blocks...)
+	}
+
+	return out, nil
+}
+
+// buildMissingBlockMax builds the block max metadata of a single segment
+// which doesn't have it yet, typically because it was created before block
+// max metadata was enabled. It is run as part of the compaction cycle, which
+// is the only place segments are replaced at, so the segment can be read
+// without holding the maintenance lock. Returns true if a segment was built.
+func (sg *SegmentGroup) buildMissingBlockMax() bool {
+	if !sg.useBlockMax {
+		return false
+	}
+
+	sg.maintenanceLock.RLock()
+	var seg *segment
+	remaining := 0
+	for _, s := range sg.segments {
+		if s.needsBlockMax() {
+			if seg == nil {
+				seg = s
+			}
+			remaining++
+		}
+	}
+	sg.maintenanceLock.RUnlock()
+
+	if seg == nil {
+		return false
+	}
+
+	before := time.Now()
+	if err := seg.computeAndStoreBlockMax(); err != nil {
+		// don't retry endlessly, search falls back to not using block max
+		// metadata for this segment until the next restart
+		seg.blockMaxFailed.Store(true)
+		sg.logger.WithField("action", "lsm_build_missing_block_max").
+			WithField("path", seg.path).
+			WithError(err).
+			Error("building missing block max metadata failed")
+		return true
+	}
+
+	sg.logger.WithField("action", "lsm_build_missing_block_max").
+		WithField("path", seg.path).
+		WithField("took", time.Since(before)).
+		WithField("remaining_segments", remaining-1).
+		Info("built missing block max metadata of segment")
+	return true
+}
@@ -0,0 +1,281 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestBlockMax(t *testing.T) {
+	ctx := context.Background()
+	tests := bucketTests{
+		{
+			name: "blockMaxCoversPostings",
+			f:    blockMaxCoversPostings,
+			opts: []BucketOption{
+				WithStrategy(StrategyMapCollection),
+				WithBlockMax(true),
+			},
+		},
+		{
+			name: "recreateBlockMaxOnInit",
+			f:    recreateBlockMaxOnInit,
+			opts: []BucketOption{
+				WithStrategy(StrategyMapCollection),
+				WithBlockMax(true),
+			},
+		},
+		{
+			name: "blockMaxDisabled",
+			f:    blockMaxDisabled,
+			opts: []BucketOption{
+				WithStrategy(StrategyMapCollection),
+			},
+		},
+	}
+	tests.run(ctx, t)
+}
+
+func blockMaxCoversPostings(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	rowKey := []byte("term")
+
+	t.Run("first segment", func(t *testing.T) {
+		for id := uint64(0); id < 300; id++ {
+			require.Nil(t, b.MapSet(rowKey, postingPair(id, float32(id%7+1), float32(10+id%5))))
+		}
+		require.Nil(t, b.FlushMemtable())
+		assertBlockMaxCoversPostings(ctx, t, b, rowKey)
+	})
+
+	t.Run("second segment with updates and deletes", func(t *testing.T) {
+		for id := uint64(250); id < 500; id++ {
+			require.Nil(t, b.MapSet(rowKey, postingPair(id, float32(id%11+1), float32(3+id%3))))
+		}
+		require.Nil(t, b.MapDeleteKey(rowKey, postingKey(5)))
+		require.Nil(t, b.FlushMemtable())
+		assertBlockMaxCoversPostings(ctx, t, b, rowKey)
+	})
+
+	t.Run("active memtable", func(t *testing.T) {
+		for id := uint64(400); id < 600; id += 2 {
+			require.Nil(t, b.MapSet(rowKey, postingPair(id, 20, 1)))
+		}
+		assertBlockMaxCoversPostings(ctx, t, b, rowKey)
+	})
+
+	t.Run("compaction", func(t *testing.T) {
+		compacted, err := b.disk.compactOnce()
+		require.Nil(t, err)
+		require.True(t, compacted)
+		require.Len(t, b.disk.segments, 1)
+
+		files, err := os.ReadDir(dirName)
+		require.Nil(t, err)
+		count := 0
+		for _, file := range files {
+			if path.Ext(file.Name()) == ".blockmax" {
+				count++
+			}
+		}
+		assert.Equal(t, 1, count, "block max of the compacted segments are removed")
+
+		assertBlockMaxCoversPostings(ctx, t, b, rowKey)
+	})
+
+	t.Run("unknown row key", func(t *testing.T) {
+		blocks, err := b.MapListBlockMax(ctx, []byte("unknown"))
+		require.Nil(t, err)
+		assert.Len(t, blocks, 0)
+	})
+}
+
+func recreateBlockMaxOnInit(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	rowKey := []byte("term")
+	for id := uint64(0); id < 1000; id++ {
+		require.Nil(t, b.MapSet(rowKey, postingPair(id, float32(id%13), float32(id%17))))
+	}
+	require.Nil(t, b.FlushMemtable())
+
+	expected, err := b.MapListBlockMax(ctx, rowKey)
+	require.Nil(t, err)
+	require.Len(t, expected, 8)
+
+	files, err := os.ReadDir(dirName)
+	require.Nil(t, err)
+	fname, ok := findFileWithExt(files, ".blockmax")
+	require.True(t, ok)
+
+	// on Windows we have to shutdown the bucket before opening it again
+	require.Nil(t, b.Shutdown(ctx))
+
+	t.Run("missing file", func(t *testing.T) {
+		require.Nil(t, os.RemoveAll(path.Join(dirName, fname)))
+
+		b2, err := NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+		require.Nil(t, err)
+		defer b2.Shutdown(ctx)
+
+		// existing segments are not scanned at startup
+		files, err := os.ReadDir(dirName)
+		require.Nil(t, err)
+		_, ok := findFileWithExt(files, ".blockmax")
+		assert.False(t, ok)
+
+		_, err = b2.MapListBlockMax(ctx, rowKey)
+		assert.ErrorIs(t, err, ErrBlockMaxUnavailable)
+
+		// but built as part of the compaction cycle
+		assert.True(t, b2.disk.compactIfLevelsMatch(func() bool { return false }))
+		assert.False(t, b2.disk.compactIfLevelsMatch(func() bool { return false }))
+
+		blocks, err := b2.MapListBlockMax(ctx, rowKey)
+		require.Nil(t, err)
+		assert.Equal(t, expected, blocks)
+
+		files, err = os.ReadDir(dirName)
+		require.Nil(t, err)
+		_, ok = findFileWithExt(files, ".blockmax")
+		assert.True(t, ok)
+	})
+
+	t.Run("corrupted file", func(t *testing.T) {
+		data, err := os.ReadFile(path.Join(dirName, fname))
+		require.Nil(t, err)
+		data[len(data)/2] ^= 0xff
+		require.Nil(t, os.WriteFile(path.Join(dirName, fname), data, 0o600))
+
+		b3, err := NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+		require.Nil(t, err)
+		defer b3.Shutdown(ctx)
+
+		_, err = b3.MapListBlockMax(ctx, rowKey)
+		assert.ErrorIs(t, err, ErrBlockMaxUnavailable)
+
+		assert.True(t, b3.disk.compactIfLevelsMatch(func() bool { return false }))
+
+		blocks, err := b3.MapListBlockMax(ctx, rowKey)
+		require.Nil(t, err)
+		assert.Equal(t, expected, blocks)
+	})
+
+	t.Run("failed build", func(t *testing.T) {
+		require.Nil(t, os.RemoveAll(path.Join(dirName, fname)))
+
+		b4, err := NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+		require.Nil(t, err)
+		defer b4.Shutdown(ctx)
+
+		// the metadata can't be written if its path is taken by a directory
+		require.Nil(t, os.Mkdir(path.Join(dirName, fname), 0o700))
+		defer os.RemoveAll(path.Join(dirName, fname))
+
+		// the build is attempted once and not retried
+		assert.True(t, b4.disk.compactIfLevelsMatch(func() bool { return false }))
+		assert.False(t, b4.disk.compactIfLevelsMatch(func() bool { return false }))
+
+		_, err = b4.MapListBlockMax(ctx, rowKey)
+		assert.ErrorIs(t, err, ErrBlockMaxUnavailable)
+	})
+}
+
+func blockMaxDisabled(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	require.Nil(t, b.MapSet([]byte("term"), postingPair(1, 1, 1)))
+	require.Nil(t, b.FlushMemtable())
+
+	_, err = b.MapListBlockMax(ctx, []byte("term"))
+	assert.ErrorIs(t, err, ErrBlockMaxDisabled)
+
+	files, err := os.ReadDir(dirName)
+	require.Nil(t, err)
+	_, ok := findFileWithExt(files, ".blockmax")
+	assert.False(t, ok)
+}
+
+// assertBlockMaxCoversPostings verifies that every posting returned by
+// MapList is covered by a block which bounds its frequency and length
+func assertBlockMaxCoversPostings(ctx context.Context, t *testing.T, b *Bucket, rowKey []byte) {
+	pairs, err := b.MapList(ctx, rowKey)
+	require.Nil(t, err)
+	require.NotEmpty(t, pairs)
+
+	blocks, err := b.MapListBlockMax(ctx, rowKey)
+	require.Nil(t, err)
+
+	for _, pair := range pairs {
+		id := binary.BigEndian.Uint64(pair.Key)
+		frequency := math.Float32frombits(binary.LittleEndian.Uint32(pair.Value[0:4]))
+		propLength := math.Float32frombits(binary.LittleEndian.Uint32(pair.Value[4:8]))
+
+		covered := false
+		for _, block := range blocks {
+			if block.MinID <= id && id <= block.MaxID &&
+				block.MaxFrequency >= frequency && block.MinPropLength <= propLength {
+				covered = true
+				break
+			}
+		}
+		assert.True(t, covered, "posting of doc %d is not covered by any block", id)
+	}
+}
+
+func postingKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+func postingPair(id uint64, frequency, propLength float32) MapPair {
+	value := make([]byte, 8)
+	binary.LittleEndian.PutUint32(value[0:4], math.Float32bits(frequency))
+	binary.LittleEndian.PutUint32(value[4:8], math.Float32bits(propLength))
+	return MapPair{Key: postingKey(id), Value: value}
+}
@@ -60,6 +60,7 @@ type SegmentGroup struct {
 	keepTombstones          bool // see bucket for more datails
 	useBloomFilter          bool // see bucket for more datails
 	calcCountNetAdditions   bool // see bucket for more datails
+	useBlockMax             bool // see bucket for more datails
 	compactLeftOverSegments bool // see bucket for more datails
 
 	allocChecker   memwatch.AllocChecker
@@ -75,6 +76,7 @@ type sgConfig struct {
 	keepTombstones        bool
 	useBloomFilter        bool
 	calcCountNetAdditions bool
+	useBlockMax           bool
 	forceCompaction       bool
 	maxSegmentSize        int64
 }
@@ -100,6 +102,7 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 		keepTombstones:          cfg.keepTombstones,
 		useBloomFilter:          cfg.useBloomFilter,
 		calcCountNetAdditions:   cfg.calcCountNetAdditions,
+		useBlockMax:             cfg.useBlockMax,
 		compactLeftOverSegments: cfg.forceCompaction,
 		maxSegmentSize:          cfg.maxSegmentSize,
 		allocChecker:            allocChecker,
@@ -167,7 +170,7 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 			// there is no need of bloom filters nor net addition counter re-calculation
 			rightSegment, err := newSegment(rightSegmentPath, logger,
 				metrics, sg.makeExistsOnLower(segmentIndex),
-				sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false)
+				sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, sg.useBlockMax, false)
 			if err != nil {
 				return nil, fmt.Errorf("init already compacted right segment %s: %w", rightSegmentFilename, err)
 			}
@@ -194,7 +197,7 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 
 		segment, err := newSegment(rightSegmentPath, logger,
 			metrics, sg.makeExistsOnLower(segmentIndex),
-			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, true)
+			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, sg.useBlockMax, true)
 		if err != nil {
 			return nil, fmt.Errorf("init segment %s: %w", rightSegmentFilename, err)
 		}
@@ -244,7 +247,7 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 
 		segment, err := newSegment(filepath.Join(sg.dir, entry.Name()), logger,
 			metrics, sg.makeExistsOnLower(segmentIndex),
-			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false)
+			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, sg.useBlockMax, false)
 		if err != nil {
 			return nil, fmt.Errorf("init segment %s: %w", entry.Name(), err)
 		}
@@ -290,7 +293,7 @@ func (sg *SegmentGroup) add(path string) error {
 	newSegmentIndex := len(sg.segments)
 	segment, err := newSegment(path, sg.logger,
 		sg.metrics, sg.makeExistsOnLower(newSegmentIndex),
-		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, true)
+		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, sg.useBlockMax, true)
 	if err != nil {
 		return fmt.Errorf("init segment %s: %w", path, err)
 	}
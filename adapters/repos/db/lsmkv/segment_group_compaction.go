@@ -311,7 +311,7 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 	// WIP: we could add a random suffix to the tmp file to avoid conflicts
 	precomputedFiles, err := preComputeSegmentMeta(newPathTmp,
 		updatedCountNetAdditions, sg.logger,
-		sg.useBloomFilter, sg.calcCountNetAdditions)
+		sg.useBloomFilter, sg.calcCountNetAdditions, sg.useBlockMax)
 	if err != nil {
 		return fmt.Errorf("precompute segment meta: %w", err)
 	}
@@ -360,7 +360,7 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 	}
 
 	seg, err := newSegment(newPath, sg.logger, sg.metrics, nil,
-		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false)
+		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, sg.useBlockMax, false)
 	if err != nil {
 		return errors.Wrap(err, "create new segment")
 	}
@@ -401,6 +401,8 @@ func (sg *SegmentGroup) compactIfLevelsMatch(shouldAbort cyclemanager.ShouldAbor
 
 	if compacted {
 		return true
+	} else if sg.buildMissingBlockMax() {
+		return true
 	} else {
 		sg.logger.WithField("action", "lsm_compaction").
 			WithField("path", sg.dir).
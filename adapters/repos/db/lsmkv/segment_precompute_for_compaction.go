@@ -29,6 +29,7 @@ import (
 // segments that might have a similar name.
 func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 	logger logrus.FieldLogger, useBloomFilter bool, calcCountNetAdditions bool,
+	useBlockMax bool,
 ) ([]string, error) {
 	out := []string{path}
 
@@ -92,8 +93,10 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		dataEndPos:            header.IndexStart,
 		index:                 primaryDiskIndex,
 		logger:                logger,
+		size:                  fileInfo.Size(),
 		useBloomFilter:        useBloomFilter,
 		calcCountNetAdditions: calcCountNetAdditions,
+		useBlockMax:           useBlockMax,
 	}
 
 	if seg.secondaryIndexCount > 0 {
@@ -121,6 +124,13 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		}
 		out = append(out, files...)
 	}
+	if seg.useBlockMax {
+		files, err := seg.precomputeBlockMax()
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, files...)
+	}
 
 	return out, nil
 }
@@ -476,6 +476,36 @@ func (s *Store) RenameBucket(ctx context.Context, bucketName, newBucketName stri
 	return nil
 }
 
+// DropBucket shuts down a bucket, removes it from the store and deletes its
+// files. Dropping a bucket which isn't registered is a no-op.
+func (s *Store) DropBucket(ctx context.Context, bucketName string) error {
+	s.closeLock.RLock()
+	defer s.closeLock.RUnlock()
+
+	if s.closed {
+		return fmt.Errorf("%w: dropping bucket %q in store %q", ErrAlreadyClosed, bucketName, s.dir)
+	}
+
+	s.bucketsLocks.Lock(bucketName)
+	defer s.bucketsLocks.Unlock(bucketName)
+
+	s.bucketAccessLock.Lock()
+	bucket := s.bucketsByName[bucketName]
+	delete(s.bucketsByName, bucketName)
+	s.bucketAccessLock.Unlock()
+
+	if bucket == nil {
+		return nil
+	}
+	if err := bucket.Shutdown(ctx); err != nil {
+		return errors.Wrapf(err, "failed shutting down bucket '%s'", bucketName)
+	}
+	if err := os.RemoveAll(bucket.GetDir()); err != nil {
+		return errors.Wrapf(err, "failed removing dir '%s'", bucket.GetDir())
+	}
+	return nil
+}
+
 func (s *Store) updateBucketDir(bucket *Bucket, bucketDir, newBucketDir string) {
 	updatePath := func(src string) string {
 		return strings.Replace(src, bucketDir, newBucketDir, 1)
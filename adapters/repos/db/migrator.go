@@ -14,15 +14,19 @@ package db
 import (
 	"context"
 	"fmt"
+	"os"
+	"slices"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/diskann"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/sparse"
 	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
@@ -560,6 +564,48 @@ func (m *Migrator) DeleteTenants(ctx context.Context, class string, tenants []st
 	return nil
 }
 
+// DropRemovedReplicas drops local shards which are no longer assigned to this
+// node by the incoming sharding state, e.g. after the replication factor of
+// the class was decreased.
+func (m *Migrator) DropRemovedReplicas(ctx context.Context, className string,
+	incomingSS *sharding.State,
+) error {
+	indexID := indexID(schema.ClassName(className))
+
+	m.classLocks.Lock(indexID)
+	defer m.classLocks.Unlock(indexID)
+
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil
+	}
+
+	localNode := m.db.schemaGetter.NodeName()
+	var toRemove []string
+	for name, phys := range incomingSS.Physical {
+		if !slices.Contains(phys.BelongsToNodes, localNode) {
+			toRemove = append(toRemove, name)
+		}
+	}
+	if len(toRemove) == 0 {
+		return nil
+	}
+
+	if err := idx.dropShards(toRemove); err != nil {
+		return fmt.Errorf("drop removed replicas %v: %w", toRemove, err)
+	}
+
+	// shards which weren't loaded (e.g. inactive tenants) are not dropped
+	// above, their data is removed from disk directly
+	for _, name := range toRemove {
+		if err := os.RemoveAll(shardPath(idx.path(), name)); err != nil {
+			return fmt.Errorf("remove data of replica %q: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
 func (m *Migrator) UpdateVectorIndexConfig(ctx context.Context,
 	className string, updated schemaConfig.VectorIndexConfig,
 ) error {
@@ -605,6 +651,10 @@ func (m *Migrator) ValidateVectorIndexConfigUpdate(
 		return flat.ValidateUserConfigUpdate(old, updated)
 	case vectorindex.VectorIndexTypeDYNAMIC:
 		return dynamic.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeDISKANN:
+		return diskann.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeSPARSE:
+		return sparse.ValidateUserConfigUpdate(old, updated)
 	}
 	return fmt.Errorf("Invalid index type: %s", old.IndexType())
 }
@@ -214,6 +214,9 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 			Compressed:           compressed,
 			Loaded:               true,
 		}
+		if progress, ok := shard.requantizationProgress(); ok {
+			shardStatus.RequantizationProgress = &progress
+		}
 		*status = append(*status, shardStatus)
 		shardCount++
 		return nil
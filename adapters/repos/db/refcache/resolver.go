@@ -228,9 +228,13 @@ func (r *Resolver) resolveRef(item *models.SingleRef, desiredClass string,
 		nested["vector"] = res.Vector
 	}
 	if len(additionalProperties.Vectors) > 0 {
-		vectors := make(map[string][]float32)
+		vectors := make(map[string]interface{})
 		for _, targetVector := range additionalProperties.Vectors {
-			vectors[targetVector] = res.Vectors[targetVector]
+			if multiVector, ok := res.MultiVectors[targetVector]; ok {
+				vectors[targetVector] = multiVector
+			} else {
+				vectors[targetVector] = []float32(res.Vectors[targetVector])
+			}
 		}
 		nested["vectors"] = vectors
 	}
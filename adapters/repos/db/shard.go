@@ -40,11 +40,14 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/diskann"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/multivector"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/sparse"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/backup"
@@ -61,9 +64,11 @@ import (
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	diskannent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	dynamicent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	sparseent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
@@ -96,7 +101,7 @@ type ShardLike interface {
 	ObjectByIDErrDeleted(ctx context.Context, id strfmt.UUID, props search.SelectProperties, additional additional.Properties) (*storobj.Object, error)
 	Exists(ctx context.Context, id strfmt.UUID) (bool, error)
 	ObjectSearch(ctx context.Context, limit int, filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties, properties []string) ([]*storobj.Object, []float32, error)
-	ObjectVectorSearch(ctx context.Context, searchVectors [][]float32, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string) ([]*storobj.Object, []float32, error)
+	ObjectVectorSearch(ctx context.Context, searchVectors [][]float32, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string, distanceMetric string) ([]*storobj.Object, []float32, error)
 	UpdateVectorIndexConfig(ctx context.Context, updated schemaConfig.VectorIndexConfig) error
 	UpdateVectorIndexConfigs(ctx context.Context, updated map[string]schemaConfig.VectorIndexConfig) error
 	UpdateAsyncReplication(ctx context.Context, enabled bool) error
@@ -171,6 +176,8 @@ type ShardLike interface {
 	updatePropertySpecificIndices(object *storobj.Object, status objectInsertStatus) error
 	updateVectorIndexIgnoreDelete(vector []float32, status objectInsertStatus) error
 	updateVectorIndexesIgnoreDelete(vectors map[string][]float32, status objectInsertStatus) error
+	updateMultiVectorIndexesIgnoreDelete(multiVectors map[string][][]float32, status objectInsertStatus) error
+	updateSparseVectorIndexesIgnoreDelete(sparseVectors map[string]sparseent.Vector, status objectInsertStatus) error
 	hasGeoIndex() bool
 
 	Metrics() *Metrics
@@ -181,6 +188,9 @@ type ShardLike interface {
 	Activity() int32
 	// Debug methods
 	DebugResetVectorIndex(ctx context.Context, targetVector string) error
+	RequantizeVectorIndex(ctx context.Context, targetVector string) error
+	VectorIndexHealth(ctx context.Context, targetVector string, recallSamples int) (*hnsw.HealthReport, error)
+	requantizationProgress() (float32, bool)
 }
 
 // Shard is the smallest completely-contained index unit. A shard manages
@@ -204,6 +214,10 @@ type Shard struct {
 	propLenTracker    *inverted.JsonShardMetaData
 	versioner         *shardVersioner
 
+	// vectorPrecisions holds the storage precision of all target vectors
+	// which are not stored as float32
+	vectorPrecisions map[string]common.StoragePrecision
+
 	hashtree             hashtree.AggregatedHashTree
 	hashtreeRWMux        sync.RWMutex
 	hashtreeInitialized  atomic.Bool
@@ -399,6 +413,17 @@ func hasTargetVectors(cfg schemaConfig.VectorIndexConfig, targetCfgs map[string]
 func (s *Shard) initTargetVectors(ctx context.Context) error {
 	s.vectorIndexes = make(map[string]VectorIndex)
 	for targetVector, vectorIndexConfig := range s.index.vectorIndexUserConfigs {
+		precision, err := common.StoragePrecisionOf(vectorIndexConfig)
+		if err != nil {
+			return fmt.Errorf("storage precision for %q: %w", targetVector, err)
+		}
+		if precision != common.PrecisionFloat32 {
+			if s.vectorPrecisions == nil {
+				s.vectorPrecisions = make(map[string]common.StoragePrecision)
+			}
+			s.vectorPrecisions[targetVector] = precision
+		}
+
 		vectorIndex, err := s.initVectorIndex(ctx, targetVector, vectorIndexConfig)
 		if err != nil {
 			return fmt.Errorf("cannot create vector index for %q: %w", targetVector, err)
@@ -440,26 +465,30 @@ func (s *Shard) initLegacyQueue() error {
 	return nil
 }
 
-func (s *Shard) initVectorIndex(ctx context.Context,
-	targetVector string, vectorIndexUserConfig schemaConfig.VectorIndexConfig,
-) (VectorIndex, error) {
-	var distProv distancer.Provider
-
-	switch vectorIndexUserConfig.DistanceName() {
+func distancerProviderForName(distance string) (distancer.Provider, error) {
+	switch distance {
 	case "", common.DistanceCosine:
-		distProv = distancer.NewCosineDistanceProvider()
+		return distancer.NewCosineDistanceProvider(), nil
 	case common.DistanceDot:
-		distProv = distancer.NewDotProductProvider()
+		return distancer.NewDotProductProvider(), nil
 	case common.DistanceL2Squared:
-		distProv = distancer.NewL2SquaredProvider()
+		return distancer.NewL2SquaredProvider(), nil
 	case common.DistanceManhattan:
-		distProv = distancer.NewManhattanProvider()
+		return distancer.NewManhattanProvider(), nil
 	case common.DistanceHamming:
-		distProv = distancer.NewHammingProvider()
+		return distancer.NewHammingProvider(), nil
 	default:
-		return nil, fmt.Errorf("init vector index: %w",
-			errors.Errorf("unrecognized distance metric %q,"+
-				"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", vectorIndexUserConfig.DistanceName()))
+		return nil, errors.Errorf("unrecognized distance metric %q,"+
+			"choose one of [\"cosine\", \"dot\", \"l2-squared\", \"manhattan\",\"hamming\"]", distance)
+	}
+}
+
+func (s *Shard) initVectorIndex(ctx context.Context,
+	targetVector string, vectorIndexUserConfig schemaConfig.VectorIndexConfig,
+) (VectorIndex, error) {
+	distProv, err := distancerProviderForName(vectorIndexUserConfig.DistanceName())
+	if err != nil {
+		return nil, fmt.Errorf("init vector index: %w", err)
 	}
 
 	var vectorIndex VectorIndex
@@ -474,6 +503,41 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 
 		if hnswUserConfig.Skip {
 			vectorIndex = noop.NewIndex()
+		} else if hnswUserConfig.Multivector.Enabled {
+			s.index.cycleCallbacks.vectorCommitLoggerCycle.Start()
+			s.index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
+
+			vecIdxID := s.vectorIndexID(targetVector)
+
+			vi, err := multivector.New(multivector.Config{
+				ID:                vecIdxID,
+				TargetVector:      targetVector,
+				Logger:            s.index.logger,
+				RootPath:          s.path(),
+				ShardName:         s.name,
+				ClassName:         s.index.Config.ClassName.String(),
+				PrometheusMetrics: s.promMetrics,
+				MultiVectorForIDThunk: func(ctx context.Context, id uint64) ([][]float32, error) {
+					return s.multiVectorByIndexID(ctx, id, targetVector)
+				},
+				DistanceProvider: distProv,
+				MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
+					return hnsw.NewCommitLogger(s.path(), vecIdxID,
+						s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks,
+						hnsw.WithAllocChecker(s.index.allocChecker),
+						hnsw.WithCommitlogThresholdForCombining(s.index.Config.HNSWMaxLogSize),
+						hnsw.WithCommitlogThreshold(s.index.Config.HNSWMaxLogSize/5),
+					)
+				},
+				AllocChecker:             s.index.allocChecker,
+				TombstoneCallbacks:       s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
+				ShardCompactionCallbacks: s.cycleCallbacks.compactionCallbacks,
+				ShardFlushCallbacks:      s.cycleCallbacks.flushCallbacks,
+			}, hnswUserConfig, s.store)
+			if err != nil {
+				return nil, errors.Wrapf(err, "init shard %q: multi vector index", s.ID())
+			}
+			vectorIndex = vi
 		} else {
 			// starts vector cycles if vector is configured
 			s.index.cycleCallbacks.vectorCommitLoggerCycle.Start()
@@ -490,6 +554,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 				Logger:               s.index.logger,
 				RootPath:             s.path(),
 				ID:                   vecIdxID,
+				TargetVector:         targetVector,
 				ShardName:            s.name,
 				ClassName:            s.index.Config.ClassName.String(),
 				PrometheusMetrics:    s.promMetrics,
@@ -535,6 +600,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			Logger:           s.index.logger,
 			DistanceProvider: distProv,
 			AllocChecker:     s.index.allocChecker,
+			VectorForIDThunk: hnsw.NewVectorForIDThunk(targetVector, s.vectorByIndexID),
 		}, flatUserConfig, s.store)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init shard %q: flat index", s.ID())
@@ -578,9 +644,53 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			return nil, errors.Wrapf(err, "init shard %q: dynamic index", s.ID())
 		}
 		vectorIndex = vi
+	case vectorindex.VectorIndexTypeDISKANN:
+		diskannUserConfig, ok := vectorIndexUserConfig.(diskannent.UserConfig)
+		if !ok {
+			return nil, errors.Errorf("diskann vector index: config is not diskann.UserConfig: %T",
+				vectorIndexUserConfig)
+		}
+
+		s.index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
+
+		// the graph and the full vectors are kept in a file of their own next
+		// to the shard's buckets, only the compressed vectors stay in memory
+		vecIdxID := s.vectorIndexID(targetVector)
+
+		vi, err := diskann.New(diskann.Config{
+			ID:                 vecIdxID,
+			TargetVector:       targetVector,
+			RootPath:           s.path(),
+			Logger:             s.index.logger,
+			DistanceProvider:   distProv,
+			AllocChecker:       s.index.allocChecker,
+			TombstoneCallbacks: s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
+		}, diskannUserConfig)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: diskann index", s.ID())
+		}
+		vectorIndex = vi
+	case vectorindex.VectorIndexTypeSPARSE:
+		sparseUserConfig, ok := vectorIndexUserConfig.(sparseent.UserConfig)
+		if !ok {
+			return nil, errors.Errorf("sparse vector index: config is not sparse.UserConfig: %T",
+				vectorIndexUserConfig)
+		}
+
+		// the postings are kept in buckets of the shard's store
+		vi, err := sparse.New(sparse.Config{
+			ID:           s.vectorIndexID(targetVector),
+			TargetVector: targetVector,
+			Logger:       s.index.logger,
+		}, sparseUserConfig, s.store)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: sparse index", s.ID())
+		}
+		vectorIndex = vi
 	default:
-		return nil, fmt.Errorf("Unknown vector index type: %q. Choose one from [\"%s\", \"%s\", \"%s\"]",
-			vectorIndexUserConfig.IndexType(), vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC)
+		return nil, fmt.Errorf("Unknown vector index type: %q. Choose one from [\"%s\", \"%s\", \"%s\", \"%s\", \"%s\"]",
+			vectorIndexUserConfig.IndexType(), vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT,
+			vectorindex.VectorIndexTypeDYNAMIC, vectorindex.VectorIndexTypeDISKANN, vectorindex.VectorIndexTypeSPARSE)
 	}
 	defer vectorIndex.PostStartup()
 	return vectorIndex, nil
@@ -1074,6 +1184,7 @@ func (s *Shard) drop() (err error) {
 		s.cycleCallbacks.flushCallbacksCtrl,
 		s.cycleCallbacks.vectorCombinedCallbacksCtrl,
 		s.cycleCallbacks.geoPropsCombinedCallbacksCtrl,
+		s.cycleCallbacks.objectTTLCallbacksCtrl,
 	).Unregister(ctx); err != nil {
 		return err
 	}
@@ -1307,7 +1418,8 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 	}
 
 	if inverted.HasSearchableIndex(prop) {
-		searchableBucketOpts := append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyMapCollection))
+		searchableBucketOpts := append(bucketOpts,
+			lsmkv.WithStrategy(lsmkv.StrategyMapCollection), lsmkv.WithBlockMax(true))
 		if s.versioner.Version() < 2 {
 			searchableBucketOpts = append(searchableBucketOpts, lsmkv.WithLegacyMapSorting())
 		}
@@ -1320,6 +1432,15 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 		}
 	}
 
+	if inverted.HasPositionIndex(prop) {
+		if err := s.store.CreateOrLoadBucket(ctx,
+			helpers.BucketPositionsFromPropNameLSM(prop.Name),
+			append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyReplace))...,
+		); err != nil {
+			return err
+		}
+	}
+
 	if inverted.HasRangeableIndex(prop) {
 		if err := s.store.CreateOrLoadBucket(ctx,
 			helpers.BucketRangeableFromPropNameLSM(prop.Name),
@@ -1451,6 +1572,7 @@ func (s *Shard) Shutdown(ctx context.Context) (err error) {
 		s.cycleCallbacks.flushCallbacksCtrl,
 		s.cycleCallbacks.vectorCombinedCallbacksCtrl,
 		s.cycleCallbacks.geoPropsCombinedCallbacksCtrl,
+		s.cycleCallbacks.objectTTLCallbacksCtrl,
 	).Unregister(ctx); err != nil {
 		return err
 	}
@@ -1511,6 +1633,7 @@ func (s *Shard) Shutdown(ctx context.Context) (err error) {
 		s.cycleCallbacks.flushCallbacksCtrl,
 		s.cycleCallbacks.vectorCombinedCallbacksCtrl,
 		s.cycleCallbacks.geoPropsCombinedCallbacksCtrl,
+		s.cycleCallbacks.objectTTLCallbacksCtrl,
 	).Unregister(ctx); err != nil {
 		return err
 	}
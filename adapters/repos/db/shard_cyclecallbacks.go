@@ -31,6 +31,8 @@ type shardCycleCallbacks struct {
 	geoPropsCommitLoggerCallbacks     cyclemanager.CycleCallbackGroup
 	geoPropsTombstoneCleanupCallbacks cyclemanager.CycleCallbackGroup
 	geoPropsCombinedCallbacksCtrl     cyclemanager.CycleCallbackCtrl
+
+	objectTTLCallbacksCtrl cyclemanager.CycleCallbackCtrl
 }
 
 func (s *Shard) initCycleCallbacks() {
@@ -81,6 +83,11 @@ func (s *Shard) initCycleCallbacks() {
 	geoPropsCombinedCallbacksCtrl := cyclemanager.NewCombinedCallbackCtrl(2, s.index.logger,
 		geoPropsCommitLoggerCallbacksCtrl, geoPropsTombstoneCleanupCallbacksCtrl)
 
+	objectTTLId := id("object_ttl")
+	// fixed interval on class level, no need to specify separate on shard level
+	objectTTLCallbacksCtrl := s.index.cycleCallbacks.objectTTLCallbacks.Register(
+		objectTTLId, s.deleteExpiredObjects)
+
 	s.cycleCallbacks = &shardCycleCallbacks{
 		compactionCallbacks:     compactionCallbacks,
 		compactionCallbacksCtrl: compactionCallbacksCtrl,
@@ -95,5 +102,7 @@ func (s *Shard) initCycleCallbacks() {
 		geoPropsCommitLoggerCallbacks:     geoPropsCommitLoggerCallbacks,
 		geoPropsTombstoneCleanupCallbacks: geoPropsTombstoneCleanupCallbacks,
 		geoPropsCombinedCallbacksCtrl:     geoPropsCombinedCallbacksCtrl,
+
+		objectTTLCallbacksCtrl: objectTTLCallbacksCtrl,
 	}
 }
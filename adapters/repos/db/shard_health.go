@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+type inspectableIndexer interface {
+	Health(ctx context.Context, recallSamples int) (*hnsw.HealthReport, error)
+}
+
+// VectorIndexHealth reports on the structure of the graph of a vector index
+// and optionally estimates its recall based on the given number of samples.
+func (s *Shard) VectorIndexHealth(ctx context.Context, targetVector string, recallSamples int) (*hnsw.HealthReport, error) {
+	var vidx VectorIndex
+	if s.hasTargetVectors() {
+		vidx = s.VectorIndexForName(targetVector)
+	} else {
+		vidx = s.VectorIndex()
+	}
+	if vidx == nil {
+		return nil, fmt.Errorf("vector index %q not found", targetVector)
+	}
+
+	index, ok := vidx.(inspectableIndexer)
+	if !ok {
+		return nil, fmt.Errorf("vector index %q does not support health reports", targetVector)
+	}
+	return index.Health(ctx, recallSamples)
+}
+
+// GetVectorIndexHealth reports on the vector index of the local replica of a
+// shard, see Shard.VectorIndexHealth
+func (db *DB) GetVectorIndexHealth(ctx context.Context, className, shardName, targetVector string,
+	recallSamples int,
+) (*models.VectorIndexHealth, error) {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("collection %q not found", className))
+	}
+
+	shard := idx.GetShard(shardName)
+	if shard == nil {
+		return nil, enterrors.NewErrNotFound(fmt.Errorf("shard %q not found on this node", shardName))
+	}
+
+	report, err := shard.VectorIndexHealth(ctx, targetVector, recallSamples)
+	if err != nil {
+		return nil, enterrors.NewErrUnprocessable(err)
+	}
+
+	layers := make([]*models.VectorIndexHealthLayer, len(report.Layers))
+	for i, layer := range report.Layers {
+		layers[i] = &models.VectorIndexHealthLayer{
+			Level:            int64(layer.Level),
+			Nodes:            int64(layer.Nodes),
+			AverageOutDegree: layer.AverageOutDegree,
+		}
+	}
+	return &models.VectorIndexHealth{
+		Nodes:           int64(report.Nodes),
+		Tombstones:      int64(report.Tombstones),
+		Layers:          layers,
+		Unreachable:     int64(report.Unreachable),
+		Entrypoint:      int64(report.Entrypoint),
+		Compressed:      report.Compressed,
+		Compression:     report.Compression,
+		CommitLogSize:   report.CommitLogSize,
+		EstimatedRecall: report.EstimatedRecall,
+	}, nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcounter"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/backup"
@@ -39,6 +40,7 @@ import (
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	sparseent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -239,11 +241,11 @@ func (l *LazyLoadShard) ObjectSearch(ctx context.Context, limit int, filters *fi
 	return l.shard.ObjectSearch(ctx, limit, filters, keywordRanking, sort, cursor, additional, properties)
 }
 
-func (l *LazyLoadShard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float32, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string) ([]*storobj.Object, []float32, error) {
+func (l *LazyLoadShard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float32, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string, distanceMetric string) ([]*storobj.Object, []float32, error) {
 	if err := l.Load(ctx); err != nil {
 		return nil, nil, err
 	}
-	return l.shard.ObjectVectorSearch(ctx, searchVectors, targetVectors, targetDist, limit, filters, sort, groupBy, additional, targetCombination, properties)
+	return l.shard.ObjectVectorSearch(ctx, searchVectors, targetVectors, targetDist, limit, filters, sort, groupBy, additional, targetCombination, properties, distanceMetric)
 }
 
 func (l *LazyLoadShard) UpdateVectorIndexConfig(ctx context.Context, updated schemaConfig.VectorIndexConfig) error {
@@ -354,6 +356,25 @@ func (l *LazyLoadShard) DebugResetVectorIndex(ctx context.Context, targetVector
 	return l.shard.DebugResetVectorIndex(ctx, targetVector)
 }
 
+func (l *LazyLoadShard) RequantizeVectorIndex(ctx context.Context, targetVector string) error {
+	if err := l.Load(ctx); err != nil {
+		return err
+	}
+	return l.shard.RequantizeVectorIndex(ctx, targetVector)
+}
+
+func (l *LazyLoadShard) VectorIndexHealth(ctx context.Context, targetVector string, recallSamples int) (*hnsw.HealthReport, error) {
+	if err := l.Load(ctx); err != nil {
+		return nil, err
+	}
+	return l.shard.VectorIndexHealth(ctx, targetVector, recallSamples)
+}
+
+func (l *LazyLoadShard) requantizationProgress() (float32, bool) {
+	l.mustLoad()
+	return l.shard.requantizationProgress()
+}
+
 func (l *LazyLoadShard) createPropertyIndex(ctx context.Context, eg *enterrors.ErrorGroupWrapper, props ...*models.Property) error {
 	l.mustLoad()
 	return l.shard.createPropertyIndex(ctx, eg, props...)
@@ -648,6 +669,16 @@ func (l *LazyLoadShard) updateVectorIndexesIgnoreDelete(vectors map[string][]flo
 	return l.shard.updateVectorIndexesIgnoreDelete(vectors, status)
 }
 
+func (l *LazyLoadShard) updateMultiVectorIndexesIgnoreDelete(multiVectors map[string][][]float32, status objectInsertStatus) error {
+	l.mustLoad()
+	return l.shard.updateMultiVectorIndexesIgnoreDelete(multiVectors, status)
+}
+
+func (l *LazyLoadShard) updateSparseVectorIndexesIgnoreDelete(sparseVectors map[string]sparseent.Vector, status objectInsertStatus) error {
+	l.mustLoad()
+	return l.shard.updateSparseVectorIndexesIgnoreDelete(sparseVectors, status)
+}
+
 func (l *LazyLoadShard) hasGeoIndex() bool {
 	l.mustLoad()
 	return l.shard.hasGeoIndex()
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// multiVectorIndex is implemented by vector indexes which store multiple
+// vectors per object. Multi vectors bypass the index queue and are added to
+// the index directly.
+type multiVectorIndex interface {
+	AddMulti(id uint64, vectors [][]float32) error
+	ValidateMultiBeforeInsert(vectors [][]float32) error
+}
+
+func (s *Shard) multiVectorIndexForName(targetVector string) (multiVectorIndex, bool) {
+	vectorIndex := s.VectorIndexForName(targetVector)
+	if vectorIndex == nil {
+		return nil, false
+	}
+	index, ok := vectorIndex.(multiVectorIndex)
+	return index, ok
+}
+
+func (s *Shard) validateMultiVectors(obj *storobj.Object) error {
+	for targetVector, vectors := range obj.MultiVectors {
+		index, ok := s.multiVectorIndexForName(targetVector)
+		if !ok {
+			return fmt.Errorf("target vector %s is not configured for multi vectors", targetVector)
+		}
+		if err := index.ValidateMultiBeforeInsert(vectors); err != nil {
+			return errors.Wrapf(err, "Validate vector index %s for target vector %s", targetVector, obj.ID())
+		}
+	}
+	return nil
+}
+
+// updateMultiVectorIndexes removes the old doc id from all multi vector
+// indexes if it changed and adds the multi vectors of the new doc id
+func (s *Shard) updateMultiVectorIndexes(multiVectors map[string][][]float32,
+	status objectInsertStatus,
+) error {
+	if status.docIDChanged {
+		for targetVector, vectorIndex := range s.vectorIndexes {
+			if _, ok := vectorIndex.(multiVectorIndex); !ok {
+				continue
+			}
+			if err := vectorIndex.Delete(status.oldDocID); err != nil {
+				return errors.Wrapf(err, "delete doc id %d from vector index for target vector %s",
+					status.oldDocID, targetVector)
+			}
+		}
+	}
+
+	return s.updateMultiVectorIndexesIgnoreDelete(multiVectors, status)
+}
+
+// as the name implies this method only performs the insertions, but completely
+// ignores any deletes. It thus assumes that the caller has already taken care
+// of all the deletes in another way
+func (s *Shard) updateMultiVectorIndexesIgnoreDelete(multiVectors map[string][][]float32,
+	status objectInsertStatus,
+) error {
+	if status.docIDPreserved || status.skipUpsert {
+		return nil
+	}
+
+	for targetVector, vectors := range multiVectors {
+		index, ok := s.multiVectorIndexForName(targetVector)
+		if !ok {
+			return fmt.Errorf("multi vector index not found for target vector %s", targetVector)
+		}
+		if err := index.AddMulti(status.docID, vectors); err != nil {
+			return errors.Wrapf(err, "insert doc id %d to vector index for target vector %s",
+				status.docID, targetVector)
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) multiVectorByIndexID(ctx context.Context, indexID uint64, targetVector string) ([][]float32, error) {
+	keyBuf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(keyBuf, indexID)
+
+	bytes, err := s.store.Bucket(helpers.ObjectsBucketLSM).GetBySecondary(0, keyBuf)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes == nil {
+		return nil, storobj.NewErrNotFoundf(indexID,
+			"no object for doc id, it could have been deleted")
+	}
+
+	return storobj.MultiVectorFromBinary(bytes, targetVector)
+}
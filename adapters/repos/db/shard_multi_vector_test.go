@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShardMultiVectors(t *testing.T) {
+	ctx := context.Background()
+	className := "MultiVectorClass"
+
+	uc := enthnsw.NewDefaultUserConfig()
+	uc.Distance = vectorindexcommon.DistanceDot
+	uc.Multivector = enthnsw.MultivectorConfig{Enabled: true, Aggregation: enthnsw.MultivectorAggregationMaxSim}
+	class := &models.Class{
+		Class: className,
+		VectorConfig: map[string]models.VectorConfig{
+			"colbert": {
+				Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+				VectorIndexType:   "hnsw",
+				VectorIndexConfig: uc,
+			},
+		},
+	}
+	shard, _ := testShardWithSettings(t, ctx, class, enthnsw.UserConfig{Skip: true}, false, false,
+		func(i *Index) {
+			i.vectorIndexUserConfigs = map[string]schemaConfig.VectorIndexConfig{"colbert": uc}
+		})
+
+	newObject := func(id strfmt.UUID, vectors [][]float32) *storobj.Object {
+		return &storobj.Object{
+			MarshallerVersion: 1,
+			Object:            models.Object{ID: id, Class: className},
+			MultiVectors:      map[string][][]float32{"colbert": vectors},
+		}
+	}
+	search := func(query [][]float32) []strfmt.UUID {
+		objs, _, err := shard.ObjectVectorSearch(ctx, [][]float32{searchparams.FlattenMultiVector(query)},
+			[]string{"colbert"}, 0, 2, nil, nil, nil, additional.Properties{}, nil, nil, "")
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(objs))
+		for i, obj := range objs {
+			ids[i] = obj.ID()
+		}
+		return ids
+	}
+
+	id1 := strfmt.UUID(uuid.NewString())
+	id2 := strfmt.UUID(uuid.NewString())
+
+	t.Run("put", func(t *testing.T) {
+		require.Nil(t, shard.PutObject(ctx, newObject(id1, [][]float32{{1, 0, 0}, {0, 1, 0}})))
+		require.Nil(t, shard.PutObject(ctx, newObject(id2, [][]float32{{0, 0, 1}})))
+	})
+
+	t.Run("put with mismatching length", func(t *testing.T) {
+		err := shard.PutObject(ctx, newObject(strfmt.UUID(uuid.NewString()), [][]float32{{1, 0}}))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{id1, id2}, search([][]float32{{1, 0, 0}, {0, 1, 0}}))
+		assert.Equal(t, []strfmt.UUID{id2, id1}, search([][]float32{{0, 0, 1}}))
+	})
+
+	t.Run("multi vectors are returned", func(t *testing.T) {
+		obj, err := shard.ObjectByID(ctx, id2, nil,
+			additional.Properties{Vectors: []string{"colbert"}})
+		require.Nil(t, err)
+		assert.Equal(t, [][]float32{{0, 0, 1}}, obj.MultiVectors["colbert"])
+	})
+
+	t.Run("update", func(t *testing.T) {
+		require.Nil(t, shard.PutObject(ctx, newObject(id2, [][]float32{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}})))
+		assert.Equal(t, []strfmt.UUID{id2, id1}, search([][]float32{{0, 0, 1}}))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.Nil(t, shard.DeleteObject(ctx, id2))
+		assert.Equal(t, []strfmt.UUID{id1}, search([][]float32{{0, 0, 1}}))
+	})
+}
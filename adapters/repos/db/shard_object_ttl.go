@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/objectttl"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+// objectTTLBatchSize is the maximum number of expired objects deleted at once
+const objectTTLBatchSize = 1000
+
+// deleteExpiredObjects is the callback of the object TTL cycle. It finds the
+// expired objects of the shard using the inverted index and deletes them in
+// batches. It returns true if any object has been deleted.
+func (s *Shard) deleteExpiredObjects(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	class := s.index.getSchema.ReadOnlyClass(s.index.Config.ClassName.String())
+	if !objectttl.Enabled(class) || s.isReadOnly() || !s.isObjectTTLCoordinator() {
+		return false
+	}
+
+	logger := s.index.logger.WithFields(logrus.Fields{
+		"action": "delete_expired_objects",
+		"class":  class.Class,
+		"shard":  s.name,
+	})
+
+	ctx := context.Background()
+	docIDs, err := s.findDocIDs(ctx, objectttl.ExpiredFilter(class, time.Now()))
+	if err != nil {
+		logger.WithError(err).Error("find expired objects")
+		return false
+	}
+
+	deleted, failed := 0, 0
+	for start := 0; start < len(docIDs); start += objectTTLBatchSize {
+		if shouldAbort() {
+			break
+		}
+		end := min(start+objectTTLBatchSize, len(docIDs))
+		uuids := make([]strfmt.UUID, 0, end-start)
+		for _, docID := range docIDs[start:end] {
+			uuid, err := s.uuidFromDocID(docID)
+			if err != nil {
+				// object has been deleted in the meantime
+				continue
+			}
+			uuids = append(uuids, uuid)
+		}
+
+		for _, res := range s.index.deleteExpiredObjectBatch(ctx, s, uuids) {
+			if res.Err != nil {
+				failed++
+				err = res.Err
+			} else {
+				deleted++
+			}
+		}
+	}
+
+	if failed > 0 {
+		logger.WithError(err).WithField("failed", failed).Error("delete expired objects")
+	}
+	if deleted > 0 {
+		logger.WithField("deleted", deleted).Debug("deleted expired objects")
+	}
+	return deleted > 0
+}
+
+// isObjectTTLCoordinator returns whether this node deletes expired objects of
+// the shard. Only the first replica of a replicated shard looks for expired
+// objects, its deletes are replicated to the other replicas.
+func (s *Shard) isObjectTTLCoordinator() bool {
+	if !s.index.replicationEnabled() {
+		return true
+	}
+	replicas, err := s.index.getSchema.ShardReplicas(s.index.Config.ClassName.String(), s.name)
+	if err != nil || len(replicas) == 0 {
+		return false
+	}
+	return replicas[0] == s.index.getSchema.NodeName()
+}
+
+// deleteExpiredObjectBatch deletes expired objects of a local shard using the
+// same path as batch deletes, that is through the replicator if the class is
+// replicated
+func (i *Index) deleteExpiredObjectBatch(ctx context.Context, shard ShardLike,
+	uuids []strfmt.UUID,
+) objects.BatchSimpleObjects {
+	if len(uuids) == 0 {
+		return nil
+	}
+	if i.replicationEnabled() {
+		return i.replicator.DeleteObjects(ctx, shard.Name(), uuids, false, replica.Quorum, 0)
+	}
+
+	var objs objects.BatchSimpleObjects
+	i.shardTransferMutex.RLockGuard(func() error {
+		objs = shard.DeleteObjectBatch(ctx, uuids, false)
+		return nil
+	})
+	return objs
+}
@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_DeleteExpiredObjects(t *testing.T) {
+	ctx := testCtx()
+	noAbort := func() bool { return false }
+
+	t.Run("delete on date property", func(t *testing.T) {
+		class := &models.Class{
+			Class: "TTLDate",
+			Properties: []*models.Property{
+				{Name: "expiresAt", DataType: schema.DataTypeDate.PropString()},
+			},
+			ObjectTTLConfig: &models.ObjectTTLConfig{Enabled: true, DeleteOn: "expiresAt"},
+		}
+		shard := ttlTestShard(t, ctx, class)
+
+		expired := ttlTestObject(class.Class, time.Now().Add(-time.Hour))
+		valid := ttlTestObject(class.Class, time.Now().Add(time.Hour))
+		require.Nil(t, shard.PutObject(ctx, expired))
+		require.Nil(t, shard.PutObject(ctx, valid))
+
+		require.True(t, shard.deleteExpiredObjects(noAbort))
+
+		exists, err := shard.Exists(ctx, expired.ID())
+		require.Nil(t, err)
+		require.False(t, exists)
+		exists, err = shard.Exists(ctx, valid.ID())
+		require.Nil(t, err)
+		require.True(t, exists)
+
+		// nothing left to delete
+		require.False(t, shard.deleteExpiredObjects(noAbort))
+	})
+
+	t.Run("delete on creation time", func(t *testing.T) {
+		class := &models.Class{
+			Class:               "TTLCreation",
+			InvertedIndexConfig: &models.InvertedIndexConfig{IndexTimestamps: true},
+			ObjectTTLConfig: &models.ObjectTTLConfig{
+				Enabled:    true,
+				DeleteOn:   filters.InternalPropCreationTimeUnix,
+				DefaultTTL: 60,
+			},
+		}
+		shard := ttlTestShard(t, ctx, class)
+
+		expired := testObject(class.Class)
+		expired.Object.CreationTimeUnix = time.Now().Add(-2 * time.Minute).UnixMilli()
+		valid := testObject(class.Class)
+		valid.Object.CreationTimeUnix = time.Now().UnixMilli()
+		require.Nil(t, shard.PutObject(ctx, expired))
+		require.Nil(t, shard.PutObject(ctx, valid))
+
+		require.True(t, shard.deleteExpiredObjects(noAbort))
+
+		exists, err := shard.Exists(ctx, expired.ID())
+		require.Nil(t, err)
+		require.False(t, exists)
+		exists, err = shard.Exists(ctx, valid.ID())
+		require.Nil(t, err)
+		require.True(t, exists)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		class := &models.Class{
+			Class: "TTLDisabled",
+			Properties: []*models.Property{
+				{Name: "expiresAt", DataType: schema.DataTypeDate.PropString()},
+			},
+			ObjectTTLConfig: &models.ObjectTTLConfig{Enabled: false, DeleteOn: "expiresAt"},
+		}
+		shard := ttlTestShard(t, ctx, class)
+
+		expired := ttlTestObject(class.Class, time.Now().Add(-time.Hour))
+		require.Nil(t, shard.PutObject(ctx, expired))
+		require.False(t, shard.deleteExpiredObjects(noAbort))
+
+		exists, err := shard.Exists(ctx, expired.ID())
+		require.Nil(t, err)
+		require.True(t, exists)
+	})
+}
+
+func ttlTestShard(t *testing.T, ctx context.Context, class *models.Class) *Shard {
+	shd, _ := testShardWithSettings(t, ctx, class, hnsw.UserConfig{Skip: true}, false, false,
+		func(i *Index) { i.Config.DisableLazyLoadShards = true })
+	return shd.(*Shard)
+}
+
+func ttlTestObject(className string, expiresAt time.Time) *storobj.Object {
+	obj := testObject(className)
+	obj.Object.Properties = map[string]interface{}{"expiresAt": expiresAt}
+	return obj
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/multi"
@@ -282,6 +283,9 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 	}()
 
 	s.activityTracker.Add(1)
+	if keywordRanking != nil && keywordRanking.Type == "sparse" {
+		return s.sparseObjectSearch(ctx, limit, filters, keywordRanking.SparseVector, additional, properties)
+	}
 	if keywordRanking != nil {
 		if v := s.versioner.Version(); v < 2 {
 			return nil, nil, errors.Errorf(
@@ -370,7 +374,31 @@ func (s *Shard) getIndexQueue(targetVector string) (*IndexQueue, error) {
 	return s.queue, nil
 }
 
-func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float32, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string) ([]*storobj.Object, []float32, error) {
+// distancerOverride returns the distancer which replaces the one of the
+// vector indexes for a single query, or nil if the distance metric is not
+// overridden
+func (s *Shard) distancerOverride(targetVectors []string, distanceMetric string) (distancer.Provider, error) {
+	if distanceMetric == "" {
+		return nil, nil
+	}
+
+	provider, err := distancerProviderForName(distanceMetric)
+	if err != nil {
+		return nil, err
+	}
+	for _, targetVector := range targetVectors {
+		queue, err := s.getIndexQueue(targetVector)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := queue.Index.(distancerOverridableIndexer); !ok {
+			return nil, errDistanceMetricOverride
+		}
+	}
+	return provider, nil
+}
+
+func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float32, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string, distanceMetric string) ([]*storobj.Object, []float32, error) {
 	startTime := time.Now()
 	defer func() {
 		s.slowQueryReporter.LogIfSlow(startTime, map[string]any{
@@ -389,6 +417,11 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float3
 
 	s.activityTracker.Add(1)
 
+	distancerOverride, err := s.distancerOverride(targetVectors, distanceMetric)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var allowList helpers.AllowList
 	if filters != nil {
 		beforeFilter := time.Now()
@@ -420,8 +453,13 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float3
 			}
 
 			if limit < 0 {
-				ids, dists, err = queue.SearchByVectorDistance(
-					searchVectors[i], targetDist, s.index.Config.QueryMaximumResults, allowList)
+				if distancerOverride != nil {
+					ids, dists, err = queue.SearchByVectorDistanceWithDistancer(searchVectors[i],
+						targetDist, s.index.Config.QueryMaximumResults, allowList, distancerOverride)
+				} else {
+					ids, dists, err = queue.SearchByVectorDistance(
+						searchVectors[i], targetDist, s.index.Config.QueryMaximumResults, allowList)
+				}
 				if err != nil {
 					// This should normally not fail. A failure here could indicate that more
 					// attention is required, for example because data is corrupted. That's
@@ -431,7 +469,12 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float3
 					return err
 				}
 			} else {
-				ids, dists, err = queue.SearchByVector(searchVectors[i], limit, allowList)
+				if distancerOverride != nil {
+					ids, dists, err = queue.SearchByVectorWithDistancer(searchVectors[i],
+						limit, allowList, distancerOverride)
+				} else {
+					ids, dists, err = queue.SearchByVector(searchVectors[i], limit, allowList)
+				}
 				if err != nil {
 					// This should normally not fail. A failure here could indicate that more
 					// attention is required, for example because data is corrupted. That's
@@ -0,0 +1,89 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+type requantizableIndexer interface {
+	Requantize(callback func()) error
+	RequantizationProgress() (float32, bool)
+}
+
+// RequantizeVectorIndex retrains or replaces the quantizer of a compressed
+// vector index based on the current compression config of the class. The
+// vectors are re-encoded in the background while the index keeps serving
+// requests, the progress is reported as part of the node shard status.
+func (s *Shard) RequantizeVectorIndex(ctx context.Context, targetVector string) error {
+	var vidx VectorIndex
+	if s.hasTargetVectors() {
+		vidx = s.VectorIndexForName(targetVector)
+	} else {
+		vidx = s.VectorIndex()
+	}
+	if vidx == nil {
+		return fmt.Errorf("vector index %q not found", targetVector)
+	}
+
+	index, ok := vidx.(requantizableIndexer)
+	if !ok {
+		return fmt.Errorf("vector index %q does not support requantization", targetVector)
+	}
+	return index.Requantize(func() {})
+}
+
+// RequantizeShard requantizes the vector index of the local replica of a
+// shard. Missing collections or shards are reported as not found, indexes
+// which can't be requantized as unprocessable.
+func (db *DB) RequantizeShard(ctx context.Context, className, shardName, targetVector string) error {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return enterrors.NewErrNotFound(fmt.Errorf("collection %q not found", className))
+	}
+
+	shard := idx.GetShard(shardName)
+	if shard == nil {
+		return enterrors.NewErrNotFound(fmt.Errorf("shard %q not found on this node", shardName))
+	}
+
+	if err := shard.RequantizeVectorIndex(ctx, targetVector); err != nil {
+		return enterrors.NewErrUnprocessable(err)
+	}
+	return nil
+}
+
+// requantizationProgress returns the progress of the slowest running
+// requantization of the shard's vector indexes
+func (s *Shard) requantizationProgress() (float32, bool) {
+	vidxs := s.VectorIndexes()
+	if !s.hasTargetVectors() {
+		vidxs = map[string]VectorIndex{"": s.VectorIndex()}
+	}
+
+	var progress float32
+	var running bool
+	for _, vidx := range vidxs {
+		index, ok := vidx.(requantizableIndexer)
+		if !ok {
+			continue
+		}
+		if p, ok := index.RequantizationProgress(); ok && (!running || p < progress) {
+			progress, running = p, true
+		}
+	}
+	return progress, running
+}
@@ -511,7 +511,7 @@ func TestShard_SkipVectorReindex(t *testing.T) {
 		return func(t *testing.T) {
 			t.Run("to be found", func(t *testing.T) {
 				found, _, err := shard.ObjectVectorSearch(ctx, [][]float32{vectorToBeFound}, []string{targetVector},
-					vectorSearchDist, vectorSearchLimit, nil, nil, nil, additional.Properties{}, nil, nil)
+					vectorSearchDist, vectorSearchLimit, nil, nil, nil, additional.Properties{}, nil, nil, "")
 				require.NoError(t, err)
 				require.Len(t, found, 1)
 				require.Equal(t, uuid_, found[0].Object.ID)
@@ -519,7 +519,7 @@ func TestShard_SkipVectorReindex(t *testing.T) {
 
 			t.Run("not to be found", func(t *testing.T) {
 				found, _, err := shard.ObjectVectorSearch(ctx, [][]float32{vectorNotToBeFound}, []string{targetVector},
-					vectorSearchDist, vectorSearchLimit, nil, nil, nil, additional.Properties{}, nil, nil)
+					vectorSearchDist, vectorSearchLimit, nil, nil, nil, additional.Properties{}, nil, nil, "")
 				require.NoError(t, err)
 				require.Len(t, found, 0)
 			})
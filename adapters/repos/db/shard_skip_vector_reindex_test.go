@@ -828,3 +828,53 @@ func TestTargetVectorsEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiVectorsEqual(t *testing.T) {
+	vec1 := []float32{1, 2, 3}
+	vec2 := []float32{2, 3, 4}
+
+	type testCase struct {
+		prevVecs      map[string][][]float32
+		nextVecs      map[string][][]float32
+		expectedEqual bool
+	}
+
+	testCases := []testCase{
+		{
+			prevVecs:      nil,
+			nextVecs:      nil,
+			expectedEqual: true,
+		},
+		{
+			prevVecs:      map[string][][]float32{"vec": nil},
+			nextVecs:      nil,
+			expectedEqual: true,
+		},
+		{
+			prevVecs:      nil,
+			nextVecs:      map[string][][]float32{"vec": {vec1}},
+			expectedEqual: false,
+		},
+		{
+			prevVecs:      map[string][][]float32{"vec": {vec1, vec2}},
+			nextVecs:      map[string][][]float32{"vec": {vec1, vec2}},
+			expectedEqual: true,
+		},
+		{
+			prevVecs:      map[string][][]float32{"vec": {vec1, vec2}},
+			nextVecs:      map[string][][]float32{"vec": {vec2, vec1}},
+			expectedEqual: false,
+		},
+		{
+			prevVecs:      map[string][][]float32{"vec": {vec1, vec2}},
+			nextVecs:      map[string][][]float32{"vec": {vec1}},
+			expectedEqual: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		t.Run(fmt.Sprintf("#%d", i), func(t *testing.T) {
+			assert.Equal(t, tc.expectedEqual, multiVectorsEqual(tc.prevVecs, tc.nextVecs))
+		})
+	}
+}
@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	sparseent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+// sparseVectorIndex is implemented by vector indexes which store sparse
+// vectors. Like multi vectors, sparse vectors bypass the index queue and are
+// added to the index directly.
+type sparseVectorIndex interface {
+	AddSparse(id uint64, vector sparseent.Vector) error
+	ValidateSparseBeforeInsert(vector sparseent.Vector) error
+	SearchBySparseVector(ctx context.Context, query sparseent.Vector, k int,
+		allow helpers.AllowList) ([]uint64, []float32, error)
+}
+
+func (s *Shard) sparseVectorIndexForName(targetVector string) (sparseVectorIndex, bool) {
+	vectorIndex := s.VectorIndexForName(targetVector)
+	if vectorIndex == nil {
+		return nil, false
+	}
+	index, ok := vectorIndex.(sparseVectorIndex)
+	return index, ok
+}
+
+func (s *Shard) validateSparseVectors(obj *storobj.Object) error {
+	for targetVector, vector := range obj.SparseVectors {
+		index, ok := s.sparseVectorIndexForName(targetVector)
+		if !ok {
+			return fmt.Errorf("target vector %s is not configured for sparse vectors", targetVector)
+		}
+		if err := index.ValidateSparseBeforeInsert(vector); err != nil {
+			return errors.Wrapf(err, "Validate vector index %s for target vector %s", targetVector, obj.ID())
+		}
+	}
+	return nil
+}
+
+// updateSparseVectorIndexes removes the old doc id from all sparse vector
+// indexes if it changed and adds the sparse vectors of the new doc id
+func (s *Shard) updateSparseVectorIndexes(sparseVectors map[string]sparseent.Vector,
+	status objectInsertStatus,
+) error {
+	if status.docIDChanged {
+		for targetVector, vectorIndex := range s.vectorIndexes {
+			if _, ok := vectorIndex.(sparseVectorIndex); !ok {
+				continue
+			}
+			if err := vectorIndex.Delete(status.oldDocID); err != nil {
+				return errors.Wrapf(err, "delete doc id %d from vector index for target vector %s",
+					status.oldDocID, targetVector)
+			}
+		}
+	}
+
+	return s.updateSparseVectorIndexesIgnoreDelete(sparseVectors, status)
+}
+
+// as the name implies this method only performs the insertions, but completely
+// ignores any deletes. It thus assumes that the caller has already taken care
+// of all the deletes in another way
+func (s *Shard) updateSparseVectorIndexesIgnoreDelete(sparseVectors map[string]sparseent.Vector,
+	status objectInsertStatus,
+) error {
+	if status.docIDPreserved || status.skipUpsert {
+		return nil
+	}
+
+	for targetVector, vector := range sparseVectors {
+		index, ok := s.sparseVectorIndexForName(targetVector)
+		if !ok {
+			return fmt.Errorf("sparse vector index not found for target vector %s", targetVector)
+		}
+		if err := index.AddSparse(status.docID, vector); err != nil {
+			return errors.Wrapf(err, "insert doc id %d to vector index for target vector %s",
+				status.docID, targetVector)
+		}
+	}
+
+	return nil
+}
+
+func sparseVectorsEqual(prevSparseVectors, nextSparseVectors map[string]sparseent.Vector) bool {
+	for vecName, vec := range prevSparseVectors {
+		next := nextSparseVectors[vecName]
+		if !slices.Equal(vec.Indices, next.Indices) || !slices.Equal(vec.Values, next.Values) {
+			return false
+		}
+	}
+	for vecName, vec := range nextSparseVectors {
+		if _, ok := prevSparseVectors[vecName]; !ok && len(vec.Indices) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sparseObjectSearch returns the objects with the highest dot product with
+// the sparse query, the scores are returned in the same order
+func (s *Shard) sparseObjectSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
+	query *searchparams.NearSparseVector, additional additional.Properties, properties []string,
+) ([]*storobj.Object, []float32, error) {
+	if query == nil {
+		return nil, nil, fmt.Errorf("sparse search without a sparse vector")
+	}
+	index, ok := s.sparseVectorIndexForName(query.TargetVector)
+	if !ok {
+		return nil, nil, fmt.Errorf("target vector %s is not configured for sparse vectors", query.TargetVector)
+	}
+
+	var allowList helpers.AllowList
+	if filters != nil {
+		var err error
+		allowList, err = inverted.NewSearcher(s.index.logger, s.store,
+			s.index.getSchema.ReadOnlyClass, s.propertyIndices,
+			s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
+			s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit,
+			s.bitmapFactory).
+			DocIDs(ctx, filters, additional, s.index.Config.ClassName)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	ids, scores, err := index.SearchBySparseVector(ctx, sparseent.Vector{
+		Indices: query.Indices,
+		Values:  query.Values,
+	}, limit, allowList)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "sparse search for target vector %s", query.TargetVector)
+	}
+
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	objs, err := storobj.ObjectsByDocID(bucket, ids, additional, properties, s.index.logger)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// objects which were deleted in the meantime are skipped, the scores
+	// have to be matched to the remaining objects
+	scoreByID := make(map[uint64]float32, len(ids))
+	for i, id := range ids {
+		scoreByID[id] = scores[i]
+	}
+	outObjs := make([]*storobj.Object, 0, len(objs))
+	outScores := make([]float32, 0, len(objs))
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		outObjs = append(outObjs, obj)
+		outScores = append(outScores, scoreByID[obj.DocID])
+	}
+	return outObjs, outScores, nil
+}
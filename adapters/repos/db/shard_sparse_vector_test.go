@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	sparseent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+func TestShardSparseVectors(t *testing.T) {
+	ctx := context.Background()
+	className := "SparseVectorClass"
+
+	uc := sparseent.NewDefaultUserConfig()
+	class := &models.Class{
+		Class: className,
+		VectorConfig: map[string]models.VectorConfig{
+			"splade": {
+				Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+				VectorIndexType:   "sparse",
+				VectorIndexConfig: uc,
+			},
+		},
+	}
+	shard, _ := testShardWithSettings(t, ctx, class, enthnsw.UserConfig{Skip: true}, false, false,
+		func(i *Index) {
+			i.vectorIndexUserConfigs = map[string]schemaConfig.VectorIndexConfig{"splade": uc}
+		})
+
+	newObject := func(id strfmt.UUID, indices []uint32, values []float32) *storobj.Object {
+		return &storobj.Object{
+			MarshallerVersion: 1,
+			Object:            models.Object{ID: id, Class: className},
+			SparseVectors: map[string]sparseent.Vector{
+				"splade": {Indices: indices, Values: values},
+			},
+		}
+	}
+	search := func(indices []uint32, values []float32) []strfmt.UUID {
+		objs, _, err := shard.ObjectSearch(ctx, 10, nil, &searchparams.KeywordRanking{
+			Type: "sparse",
+			SparseVector: &searchparams.NearSparseVector{
+				Indices: indices, Values: values, TargetVector: "splade",
+			},
+		}, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(objs))
+		for i, obj := range objs {
+			ids[i] = obj.ID()
+		}
+		return ids
+	}
+
+	id1 := strfmt.UUID(uuid.NewString())
+	id2 := strfmt.UUID(uuid.NewString())
+
+	t.Run("put", func(t *testing.T) {
+		require.Nil(t, shard.PutObject(ctx, newObject(id1, []uint32{1, 2}, []float32{1, 0.5})))
+		require.Nil(t, shard.PutObject(ctx, newObject(id2, []uint32{2, 3}, []float32{2, 1})))
+	})
+
+	t.Run("put with mismatching length", func(t *testing.T) {
+		err := shard.PutObject(ctx, newObject(strfmt.UUID(uuid.NewString()), []uint32{1, 2}, []float32{1}))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{id1}, search([]uint32{1}, []float32{1}))
+		assert.Equal(t, []strfmt.UUID{id2, id1}, search([]uint32{2}, []float32{1}))
+		assert.Empty(t, search([]uint32{42}, []float32{1}))
+	})
+
+	t.Run("sparse vectors are returned", func(t *testing.T) {
+		obj, err := shard.ObjectByID(ctx, id2, nil,
+			additional.Properties{Vectors: []string{"splade"}})
+		require.Nil(t, err)
+		assert.Equal(t, sparseent.Vector{Indices: []uint32{2, 3}, Values: []float32{2, 1}},
+			obj.SparseVectors["splade"])
+	})
+
+	t.Run("update", func(t *testing.T) {
+		require.Nil(t, shard.PutObject(ctx, newObject(id2, []uint32{1}, []float32{3})))
+		assert.Equal(t, []strfmt.UUID{id2, id1}, search([]uint32{1}, []float32{1}))
+		assert.Equal(t, []strfmt.UUID{id1}, search([]uint32{2}, []float32{1}))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.Nil(t, shard.DeleteObject(ctx, id2))
+		assert.Equal(t, []strfmt.UUID{id1}, search([]uint32{1}, []float32{1}))
+	})
+}
@@ -332,6 +332,17 @@ func (ob *objectsBatcher) storeAdditionalStorageWithAsyncQueue(ctx context.Conte
 			continue
 		}
 
+		// multi and sparse vectors are not queued, they are added to their
+		// index directly
+		if err := ob.shard.updateMultiVectorIndexesIgnoreDelete(object.MultiVectors, status); err != nil {
+			ob.setErrorAtIndex(errors.Wrap(err, "insert to multi vector index"), i)
+			continue
+		}
+		if err := ob.shard.updateSparseVectorIndexesIgnoreDelete(object.SparseVectors, status); err != nil {
+			ob.setErrorAtIndex(errors.Wrap(err, "insert to sparse vector index"), i)
+			continue
+		}
+
 		if len(object.Vector) == 0 && len(object.Vectors) == 0 {
 			continue
 		}
@@ -440,6 +451,14 @@ func (ob *objectsBatcher) storeSingleObjectInAdditionalStorage(ctx context.Conte
 					return
 				}
 			}
+			if err := ob.shard.updateMultiVectorIndexesIgnoreDelete(object.MultiVectors, status); err != nil {
+				ob.setErrorAtIndex(errors.Wrap(err, "insert to multi vector index"), index)
+				return
+			}
+			if err := ob.shard.updateSparseVectorIndexesIgnoreDelete(object.SparseVectors, status); err != nil {
+				ob.setErrorAtIndex(errors.Wrap(err, "insert to sparse vector index"), index)
+				return
+			}
 		} else {
 			if object.Vector != nil {
 				if err := ob.shard.updateVectorIndexIgnoreDelete(object.Vector, status); err != nil {
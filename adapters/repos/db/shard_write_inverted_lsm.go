@@ -98,6 +98,20 @@ func (s *Shard) addToPropertyValueIndex(docID uint64, property inverted.Property
 		}
 	}
 
+	if property.HasPositionIndex {
+		bucketValue := s.store.Bucket(helpers.BucketPositionsFromPropNameLSM(property.Name))
+		if bucketValue == nil {
+			return errors.Errorf("no bucket positions for prop '%s' found", property.Name)
+		}
+
+		for _, item := range property.Items {
+			if err := bucketValue.Put(inverted.PositionKey(item.Data, docID),
+				inverted.EncodePositions(item.Positions)); err != nil {
+				return errors.Wrapf(err, "failed adding to prop '%s' positions bucket", property.Name)
+			}
+		}
+	}
+
 	if property.HasRangeableIndex {
 		bucketValue := s.store.Bucket(helpers.BucketRangeableFromPropNameLSM(property.Name))
 		if bucketValue == nil {
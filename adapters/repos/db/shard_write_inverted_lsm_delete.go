@@ -54,6 +54,19 @@ func (s *Shard) deleteFromInvertedIndicesLSM(props []inverted.Property, nilProps
 			}
 		}
 
+		if prop.HasPositionIndex {
+			bucket := s.store.Bucket(helpers.BucketPositionsFromPropNameLSM(prop.Name))
+			if bucket == nil {
+				return fmt.Errorf("no bucket positions for prop %q found", prop.Name)
+			}
+			for _, item := range prop.Items {
+				if err := bucket.Delete(inverted.PositionKey(item.Data, docID)); err != nil {
+					return errors.Wrapf(err, "delete positions of item '%s' from index",
+						string(item.Data))
+				}
+			}
+		}
+
 		if prop.HasRangeableIndex {
 			bucket := s.store.Bucket(helpers.BucketRangeableFromPropNameLSM(prop.Name))
 			if bucket == nil {
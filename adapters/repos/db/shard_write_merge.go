@@ -78,6 +78,12 @@ func (s *Shard) merge(ctx context.Context, idBytes []byte, doc objects.MergeDocu
 				return errors.Wrapf(err, "update vector index for target vector %s", targetVector)
 			}
 		}
+		if err := s.updateMultiVectorIndexes(obj.MultiVectors, status); err != nil {
+			return errors.Wrap(err, "update multi vector indexes")
+		}
+		if err := s.updateSparseVectorIndexes(obj.SparseVectors, status); err != nil {
+			return errors.Wrap(err, "update sparse vector indexes")
+		}
 	} else {
 		if err := s.updateVectorIndex(obj.Vector, status); err != nil {
 			return errors.Wrap(err, "update vector index")
@@ -136,6 +142,7 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 			return nil
 		}
 
+		obj.VectorPrecisions = s.vectorPrecisions
 		objBytes, err := obj.MarshalBinary()
 		if err != nil {
 			return errors.Wrapf(err, "marshal object %s to binary", obj.ID())
@@ -215,6 +222,7 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 	out.status = status
 
 	obj.DocID = status.docID // is not changed
+	obj.VectorPrecisions = s.vectorPrecisions
 	objBytes, err := obj.MarshalBinary()
 	if err != nil {
 		return out, errors.Wrapf(err, "marshal object %s to binary", obj.ID())
@@ -61,6 +61,12 @@ func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object)
 				return errors.Wrapf(err, "update vector index for target vector %s", targetVector)
 			}
 		}
+		if err := s.updateMultiVectorIndexes(object.MultiVectors, status); err != nil {
+			return errors.Wrap(err, "update multi vector indexes")
+		}
+		if err := s.updateSparseVectorIndexes(object.SparseVectors, status); err != nil {
+			return errors.Wrap(err, "update sparse vector indexes")
+		}
 	} else {
 		if err := s.updateVectorIndex(object.Vector, status); err != nil {
 			return errors.Wrap(err, "update vector index")
@@ -240,6 +246,13 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 		}
 	}
 
+	if err := s.validateMultiVectors(obj); err != nil {
+		return status, err
+	}
+	if err := s.validateSparseVectors(obj); err != nil {
+		return status, err
+	}
+
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	var prevObj *storobj.Object
 
@@ -272,6 +285,7 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 			return nil
 		}
 
+		obj.VectorPrecisions = s.vectorPrecisions
 		objBinary, err := obj.MarshalBinary()
 		if err != nil {
 			return errors.Wrapf(err, "marshal object %s to binary", obj.ID())
@@ -561,6 +575,12 @@ func compareObjsForInsertStatus(prevObj, nextObj *storobj.Object) (preserve, ski
 	if !targetVectorsEqual(prevObj.Vectors, nextObj.Vectors) {
 		return false, false
 	}
+	if !multiVectorsEqual(prevObj.MultiVectors, nextObj.MultiVectors) {
+		return false, false
+	}
+	if !sparseVectorsEqual(prevObj.SparseVectors, nextObj.SparseVectors) {
+		return false, false
+	}
 	if !addPropsEqual(prevObj.Object.Additional, nextObj.Object.Additional) {
 		return true, false
 	}
@@ -646,6 +666,33 @@ func targetVectorsEqual(prevTargetVectors, nextTargetVectors map[string][]float3
 	return true
 }
 
+func multiVectorsEqual(prevMultiVectors, nextMultiVectors map[string][][]float32) bool {
+	for vecName, vecs := range prevMultiVectors {
+		if !multiVectorEqual(vecs, nextMultiVectors[vecName]) {
+			return false
+		}
+	}
+	for vecName, vecs := range nextMultiVectors {
+		if _, ok := prevMultiVectors[vecName]; !ok && len(vecs) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func multiVectorEqual(prevVecs, nextVecs [][]float32) bool {
+	if len(prevVecs) != len(nextVecs) {
+		return false
+	}
+	for i := range prevVecs {
+		if !common.VectorsEqual(prevVecs[i], nextVecs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func addPropsEqual(prevAddProps, nextAddProps models.AdditionalProperties) bool {
 	return reflect.DeepEqual(prevAddProps, nextAddProps)
 }
@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func SetupTextAnalyzerClass(t require.TestingT, repo *DB, schemaGetter *fakeSchemaGetter, logger logrus.FieldLogger,
+) []string {
+	vTrue := true
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "none"),
+		Class:               "TextAnalyzerClass",
+
+		Properties: []*models.Property{
+			{
+				Name:           "german",
+				DataType:       schema.DataTypeText.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+				TextAnalyzer: &models.TextAnalyzerConfig{
+					StopwordPreset: "de",
+					Stemmer:        "de",
+					ASCIIFold:      true,
+				},
+			},
+			{
+				Name:         "english",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+				TextAnalyzer: &models.TextAnalyzerConfig{
+					Stemmer: "en",
+				},
+			},
+			{
+				Name:         "plain",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	props := make([]string, len(class.Properties))
+	for i, prop := range class.Properties {
+		props[i] = prop.Name
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	migrator := NewMigrator(repo, logger)
+	migrator.AddClass(context.Background(), class, schemaGetter.shardState)
+
+	testData := []map[string]interface{}{
+		{"german": "Die Häuser am großen See"},
+		{"german": "Ein kleines Haus"},
+		{"english": []string{"running shoes", "trail"}},
+		{"english": []string{"the runner runs"}},
+		{"plain": "running houses"},
+	}
+	for i, data := range testData {
+		obj := &models.Object{Class: "TextAnalyzerClass", ID: textAnalyzerObjectID(i), Properties: data}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil, nil, 0))
+	}
+	return props
+}
+
+func textAnalyzerObjectID(i int) strfmt.UUID {
+	return strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+}
+
+func TestTextAnalyzers(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	props := SetupTextAnalyzerClass(t, repo, schemaGetter, logger)
+
+	idx := repo.GetIndex("TextAnalyzerClass")
+	require.NotNil(t, idx)
+
+	ids := func(res []*storobj.Object) []strfmt.UUID {
+		out := make([]strfmt.UUID, len(res))
+		for i := range res {
+			out[i] = res[i].ID()
+		}
+		return out
+	}
+
+	search := func(t *testing.T, query string, properties ...string) []strfmt.UUID {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Query: query, Properties: properties}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+		require.Nil(t, err)
+		return ids(res)
+	}
+
+	filter := func(t *testing.T, operator filters.Operator, property, value string) ([]strfmt.UUID, error) {
+		f := &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: operator,
+				On:       &filters.Path{Class: "TextAnalyzerClass", Property: schema.PropertyName(property)},
+				Value:    &filters.Value{Value: value, Type: schema.DataTypeText},
+			},
+		}
+		res, _, err := idx.objectSearch(context.TODO(), 1000, f, nil, nil, nil, additional.Properties{}, nil, "", 0, props)
+		return ids(res), err
+	}
+
+	t.Run("bm25 matches inflections and folded spellings", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{textAnalyzerObjectID(0), textAnalyzerObjectID(1)},
+			search(t, "hausern", "german"))
+		assert.ElementsMatch(t, []strfmt.UUID{textAnalyzerObjectID(0)}, search(t, "grosser", "german"))
+		assert.ElementsMatch(t, []strfmt.UUID{textAnalyzerObjectID(2), textAnalyzerObjectID(3)},
+			search(t, "run", "english"))
+	})
+
+	t.Run("bm25 analyzes the query per property", func(t *testing.T) {
+		assert.ElementsMatch(t, []strfmt.UUID{textAnalyzerObjectID(2), textAnalyzerObjectID(3), textAnalyzerObjectID(4)},
+			search(t, "running", "english", "plain"))
+		assert.ElementsMatch(t, []strfmt.UUID{textAnalyzerObjectID(2), textAnalyzerObjectID(3)},
+			search(t, "runs", "english", "plain"))
+	})
+
+	t.Run("stopwords of the analyzer are not indexed", func(t *testing.T) {
+		assert.Empty(t, search(t, "die", "german"))
+	})
+
+	t.Run("phrases skip removed stopwords", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{textAnalyzerObjectID(0)}, search(t, `"Häuser an dem grossen See"`, "german"))
+	})
+
+	t.Run("equal filter", func(t *testing.T) {
+		res, err := filter(t, filters.OperatorEqual, "german", "Häusern")
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{textAnalyzerObjectID(0), textAnalyzerObjectID(1)}, res)
+
+		res, err = filter(t, filters.OperatorEqual, "english", "runs")
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{textAnalyzerObjectID(2), textAnalyzerObjectID(3)}, res)
+	})
+
+	t.Run("like filter", func(t *testing.T) {
+		res, err := filter(t, filters.OperatorLike, "german", "hä*")
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{textAnalyzerObjectID(0), textAnalyzerObjectID(1)}, res)
+	})
+
+	t.Run("filter with only stopwords", func(t *testing.T) {
+		_, err := filter(t, filters.OperatorEqual, "german", "die")
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "only stopwords")
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 )
 
 type shardedLockCache[T float32 | byte | uint64] struct {
@@ -38,6 +39,10 @@ type shardedLockCache[T float32 | byte | uint64] struct {
 	deletionInterval time.Duration
 	allocChecker     memwatch.AllocChecker
 
+	// preloadTransform is applied to vectors which are handed to Preload
+	// instead of being read through vectorForID
+	preloadTransform func(vec []T) []T
+
 	// The maintenanceLock makes sure that only one maintenance operation, such
 	// as growing the cache or clearing the cache happens at the same time.
 	maintenanceLock sync.RWMutex
@@ -50,7 +55,8 @@ const (
 )
 
 func NewShardedFloat32LockCache(vecForID common.VectorForID[float32], maxSize int,
-	logger logrus.FieldLogger, normalizeOnRead bool, deletionInterval time.Duration,
+	logger logrus.FieldLogger, normalizeOnRead bool,
+	precision vectorindexcommon.StoragePrecision, deletionInterval time.Duration,
 	allocChecker memwatch.AllocChecker,
 ) Cache[float32] {
 	vc := &shardedLockCache[float32]{
@@ -76,6 +82,18 @@ func NewShardedFloat32LockCache(vecForID common.VectorForID[float32], maxSize in
 		allocChecker:     allocChecker,
 	}
 
+	if precision != vectorindexcommon.PrecisionFloat32 {
+		// vectors read from disk already have the reduced precision, the ones
+		// preloaded on insert are rounded so the cache never diverges from disk
+		vc.preloadTransform = func(vec []float32) []float32 {
+			vec = precision.Round(vec)
+			if normalizeOnRead {
+				vec = distancer.Normalize(vec)
+			}
+			return vec
+		}
+	}
+
 	vc.watchForDeletion()
 	return vc
 }
@@ -220,6 +238,10 @@ func (s *shardedLockCache[T]) Prefetch(id uint64) {
 }
 
 func (s *shardedLockCache[T]) Preload(id uint64, vec []T) {
+	if s.preloadTransform != nil {
+		vec = s.preloadTransform(vec)
+	}
+
 	s.shardedLocks.Lock(id)
 	defer s.shardedLocks.Unlock(id)
 
@@ -21,6 +21,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 )
 
 func TestVectorCacheGrowth(t *testing.T) {
@@ -29,7 +30,7 @@ func TestVectorCacheGrowth(t *testing.T) {
 	id := 100_000
 	expectedCount := int64(0)
 
-	vectorCache := NewShardedFloat32LockCache(vecForId, 1_000_000, logger, false, time.Duration(10_000), nil)
+	vectorCache := NewShardedFloat32LockCache(vecForId, 1_000_000, logger, false, vectorindexcommon.PrecisionFloat32, time.Duration(10_000), nil)
 	initialSize := vectorCache.Len()
 	assert.Less(t, int(initialSize), id)
 	assert.Equal(t, expectedCount, vectorCache.CountVectors())
@@ -51,7 +52,7 @@ func TestCache_ParallelGrowth(t *testing.T) {
 
 	logger, _ := test.NewNullLogger()
 	var vecForId common.VectorForID[float32] = func(context.Context, uint64) ([]float32, error) { return nil, nil }
-	vectorCache := NewShardedFloat32LockCache(vecForId, 1_000_000, logger, false, time.Second, nil)
+	vectorCache := NewShardedFloat32LockCache(vecForId, 1_000_000, logger, false, vectorindexcommon.PrecisionFloat32, time.Second, nil)
 
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	count := 10_000
@@ -82,7 +83,7 @@ func TestCacheCleanup(t *testing.T) {
 	sleepMs := deletionInterval + 100*time.Millisecond
 
 	t.Run("count is not reset on unnecessary deletion", func(t *testing.T) {
-		vectorCache := NewShardedFloat32LockCache(vecForId, maxSize, logger, false, deletionInterval, nil)
+		vectorCache := NewShardedFloat32LockCache(vecForId, maxSize, logger, false, vectorindexcommon.PrecisionFloat32, deletionInterval, nil)
 		shardedLockCache, ok := vectorCache.(*shardedLockCache[float32])
 		assert.True(t, ok)
 
@@ -101,7 +102,7 @@ func TestCacheCleanup(t *testing.T) {
 	})
 
 	t.Run("deletion clears cache and counter when maxSize exceeded", func(t *testing.T) {
-		vectorCache := NewShardedFloat32LockCache(vecForId, maxSize, logger, false, deletionInterval, nil)
+		vectorCache := NewShardedFloat32LockCache(vecForId, maxSize, logger, false, vectorindexcommon.PrecisionFloat32, deletionInterval, nil)
 		shardedLockCache, ok := vectorCache.(*shardedLockCache[float32])
 		assert.True(t, ok)
 
@@ -132,3 +133,30 @@ func countCached(c *shardedLockCache[float32]) int {
 	}
 	return count
 }
+
+func TestCachePreloadWithReducedPrecision(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	var vecForId common.VectorForID[float32] = func(context.Context, uint64) ([]float32, error) { return nil, nil }
+	vec := []float32{0.1, -0.25, 1.0 / 3}
+
+	t.Run("vectors are rounded to the storage precision", func(t *testing.T) {
+		vectorCache := NewShardedFloat32LockCache(vecForId, 1_000_000, logger, false,
+			vectorindexcommon.PrecisionFloat16, time.Second, nil)
+		vectorCache.Preload(0, vec)
+
+		cached, err := vectorCache.Get(context.Background(), 0)
+		assert.Nil(t, err)
+		assert.Equal(t, vectorindexcommon.PrecisionFloat16.Round(vec), cached)
+		assert.Equal(t, float32(1.0/3), vec[2], "the input must not be modified")
+	})
+
+	t.Run("float32 vectors are cached as they are", func(t *testing.T) {
+		vectorCache := NewShardedFloat32LockCache(vecForId, 1_000_000, logger, false,
+			vectorindexcommon.PrecisionFloat32, time.Second, nil)
+		vectorCache.Preload(0, vec)
+
+		cached, err := vectorCache.Get(context.Background(), 0)
+		assert.Nil(t, err)
+		assert.Equal(t, vec, cached)
+	})
+}
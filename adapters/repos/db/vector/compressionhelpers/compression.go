@@ -38,6 +38,7 @@ type ReturnDistancerFn func()
 type CommitLogger interface {
 	AddPQCompression(PQData) error
 	AddSQCompression(SQData) error
+	AddRQCompression(RQData) error
 }
 
 type VectorCompressor interface {
@@ -47,6 +48,9 @@ type VectorCompressor interface {
 	GetCacheMaxSize() int64
 	Delete(ctx context.Context, id uint64)
 	Preload(id uint64, vector []float32)
+	PreloadCache(id uint64, vector []float32)
+	PersistCache(id uint64) error
+	UseBucket(name string)
 	Prefetch(id uint64)
 	PrefillCache()
 
@@ -55,12 +59,13 @@ type VectorCompressor interface {
 	NewDistancerFromID(id uint64) (CompressorDistancer, error)
 	NewBag() CompressionDistanceBag
 
-	PersistCompression(CommitLogger)
+	PersistCompression(CommitLogger) error
 }
 
 type quantizedVectorsCompressor[T byte | uint64] struct {
 	cache           cache.Cache[T]
 	compressedStore *lsmkv.Store
+	bucketName      string
 	quantizer       quantizer[T]
 	storeId         func([]byte, uint64)
 	loadId          func([]byte) uint64
@@ -88,18 +93,49 @@ func (compressor *quantizedVectorsCompressor[T]) Delete(ctx context.Context, id
 	compressor.cache.Delete(ctx, id)
 	idBytes := make([]byte, 8)
 	compressor.storeId(idBytes, id)
-	compressor.compressedStore.Bucket(helpers.VectorsCompressedBucketLSM).Delete(idBytes)
+	compressor.compressedStore.Bucket(compressor.bucketName).Delete(idBytes)
 }
 
 func (compressor *quantizedVectorsCompressor[T]) Preload(id uint64, vector []float32) {
 	compressedVector := compressor.quantizer.Encode(vector)
 	idBytes := make([]byte, 8)
 	compressor.storeId(idBytes, id)
-	compressor.compressedStore.Bucket(helpers.VectorsCompressedBucketLSM).Put(idBytes, compressor.quantizer.CompressedBytes(compressedVector))
+	compressor.compressedStore.Bucket(compressor.bucketName).Put(idBytes, compressor.quantizer.CompressedBytes(compressedVector))
 	compressor.cache.Grow(id)
 	compressor.cache.Preload(id, compressedVector)
 }
 
+// PreloadCache compresses the vector into the cache only. Unlike Preload it
+// does not write the compressed vector to the bucket, which allows building a
+// new compressor while the bucket is still in use by the current one.
+func (compressor *quantizedVectorsCompressor[T]) PreloadCache(id uint64, vector []float32) {
+	compressedVector := compressor.quantizer.Encode(vector)
+	compressor.cache.Grow(id)
+	compressor.cache.Preload(id, compressedVector)
+}
+
+// PersistCache writes a compressed vector previously added with PreloadCache
+// to the bucket
+func (compressor *quantizedVectorsCompressor[T]) PersistCache(id uint64) error {
+	compressedVector, err := compressor.compressedVectorFromID(context.Background(), id)
+	if err != nil {
+		return err
+	}
+	idBytes := make([]byte, 8)
+	compressor.storeId(idBytes, id)
+	return compressor.compressedStore.Bucket(compressor.bucketName).Put(idBytes,
+		compressor.quantizer.CompressedBytes(compressedVector))
+}
+
+// UseBucket switches the bucket of the compressed store the compressed vectors
+// are read from and written to. The bucket must have been created already. It
+// is not safe to call while the compressor is in use, it allows writing the
+// vectors of a new compressor to a separate bucket which replaces the one of
+// the current compressor once complete.
+func (compressor *quantizedVectorsCompressor[T]) UseBucket(name string) {
+	compressor.bucketName = name
+}
+
 func (compressor *quantizedVectorsCompressor[T]) Prefetch(id uint64) {
 	compressor.cache.Prefetch(id)
 }
@@ -137,7 +173,7 @@ func (compressor *quantizedVectorsCompressor[T]) DistanceBetweenCompressedVector
 func (compressor *quantizedVectorsCompressor[T]) getCompressedVectorForID(ctx context.Context, id uint64) ([]T, error) {
 	idBytes := make([]byte, 8)
 	compressor.storeId(idBytes, id)
-	compressedVector, err := compressor.compressedStore.Bucket(helpers.VectorsCompressedBucketLSM).Get(idBytes)
+	compressedVector, err := compressor.compressedStore.Bucket(compressor.bucketName).Get(idBytes)
 	if err != nil {
 		return nil, errors.Wrap(err, "Getting vector for id")
 	}
@@ -191,7 +227,7 @@ func (compressor *quantizedVectorsCompressor[T]) NewBag() CompressionDistanceBag
 }
 
 func (compressor *quantizedVectorsCompressor[T]) initCompressedStore() error {
-	err := compressor.compressedStore.CreateOrLoadBucket(context.Background(), helpers.VectorsCompressedBucketLSM)
+	err := compressor.compressedStore.CreateOrLoadBucket(context.Background(), compressor.bucketName)
 	if err != nil {
 		return errors.Wrapf(err, "Create or load bucket (compressed vectors store)")
 	}
@@ -219,7 +255,7 @@ func (compressor *quantizedVectorsCompressor[T]) PrefillCache() {
 	vecs := make([]vec, 0, 10_000)
 	maxID := uint64(0)
 
-	cursor := compressor.compressedStore.Bucket(helpers.VectorsCompressedBucketLSM).Cursor()
+	cursor := compressor.compressedStore.Bucket(compressor.bucketName).Cursor()
 	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
 
 		if len(k) == 0 {
@@ -259,8 +295,8 @@ func (compressor *quantizedVectorsCompressor[T]) PrefillCache() {
 	}).Info("prefilled compressed vector cache")
 }
 
-func (compressor *quantizedVectorsCompressor[T]) PersistCompression(logger CommitLogger) {
-	compressor.quantizer.PersistCompression(logger)
+func (compressor *quantizedVectorsCompressor[T]) PersistCompression(logger CommitLogger) error {
+	return compressor.quantizer.PersistCompression(logger)
 }
 
 func NewHNSWPQCompressor(
@@ -280,6 +316,7 @@ func NewHNSWPQCompressor(
 	pqVectorsCompressor := &quantizedVectorsCompressor[byte]{
 		quantizer:       quantizer,
 		compressedStore: store,
+		bucketName:      helpers.VectorsCompressedBucketLSM,
 		storeId:         binary.LittleEndian.PutUint64,
 		loadId:          binary.LittleEndian.Uint64,
 		logger:          logger,
@@ -313,6 +350,7 @@ func RestoreHNSWPQCompressor(
 	pqVectorsCompressor := &quantizedVectorsCompressor[byte]{
 		quantizer:       quantizer,
 		compressedStore: store,
+		bucketName:      helpers.VectorsCompressedBucketLSM,
 		storeId:         binary.LittleEndian.PutUint64,
 		loadId:          binary.LittleEndian.Uint64,
 		logger:          logger,
@@ -335,6 +373,7 @@ func NewBQCompressor(
 	bqVectorsCompressor := &quantizedVectorsCompressor[uint64]{
 		quantizer:       &quantizer,
 		compressedStore: store,
+		bucketName:      helpers.VectorsCompressedBucketLSM,
 		storeId:         binary.BigEndian.PutUint64,
 		loadId:          binary.BigEndian.Uint64,
 		logger:          logger,
@@ -358,6 +397,7 @@ func NewHNSWSQCompressor(
 	sqVectorsCompressor := &quantizedVectorsCompressor[byte]{
 		quantizer:       quantizer,
 		compressedStore: store,
+		bucketName:      helpers.VectorsCompressedBucketLSM,
 		storeId:         binary.BigEndian.PutUint64,
 		loadId:          binary.BigEndian.Uint64,
 		logger:          logger,
@@ -386,6 +426,7 @@ func RestoreHNSWSQCompressor(
 	sqVectorsCompressor := &quantizedVectorsCompressor[byte]{
 		quantizer:       quantizer,
 		compressedStore: store,
+		bucketName:      helpers.VectorsCompressedBucketLSM,
 		storeId:         binary.BigEndian.PutUint64,
 		loadId:          binary.BigEndian.Uint64,
 		logger:          logger,
@@ -397,6 +438,60 @@ func RestoreHNSWSQCompressor(
 	return sqVectorsCompressor, nil
 }
 
+func NewHNSWRQCompressor(
+	distance distancer.Provider,
+	dimensions int,
+	bits int,
+	seed uint64,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	quantizer, err := NewRotationalQuantizer(dimensions, bits, seed, distance)
+	if err != nil {
+		return nil, err
+	}
+	return newRQCompressor(quantizer, vectorCacheMaxObjects, logger, store, allocChecker), nil
+}
+
+func RestoreHNSWRQCompressor(
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	data RQData,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	quantizer, err := RestoreRotationalQuantizer(data, distance)
+	if err != nil {
+		return nil, err
+	}
+	return newRQCompressor(quantizer, vectorCacheMaxObjects, logger, store, allocChecker), nil
+}
+
+func newRQCompressor(
+	quantizer *RotationalQuantizer,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) VectorCompressor {
+	rqVectorsCompressor := &quantizedVectorsCompressor[byte]{
+		quantizer:       quantizer,
+		compressedStore: store,
+		bucketName:      helpers.VectorsCompressedBucketLSM,
+		storeId:         binary.BigEndian.PutUint64,
+		loadId:          binary.BigEndian.Uint64,
+		logger:          logger,
+	}
+	rqVectorsCompressor.initCompressedStore()
+	rqVectorsCompressor.cache = cache.NewShardedByteLockCache(
+		rqVectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, logger,
+		0, allocChecker)
+	return rqVectorsCompressor
+}
+
 type quantizedCompressorDistancer[T byte | uint64] struct {
 	compressor *quantizedVectorsCompressor[T]
 	distancer  quantizerDistancer[T]
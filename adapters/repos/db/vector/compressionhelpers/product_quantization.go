@@ -282,8 +282,8 @@ func PutCode8(code byte, buffer []byte, index int) {
 	buffer[index] = code
 }
 
-func (pq *ProductQuantizer) PersistCompression(logger CommitLogger) {
-	logger.AddPQCompression(PQData{
+func (pq *ProductQuantizer) PersistCompression(logger CommitLogger) error {
+	return logger.AddPQCompression(PQData{
 		Dimensions:          uint16(pq.dimensions),
 		EncoderType:         pq.encoderType,
 		Ks:                  uint16(pq.ks),
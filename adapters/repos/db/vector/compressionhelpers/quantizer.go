@@ -26,10 +26,11 @@ type quantizer[T byte | uint64] interface {
 	ReturnQuantizerDistancer(distancer quantizerDistancer[T])
 	CompressedBytes(compressed []T) []byte
 	FromCompressedBytes(compressed []byte) []T
-	PersistCompression(logger CommitLogger)
+	PersistCompression(logger CommitLogger) error
 }
 
-func (bq *BinaryQuantizer) PersistCompression(logger CommitLogger) {
+func (bq *BinaryQuantizer) PersistCompression(logger CommitLogger) error {
+	return nil
 }
 
 func (pq *ProductQuantizer) NewQuantizerDistancer(vec []float32) quantizerDistancer[byte] {
@@ -0,0 +1,323 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+const (
+	// three rounds of sign flips and Walsh-Hadamard transforms are enough to
+	// spread the energy of a vector evenly over all of its dimensions
+	rqRounds = 3
+	// every code ends with the lower bound, the step, the sum of the codes and
+	// the squared norm of the vector
+	rqTrailerSize = 16
+)
+
+// RotationalQuantizer compresses vectors without any training. Vectors are
+// first rotated by a pseudo-random orthogonal transformation (random sign flips
+// followed by a fast Walsh-Hadamard transform, repeated a few times), which
+// spreads their energy evenly across all dimensions. The rotated vector is then
+// scalar quantized using its own lower bound and step, so no statistics over
+// the data set are required.
+//
+// Every reconstructed coordinate is off by at most half a step, the estimated
+// dot product with a query q therefore deviates by at most step/2 * |q|_1 from
+// the exact one.
+type RotationalQuantizer struct {
+	distancer         distancer.Provider
+	dimensions        int
+	rotatedDimensions int
+	bits              int
+	seed              uint64
+	signs             [][]float32
+}
+
+type RQData struct {
+	Dimensions uint32
+	Bits       uint8
+	Seed       uint64
+}
+
+func NewRotationalQuantizer(dimensions, bits int, seed uint64,
+	distance distancer.Provider,
+) (*RotationalQuantizer, error) {
+	if dimensions <= 0 || dimensions > math.MaxUint32 {
+		return nil, errors.Errorf("invalid dimensions %d", dimensions)
+	}
+	switch bits {
+	case 1, 2, 4, 8:
+	default:
+		return nil, errors.Errorf("invalid number of bits %d, must be one of 1, 2, 4 or 8", bits)
+	}
+
+	rotatedDimensions := 1
+	for rotatedDimensions < dimensions {
+		rotatedDimensions *= 2
+	}
+
+	rng := rand.New(rand.NewSource(int64(seed)))
+	signs := make([][]float32, rqRounds)
+	for r := range signs {
+		signs[r] = make([]float32, rotatedDimensions)
+		for i := range signs[r] {
+			if rng.Intn(2) == 0 {
+				signs[r][i] = -1
+			} else {
+				signs[r][i] = 1
+			}
+		}
+	}
+
+	return &RotationalQuantizer{
+		distancer:         distance,
+		dimensions:        dimensions,
+		rotatedDimensions: rotatedDimensions,
+		bits:              bits,
+		seed:              seed,
+		signs:             signs,
+	}, nil
+}
+
+func RestoreRotationalQuantizer(data RQData, distance distancer.Provider) (*RotationalQuantizer, error) {
+	return NewRotationalQuantizer(int(data.Dimensions), int(data.Bits), data.Seed, distance)
+}
+
+// Rotate applies the orthogonal transformation, the result is padded with
+// zeros to the next power of two.
+func (rq *RotationalQuantizer) Rotate(vec []float32) []float32 {
+	out := make([]float32, rq.rotatedDimensions)
+	copy(out, vec)
+	scale := float32(1 / math.Sqrt(float64(rq.rotatedDimensions)))
+	for _, signs := range rq.signs {
+		for i := range out {
+			out[i] *= signs[i]
+		}
+		fastWalshHadamard(out)
+		for i := range out {
+			out[i] *= scale
+		}
+	}
+	return out
+}
+
+func fastWalshHadamard(x []float32) {
+	for h := 1; h < len(x); h *= 2 {
+		for i := 0; i < len(x); i += 2 * h {
+			for j := i; j < i+h; j++ {
+				a, b := x[j], x[j+h]
+				x[j], x[j+h] = a+b, a-b
+			}
+		}
+	}
+}
+
+func (rq *RotationalQuantizer) codesSize() int {
+	return (rq.rotatedDimensions*rq.bits + 7) / 8
+}
+
+func (rq *RotationalQuantizer) Encode(vec []float32) []byte {
+	rotated := rq.Rotate(vec)
+
+	lower, upper := rotated[0], rotated[0]
+	var norm float32
+	for _, x := range rotated {
+		if x < lower {
+			lower = x
+		}
+		if x > upper {
+			upper = x
+		}
+		norm += x * x
+	}
+	maxCode := float32(int(1)<<rq.bits - 1)
+	step := (upper - lower) / maxCode
+
+	size := rq.codesSize()
+	code := make([]byte, size+rqTrailerSize)
+	perByte := 8 / rq.bits
+	var sum uint32
+	for i, x := range rotated {
+		var c byte
+		if step > 0 {
+			c = byte(math.Min(math.Round(float64((x-lower)/step)), float64(maxCode)))
+		}
+		sum += uint32(c)
+		code[i/perByte] |= c << ((i % perByte) * rq.bits)
+	}
+	binary.LittleEndian.PutUint32(code[size:], math.Float32bits(lower))
+	binary.LittleEndian.PutUint32(code[size+4:], math.Float32bits(step))
+	binary.LittleEndian.PutUint32(code[size+8:], sum)
+	binary.LittleEndian.PutUint32(code[size+12:], math.Float32bits(norm))
+	return code
+}
+
+type rqCode struct {
+	codes []byte
+	lower float32
+	step  float32
+	sum   float32
+	norm  float32
+}
+
+func (rq *RotationalQuantizer) decode(code []byte) (rqCode, error) {
+	size := rq.codesSize()
+	if len(code) != size+rqTrailerSize {
+		return rqCode{}, errors.Errorf("invalid code length %d, expected %d",
+			len(code), size+rqTrailerSize)
+	}
+	return rqCode{
+		codes: code[:size],
+		lower: math.Float32frombits(binary.LittleEndian.Uint32(code[size:])),
+		step:  math.Float32frombits(binary.LittleEndian.Uint32(code[size+4:])),
+		sum:   float32(binary.LittleEndian.Uint32(code[size+8:])),
+		norm:  math.Float32frombits(binary.LittleEndian.Uint32(code[size+12:])),
+	}, nil
+}
+
+func (rq *RotationalQuantizer) codeAt(codes []byte, i int) byte {
+	if rq.bits == 8 {
+		return codes[i]
+	}
+	perByte := 8 / rq.bits
+	mask := byte(1)<<rq.bits - 1
+	return (codes[i/perByte] >> ((i % perByte) * rq.bits)) & mask
+}
+
+func (rq *RotationalQuantizer) codesDot(x, y []byte) float32 {
+	if rq.bits == 8 {
+		return float32(dotByteImpl(x, y))
+	}
+	var sum uint32
+	for i := 0; i < rq.rotatedDimensions; i++ {
+		sum += uint32(rq.codeAt(x, i)) * uint32(rq.codeAt(y, i))
+	}
+	return float32(sum)
+}
+
+func (rq *RotationalQuantizer) distanceFromDot(dot, normX, normY float32) (float32, error) {
+	switch rq.distancer.Type() {
+	case "l2-squared":
+		return normX + normY - 2*dot, nil
+	case "dot":
+		return -dot, nil
+	case "cosine-dot":
+		return 1 - dot, nil
+	}
+	return 0, errors.Errorf("Distance not supported yet %s", rq.distancer)
+}
+
+func (rq *RotationalQuantizer) DistanceBetweenCompressedVectors(x, y []byte) (float32, error) {
+	cx, err := rq.decode(x)
+	if err != nil {
+		return 0, err
+	}
+	cy, err := rq.decode(y)
+	if err != nil {
+		return 0, err
+	}
+
+	// every coordinate is reconstructed as lower + step*code
+	dot := float32(rq.rotatedDimensions)*cx.lower*cy.lower +
+		cx.lower*cy.step*cy.sum +
+		cy.lower*cx.step*cx.sum +
+		cx.step*cy.step*rq.codesDot(cx.codes, cy.codes)
+	return rq.distanceFromDot(dot, cx.norm, cy.norm)
+}
+
+type RQDistancer struct {
+	x          []float32
+	rotated    []float32
+	sum        float32
+	norm       float32
+	rq         *RotationalQuantizer
+	compressed []byte
+}
+
+func (rq *RotationalQuantizer) NewDistancer(a []float32) *RQDistancer {
+	rotated := rq.Rotate(a)
+	var sum, norm float32
+	for _, x := range rotated {
+		sum += x
+		norm += x * x
+	}
+	return &RQDistancer{
+		x:       a,
+		rotated: rotated,
+		sum:     sum,
+		norm:    norm,
+		rq:      rq,
+	}
+}
+
+// Distance estimates the distance between the query and a compressed vector.
+// The query isn't quantized, so the estimate only suffers from the
+// quantization error of x.
+func (d *RQDistancer) Distance(x []byte) (float32, error) {
+	if len(d.x) == 0 {
+		return d.rq.DistanceBetweenCompressedVectors(d.compressed, x)
+	}
+
+	cx, err := d.rq.decode(x)
+	if err != nil {
+		return 0, err
+	}
+	var dot float32
+	for i, q := range d.rotated {
+		dot += q * float32(d.rq.codeAt(cx.codes, i))
+	}
+	dot = cx.lower*d.sum + cx.step*dot
+	return d.rq.distanceFromDot(dot, cx.norm, d.norm)
+}
+
+func (d *RQDistancer) DistanceToFloat(x []float32) (float32, error) {
+	if len(d.x) > 0 {
+		return d.rq.distancer.SingleDist(d.x, x)
+	}
+	xComp := d.rq.Encode(x)
+	return d.rq.DistanceBetweenCompressedVectors(d.compressed, xComp)
+}
+
+func (rq *RotationalQuantizer) NewQuantizerDistancer(a []float32) quantizerDistancer[byte] {
+	return rq.NewDistancer(a)
+}
+
+func (rq *RotationalQuantizer) NewCompressedQuantizerDistancer(a []byte) quantizerDistancer[byte] {
+	return &RQDistancer{
+		rq:         rq,
+		compressed: a,
+	}
+}
+
+func (rq *RotationalQuantizer) ReturnQuantizerDistancer(distancer quantizerDistancer[byte]) {}
+
+func (rq *RotationalQuantizer) CompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (rq *RotationalQuantizer) FromCompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (rq *RotationalQuantizer) PersistCompression(logger CommitLogger) error {
+	return logger.AddRQCompression(RQData{
+		Dimensions: uint32(rq.dimensions),
+		Bits:       uint8(rq.bits),
+		Seed:       rq.seed,
+	})
+}
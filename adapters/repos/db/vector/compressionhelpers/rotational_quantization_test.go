@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !race
+
+package compressionhelpers_test
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	testinghelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+)
+
+func Test_NoRaceRQRotationIsOrthogonal(t *testing.T) {
+	rq, err := compressionhelpers.NewRotationalQuantizer(100, 8, 42, distancer.NewDotProductProvider())
+	require.Nil(t, err)
+
+	data, _ := testinghelpers.RandomVecsFixedSeed(2, 0, 100)
+	x, y := rq.Rotate(data[0]), rq.Rotate(data[1])
+	assert.Len(t, x, 128)
+
+	expected, _ := distancer.NewDotProductProvider().SingleDist(data[0], data[1])
+	actual, _ := distancer.NewDotProductProvider().SingleDist(x, y)
+	assert.InDelta(t, expected, actual, 1e-4)
+}
+
+func Test_NoRaceRQRestore(t *testing.T) {
+	rq, err := compressionhelpers.NewRotationalQuantizer(100, 4, 42, distancer.NewL2SquaredProvider())
+	require.Nil(t, err)
+	restored, err := compressionhelpers.RestoreRotationalQuantizer(compressionhelpers.RQData{
+		Dimensions: 100,
+		Bits:       4,
+		Seed:       42,
+	}, distancer.NewL2SquaredProvider())
+	require.Nil(t, err)
+
+	data, _ := testinghelpers.RandomVecsFixedSeed(1, 0, 100)
+	assert.Equal(t, rq.Encode(data[0]), restored.Encode(data[0]))
+}
+
+func Test_NoRaceRQInvalidBits(t *testing.T) {
+	_, err := compressionhelpers.NewRotationalQuantizer(100, 3, 42, distancer.NewL2SquaredProvider())
+	assert.NotNil(t, err)
+}
+
+func Test_NoRaceRQDistance(t *testing.T) {
+	distancers := []distancer.Provider{distancer.NewL2SquaredProvider(), distancer.NewCosineDistanceProvider(), distancer.NewDotProductProvider()}
+	data, _ := testinghelpers.RandomVecsFixedSeed(2, 0, 150)
+	testinghelpers.Normalize(data)
+	for _, distancer := range distancers {
+		for _, bits := range []int{4, 8} {
+			rq, err := compressionhelpers.NewRotationalQuantizer(150, bits, 42, distancer)
+			require.Nil(t, err)
+
+			expected, _ := distancer.SingleDist(data[0], data[1])
+			dist, err := rq.DistanceBetweenCompressedVectors(rq.Encode(data[0]), rq.Encode(data[1]))
+			require.Nil(t, err)
+			assert.InDelta(t, expected, dist, 0.05, distancer.Type())
+
+			dist, err = rq.NewDistancer(data[0]).Distance(rq.Encode(data[1]))
+			require.Nil(t, err)
+			assert.InDelta(t, expected, dist, 0.05, distancer.Type())
+		}
+	}
+}
+
+func Test_NoRaceRandomRQDistanceFloatToByte(t *testing.T) {
+	distancers := []distancer.Provider{distancer.NewL2SquaredProvider(), distancer.NewCosineDistanceProvider(), distancer.NewDotProductProvider()}
+	vSize := 100
+	qSize := 10
+	dims := 150
+	k := 10
+	data, queries := testinghelpers.RandomVecsFixedSeed(vSize, qSize, dims)
+	testinghelpers.Normalize(data)
+	testinghelpers.Normalize(queries)
+	for _, distancer := range distancers {
+		for bits, minRecall := range map[int]float32{4: 0.8, 8: 0.95} {
+			rq, err := compressionhelpers.NewRotationalQuantizer(dims, bits, 42, distancer)
+			require.Nil(t, err)
+			neighbors := make([][]uint64, qSize)
+			for j, y := range queries {
+				neighbors[j], _ = testinghelpers.BruteForce(logrus.New(), data, y, k, distancerWrapper(distancer))
+			}
+			xCompressed := make([][]byte, vSize)
+			for i, x := range data {
+				xCompressed[i] = rq.Encode(x)
+			}
+			var relevant uint64
+			mutex := sync.Mutex{}
+			compressionhelpers.Concurrently(logger, uint64(len(queries)), func(i uint64) {
+				heap := priorityqueue.NewMax[any](k)
+				cd := rq.NewDistancer(queries[i])
+				for j := range xCompressed {
+					d, _ := cd.Distance(xCompressed[j])
+					if heap.Len() < k || heap.Top().Dist > d {
+						if heap.Len() == k {
+							heap.Pop()
+						}
+						heap.Insert(uint64(j), d)
+					}
+				}
+				results := make([]uint64, 0, k)
+				for heap.Len() > 0 {
+					results = append(results, heap.Pop().ID)
+				}
+				hits := matchesInLists(neighbors[i][:k], results)
+				mutex.Lock()
+				relevant += hits
+				mutex.Unlock()
+			})
+
+			recall := float32(relevant) / float32(k*len(queries))
+			fmt.Println(distancer.Type(), bits, recall)
+			assert.GreaterOrEqual(t, recall, minRecall, fmt.Sprintf("%s %d bits", distancer.Type(), bits))
+		}
+	}
+}
+
+func Test_NoRaceRQErrorBound(t *testing.T) {
+	dims := 256
+	data, queries := testinghelpers.RandomVecsFixedSeed(50, 5, dims)
+	rq, err := compressionhelpers.NewRotationalQuantizer(dims, 4, 42, distancer.NewDotProductProvider())
+	require.Nil(t, err)
+
+	for _, q := range queries {
+		rotated := rq.Rotate(q)
+		var l1 float64
+		for _, x := range rotated {
+			l1 += math.Abs(float64(x))
+		}
+		cd := rq.NewDistancer(q)
+		for _, x := range data {
+			rotatedX := rq.Rotate(x)
+			lower, upper := rotatedX[0], rotatedX[0]
+			for _, v := range rotatedX {
+				lower = float32(math.Min(float64(lower), float64(v)))
+				upper = float32(math.Max(float64(upper), float64(v)))
+			}
+			step := float64(upper-lower) / 15
+
+			expected, _ := distancer.NewDotProductProvider().SingleDist(q, x)
+			actual, err := cd.Distance(rq.Encode(x))
+			require.Nil(t, err)
+			assert.LessOrEqual(t, math.Abs(float64(expected-actual)), step/2*l1+1e-3)
+		}
+	}
+}
@@ -181,8 +181,8 @@ func (sq *ScalarQuantizer) FromCompressedBytes(compressed []byte) []byte {
 	return compressed
 }
 
-func (sq *ScalarQuantizer) PersistCompression(logger CommitLogger) {
-	logger.AddSQCompression(SQData{
+func (sq *ScalarQuantizer) PersistCompression(logger CommitLogger) error {
+	return logger.AddSQCompression(SQData{
 		A:          sq.a,
 		B:          sq.b,
 		Dimensions: uint16(sq.dimensions),
@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+// the cleanup checks whether it should abort after this many nodes
+const cleanupAbortCheckInterval = 1000
+
+func (d *diskANN) tombstoneCleanup(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	executed, err := d.CleanUpTombstonedNodes(shouldAbort)
+	if err != nil {
+		d.logger.WithField("action", "diskann_tombstone_cleanup").
+			WithError(err).Error("tombstone cleanup errord")
+	}
+	return executed
+}
+
+// CleanUpTombstonedNodes removes deleted nodes from the graph the way
+// FreshDiskANN consolidates deletes: every node which links to a deleted node
+// is linked to the neighbors of the deleted node instead, pruned to the
+// maximum degree. Afterwards no node links to the deleted nodes anymore and
+// their slots are reused by new nodes. Nodes which are deleted while the
+// cleanup runs are removed by the next cleanup.
+func (d *diskANN) CleanUpTombstonedNodes(shouldAbort cyclemanager.ShouldAbortCallback) (bool, error) {
+	d.cleanupLock.Lock()
+	defer d.cleanupLock.Unlock()
+
+	deleted, size := d.deletedSlots()
+	if len(deleted) == 0 {
+		return false, nil
+	}
+
+	for slot := uint64(0); slot < size; slot++ {
+		if slot%cleanupAbortCheckInterval == 0 && shouldAbort() {
+			return true, nil
+		}
+		if err := d.reassignNeighbors(slot, deleted); err != nil {
+			return true, errors.Wrapf(err, "reassign neighbors of slot %d", slot)
+		}
+	}
+
+	d.replaceDeletedEntrypoint(deleted)
+
+	for slot := range deleted {
+		d.release(slot)
+	}
+	return true, d.graph.flush()
+}
+
+// deletedSlots returns the slots of all deleted nodes and the number of used
+// slots
+func (d *diskANN) deletedSlots() (map[uint64]struct{}, uint64) {
+	d.RLock()
+	defer d.RUnlock()
+
+	deleted := map[uint64]struct{}{}
+	for slot := uint64(0); slot < d.size; slot++ {
+		if d.states[slot] == uint8(nodeDeleted) {
+			deleted[slot] = struct{}{}
+		}
+	}
+	return deleted, d.size
+}
+
+// reassignNeighbors replaces the deleted neighbors of a node by their own
+// neighbors
+func (d *diskANN) reassignNeighbors(slot uint64, deleted map[uint64]struct{}) error {
+	if d.nodeState(slot) != nodePresent {
+		return nil
+	}
+
+	d.nodeLocks.Lock(slot)
+	defer d.nodeLocks.Unlock(slot)
+
+	state, _, edges, vector := d.graph.readNode(slot, nil, nil)
+	if state != nodePresent {
+		return nil
+	}
+
+	affected := false
+	for _, edge := range edges {
+		if _, ok := deleted[edge]; ok {
+			affected = true
+			break
+		}
+	}
+	if !affected {
+		return nil
+	}
+
+	seen := map[uint64]struct{}{slot: {}}
+	candidateEdges := make([]uint64, 0, len(edges))
+	var deletedEdges []uint64
+	add := func(edge uint64) {
+		if _, ok := seen[edge]; ok {
+			return
+		}
+		seen[edge] = struct{}{}
+		if _, ok := deleted[edge]; !ok {
+			candidateEdges = append(candidateEdges, edge)
+		}
+	}
+	for _, edge := range edges {
+		add(edge)
+	}
+	for _, edge := range edges {
+		if _, ok := deleted[edge]; !ok {
+			continue
+		}
+		// the record is read as a whole, so the neighbors of the deleted node
+		// are consistent without holding its lock
+		_, _, deletedEdges, _ = d.graph.readNode(edge, deletedEdges, nil)
+		for _, deletedEdge := range deletedEdges {
+			add(deletedEdge)
+		}
+	}
+
+	candidates, err := d.edgeCandidates(vector, candidateEdges, nil)
+	if err != nil {
+		return err
+	}
+	neighbors, err := d.robustPrune(slot, candidates)
+	if err != nil {
+		return err
+	}
+	d.graph.writeNeighbors(slot, state, candidateSlots(neighbors))
+	return nil
+}
+
+// replaceDeletedEntrypoint picks the first present node as the new
+// entrypoint if the entrypoint is about to be removed
+func (d *diskANN) replaceDeletedEntrypoint(deleted map[uint64]struct{}) {
+	d.Lock()
+	defer d.Unlock()
+
+	if _, ok := deleted[d.entrypoint]; !ok || !d.hasEntrypoint {
+		return
+	}
+
+	d.hasEntrypoint = false
+	for slot := uint64(0); slot < d.size; slot++ {
+		if d.states[slot] == uint8(nodePresent) {
+			d.entrypoint = slot
+			d.hasEntrypoint = true
+			break
+		}
+	}
+	d.writeHeaderLocked()
+}
+
+// release marks the slot of a removed node as absent, so that it can be
+// reused by a new node
+func (d *diskANN) release(slot uint64) {
+	d.nodeLocks.Lock(slot)
+	defer d.nodeLocks.Unlock(slot)
+
+	d.graph.writeNeighbors(slot, nodeAbsent, nil)
+
+	d.Lock()
+	defer d.Unlock()
+
+	d.states[slot] = uint8(nodeAbsent)
+	d.free = append(d.free, slot)
+}
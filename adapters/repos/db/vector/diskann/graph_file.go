@@ -0,0 +1,599 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/edsrzf/mmap-go"
+)
+
+const (
+	// node records never straddle a page boundary unless a single record is
+	// larger than a page, so that visiting a node costs a single page read
+	pageSize = 4096
+
+	graphFileMagic   uint32 = 0x4e4e4144 // "DANN"
+	graphFileVersion uint32 = 2
+
+	graphHeaderSize = 37
+
+	// every node record starts with its state, its degree and the doc id of
+	// the object it belongs to, followed by maxEdges neighbor slots and the
+	// full vector
+	nodeHeaderSize = 16
+
+	// a file never grows by more than this at once, so that doubling a very
+	// large file does not reserve terabytes of address space
+	maxGrowth = 1 << 30
+
+	// records are kept in memory until they are flushed, an insert flushes
+	// them itself if a batch writes more than this
+	maxUnflushedSize = 64 << 20
+
+	// the graph file is synced and the write-ahead log truncated once the log
+	// exceeds this size
+	maxLogSize = 256 << 20
+)
+
+const (
+	nodeAbsent uint32 = iota
+	nodePresent
+	nodeDeleted
+)
+
+// graphHeader is stored in the first page of the graph file. The layout of
+// the node records is only known once the dimensions of the first vector are
+// known.
+type graphHeader struct {
+	dims          uint32
+	maxDegree     uint32
+	bits          uint32
+	seed          uint64
+	entrypoint    uint64
+	hasEntrypoint bool
+}
+
+// graphFile stores the adjacency lists and full vectors in fixed-size node
+// records, which are addressed by slot. Reads go through a read-only memory
+// mapping, so hot pages are served from the page cache. The mapping is
+// replaced whenever the file grows.
+//
+// Writes are collected in memory until they are flushed. A flush first
+// appends the records to a write-ahead log and syncs it, and only then writes
+// them to the graph file. A crash can therefore never leave a partially
+// written record behind: the records of the last flush are restored from the
+// log on startup, all later changes are lost as a whole.
+type graphFile struct {
+	sync.RWMutex
+
+	path string
+	file *os.File
+	data mmap.MMap
+	log  *writeAheadLog
+
+	// records which have not been flushed yet, by their offset. Records which
+	// are being flushed are immutable and stay readable until they have been
+	// written to the graph file.
+	unflushed     map[int64][]byte
+	unflushedSize int
+	flushing      map[int64][]byte
+	flushLock     sync.Mutex
+
+	dims           int
+	maxEdges       int
+	recordSize     int
+	recordsPerPage int
+	pagesPerRecord int
+}
+
+func openGraphFile(path string) (*graphFile, graphHeader, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if err := createGraphFile(path); err != nil {
+			return nil, graphHeader{}, err
+		}
+	}
+
+	file, err := os.OpenFile(path, os.O_RDWR, 0o666)
+	if err != nil {
+		return nil, graphHeader{}, fmt.Errorf("open graph file: %w", err)
+	}
+
+	g := &graphFile{path: path, file: file, unflushed: map[int64][]byte{}}
+	g.log, err = openWriteAheadLog(path + ".wal")
+	if err != nil {
+		file.Close()
+		return nil, graphHeader{}, err
+	}
+
+	// restore the records of the last flush, which may not have made it
+	// into the graph file before a crash
+	if err := g.log.replay(file); err != nil {
+		g.log.close()
+		file.Close()
+		return nil, graphHeader{}, err
+	}
+
+	if err := g.remap(); err != nil {
+		g.log.close()
+		file.Close()
+		return nil, graphHeader{}, err
+	}
+
+	header, err := g.readHeader()
+	if err != nil {
+		g.close()
+		return nil, graphHeader{}, err
+	}
+	return g, header, nil
+}
+
+// createGraphFile writes the header of an empty graph file. The file is
+// written under a temporary name first, so that a crash can't leave a graph
+// file without a header behind.
+func createGraphFile(path string) error {
+	tmpPath := path + ".tmp"
+	file, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return fmt.Errorf("create graph file: %w", err)
+	}
+	defer file.Close()
+
+	page := make([]byte, pageSize)
+	copy(page, encodeHeader(graphHeader{}))
+	if _, err := file.Write(page); err != nil {
+		return fmt.Errorf("write graph file header: %w", err)
+	}
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("sync graph file: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("create graph file: %w", err)
+	}
+	return nil
+}
+
+// setLayout derives the size of the node records, it needs to be called
+// before any node is read or written
+func (g *graphFile) setLayout(dims, maxEdges int) {
+	g.dims = dims
+	g.maxEdges = maxEdges
+	g.recordSize = nodeHeaderSize + 8*maxEdges + 4*dims
+	if g.recordSize <= pageSize {
+		g.recordsPerPage = pageSize / g.recordSize
+		g.pagesPerRecord = 1
+	} else {
+		g.recordsPerPage = 1
+		g.pagesPerRecord = (g.recordSize + pageSize - 1) / pageSize
+	}
+}
+
+func (g *graphFile) offset(slot uint64) int64 {
+	// the first page holds the header
+	page := 1 + int64(slot/uint64(g.recordsPerPage))*int64(g.pagesPerRecord)
+	return page*pageSize + int64(slot%uint64(g.recordsPerPage))*int64(g.recordSize)
+}
+
+// capacity returns the number of node records covered by the file
+func (g *graphFile) capacity() uint64 {
+	g.RLock()
+	defer g.RUnlock()
+
+	pages := uint64(len(g.data)/pageSize) - 1
+	return pages / uint64(g.pagesPerRecord) * uint64(g.recordsPerPage)
+}
+
+// grow makes sure the file is large enough to hold the record of the given
+// slot
+func (g *graphFile) grow(slot uint64) error {
+	needed := g.offset(slot) + int64(g.recordSize)
+
+	g.RLock()
+	size := int64(len(g.data))
+	g.RUnlock()
+	if needed <= size {
+		return nil
+	}
+
+	g.Lock()
+	defer g.Unlock()
+
+	size = int64(len(g.data))
+	if needed <= size {
+		return nil
+	}
+
+	newSize := size + min(size, maxGrowth)
+	if newSize < needed {
+		newSize = needed
+	}
+	// round up to full pages
+	newSize = (newSize + pageSize - 1) / pageSize * pageSize
+	if err := g.file.Truncate(newSize); err != nil {
+		return fmt.Errorf("grow graph file: %w", err)
+	}
+	return g.remapLocked()
+}
+
+func (g *graphFile) remap() error {
+	g.Lock()
+	defer g.Unlock()
+
+	return g.remapLocked()
+}
+
+func (g *graphFile) remapLocked() error {
+	if g.data != nil {
+		if err := g.data.Unmap(); err != nil {
+			return fmt.Errorf("unmap graph file: %w", err)
+		}
+		g.data = nil
+	}
+
+	info, err := g.file.Stat()
+	if err != nil {
+		return fmt.Errorf("stat graph file: %w", err)
+	}
+	data, err := mmap.MapRegion(g.file, int(info.Size()), mmap.RDONLY, 0, 0)
+	if err != nil {
+		return fmt.Errorf("mmap graph file: %w", err)
+	}
+	g.data = data
+	return nil
+}
+
+func (g *graphFile) readHeader() (graphHeader, error) {
+	g.RLock()
+	defer g.RUnlock()
+
+	if len(g.data) < pageSize {
+		return graphHeader{}, errors.New("graph file is truncated")
+	}
+	buf := g.data[:graphHeaderSize]
+	if binary.LittleEndian.Uint32(buf[0:4]) != graphFileMagic {
+		return graphHeader{}, errors.New("not a diskann graph file")
+	}
+	if version := binary.LittleEndian.Uint32(buf[4:8]); version != graphFileVersion {
+		return graphHeader{}, fmt.Errorf("unsupported graph file version %d", version)
+	}
+
+	return graphHeader{
+		dims:          binary.LittleEndian.Uint32(buf[8:12]),
+		maxDegree:     binary.LittleEndian.Uint32(buf[12:16]),
+		bits:          binary.LittleEndian.Uint32(buf[16:20]),
+		seed:          binary.LittleEndian.Uint64(buf[20:28]),
+		entrypoint:    binary.LittleEndian.Uint64(buf[28:36]),
+		hasEntrypoint: buf[36] == 1,
+	}, nil
+}
+
+func encodeHeader(h graphHeader) []byte {
+	buf := make([]byte, graphHeaderSize)
+	binary.LittleEndian.PutUint32(buf[0:4], graphFileMagic)
+	binary.LittleEndian.PutUint32(buf[4:8], graphFileVersion)
+	binary.LittleEndian.PutUint32(buf[8:12], h.dims)
+	binary.LittleEndian.PutUint32(buf[12:16], h.maxDegree)
+	binary.LittleEndian.PutUint32(buf[16:20], h.bits)
+	binary.LittleEndian.PutUint64(buf[20:28], h.seed)
+	binary.LittleEndian.PutUint64(buf[28:36], h.entrypoint)
+	if h.hasEntrypoint {
+		buf[36] = 1
+	}
+	return buf
+}
+
+// writeHeader replaces the header, it is written to disk with the next flush
+func (g *graphFile) writeHeader(h graphHeader) {
+	g.Lock()
+	defer g.Unlock()
+
+	if _, ok := g.unflushed[0]; !ok {
+		g.unflushedSize += graphHeaderSize
+	}
+	g.unflushed[0] = encodeHeader(h)
+}
+
+// recordLocked returns the current content of the record at the given
+// offset, or nil if the record is outside of the file. The caller must hold
+// the lock and must not modify the record.
+func (g *graphFile) recordLocked(offset int64) []byte {
+	if record, ok := g.unflushed[offset]; ok {
+		return record
+	}
+	if record, ok := g.flushing[offset]; ok {
+		return record
+	}
+	if offset+int64(g.recordSize) > int64(len(g.data)) {
+		return nil
+	}
+	return g.data[offset : offset+int64(g.recordSize)]
+}
+
+// updateRecord applies the given change to a copy of the record of a slot,
+// which replaces the record with the next flush
+func (g *graphFile) updateRecord(slot uint64, update func(record []byte)) {
+	offset := g.offset(slot)
+
+	g.Lock()
+	defer g.Unlock()
+
+	record, ok := g.unflushed[offset]
+	if !ok {
+		// flushing records are read concurrently, so they are copied rather
+		// than changed in place
+		record = make([]byte, g.recordSize)
+		copy(record, g.recordLocked(offset))
+		g.unflushed[offset] = record
+		g.unflushedSize += len(record)
+	}
+	update(record)
+}
+
+// needsFlush returns true if there are enough unflushed records to flush
+// them without waiting for the end of the batch
+func (g *graphFile) needsFlush() bool {
+	g.RLock()
+	defer g.RUnlock()
+
+	return g.unflushedSize >= maxUnflushedSize
+}
+
+// readNode returns the state, the doc id, the neighbors and the full vector
+// of a node. The given slices are reused if they are large enough. Slots
+// outside of the file are reported as absent.
+func (g *graphFile) readNode(slot uint64, neighbors []uint64, vector []float32,
+) (uint32, uint64, []uint64, []float32) {
+	g.RLock()
+	defer g.RUnlock()
+
+	record := g.recordLocked(g.offset(slot))
+	if record == nil {
+		return nodeAbsent, 0, neighbors[:0], vector[:0]
+	}
+
+	state := binary.LittleEndian.Uint32(record[0:4])
+	degree := int(binary.LittleEndian.Uint32(record[4:8]))
+	if degree > g.maxEdges {
+		degree = g.maxEdges
+	}
+	docID := binary.LittleEndian.Uint64(record[8:16])
+
+	neighbors = neighbors[:0]
+	for i := 0; i < degree; i++ {
+		pos := nodeHeaderSize + 8*i
+		neighbors = append(neighbors, binary.LittleEndian.Uint64(record[pos:pos+8]))
+	}
+
+	return state, docID, neighbors, g.decodeVector(record, vector)
+}
+
+// readMeta returns the state and the doc id of a node
+func (g *graphFile) readMeta(slot uint64) (uint32, uint64) {
+	g.RLock()
+	defer g.RUnlock()
+
+	record := g.recordLocked(g.offset(slot))
+	if record == nil {
+		return nodeAbsent, 0
+	}
+	return binary.LittleEndian.Uint32(record[0:4]), binary.LittleEndian.Uint64(record[8:16])
+}
+
+// readVector returns the full vector of a node without its neighbors
+func (g *graphFile) readVector(slot uint64, vector []float32) (uint32, []float32) {
+	g.RLock()
+	defer g.RUnlock()
+
+	record := g.recordLocked(g.offset(slot))
+	if record == nil {
+		return nodeAbsent, vector[:0]
+	}
+	return binary.LittleEndian.Uint32(record[0:4]), g.decodeVector(record, vector)
+}
+
+func (g *graphFile) decodeVector(record []byte, vector []float32) []float32 {
+	vector = vector[:0]
+	start := nodeHeaderSize + 8*g.maxEdges
+	for i := 0; i < g.dims; i++ {
+		pos := start + 4*i
+		vector = append(vector, math.Float32frombits(binary.LittleEndian.Uint32(record[pos:pos+4])))
+	}
+	return vector
+}
+
+// writeNode replaces the complete record of a slot
+func (g *graphFile) writeNode(slot uint64, state uint32, docID uint64, neighbors []uint64,
+	vector []float32,
+) error {
+	if err := g.grow(slot); err != nil {
+		return err
+	}
+
+	g.updateRecord(slot, func(record []byte) {
+		clear(record)
+		encodeNeighbors(record, state, neighbors)
+		binary.LittleEndian.PutUint64(record[8:16], docID)
+		start := nodeHeaderSize + 8*g.maxEdges
+		for i, v := range vector {
+			binary.LittleEndian.PutUint32(record[start+4*i:], math.Float32bits(v))
+		}
+	})
+	return nil
+}
+
+// writeNeighbors replaces the state and adjacency list of a node, but leaves
+// its doc id and vector untouched
+func (g *graphFile) writeNeighbors(slot uint64, state uint32, neighbors []uint64) {
+	g.updateRecord(slot, func(record []byte) {
+		encodeNeighbors(record, state, neighbors)
+	})
+}
+
+func (g *graphFile) writeState(slot uint64, state uint32) {
+	g.updateRecord(slot, func(record []byte) {
+		binary.LittleEndian.PutUint32(record[0:4], state)
+	})
+}
+
+func encodeNeighbors(buf []byte, state uint32, neighbors []uint64) {
+	binary.LittleEndian.PutUint32(buf[0:4], state)
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(len(neighbors)))
+	for i, n := range neighbors {
+		binary.LittleEndian.PutUint64(buf[nodeHeaderSize+8*i:], n)
+	}
+}
+
+// flush persists all records which have been written since the last flush,
+// first in the write-ahead log and then in the graph file
+func (g *graphFile) flush() error {
+	g.flushLock.Lock()
+	defer g.flushLock.Unlock()
+
+	g.Lock()
+	if len(g.unflushed) == 0 {
+		g.Unlock()
+		return nil
+	}
+	flushing := g.unflushed
+	g.flushing = flushing
+	g.unflushed = map[int64][]byte{}
+	g.unflushedSize = 0
+	g.Unlock()
+
+	offsets := make([]int64, 0, len(flushing))
+	for offset := range flushing {
+		offsets = append(offsets, offset)
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+
+	if err := g.log.append(offsets, flushing); err != nil {
+		g.restoreUnflushed(flushing)
+		return err
+	}
+
+	// once the records are in the log they are safe, even if they are only
+	// partially written to the graph file
+	for _, offset := range offsets {
+		if _, err := g.file.WriteAt(flushing[offset], offset); err != nil {
+			g.restoreUnflushed(flushing)
+			return fmt.Errorf("write graph file: %w", err)
+		}
+	}
+
+	g.Lock()
+	g.flushing = nil
+	g.Unlock()
+
+	if g.log.size() >= maxLogSize {
+		return g.checkpointLocked()
+	}
+	return nil
+}
+
+// restoreUnflushed puts the records of a failed flush back, unless they have
+// been changed again in the meantime
+func (g *graphFile) restoreUnflushed(flushing map[int64][]byte) {
+	g.Lock()
+	defer g.Unlock()
+
+	for offset, record := range flushing {
+		if _, ok := g.unflushed[offset]; !ok {
+			g.unflushed[offset] = record
+			g.unflushedSize += len(record)
+		}
+	}
+	g.flushing = nil
+}
+
+// checkpoint flushes all records and syncs the graph file, after which the
+// write-ahead log is no longer needed
+func (g *graphFile) checkpoint() error {
+	if err := g.flush(); err != nil {
+		return err
+	}
+
+	g.flushLock.Lock()
+	defer g.flushLock.Unlock()
+
+	return g.checkpointLocked()
+}
+
+// backup checkpoints the graph file and copies it to path. Flushes are
+// blocked while the file is copied, so the copy holds exactly the records of
+// all completed flushes and needs neither the write-ahead log nor a snapshot
+// to be restored.
+func (g *graphFile) backup(path string) error {
+	if err := g.flush(); err != nil {
+		return err
+	}
+
+	g.flushLock.Lock()
+	defer g.flushLock.Unlock()
+
+	if err := g.checkpointLocked(); err != nil {
+		return err
+	}
+
+	src, err := os.Open(g.path)
+	if err != nil {
+		return fmt.Errorf("open graph file: %w", err)
+	}
+	defer src.Close()
+
+	tmpPath := path + ".tmp"
+	dst, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return fmt.Errorf("create graph file backup: %w", err)
+	}
+	defer dst.Close()
+
+	if _, err := io.Copy(dst, src); err != nil {
+		return fmt.Errorf("copy graph file: %w", err)
+	}
+	if err := dst.Sync(); err != nil {
+		return fmt.Errorf("sync graph file backup: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("create graph file backup: %w", err)
+	}
+	return nil
+}
+
+func (g *graphFile) checkpointLocked() error {
+	if err := g.file.Sync(); err != nil {
+		return fmt.Errorf("sync graph file: %w", err)
+	}
+	return g.log.truncate()
+}
+
+func (g *graphFile) close() error {
+	g.Lock()
+	defer g.Unlock()
+
+	if g.data != nil {
+		if err := g.data.Unmap(); err != nil {
+			return fmt.Errorf("unmap graph file: %w", err)
+		}
+		g.data = nil
+	}
+	if err := g.log.close(); err != nil {
+		return err
+	}
+	return g.file.Close()
+}
@@ -0,0 +1,549 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+const (
+	// the compressed vectors and node states grow by at least this many nodes
+	minGrowth = 1024
+
+	// Nodes can hold 30% more edges than the maximum degree. Their neighbors
+	// are only pruned back to the maximum degree once this is exceeded, which
+	// spreads the cost of pruning over several inserts.
+	degreeSlackPercent = 30
+)
+
+// diskANN is a graph index in the style of DiskANN. The graph is built with
+// the Vamana algorithm and is stored on disk together with the full vectors,
+// only the compressed vectors are kept in memory. A search navigates the
+// graph using the compressed vectors and rescores every visited node with its
+// full vector, which is read from the same page as its adjacency list.
+//
+// The records of the graph file are addressed by slot rather than by doc id,
+// so that the slots of deleted nodes can be reused. Deleted nodes are first
+// only marked as such. They are still used to navigate the graph, but are no
+// longer returned and no longer linked to new nodes, until the tombstone
+// cleanup removes them from the graph and frees their slots.
+type diskANN struct {
+	// protects the compressed vectors, the slots, the node states and the
+	// entrypoint
+	sync.RWMutex
+
+	id                string
+	targetVector      string
+	rootPath          string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	allocChecker      memwatch.AllocChecker
+
+	maxDegree     int
+	buildListSize int
+	alpha         float32
+	bits          int
+
+	// read on every search, so they are stored atomically rather than
+	// behind a lock
+	searchListSize   int64
+	flatSearchCutoff int64
+
+	graph     *graphFile
+	nodeLocks *common.ShardedRWLocks
+
+	// only one cleanup of deleted nodes runs at a time
+	cleanupLock                  sync.Mutex
+	tombstoneCleanupCallbackCtrl cyclemanager.CycleCallbackCtrl
+
+	// the following are only set once the first vector has been added
+	dims     int
+	seed     uint64
+	rq       *compressionhelpers.RotationalQuantizer
+	codeSize int
+
+	// the compressed vectors, node states and doc ids by slot
+	codes  []byte
+	states []uint8
+	docIDs []uint64
+	// the slots of the present nodes by their doc id
+	slots map[uint64]uint64
+	// slots of removed nodes, which are reused before new slots are used
+	free []uint64
+	// all slots below this have been used
+	size uint64
+
+	entrypoint    uint64
+	hasEntrypoint bool
+	count         int64
+}
+
+func New(cfg Config, uc ent.UserConfig) (*diskANN, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		l := logrus.New()
+		l.Out = io.Discard
+		logger = l
+	}
+
+	index := &diskANN{
+		id:                cfg.ID,
+		targetVector:      cfg.TargetVector,
+		rootPath:          cfg.RootPath,
+		logger:            logger,
+		distancerProvider: cfg.DistanceProvider,
+		allocChecker:      cfg.AllocChecker,
+		maxDegree:         uc.MaxDegree,
+		buildListSize:     uc.BuildListSize,
+		alpha:             float32(uc.Alpha),
+		bits:              uc.RQ.Bits,
+		searchListSize:    int64(uc.SearchListSize),
+		flatSearchCutoff:  int64(uc.FlatSearchCutoff),
+		nodeLocks:         common.NewDefaultShardedRWLocks(),
+		slots:             map[uint64]uint64{},
+	}
+
+	if err := index.restoreBackup(); err != nil {
+		return nil, errors.Wrapf(err, "init diskann index %q", cfg.ID)
+	}
+	graph, header, err := openGraphFile(index.graphPath())
+	if err != nil {
+		return nil, errors.Wrapf(err, "init diskann index %q", cfg.ID)
+	}
+	index.graph = graph
+
+	if header.dims > 0 {
+		if err := index.restore(header); err != nil {
+			graph.close()
+			return nil, errors.Wrapf(err, "restore diskann index %q", cfg.ID)
+		}
+	}
+
+	tombstoneCallbacks := cfg.TombstoneCallbacks
+	if tombstoneCallbacks == nil {
+		tombstoneCallbacks = cyclemanager.NewCallbackGroupNoop()
+	}
+	id := strings.Join([]string{"diskann", "tombstone_cleanup", cfg.ID}, "/")
+	index.tombstoneCleanupCallbackCtrl = tombstoneCallbacks.Register(id, index.tombstoneCleanup)
+
+	return index, nil
+}
+
+func (d *diskANN) graphPath() string {
+	return filepath.Join(d.rootPath, fmt.Sprintf("%s.diskann.graph", d.id))
+}
+
+func (d *diskANN) walPath() string {
+	return d.graphPath() + ".wal"
+}
+
+func (d *diskANN) snapshotPath() string {
+	return filepath.Join(d.rootPath, fmt.Sprintf("%s.diskann.codes", d.id))
+}
+
+func (d *diskANN) backupPath() string {
+	return d.graphPath() + ".backup"
+}
+
+// restoreBackup turns the copy of the graph file made for a backup into the
+// graph file if the index is restored from a backup. Otherwise the copy is
+// outdated and removed.
+func (d *diskANN) restoreBackup() error {
+	if _, err := os.Stat(d.backupPath()); os.IsNotExist(err) {
+		return nil
+	}
+	if _, err := os.Stat(d.graphPath()); err == nil {
+		return errors.Wrap(os.Remove(d.backupPath()), "remove graph file backup")
+	}
+	return errors.Wrap(os.Rename(d.backupPath(), d.graphPath()), "restore graph file backup")
+}
+
+// initLayout sets up the quantizer and the layout of the graph file once the
+// dimensions are known, the caller must hold the lock
+func (d *diskANN) initLayout(dims int, seed uint64) error {
+	rq, err := compressionhelpers.NewRotationalQuantizer(dims, d.bits, seed, d.distancerProvider)
+	if err != nil {
+		return err
+	}
+
+	d.dims = dims
+	d.seed = seed
+	d.rq = rq
+	d.codeSize = len(rq.Encode(make([]float32, dims)))
+	d.graph.setLayout(dims, d.maxEdges())
+	return nil
+}
+
+// init is called on every insert and sets up the index for the dimensions of
+// the first vector
+func (d *diskANN) init(dims int) error {
+	d.RLock()
+	initialized := d.dims > 0
+	d.RUnlock()
+	if initialized {
+		return nil
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	if d.dims > 0 {
+		return nil
+	}
+	// the rotation is derived from the seed, which is persisted in the
+	// header of the graph file to restore the same quantizer on startup
+	if err := d.initLayout(dims, rand.Uint64()); err != nil {
+		return err
+	}
+	d.writeHeaderLocked()
+	return nil
+}
+
+func (d *diskANN) maxEdges() int {
+	return d.maxDegree + d.maxDegree*degreeSlackPercent/100
+}
+
+func (d *diskANN) writeHeaderLocked() {
+	d.graph.writeHeader(graphHeader{
+		dims:          uint32(d.dims),
+		maxDegree:     uint32(d.maxDegree),
+		bits:          uint32(d.bits),
+		seed:          d.seed,
+		entrypoint:    d.entrypoint,
+		hasEntrypoint: d.hasEntrypoint,
+	})
+}
+
+// restore rebuilds the in-memory part of the index. After a clean shutdown
+// it is loaded from the snapshot written on shutdown, otherwise the
+// compressed vectors are encoded from the full vectors again.
+func (d *diskANN) restore(header graphHeader) error {
+	if int(header.maxDegree) != d.maxDegree {
+		return errors.Errorf("graph file was built with maxDegree %d, but the config has %d",
+			header.maxDegree, d.maxDegree)
+	}
+	if int(header.bits) != d.bits {
+		return errors.Errorf("graph file was built with %d rq bits, but the config has %d",
+			header.bits, d.bits)
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	if err := d.initLayout(int(header.dims), header.seed); err != nil {
+		return err
+	}
+	d.entrypoint = header.entrypoint
+	d.hasEntrypoint = header.hasEntrypoint
+
+	loaded, err := d.loadSnapshotLocked()
+	if err != nil {
+		return err
+	}
+	if !loaded {
+		if err := d.restoreFromGraphLocked(); err != nil {
+			return err
+		}
+	}
+
+	for slot := uint64(0); slot < d.size; slot++ {
+		switch uint32(d.states[slot]) {
+		case nodeAbsent:
+			d.free = append(d.free, slot)
+		case nodePresent:
+			d.slots[d.docIDs[slot]] = slot
+			d.count++
+		}
+	}
+	return nil
+}
+
+// restoreFromGraphLocked reads the state and doc id of every node from the
+// graph file and encodes its full vector
+func (d *diskANN) restoreFromGraphLocked() error {
+	capacity := d.graph.capacity()
+	var size uint64
+	for slot := uint64(0); slot < capacity; slot++ {
+		if state, _ := d.graph.readMeta(slot); state != nodeAbsent {
+			size = slot + 1
+		}
+	}
+	if err := d.growLocked(size); err != nil {
+		return err
+	}
+	d.size = size
+
+	compressionhelpers.Concurrently(d.logger, size, func(slot uint64) {
+		state, docID, _, vector := d.graph.readNode(slot, nil, nil)
+		if state == nodeAbsent {
+			return
+		}
+		// deleted nodes still need their compressed vector to navigate
+		copy(d.codes[slot*uint64(d.codeSize):], d.rq.Encode(vector))
+		d.states[slot] = uint8(state)
+		d.docIDs[slot] = docID
+	})
+	return nil
+}
+
+// allocateSlot returns the slot for the record of a doc id. A doc id which is
+// already present keeps its slot, otherwise a free slot is reused or a new
+// one is used.
+func (d *diskANN) allocateSlot(docID uint64) (uint64, error) {
+	d.Lock()
+	slot, ok := d.slots[docID]
+	if !ok {
+		if n := len(d.free); n > 0 {
+			slot = d.free[n-1]
+			d.free = d.free[:n-1]
+		} else {
+			slot = d.size
+			if err := d.growLocked(slot + 1); err != nil {
+				d.Unlock()
+				return 0, err
+			}
+			d.size++
+		}
+		d.slots[docID] = slot
+		d.docIDs[slot] = docID
+	}
+	d.Unlock()
+
+	return slot, d.graph.grow(slot)
+}
+
+func (d *diskANN) growLocked(size uint64) error {
+	current := uint64(len(d.states))
+	if size <= current {
+		return nil
+	}
+
+	newSize := max(size, current+current/4, minGrowth)
+	if d.allocChecker != nil {
+		// allocChecker is optional, we can only check if it was actually set
+		memoryNeeded := int64(newSize-current) * int64(d.codeSize+9)
+		if err := d.allocChecker.CheckAlloc(memoryNeeded); err != nil {
+			return errors.Wrap(err, "grow diskann index")
+		}
+	}
+
+	codes := make([]byte, newSize*uint64(d.codeSize))
+	copy(codes, d.codes)
+	states := make([]uint8, newSize)
+	copy(states, d.states)
+	docIDs := make([]uint64, newSize)
+	copy(docIDs, d.docIDs)
+	d.codes = codes
+	d.states = states
+	d.docIDs = docIDs
+	return nil
+}
+
+func (d *diskANN) setCode(slot uint64, code []byte) {
+	d.RLock()
+	defer d.RUnlock()
+
+	copy(d.codes[slot*uint64(d.codeSize):], code)
+}
+
+func (d *diskANN) nodeState(slot uint64) uint32 {
+	d.RLock()
+	defer d.RUnlock()
+
+	if slot >= uint64(len(d.states)) {
+		return nodeAbsent
+	}
+	return uint32(d.states[slot])
+}
+
+// setState updates the in-memory state of a node and returns the previous one
+func (d *diskANN) setState(slot uint64, state uint32) uint32 {
+	d.Lock()
+	defer d.Unlock()
+
+	previous := uint32(d.states[slot])
+	d.states[slot] = uint8(state)
+	return previous
+}
+
+// presentSlot returns the slot of a doc id if it is present in the index
+func (d *diskANN) presentSlot(docID uint64) (uint64, bool) {
+	d.RLock()
+	defer d.RUnlock()
+
+	slot, ok := d.slots[docID]
+	if !ok || d.states[slot] != uint8(nodePresent) {
+		return 0, false
+	}
+	return slot, true
+}
+
+// isPresent returns true if the slot still holds the given present doc id,
+// slots may be reused while a search is running
+func (d *diskANN) isPresent(slot, docID uint64) bool {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.states[slot] == uint8(nodePresent) && d.docIDs[slot] == docID
+}
+
+func (d *diskANN) normalized(vector []float32) []float32 {
+	if d.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		return distancer.Normalize(vector)
+	}
+	return vector
+}
+
+func (d *diskANN) ValidateBeforeInsert(vector []float32) error {
+	d.RLock()
+	dims := d.dims
+	d.RUnlock()
+
+	// no vectors exist
+	if dims == 0 {
+		return nil
+	}
+
+	// check if vector length is the same as existing nodes
+	if dims != len(vector) {
+		return fmt.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), dims)
+	}
+
+	return nil
+}
+
+func (d *diskANN) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error {
+	parsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		callback()
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	// Store atomically as a lock here would be very expensive, these values are
+	// read on every single user-facing search, which can be highly concurrent
+	atomic.StoreInt64(&d.searchListSize, int64(parsed.SearchListSize))
+	atomic.StoreInt64(&d.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
+
+	callback()
+	return nil
+}
+
+func (d *diskANN) Drop(ctx context.Context) error {
+	if err := d.tombstoneCleanupCallbackCtrl.Unregister(ctx); err != nil {
+		return errors.Wrap(err, "diskann drop")
+	}
+	if err := d.graph.close(); err != nil {
+		return err
+	}
+	for _, path := range []string{d.graphPath(), d.walPath(), d.snapshotPath(), d.backupPath()} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "remove %s", filepath.Base(path))
+		}
+	}
+	return nil
+}
+
+func (d *diskANN) Flush() error {
+	return d.graph.flush()
+}
+
+func (d *diskANN) Shutdown(ctx context.Context) error {
+	if err := d.tombstoneCleanupCallbackCtrl.Unregister(ctx); err != nil {
+		return errors.Wrap(err, "diskann shutdown")
+	}
+	if err := d.graph.checkpoint(); err != nil {
+		return err
+	}
+	if err := d.writeSnapshot(); err != nil {
+		return err
+	}
+	return d.graph.close()
+}
+
+// SwitchCommitLogs prepares a backup. The graph file is written in place, so
+// it is copied while flushes are blocked and the copy is backed up instead.
+func (d *diskANN) SwitchCommitLogs(context.Context) error {
+	return d.graph.backup(d.backupPath())
+}
+
+// ListFiles lists the copy of the graph file made by SwitchCommitLogs. It
+// holds all nodes and is restored without the write-ahead log, which is empty
+// after the checkpoint, and without the snapshot, which only exists after a
+// shutdown: the compressed vectors are encoded from the graph file again.
+func (d *diskANN) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	rel, err := filepath.Rel(basePath, d.backupPath())
+	if err != nil {
+		return nil, errors.Wrap(err, "list files of diskann index")
+	}
+	return []string{rel}, nil
+}
+
+func (d *diskANN) PostStartup() {
+	// the in-memory part of the index is restored in New
+}
+
+func (d *diskANN) Compressed() bool {
+	return true
+}
+
+func (d *diskANN) Dump(labels ...string) {
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", strings.Join(labels, ", "))
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", d.id)
+	fmt.Printf("Nodes: %d\n", atomic.LoadInt64(&d.count))
+	fmt.Printf("--------------------------------------------------\n")
+}
+
+func (d *diskANN) DistanceBetweenVectors(x, y []float32) (float32, error) {
+	return d.distancerProvider.SingleDist(x, y)
+}
+
+func (d *diskANN) ContainsNode(id uint64) bool {
+	_, ok := d.presentSlot(id)
+	return ok
+}
+
+func (d *diskANN) AlreadyIndexed() uint64 {
+	return uint64(atomic.LoadInt64(&d.count))
+}
+
+func (d *diskANN) DistancerProvider() distancer.Provider {
+	return d.distancerProvider
+}
@@ -0,0 +1,365 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+)
+
+func distanceWrapper(provider distancer.Provider) func(x, y []float32) float32 {
+	return func(x, y []float32) float32 {
+		dist, _ := provider.SingleDist(x, y)
+		return dist
+	}
+}
+
+func newTestIndex(t *testing.T, rootPath string, provider distancer.Provider) *diskANN {
+	logger, _ := test.NewNullLogger()
+	index, err := New(Config{
+		ID:               "diskann-test",
+		RootPath:         rootPath,
+		Logger:           logger,
+		DistanceProvider: provider,
+	}, ent.NewDefaultUserConfig())
+	require.Nil(t, err)
+	return index
+}
+
+func TestDiskANNDeleteAndFilter(t *testing.T) {
+	dimensions := 32
+	vectorsSize := 2000
+	vectors, _ := testinghelpers.RandomVecs(vectorsSize, 0, dimensions)
+	provider := distancer.NewL2SquaredProvider()
+
+	index := newTestIndex(t, t.TempDir(), provider)
+	defer index.Shutdown(context.Background())
+	require.Nil(t, index.AddBatch(context.Background(), idRange(vectorsSize), vectors))
+
+	t.Run("deleted nodes are not returned", func(t *testing.T) {
+		require.Nil(t, index.Delete(7))
+
+		ids, _, err := index.SearchByVector(vectors[7], 5, nil)
+		require.Nil(t, err)
+		assert.NotContains(t, ids, uint64(7))
+		assert.False(t, index.ContainsNode(7))
+		assert.Equal(t, uint64(vectorsSize-1), index.AlreadyIndexed())
+	})
+
+	t.Run("nodes are still found after deleting their neighbors", func(t *testing.T) {
+		for id := uint64(100); id < 200; id += 2 {
+			require.Nil(t, index.Delete(id))
+		}
+
+		for id := uint64(101); id < 200; id += 2 {
+			ids, _, err := index.SearchByVector(vectors[id], 1, nil)
+			require.Nil(t, err)
+			assert.Equal(t, []uint64{id}, ids)
+		}
+	})
+
+	t.Run("filtered graph search", func(t *testing.T) {
+		index.flatSearchCutoff = 0
+		defer func() { index.flatSearchCutoff = ent.DefaultFlatSearchCutoff }()
+
+		allow := helpers.NewAllowList()
+		for id := uint64(1000); id < 2000; id++ {
+			allow.Insert(id)
+		}
+		ids, _, err := index.SearchByVector(vectors[1500], 10, allow)
+		require.Nil(t, err)
+		require.Len(t, ids, 10)
+		assert.Equal(t, uint64(1500), ids[0])
+		for _, id := range ids {
+			assert.True(t, allow.Contains(id))
+		}
+	})
+
+	t.Run("filtered flat search", func(t *testing.T) {
+		allow := helpers.NewAllowList(1, 2, 3, 7)
+		ids, dists, err := index.SearchByVector(vectors[2], 10, allow)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{1, 2, 3}, ids)
+		assert.Equal(t, uint64(2), ids[0])
+		assert.Equal(t, float32(0), dists[0])
+	})
+
+	t.Run("search by distance", func(t *testing.T) {
+		ids, dists, err := index.SearchByVectorDistance(vectors[3], 0.0001, 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{3}, ids)
+		assert.Equal(t, []float32{0}, dists)
+	})
+}
+
+func TestDiskANNRestart(t *testing.T) {
+	dimensions := 32
+	vectorsSize := 1000
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, 10, dimensions)
+	provider := distancer.NewCosineDistanceProvider()
+	rootPath := t.TempDir()
+
+	index := newTestIndex(t, rootPath, provider)
+	require.Nil(t, index.AddBatch(context.Background(), idRange(vectorsSize), vectors))
+	require.Nil(t, index.Delete(3))
+
+	expectedIDs := make([][]uint64, len(queries))
+	expectedDists := make([][]float32, len(queries))
+	for i, query := range queries {
+		var err error
+		expectedIDs[i], expectedDists[i], err = index.SearchByVector(query, 10, nil)
+		require.Nil(t, err)
+	}
+	require.Nil(t, index.Shutdown(context.Background()))
+
+	// the compressed vectors are loaded from the snapshot written on shutdown
+	// rather than encoded again
+	require.FileExists(t, index.snapshotPath())
+	index = newTestIndex(t, rootPath, provider)
+	defer index.Shutdown(context.Background())
+	assert.NoFileExists(t, index.snapshotPath())
+
+	assert.Equal(t, uint64(vectorsSize-1), index.AlreadyIndexed())
+	assert.False(t, index.ContainsNode(3))
+	assert.True(t, index.ContainsNode(4))
+	for i, query := range queries {
+		ids, dists, err := index.SearchByVector(query, 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, expectedIDs[i], ids)
+		assert.Equal(t, expectedDists[i], dists)
+	}
+
+	t.Run("wrong dimensions", func(t *testing.T) {
+		err := index.Add(uint64(vectorsSize), make([]float32, dimensions+1))
+		assert.ErrorContains(t, err, "Existing nodes have vectors with length 32")
+	})
+}
+
+func TestDiskANNCleanUpTombstonedNodes(t *testing.T) {
+	dimensions := 32
+	vectorsSize := 1000
+	vectors, _ := testinghelpers.RandomVecs(vectorsSize+250, 0, dimensions)
+	provider := distancer.NewL2SquaredProvider()
+	rootPath := t.TempDir()
+	noAbort := func() bool { return false }
+
+	index := newTestIndex(t, rootPath, provider)
+	require.Nil(t, index.AddBatch(context.Background(), idRange(vectorsSize), vectors[:vectorsSize]))
+
+	// deletes the entrypoint as well
+	for id := uint64(0); id < 500; id += 2 {
+		require.Nil(t, index.Delete(id))
+	}
+
+	executed, err := index.CleanUpTombstonedNodes(noAbort)
+	require.Nil(t, err)
+	assert.True(t, executed)
+
+	t.Run("no node links to a removed node", func(t *testing.T) {
+		assert.Len(t, index.free, 250)
+		for slot := uint64(0); slot < index.size; slot++ {
+			state, _, edges, _ := index.graph.readNode(slot, nil, nil)
+			if state == nodeAbsent {
+				assert.Empty(t, edges)
+				continue
+			}
+			for _, edge := range edges {
+				assert.Equal(t, nodePresent, index.nodeState(edge))
+			}
+		}
+		assert.Equal(t, nodePresent, index.nodeState(index.entrypoint))
+	})
+
+	t.Run("remaining nodes are still found", func(t *testing.T) {
+		for id := uint64(1); id < 500; id += 2 {
+			ids, _, err := index.SearchByVector(vectors[id], 1, nil)
+			require.Nil(t, err)
+			assert.Equal(t, []uint64{id}, ids)
+		}
+	})
+
+	t.Run("nothing left to clean up", func(t *testing.T) {
+		executed, err := index.CleanUpTombstonedNodes(noAbort)
+		require.Nil(t, err)
+		assert.False(t, executed)
+	})
+
+	t.Run("new nodes reuse the slots of removed nodes", func(t *testing.T) {
+		newIDs := make([]uint64, 250)
+		for i := range newIDs {
+			newIDs[i] = uint64(vectorsSize + i)
+		}
+		require.Nil(t, index.AddBatch(context.Background(), newIDs, vectors[vectorsSize:]))
+
+		assert.Equal(t, uint64(vectorsSize), index.size)
+		assert.Empty(t, index.free)
+		assert.Equal(t, uint64(vectorsSize), index.AlreadyIndexed())
+		for _, id := range newIDs {
+			ids, _, err := index.SearchByVector(vectors[id], 1, nil)
+			require.Nil(t, err)
+			assert.Equal(t, []uint64{id}, ids)
+		}
+	})
+
+	t.Run("restart", func(t *testing.T) {
+		require.Nil(t, index.Shutdown(context.Background()))
+		index = newTestIndex(t, rootPath, provider)
+		defer index.Shutdown(context.Background())
+
+		assert.Equal(t, uint64(vectorsSize), index.AlreadyIndexed())
+		assert.False(t, index.ContainsNode(2))
+		assert.True(t, index.ContainsNode(3))
+		assert.True(t, index.ContainsNode(uint64(vectorsSize)))
+
+		ids, _, err := index.SearchByVector(vectors[vectorsSize+10], 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{uint64(vectorsSize + 10)}, ids)
+	})
+}
+
+func TestDiskANNRestoreAfterCrash(t *testing.T) {
+	dimensions := 32
+	vectorsSize := 500
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize+1, 10, dimensions)
+	provider := distancer.NewL2SquaredProvider()
+	rootPath := t.TempDir()
+
+	index := newTestIndex(t, rootPath, provider)
+	require.Nil(t, index.AddBatch(context.Background(), idRange(vectorsSize), vectors[:vectorsSize]))
+	require.Nil(t, index.Delete(5))
+	require.Nil(t, index.Flush())
+
+	expectedIDs := make([][]uint64, len(queries))
+	for i, query := range queries {
+		var err error
+		expectedIDs[i], _, err = index.SearchByVector(query, 10, nil)
+		require.Nil(t, err)
+	}
+
+	// changes after the last flush are lost
+	require.Nil(t, index.Add(uint64(vectorsSize), vectors[vectorsSize]))
+
+	// crash without a checkpoint and without a snapshot, with the records of
+	// the graph file partially written and a partially written log entry
+	require.Nil(t, index.graph.close())
+	graph, err := os.OpenFile(index.graphPath(), os.O_RDWR, 0o666)
+	require.Nil(t, err)
+	_, err = graph.WriteAt(make([]byte, 3*pageSize), pageSize)
+	require.Nil(t, err)
+	require.Nil(t, graph.Close())
+	wal, err := os.OpenFile(index.walPath(), os.O_WRONLY|os.O_APPEND, 0o666)
+	require.Nil(t, err)
+	_, err = wal.Write([]byte{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	require.Nil(t, err)
+	require.Nil(t, wal.Close())
+
+	index = newTestIndex(t, rootPath, provider)
+	defer index.Shutdown(context.Background())
+
+	assert.Equal(t, uint64(vectorsSize-1), index.AlreadyIndexed())
+	assert.False(t, index.ContainsNode(5))
+	assert.False(t, index.ContainsNode(uint64(vectorsSize)))
+	for i, query := range queries {
+		ids, _, err := index.SearchByVector(query, 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, expectedIDs[i], ids)
+	}
+}
+
+func TestDiskANNBackupDuringInserts(t *testing.T) {
+	ctx := context.Background()
+	dimensions := 32
+	vectorsSize := 2000
+	vectors, _ := testinghelpers.RandomVecs(vectorsSize, 0, dimensions)
+	provider := distancer.NewL2SquaredProvider()
+	rootPath := t.TempDir()
+
+	index := newTestIndex(t, rootPath, provider)
+	require.Nil(t, index.AddBatch(ctx, idRange(vectorsSize/2), vectors[:vectorsSize/2]))
+	require.Nil(t, index.Flush())
+
+	// the shard flushes the index after every batch
+	inserted := make(chan error)
+	go func() {
+		for start := vectorsSize / 2; start < vectorsSize; start += 50 {
+			ids := idRange(start + 50)[start:]
+			if err := index.AddBatch(ctx, ids, vectors[start:start+50]); err != nil {
+				inserted <- err
+				return
+			}
+			if err := index.Flush(); err != nil {
+				inserted <- err
+				return
+			}
+		}
+		inserted <- nil
+	}()
+
+	require.Nil(t, index.SwitchCommitLogs(ctx))
+	files, err := index.ListFiles(ctx, rootPath)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"diskann-test.diskann.graph.backup"}, files)
+
+	restorePath := t.TempDir()
+	for _, file := range files {
+		data, err := os.ReadFile(filepath.Join(rootPath, file))
+		require.Nil(t, err)
+		require.Nil(t, os.WriteFile(filepath.Join(restorePath, file), data, 0o666))
+	}
+	require.Nil(t, <-inserted)
+	require.Nil(t, index.Shutdown(ctx))
+
+	t.Run("restore", func(t *testing.T) {
+		restored := newTestIndex(t, restorePath, provider)
+		defer restored.Shutdown(ctx)
+		assert.NoFileExists(t, restored.backupPath())
+
+		count := restored.AlreadyIndexed()
+		assert.GreaterOrEqual(t, count, uint64(vectorsSize/2))
+		assert.LessOrEqual(t, count, uint64(vectorsSize))
+		for id := uint64(0); id < uint64(vectorsSize); id++ {
+			if !restored.ContainsNode(id) {
+				assert.GreaterOrEqual(t, id, uint64(vectorsSize/2))
+				continue
+			}
+			ids, dists, err := restored.SearchByVector(vectors[id], 1, nil)
+			require.Nil(t, err)
+			assert.Equal(t, []uint64{id}, ids)
+			assert.Equal(t, []float32{0}, dists)
+		}
+	})
+
+	t.Run("outdated backup copy is removed", func(t *testing.T) {
+		index := newTestIndex(t, rootPath, provider)
+		defer index.Shutdown(ctx)
+		assert.NoFileExists(t, index.backupPath())
+		assert.Equal(t, uint64(vectorsSize), index.AlreadyIndexed())
+	})
+}
+
+func idRange(n int) []uint64 {
+	ids := make([]uint64, n)
+	for i := range ids {
+		ids[i] = uint64(i)
+	}
+	return ids
+}
@@ -0,0 +1,289 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+// candidate is a node which has been visited by a search, its distance is
+// calculated from the full vectors
+type candidate struct {
+	slot   uint64
+	docID  uint64
+	dist   float32
+	vector []float32
+	state  uint32
+}
+
+func (d *diskANN) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(ids) != len(vectors) {
+		return errors.Errorf("ids and vectors sizes does not match")
+	}
+	if len(ids) == 0 {
+		return errors.Errorf("insertBatch called with empty lists")
+	}
+	for i := range ids {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := d.Add(ids[i], vectors[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Add inserts a node the way FreshDiskANN does: a greedy search for the new
+// vector collects candidates, which are pruned to the neighbors of the new
+// node. The new node is then added to the neighbors of each of them, pruning
+// their neighbors again if they exceed the maximum degree.
+func (d *diskANN) Add(id uint64, vector []float32) error {
+	if len(vector) == 0 {
+		return errors.Errorf("insert called with nil-vector")
+	}
+	if err := d.ValidateBeforeInsert(vector); err != nil {
+		return err
+	}
+	if err := d.init(len(vector)); err != nil {
+		return errors.Wrap(err, "init diskann index")
+	}
+
+	vector = d.normalized(vector)
+	slot, err := d.allocateSlot(id)
+	if err != nil {
+		return err
+	}
+	d.setCode(slot, d.rq.Encode(vector))
+
+	if err := d.link(slot, id, vector); err != nil {
+		return err
+	}
+
+	// a large batch flushes in between, so that its records don't need to
+	// be kept in memory until the end of the batch
+	if d.graph.needsFlush() {
+		return d.graph.flush()
+	}
+	return nil
+}
+
+// link writes the record of a new node and adds it to the graph
+func (d *diskANN) link(slot, docID uint64, vector []float32) error {
+	added, err := d.addEntrypoint(slot, docID, vector)
+	if err != nil || added {
+		return err
+	}
+
+	candidates, err := d.traverse(vector, d.buildListSize)
+	if err != nil {
+		return errors.Wrapf(err, "search neighbors of node %d", docID)
+	}
+	neighbors, err := d.robustPrune(slot, candidates)
+	if err != nil {
+		return errors.Wrapf(err, "prune neighbors of node %d", docID)
+	}
+
+	d.nodeLocks.Lock(slot)
+	err = d.graph.writeNode(slot, nodePresent, docID, candidateSlots(neighbors), vector)
+	d.nodeLocks.Unlock(slot)
+	if err != nil {
+		return err
+	}
+	if d.setState(slot, nodePresent) != nodePresent {
+		atomic.AddInt64(&d.count, 1)
+	}
+
+	for _, neighbor := range neighbors {
+		if err := d.addBackEdge(neighbor, slot, vector); err != nil {
+			return errors.Wrapf(err, "link node %d", docID)
+		}
+	}
+	return nil
+}
+
+// addEntrypoint adds the very first node of the index, it returns false if
+// the index already has an entrypoint
+func (d *diskANN) addEntrypoint(slot, docID uint64, vector []float32) (bool, error) {
+	d.RLock()
+	hasEntrypoint := d.hasEntrypoint
+	d.RUnlock()
+	if hasEntrypoint {
+		return false, nil
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	if d.hasEntrypoint {
+		return false, nil
+	}
+
+	if err := d.graph.writeNode(slot, nodePresent, docID, nil, vector); err != nil {
+		return false, err
+	}
+	d.states[slot] = uint8(nodePresent)
+	d.entrypoint = slot
+	d.hasEntrypoint = true
+	atomic.AddInt64(&d.count, 1)
+	d.writeHeaderLocked()
+
+	return true, nil
+}
+
+// robustPrune selects up to maxDegree neighbors from the candidates, which
+// must contain their distance to the node. A candidate is skipped if it is
+// alpha times closer to an already selected neighbor than to the node, which
+// keeps long edges in the graph and therefore the number of hops low.
+func (d *diskANN) robustPrune(slot uint64, candidates []candidate) ([]candidate, error) {
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].dist < candidates[j].dist
+	})
+
+	neighbors := make([]candidate, 0, d.maxDegree)
+	pruned := make([]bool, len(candidates))
+	for i, c := range candidates {
+		if pruned[i] || c.slot == slot || c.state != nodePresent {
+			continue
+		}
+
+		neighbors = append(neighbors, c)
+		if len(neighbors) == d.maxDegree {
+			break
+		}
+
+		for j := i + 1; j < len(candidates); j++ {
+			if pruned[j] {
+				continue
+			}
+			dist, err := d.distancerProvider.SingleDist(c.vector, candidates[j].vector)
+			if err != nil {
+				return nil, err
+			}
+			if d.alpha*dist <= candidates[j].dist {
+				pruned[j] = true
+			}
+		}
+	}
+
+	return neighbors, nil
+}
+
+// addBackEdge adds the new node to the neighbors of the given neighbor
+func (d *diskANN) addBackEdge(neighbor candidate, slot uint64, vector []float32) error {
+	d.nodeLocks.Lock(neighbor.slot)
+	defer d.nodeLocks.Unlock(neighbor.slot)
+
+	state, _, edges, _ := d.graph.readNode(neighbor.slot, nil, nil)
+	if state == nodeAbsent {
+		return nil
+	}
+	for _, edge := range edges {
+		if edge == slot {
+			return nil
+		}
+	}
+
+	if len(edges) < d.maxEdges() {
+		d.graph.writeNeighbors(neighbor.slot, state, append(edges, slot))
+		return nil
+	}
+
+	dist, err := d.distancerProvider.SingleDist(neighbor.vector, vector)
+	if err != nil {
+		return err
+	}
+	candidates := make([]candidate, 0, len(edges)+1)
+	candidates = append(candidates, candidate{slot: slot, dist: dist, vector: vector, state: nodePresent})
+	candidates, err = d.edgeCandidates(neighbor.vector, edges, candidates)
+	if err != nil {
+		return err
+	}
+
+	pruned, err := d.robustPrune(neighbor.slot, candidates)
+	if err != nil {
+		return err
+	}
+	d.graph.writeNeighbors(neighbor.slot, state, candidateSlots(pruned))
+	return nil
+}
+
+// edgeCandidates appends the given edges of a node with their distance to the
+// node to the candidates
+func (d *diskANN) edgeCandidates(vector []float32, edges []uint64, candidates []candidate,
+) ([]candidate, error) {
+	for _, edge := range edges {
+		// the vector of a slot is only replaced once no node links to it
+		// anymore, so it can be read without holding the lock of the node
+		_, edgeVector := d.graph.readVector(edge, nil)
+		if len(edgeVector) == 0 {
+			continue
+		}
+		dist, err := d.distancerProvider.SingleDist(vector, edgeVector)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, candidate{
+			slot:   edge,
+			dist:   dist,
+			vector: edgeVector,
+			state:  d.nodeState(edge),
+		})
+	}
+	return candidates, nil
+}
+
+// Delete marks the nodes of the given doc ids as deleted, they are removed
+// from the graph by the next tombstone cleanup
+func (d *diskANN) Delete(ids ...uint64) error {
+	for _, id := range ids {
+		d.delete(id)
+	}
+	return nil
+}
+
+func (d *diskANN) delete(id uint64) {
+	slot, ok := d.presentSlot(id)
+	if !ok {
+		return
+	}
+
+	d.nodeLocks.Lock(slot)
+	defer d.nodeLocks.Unlock(slot)
+
+	d.Lock()
+	if current, ok := d.slots[id]; !ok || current != slot || d.states[slot] != uint8(nodePresent) {
+		d.Unlock()
+		return
+	}
+	d.states[slot] = uint8(nodeDeleted)
+	delete(d.slots, id)
+	d.Unlock()
+
+	atomic.AddInt64(&d.count, -1)
+	d.graph.writeState(slot, nodeDeleted)
+}
+
+func candidateSlots(candidates []candidate) []uint64 {
+	slots := make([]uint64, len(candidates))
+	for i, c := range candidates {
+		slots[i] = c.slot
+	}
+	return slots
+}
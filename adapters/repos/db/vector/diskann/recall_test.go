@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !race
+
+package diskann
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+)
+
+func recall(index *diskANN, queries [][]float32, truths [][]uint64, k int,
+	allow helpers.AllowList,
+) float32 {
+	logger, _ := test.NewNullLogger()
+	var relevant uint64
+	var mu sync.Mutex
+	compressionhelpers.Concurrently(logger, uint64(len(queries)), func(i uint64) {
+		results, _, _ := index.SearchByVector(queries[i], k, allow)
+		matches := testinghelpers.MatchesInLists(truths[i], results)
+		mu.Lock()
+		relevant += matches
+		mu.Unlock()
+	})
+	return float32(relevant) / float32(k*len(queries))
+}
+
+func Test_NoRaceDiskANNRecall(t *testing.T) {
+	dimensions := 64
+	vectorsSize := 5000
+	queriesSize := 100
+	k := 10
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, queriesSize, dimensions)
+	testinghelpers.Normalize(vectors)
+	testinghelpers.Normalize(queries)
+	logger, _ := test.NewNullLogger()
+
+	for _, provider := range []distancer.Provider{
+		distancer.NewL2SquaredProvider(),
+		distancer.NewCosineDistanceProvider(),
+	} {
+		t.Run(provider.Type(), func(t *testing.T) {
+			index := newTestIndex(t, t.TempDir(), provider)
+			defer index.Shutdown(context.Background())
+
+			compressionhelpers.Concurrently(logger, uint64(vectorsSize), func(id uint64) {
+				require.Nil(t, index.Add(id, vectors[id]))
+			})
+			assert.Equal(t, uint64(vectorsSize), index.AlreadyIndexed())
+
+			truths := make([][]uint64, queriesSize)
+			compressionhelpers.Concurrently(logger, uint64(queriesSize), func(i uint64) {
+				truths[i], _ = testinghelpers.BruteForce(logger, vectors, queries[i], k,
+					distanceWrapper(provider))
+			})
+
+			r := recall(index, queries, truths, k, nil)
+			fmt.Println(r)
+			assert.Greater(t, r, float32(0.9))
+		})
+	}
+}
@@ -0,0 +1,310 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"sort"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+)
+
+// filtered searches may need to visit more nodes to find enough matches, but
+// never more than this many times the configured search list size
+const maxFilteredListSizeFactor = 10
+
+type listEntry struct {
+	slot     uint64
+	dist     float32
+	expanded bool
+}
+
+// traverse runs a beam search over the graph starting at the entrypoint. The
+// search list holds the listSize closest nodes seen so far based on the
+// compressed vectors. The closest node that has not been expanded yet is read
+// from disk until all nodes in the list have been expanded. All expanded
+// nodes are returned with their exact distance, including deleted nodes.
+func (d *diskANN) traverse(query []float32, listSize int) ([]candidate, error) {
+	d.RLock()
+	entrypoint, ok := d.entrypoint, d.hasEntrypoint
+	d.RUnlock()
+	if !ok {
+		return nil, nil
+	}
+
+	distancer := d.rq.NewDistancer(query)
+	visited := map[uint64]struct{}{entrypoint: {}}
+	list := make([]listEntry, 0, listSize+1)
+
+	d.RLock()
+	dist, err := distancer.Distance(d.codeLocked(entrypoint))
+	d.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	list = append(list, listEntry{slot: entrypoint, dist: dist})
+
+	var expanded []candidate
+	var edges []uint64
+	for {
+		next := -1
+		for i := range list {
+			if !list[i].expanded {
+				next = i
+				break
+			}
+		}
+		if next == -1 {
+			break
+		}
+		list[next].expanded = true
+		slot := list[next].slot
+
+		var state uint32
+		var docID uint64
+		var vector []float32
+		d.nodeLocks.RLock(slot)
+		state, docID, edges, vector = d.graph.readNode(slot, edges, nil)
+		d.nodeLocks.RUnlock(slot)
+		if state == nodeAbsent {
+			continue
+		}
+
+		dist, err := d.distancerProvider.SingleDist(query, vector)
+		if err != nil {
+			return nil, err
+		}
+		expanded = append(expanded, candidate{slot: slot, docID: docID, dist: dist, vector: vector, state: state})
+
+		d.RLock()
+		for _, edge := range edges {
+			if _, ok := visited[edge]; ok {
+				continue
+			}
+			visited[edge] = struct{}{}
+			if edge >= uint64(len(d.states)) || d.states[edge] == uint8(nodeAbsent) {
+				continue
+			}
+
+			dist, err := distancer.Distance(d.codeLocked(edge))
+			if err != nil {
+				d.RUnlock()
+				return nil, err
+			}
+			if len(list) == listSize && dist >= list[len(list)-1].dist {
+				continue
+			}
+
+			pos := sort.Search(len(list), func(i int) bool { return list[i].dist > dist })
+			list = append(list, listEntry{})
+			copy(list[pos+1:], list[pos:])
+			list[pos] = listEntry{slot: edge, dist: dist}
+			if len(list) > listSize {
+				list = list[:listSize]
+			}
+		}
+		d.RUnlock()
+	}
+
+	return expanded, nil
+}
+
+func (d *diskANN) codeLocked(slot uint64) []byte {
+	return d.codes[slot*uint64(d.codeSize) : (slot+1)*uint64(d.codeSize)]
+}
+
+func (d *diskANN) isEmpty() bool {
+	d.RLock()
+	defer d.RUnlock()
+
+	return !d.hasEntrypoint
+}
+
+func (d *diskANN) SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	if k <= 0 || d.isEmpty() {
+		return nil, nil, nil
+	}
+	if err := d.ValidateBeforeInsert(vector); err != nil {
+		return nil, nil, err
+	}
+	vector = d.normalized(vector)
+
+	if allow != nil && allow.Len() < int(atomic.LoadInt64(&d.flatSearchCutoff)) {
+		return d.flatSearch(vector, k, allow)
+	}
+
+	searchListSize := int(atomic.LoadInt64(&d.searchListSize))
+	listSize := max(searchListSize, k)
+	if allow != nil && allow.Len() > 0 {
+		// visit more nodes the more selective the filter is
+		count := int(atomic.LoadInt64(&d.count))
+		listSize = min(max(listSize, k*count/allow.Len()), maxFilteredListSizeFactor*listSize)
+	}
+
+	candidates, err := d.traverse(vector, listSize)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "search graph")
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].dist < candidates[j].dist
+	})
+
+	ids := make([]uint64, 0, k)
+	dists := make([]float32, 0, k)
+	for _, c := range candidates {
+		if len(ids) == k {
+			break
+		}
+		if !d.isPresent(c.slot, c.docID) || (allow != nil && !allow.Contains(c.docID)) {
+			continue
+		}
+		ids = append(ids, c.docID)
+		dists = append(dists, c.dist)
+	}
+	return ids, dists, nil
+}
+
+// flatSearch calculates the distance to every allowed node, which is cheaper
+// than a graph search if only few nodes are allowed
+func (d *diskANN) flatSearch(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	heap := priorityqueue.NewMax[any](k)
+	var buf []float32
+
+	it := allow.Iterator()
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		slot, ok := d.presentSlot(id)
+		if !ok {
+			continue
+		}
+
+		var state uint32
+		state, buf = d.graph.readVector(slot, buf)
+		if state == nodeAbsent {
+			continue
+		}
+		dist, err := d.distancerProvider.SingleDist(vector, buf)
+		if err != nil {
+			return nil, nil, err
+		}
+		if heap.Len() < k || heap.Top().Dist > dist {
+			heap.Insert(id, dist)
+			if heap.Len() > k {
+				heap.Pop()
+			}
+		}
+	}
+
+	ids := make([]uint64, heap.Len())
+	dists := make([]float32, heap.Len())
+	for i := len(ids) - 1; i >= 0; i-- {
+		item := heap.Pop()
+		ids[i] = item.ID
+		dists[i] = item.Dist
+	}
+	return ids, dists, nil
+}
+
+func (d *diskANN) SearchByVectorDistance(vector []float32, targetDistance float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	var (
+		searchParams = newSearchByDistParams(maxLimit)
+
+		resultIDs  []uint64
+		resultDist []float32
+	)
+
+	recursiveSearch := func() (bool, error) {
+		totalLimit := searchParams.TotalLimit()
+		ids, dist, err := d.SearchByVector(vector, totalLimit, allow)
+		if err != nil {
+			return false, errors.Wrap(err, "vector search")
+		}
+
+		// if there is less results than given limit search can be stopped
+		shouldContinue := !(len(ids) < totalLimit)
+
+		// ensures the indexes aren't out of range
+		offsetCap := searchParams.OffsetCapacity(ids)
+		totalLimitCap := searchParams.TotalLimitCapacity(ids)
+
+		if offsetCap == totalLimitCap {
+			return false, nil
+		}
+
+		ids, dist = ids[offsetCap:totalLimitCap], dist[offsetCap:totalLimitCap]
+		for i := range ids {
+			if aboveThresh := dist[i] <= targetDistance; aboveThresh ||
+				floatcomp.InDelta(float64(dist[i]), float64(targetDistance), 1e-6) {
+				resultIDs = append(resultIDs, ids[i])
+				resultDist = append(resultDist, dist[i])
+			} else {
+				// as soon as we encounter a certainty which
+				// is below threshold, we can stop searching
+				shouldContinue = false
+				break
+			}
+		}
+
+		return shouldContinue, nil
+	}
+
+	shouldContinue, err := recursiveSearch()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for shouldContinue {
+		searchParams.Iterate()
+		if searchParams.MaxLimitReached() {
+			d.logger.
+				WithField("action", "unlimited_vector_search").
+				Warnf("maximum search limit of %d results has been reached",
+					searchParams.MaximumSearchLimit())
+			break
+		}
+
+		shouldContinue, err = recursiveSearch()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return resultIDs, resultDist, nil
+}
+
+func newSearchByDistParams(maxLimit int64) *common.SearchByDistParams {
+	initialOffset := 0
+	initialLimit := common.DefaultSearchByDistInitialLimit
+
+	return common.NewSearchByDistParams(initialOffset, initialLimit, initialOffset+initialLimit, maxLimit)
+}
+
+func (d *diskANN) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	queryVector = d.normalized(queryVector)
+	f := func(nodeID uint64) (float32, error) {
+		slot, ok := d.presentSlot(nodeID)
+		if !ok {
+			return 0, errors.Errorf("node %d not found", nodeID)
+		}
+		state, vector := d.graph.readVector(slot, nil)
+		if state != nodePresent {
+			return 0, errors.Errorf("node %d not found", nodeID)
+		}
+		return d.distancerProvider.SingleDist(queryVector, vector)
+	}
+	return common.QueryVectorDistancer{DistanceFunc: f}
+}
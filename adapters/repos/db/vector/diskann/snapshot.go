@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	snapshotMagic   uint32 = 0x434e4144 // "DANC"
+	snapshotVersion uint32 = 1
+
+	snapshotHeaderSize = 20
+)
+
+// writeSnapshot writes the state, doc id and compressed vector of every slot
+// on shutdown, so that the next startup does not need to encode every vector
+// again. It must only be called once all records have been flushed.
+func (d *diskANN) writeSnapshot() error {
+	d.RLock()
+	defer d.RUnlock()
+
+	if d.dims == 0 {
+		return nil
+	}
+
+	tmpPath := d.snapshotPath() + ".tmp"
+	file, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return errors.Wrap(err, "create diskann snapshot")
+	}
+	defer file.Close()
+
+	w := bufio.NewWriter(file)
+	header := make([]byte, snapshotHeaderSize)
+	binary.LittleEndian.PutUint32(header[0:4], snapshotMagic)
+	binary.LittleEndian.PutUint32(header[4:8], snapshotVersion)
+	binary.LittleEndian.PutUint32(header[8:12], uint32(d.codeSize))
+	binary.LittleEndian.PutUint64(header[12:20], d.size)
+	if _, err := w.Write(header); err != nil {
+		return errors.Wrap(err, "write diskann snapshot")
+	}
+
+	meta := make([]byte, 9)
+	for slot := uint64(0); slot < d.size; slot++ {
+		meta[0] = d.states[slot]
+		binary.LittleEndian.PutUint64(meta[1:9], d.docIDs[slot])
+		if _, err := w.Write(meta); err != nil {
+			return errors.Wrap(err, "write diskann snapshot")
+		}
+		if _, err := w.Write(d.codeLocked(slot)); err != nil {
+			return errors.Wrap(err, "write diskann snapshot")
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return errors.Wrap(err, "write diskann snapshot")
+	}
+	if err := file.Sync(); err != nil {
+		return errors.Wrap(err, "sync diskann snapshot")
+	}
+	return errors.Wrap(os.Rename(tmpPath, d.snapshotPath()), "write diskann snapshot")
+}
+
+// loadSnapshotLocked restores the slots from the snapshot of the last clean
+// shutdown and returns false if there is none. The snapshot is removed once
+// it is loaded, as it is outdated by the first change to the graph.
+func (d *diskANN) loadSnapshotLocked() (bool, error) {
+	file, err := os.Open(d.snapshotPath())
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, "open diskann snapshot")
+	}
+	defer file.Close()
+
+	r := bufio.NewReader(file)
+	header := make([]byte, snapshotHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return false, errors.Wrap(err, "read diskann snapshot")
+	}
+	if binary.LittleEndian.Uint32(header[0:4]) != snapshotMagic {
+		return false, errors.New("not a diskann snapshot")
+	}
+	if version := binary.LittleEndian.Uint32(header[4:8]); version != snapshotVersion {
+		return false, errors.Errorf("unsupported diskann snapshot version %d", version)
+	}
+	if codeSize := int(binary.LittleEndian.Uint32(header[8:12])); codeSize != d.codeSize {
+		return false, errors.Errorf("diskann snapshot has codes of %d bytes, expected %d",
+			codeSize, d.codeSize)
+	}
+
+	size := binary.LittleEndian.Uint64(header[12:20])
+	if err := d.growLocked(size); err != nil {
+		return false, err
+	}
+
+	meta := make([]byte, 9)
+	for slot := uint64(0); slot < size; slot++ {
+		if _, err := io.ReadFull(r, meta); err != nil {
+			return false, errors.Wrap(err, "read diskann snapshot")
+		}
+		d.states[slot] = meta[0]
+		d.docIDs[slot] = binary.LittleEndian.Uint64(meta[1:9])
+		if _, err := io.ReadFull(r, d.codes[slot*uint64(d.codeSize):(slot+1)*uint64(d.codeSize)]); err != nil {
+			return false, errors.Wrap(err, "read diskann snapshot")
+		}
+	}
+	d.size = size
+
+	if err := os.Remove(d.snapshotPath()); err != nil {
+		return false, errors.Wrap(err, "remove diskann snapshot")
+	}
+	return true, nil
+}
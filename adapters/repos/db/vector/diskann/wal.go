@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+)
+
+// every log entry starts with the offset of the record in the graph file,
+// the length of the record and a checksum over both and the record itself
+const walEntryHeaderSize = 16
+
+// writeAheadLog holds the records of all flushes since the graph file was
+// last synced. Records are only written to the graph file once they are in
+// the log, so that they can be written again if the graph file was only
+// partially written when the process or the machine crashed.
+type writeAheadLog struct {
+	file    *os.File
+	written int64
+}
+
+func openWriteAheadLog(path string) (*writeAheadLog, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o666)
+	if err != nil {
+		return nil, fmt.Errorf("open write-ahead log: %w", err)
+	}
+	return &writeAheadLog{file: file}, nil
+}
+
+// append writes the records with the given offsets to the log and syncs it
+func (l *writeAheadLog) append(offsets []int64, records map[int64][]byte) error {
+	size := 0
+	for _, offset := range offsets {
+		size += walEntryHeaderSize + len(records[offset])
+	}
+
+	buf := make([]byte, 0, size)
+	for _, offset := range offsets {
+		record := records[offset]
+		header := make([]byte, walEntryHeaderSize)
+		binary.LittleEndian.PutUint64(header[0:8], uint64(offset))
+		binary.LittleEndian.PutUint32(header[8:12], uint32(len(record)))
+		checksum := crc32.ChecksumIEEE(header[0:12])
+		checksum = crc32.Update(checksum, crc32.IEEETable, record)
+		binary.LittleEndian.PutUint32(header[12:16], checksum)
+
+		buf = append(buf, header...)
+		buf = append(buf, record...)
+	}
+
+	if _, err := l.file.WriteAt(buf, l.written); err != nil {
+		return fmt.Errorf("write write-ahead log: %w", err)
+	}
+	if err := l.file.Sync(); err != nil {
+		return fmt.Errorf("sync write-ahead log: %w", err)
+	}
+	l.written += int64(len(buf))
+	return nil
+}
+
+// replay writes all complete entries of the log to the graph file and syncs
+// it. The log ends at the first incomplete or corrupt entry, which was being
+// written during a crash and has never been written to the graph file.
+func (l *writeAheadLog) replay(graph *os.File) error {
+	if _, err := l.file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("read write-ahead log: %w", err)
+	}
+	r := bufio.NewReader(l.file)
+
+	replayed := 0
+	header := make([]byte, walEntryHeaderSize)
+	var record []byte
+	for {
+		if _, err := io.ReadFull(r, header); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return fmt.Errorf("read write-ahead log: %w", err)
+		}
+		offset := int64(binary.LittleEndian.Uint64(header[0:8]))
+		length := binary.LittleEndian.Uint32(header[8:12])
+		if length > maxUnflushedSize {
+			break
+		}
+
+		if cap(record) < int(length) {
+			record = make([]byte, length)
+		}
+		record = record[:length]
+		if _, err := io.ReadFull(r, record); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return fmt.Errorf("read write-ahead log: %w", err)
+		}
+		checksum := crc32.ChecksumIEEE(header[0:12])
+		checksum = crc32.Update(checksum, crc32.IEEETable, record)
+		if checksum != binary.LittleEndian.Uint32(header[12:16]) {
+			break
+		}
+
+		if _, err := graph.WriteAt(record, offset); err != nil {
+			return fmt.Errorf("replay write-ahead log: %w", err)
+		}
+		replayed++
+	}
+
+	if replayed > 0 {
+		if err := graph.Sync(); err != nil {
+			return fmt.Errorf("sync graph file: %w", err)
+		}
+	}
+	return l.truncate()
+}
+
+func (l *writeAheadLog) size() int64 {
+	return l.written
+}
+
+// truncate empties the log, the graph file must be synced before
+func (l *writeAheadLog) truncate() error {
+	if err := l.file.Truncate(0); err != nil {
+		return fmt.Errorf("truncate write-ahead log: %w", err)
+	}
+	if err := l.file.Sync(); err != nil {
+		return fmt.Errorf("sync write-ahead log: %w", err)
+	}
+	l.written = 0
+	return nil
+}
+
+func (l *writeAheadLog) close() error {
+	return l.file.Close()
+}
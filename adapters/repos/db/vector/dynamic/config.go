@@ -12,6 +12,8 @@
 package dynamic
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
@@ -40,6 +42,9 @@ type Config struct {
 	TombstoneCallbacks       cyclemanager.CycleCallbackGroup
 	ShardCompactionCallbacks cyclemanager.CycleCallbackGroup
 	ShardFlushCallbacks      cyclemanager.CycleCallbackGroup
+	// DowngradeCheckInterval is the minimum time between two downgrade
+	// checks, zero uses the default and a negative interval checks every time
+	DowngradeCheckInterval time.Duration
 }
 
 func (c Config) Validate() error {
@@ -72,6 +77,10 @@ func ValidateUserConfigUpdate(initial, updated schemaconfig.VectorIndexConfig) e
 			name:     "distance",
 			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			name:     "storagePrecision",
+			accessor: func(c ent.UserConfig) interface{} { return c.StoragePrecision },
+		},
 	}
 
 	for _, u := range immutableFields {
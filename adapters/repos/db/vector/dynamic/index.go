@@ -16,13 +16,13 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
-	"math"
 	"os"
 	"path/filepath"
 	"runtime"
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -36,7 +36,10 @@ import (
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	werrors "github.com/weaviate/weaviate/entities/errors"
 	schemaconfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	bolt "go.etcd.io/bbolt"
@@ -44,6 +47,10 @@ import (
 
 const composerUpgradedKey = "upgraded"
 
+// downgradeCheckInterval is the minimum time between two counts of the nodes
+// of an upgraded index by ShouldDowngrade.
+const downgradeCheckInterval = time.Minute
+
 var dynamicBucket = []byte("dynamic")
 
 type VectorIndex interface {
@@ -76,6 +83,26 @@ type upgradableIndexer interface {
 	ShouldUpgrade() (bool, int)
 }
 
+type iterableIndexer interface {
+	Iterate(fn func(id uint64) bool)
+}
+
+type requantizableIndexer interface {
+	Requantize(callback func()) error
+	RequantizationProgress() (float32, bool)
+}
+
+type inspectableIndexer interface {
+	Health(ctx context.Context, recallSamples int) (*hnsw.HealthReport, error)
+}
+
+type distancerOverridableIndexer interface {
+	SearchByVectorWithDistancer(vector []float32, k int, allow helpers.AllowList,
+		provider distancer.Provider) ([]uint64, []float32, error)
+	SearchByVectorDistanceWithDistancer(vector []float32, targetDistance float32,
+		maxLimit int64, allow helpers.AllowList, provider distancer.Provider) ([]uint64, []float32, error)
+}
+
 type dynamic struct {
 	sync.RWMutex
 	id                       string
@@ -97,7 +124,19 @@ type dynamic struct {
 	shardCompactionCallbacks cyclemanager.CycleCallbackGroup
 	shardFlushCallbacks      cyclemanager.CycleCallbackGroup
 	hnswUC                   hnswent.UserConfig
+	flatUC                   flatent.UserConfig
+	precision                vectorindexcommon.StoragePrecision
+	downgradeThreshold       atomic.Uint64
+	downgradeCheckInterval   time.Duration
+	lastDowngradeCheck       atomic.Int64
 	db                       *bolt.DB
+
+	// migration is the flat index which is filled while downgrading. Writes
+	// are applied to it as well and deletes are recorded, so that they can be
+	// replayed once the copy from the hnsw index is complete.
+	migrationLock    sync.Mutex
+	migration        VectorIndex
+	migrationDeletes []uint64
 }
 
 func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
@@ -115,11 +154,10 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 		logger = l
 	}
 
-	flatConfig := flat.Config{
-		ID:               cfg.ID,
-		TargetVector:     cfg.TargetVector,
-		Logger:           cfg.Logger,
-		DistanceProvider: cfg.DistanceProvider,
+	uc = withStoragePrecision(uc)
+	precision, err := vectorindexcommon.ParseStoragePrecision(uc.StoragePrecision)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid config")
 	}
 
 	index := &dynamic{
@@ -140,7 +178,14 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 		shardCompactionCallbacks: cfg.ShardCompactionCallbacks,
 		shardFlushCallbacks:      cfg.ShardFlushCallbacks,
 		hnswUC:                   uc.HnswUC,
+		flatUC:                   uc.FlatUC,
+		precision:                precision,
+		downgradeCheckInterval:   cfg.DowngradeCheckInterval,
+	}
+	if index.downgradeCheckInterval == 0 {
+		index.downgradeCheckInterval = downgradeCheckInterval
 	}
+	index.downgradeThreshold.Store(uc.DowngradeThreshold)
 
 	path := filepath.Join(cfg.RootPath, "index.db")
 
@@ -179,6 +224,7 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 				Logger:                index.logger,
 				RootPath:              index.rootPath,
 				ID:                    index.id,
+				TargetVector:          index.targetVector,
 				ShardName:             index.shardName,
 				ClassName:             index.className,
 				PrometheusMetrics:     index.prometheusMetrics,
@@ -198,7 +244,7 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 		}
 		index.index = hnsw
 	} else {
-		flat, err := flat.New(flatConfig, uc.FlatUC, store)
+		flat, err := index.newFlat(index.flatUC)
 		if err != nil {
 			return nil, err
 		}
@@ -217,19 +263,47 @@ func (dynamic *dynamic) Compressed() bool {
 func (dynamic *dynamic) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
-	return dynamic.index.AddBatch(ctx, ids, vectors)
+	if err := dynamic.index.AddBatch(ctx, ids, vectors); err != nil {
+		return err
+	}
+
+	dynamic.migrationLock.Lock()
+	defer dynamic.migrationLock.Unlock()
+	if dynamic.migration == nil {
+		return nil
+	}
+	return dynamic.migration.AddBatch(ctx, ids, vectors)
 }
 
 func (dynamic *dynamic) Add(id uint64, vector []float32) error {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
-	return dynamic.index.Add(id, vector)
+	if err := dynamic.index.Add(id, vector); err != nil {
+		return err
+	}
+
+	dynamic.migrationLock.Lock()
+	defer dynamic.migrationLock.Unlock()
+	if dynamic.migration == nil {
+		return nil
+	}
+	return dynamic.migration.Add(id, vector)
 }
 
 func (dynamic *dynamic) Delete(ids ...uint64) error {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
-	return dynamic.index.Delete(ids...)
+	if err := dynamic.index.Delete(ids...); err != nil {
+		return err
+	}
+
+	dynamic.migrationLock.Lock()
+	defer dynamic.migrationLock.Unlock()
+	if dynamic.migration == nil {
+		return nil
+	}
+	dynamic.migrationDeletes = append(dynamic.migrationDeletes, ids...)
+	return dynamic.migration.Delete(ids...)
 }
 
 func (dynamic *dynamic) SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
@@ -250,14 +324,20 @@ func (dynamic *dynamic) UpdateUserConfig(updated schemaconfig.VectorIndexConfig,
 		callback()
 		return errors.Errorf("config is not UserConfig, but %T", updated)
 	}
+	parsed = withStoragePrecision(parsed)
+	// both configs are kept, as the index can switch between hnsw and flat
+	dynamic.Lock()
+	dynamic.hnswUC = parsed.HnswUC
+	dynamic.flatUC = parsed.FlatUC
+	dynamic.Unlock()
+	dynamic.downgradeThreshold.Store(parsed.DowngradeThreshold)
+
+	// the index can't be swapped while the lock is held
+	dynamic.RLock()
+	defer dynamic.RUnlock()
 	if dynamic.upgraded.Load() {
-		dynamic.RLock()
-		defer dynamic.RUnlock()
 		dynamic.index.UpdateUserConfig(parsed.HnswUC, callback)
 	} else {
-		dynamic.hnswUC = parsed.HnswUC
-		dynamic.RLock()
-		defer dynamic.RUnlock()
 		dynamic.index.UpdateUserConfig(parsed.FlatUC, callback)
 	}
 	return nil
@@ -367,11 +447,74 @@ func (dynamic *dynamic) Upgraded() bool {
 	return dynamic.upgraded.Load() && dynamic.index.(upgradableIndexer).Upgraded()
 }
 
-func float32SliceFromByteSlice(vector []byte, slice []float32) []float32 {
-	for i := range slice {
-		slice[i] = math.Float32frombits(binary.LittleEndian.Uint32(vector[i*4:]))
+// Requantize replaces the quantizer of the hnsw index, it fails as long as the
+// index has not been upgraded
+func (dynamic *dynamic) Requantize(callback func()) error {
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	index, ok := dynamic.index.(requantizableIndexer)
+	if !ok {
+		callback()
+		return errors.New("requantize: index has not been upgraded to hnsw")
+	}
+	return index.Requantize(callback)
+}
+
+func (dynamic *dynamic) RequantizationProgress() (float32, bool) {
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	if index, ok := dynamic.index.(requantizableIndexer); ok {
+		return index.RequantizationProgress()
 	}
-	return slice
+	return 0, false
+}
+
+// Health reports on the graph of the hnsw index, it fails as long as the index
+// has not been upgraded
+func (dynamic *dynamic) Health(ctx context.Context, recallSamples int) (*hnsw.HealthReport, error) {
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	index, ok := dynamic.index.(inspectableIndexer)
+	if !ok {
+		return nil, errors.New("health: index has not been upgraded to hnsw")
+	}
+	return index.Health(ctx, recallSamples)
+}
+
+// SearchByVectorWithDistancer searches the flat index with the given
+// distancer, it fails once the index has been upgraded to hnsw
+func (dynamic *dynamic) SearchByVectorWithDistancer(vector []float32, k int,
+	allow helpers.AllowList, provider distancer.Provider,
+) ([]uint64, []float32, error) {
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	index, ok := dynamic.index.(distancerOverridableIndexer)
+	if !ok {
+		return nil, nil, errors.New("distance metric override: index has been upgraded to hnsw")
+	}
+	return index.SearchByVectorWithDistancer(vector, k, allow, provider)
+}
+
+func (dynamic *dynamic) SearchByVectorDistanceWithDistancer(vector []float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+	provider distancer.Provider,
+) ([]uint64, []float32, error) {
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	index, ok := dynamic.index.(distancerOverridableIndexer)
+	if !ok {
+		return nil, nil, errors.New("distance metric override: index has been upgraded to hnsw")
+	}
+	return index.SearchByVectorDistanceWithDistancer(vector, targetDistance,
+		maxLimit, allow, provider)
+}
+
+// withStoragePrecision applies the storage precision of the dynamic index to
+// the configs of both underlying indexes
+func withStoragePrecision(uc ent.UserConfig) ent.UserConfig {
+	uc.HnswUC.StoragePrecision = uc.StoragePrecision
+	uc.FlatUC.StoragePrecision = uc.StoragePrecision
+	return uc
 }
 
 func (dynamic *dynamic) Upgrade(callback func()) error {
@@ -386,6 +529,7 @@ func (dynamic *dynamic) Upgrade(callback func()) error {
 			Logger:                dynamic.logger,
 			RootPath:              dynamic.rootPath,
 			ID:                    dynamic.id,
+			TargetVector:          dynamic.targetVector,
 			ShardName:             dynamic.shardName,
 			ClassName:             dynamic.className,
 			PrometheusMetrics:     dynamic.prometheusMetrics,
@@ -405,7 +549,7 @@ func (dynamic *dynamic) Upgrade(callback func()) error {
 		return err
 	}
 
-	bucket := dynamic.store.Bucket(helpers.VectorsBucketLSM)
+	bucket := dynamic.store.Bucket(dynamic.flatBucketName())
 
 	g := werrors.NewErrorGroupWrapper(dynamic.logger)
 	workerCount := runtime.GOMAXPROCS(0)
@@ -433,8 +577,7 @@ func (dynamic *dynamic) Upgrade(callback func()) error {
 
 	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
 		id := binary.BigEndian.Uint64(k)
-		vc := make([]float32, len(v)/4)
-		float32SliceFromByteSlice(v, vc)
+		vc := dynamic.precision.Decode(v, make([]float32, dynamic.precision.Dims(len(v))))
 
 		ch <- task{id: id, vector: vc}
 	}
@@ -461,3 +604,245 @@ func (dynamic *dynamic) Upgrade(callback func()) error {
 	callback()
 	return nil
 }
+
+// ShouldDowngrade reports whether an upgraded index holds fewer vectors than
+// the downgrade threshold. It is called on every indexer tick, so the nodes
+// are only counted once per downgradeCheckInterval and counting stops as
+// soon as the threshold is reached.
+func (dynamic *dynamic) ShouldDowngrade() bool {
+	threshold := dynamic.downgradeThreshold.Load()
+	if threshold == 0 || !dynamic.upgraded.Load() {
+		return false
+	}
+
+	now := time.Now().UnixNano()
+	last := dynamic.lastDowngradeCheck.Load()
+	if last != 0 && now-last < int64(dynamic.downgradeCheckInterval) {
+		return false
+	}
+	if !dynamic.lastDowngradeCheck.CompareAndSwap(last, now) {
+		return false
+	}
+
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	if dynamic.sharesCompressedBucket() {
+		return false
+	}
+	index, ok := dynamic.index.(iterableIndexer)
+	if !ok {
+		return false
+	}
+
+	var count uint64
+	index.Iterate(func(id uint64) bool {
+		count++
+		return count < threshold
+	})
+	return count < threshold
+}
+
+// Downgrade migrates an upgraded index back to flat. The vectors are copied
+// while the hnsw index keeps serving queries, the indexes are only swapped
+// once the flat index is complete.
+func (dynamic *dynamic) Downgrade(callback func()) error {
+	if !dynamic.upgraded.Load() {
+		callback()
+		return nil
+	}
+
+	dynamic.RLock()
+	shared := dynamic.sharesCompressedBucket()
+	flatUC := dynamic.flatUC
+	dynamic.RUnlock()
+	if shared {
+		callback()
+		return errors.New("downgrade: compressed hnsw and flat indexes without a target vector share their compressed bucket")
+	}
+
+	index, err := dynamic.newFlat(flatUC)
+	if err != nil {
+		dynamic.dropFlat(nil, flatUC)
+		callback()
+		return errors.Wrap(err, "downgrade")
+	}
+	// the buckets may still contain the vectors written before the upgrade
+	if err := dynamic.clearFlat(index); err != nil {
+		dynamic.dropFlat(index, flatUC)
+		callback()
+		return errors.Wrap(err, "downgrade")
+	}
+
+	dynamic.migrationLock.Lock()
+	dynamic.migration = index
+	dynamic.migrationDeletes = nil
+	dynamic.migrationLock.Unlock()
+
+	err = dynamic.copyToFlat(index)
+
+	dynamic.Lock()
+	defer dynamic.Unlock()
+
+	dynamic.migrationLock.Lock()
+	deleted := dynamic.migrationDeletes
+	dynamic.migration = nil
+	dynamic.migrationDeletes = nil
+	dynamic.migrationLock.Unlock()
+
+	if err != nil {
+		dynamic.dropFlat(index, flatUC)
+		callback()
+		return errors.Wrap(err, "downgrade")
+	}
+
+	// objects deleted during the copy might have been added back by a worker
+	// which read the vector just before
+	if len(deleted) > 0 {
+		if err := index.Delete(deleted...); err != nil {
+			dynamic.dropFlat(index, flatUC)
+			callback()
+			return errors.Wrap(err, "downgrade")
+		}
+	}
+
+	err = dynamic.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(dynamicBucket)
+		return b.Put([]byte(composerUpgradedKey), []byte{0})
+	})
+	if err != nil {
+		dynamic.dropFlat(index, flatUC)
+		callback()
+		return errors.Wrap(err, "update dynamic")
+	}
+
+	previous := dynamic.index
+	dynamic.index = index
+	dynamic.upgraded.Store(false)
+	// dropping removes the commit logs, a later upgrade must not load the
+	// outdated graph
+	if err := previous.Drop(context.Background()); err != nil {
+		dynamic.logger.WithError(err).Warn("failed to drop hnsw index after downgrade")
+	}
+
+	callback()
+	return nil
+}
+
+func (dynamic *dynamic) copyToFlat(index VectorIndex) error {
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+
+	source, ok := dynamic.index.(iterableIndexer)
+	if !ok {
+		return errors.Errorf("cannot iterate over %T", dynamic.index)
+	}
+
+	g, ctx := werrors.NewErrorGroupWithContextWrapper(dynamic.logger, context.Background())
+	workerCount := runtime.GOMAXPROCS(0)
+	ch := make(chan uint64, workerCount)
+
+	for i := 0; i < workerCount; i++ {
+		g.Go(func() error {
+			for id := range ch {
+				vector, err := dynamic.vectorForIDThunk(ctx, id)
+				if err != nil {
+					var e storobj.ErrNotFound
+					if errors.As(err, &e) {
+						// deleted in the meantime
+						continue
+					}
+					return err
+				}
+				if err := index.Add(id, vector); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	// stop feeding the workers once one of them failed
+	source.Iterate(func(id uint64) bool {
+		select {
+		case ch <- id:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	})
+	close(ch)
+
+	return g.Wait()
+}
+
+func (dynamic *dynamic) newFlat(uc flatent.UserConfig) (VectorIndex, error) {
+	return flat.New(flat.Config{
+		ID:               dynamic.id,
+		TargetVector:     dynamic.targetVector,
+		Logger:           dynamic.logger,
+		DistanceProvider: dynamic.distanceProvider,
+		VectorForIDThunk: dynamic.vectorForIDThunk,
+	}, uc, dynamic.store)
+}
+
+func (dynamic *dynamic) clearFlat(index VectorIndex) error {
+	var ids []uint64
+	cursor := dynamic.store.Bucket(dynamic.flatBucketName()).Cursor()
+	for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+		ids = append(ids, binary.BigEndian.Uint64(k))
+	}
+	cursor.Close()
+
+	return index.Delete(ids...)
+}
+
+// sharesCompressedBucket reports whether the flat index of a downgrade would
+// write its codes into the bucket of the compressed hnsw index, which keeps
+// serving until the downgrade completes. The hnsw compressors don't include
+// the target vector in their bucket name, so this only happens without one.
+// Must be called with the lock held.
+func (dynamic *dynamic) sharesCompressedBucket() bool {
+	return dynamic.targetVector == "" && flatCompressed(dynamic.flatUC) && dynamic.index.Compressed()
+}
+
+func flatCompressed(uc flatent.UserConfig) bool {
+	return uc.BQ.Enabled || uc.RQ.Enabled
+}
+
+// dropFlat shuts down a flat index created by a failed downgrade and drops
+// its buckets, a nil index only drops the buckets. The compressed bucket is
+// only dropped if the flat index uses one, otherwise it may belong to the
+// hnsw index.
+func (dynamic *dynamic) dropFlat(index VectorIndex, uc flatent.UserConfig) {
+	ctx := context.Background()
+	if index != nil {
+		if err := index.Shutdown(ctx); err != nil {
+			dynamic.logger.WithError(err).Warn("failed to shut down flat index after failed downgrade")
+		}
+	}
+
+	buckets := []string{dynamic.flatBucketName()}
+	if flatCompressed(uc) {
+		buckets = append(buckets, dynamic.flatCompressedBucketName())
+	}
+	for _, name := range buckets {
+		if err := dynamic.store.DropBucket(ctx, name); err != nil {
+			dynamic.logger.WithError(err).Warnf("failed to drop bucket %q after failed downgrade", name)
+		}
+	}
+}
+
+func (dynamic *dynamic) flatBucketName() string {
+	if dynamic.targetVector != "" {
+		return fmt.Sprintf("%s_%s", helpers.VectorsBucketLSM, dynamic.targetVector)
+	}
+	return helpers.VectorsBucketLSM
+}
+
+func (dynamic *dynamic) flatCompressedBucketName() string {
+	if dynamic.targetVector != "" {
+		return fmt.Sprintf("%s_%s", helpers.VectorsCompressedBucketLSM, dynamic.targetVector)
+	}
+	return helpers.VectorsCompressedBucketLSM
+}
@@ -13,14 +13,19 @@ package dynamic_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
@@ -109,6 +114,452 @@ func TestDynamic(t *testing.T) {
 	assert.True(t, latency1 > latency2)
 }
 
+func TestDynamicDowngrade(t *testing.T) {
+	currentIndexing := os.Getenv("ASYNC_INDEXING")
+	os.Setenv("ASYNC_INDEXING", "true")
+	defer os.Setenv("ASYNC_INDEXING", currentIndexing)
+	dimensions := 20
+	vectors_size := 1_000
+	remaining := 100
+	queries_size := 10
+	k := 10
+
+	vectors, queries := testinghelpers.RandomVecs(vectors_size, queries_size, dimensions)
+	rootPath := t.TempDir()
+	distancer := distancer.NewL2SquaredProvider()
+	noopCallback := cyclemanager.NewCallbackGroupNoop()
+	store := testinghelpers.NewDummyStore(t)
+	fuc := flatent.UserConfig{}
+	fuc.SetDefaults()
+	hnswuc := hnswent.UserConfig{
+		MaxConnections:        30,
+		EFConstruction:        64,
+		EF:                    32,
+		VectorCacheMaxObjects: 1_000_000,
+	}
+	var lock sync.RWMutex
+	deleted := map[uint64]struct{}{}
+	config := dynamic.Config{
+		RootPath:              rootPath,
+		ID:                    "downgrade-test",
+		MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+		DistanceProvider:      distancer,
+		// the test counts the nodes right after deleting them
+		DowngradeCheckInterval: -1,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			lock.RLock()
+			defer lock.RUnlock()
+			if _, ok := deleted[id]; ok {
+				return nil, storobj.NewErrNotFoundf(id, "deleted")
+			}
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk:     TempVectorForIDThunk(vectors),
+		TombstoneCallbacks:       noopCallback,
+		ShardCompactionCallbacks: noopCallback,
+		ShardFlushCallbacks:      noopCallback,
+	}
+	uc := ent.UserConfig{
+		Threshold:          uint64(vectors_size),
+		DowngradeThreshold: uint64(2 * remaining),
+		Distance:           distancer.Type(),
+		HnswUC:             hnswuc,
+		FlatUC:             fuc,
+	}
+	index, err := dynamic.New(config, uc, store)
+	require.Nil(t, err)
+
+	compressionhelpers.Concurrently(logger, uint64(vectors_size), func(i uint64) {
+		index.Add(i, vectors[i])
+	})
+	assert.False(t, index.ShouldDowngrade())
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	require.Nil(t, index.Upgrade(func() {
+		wg.Done()
+	}))
+	wg.Wait()
+	shouldUpgrade, _ := index.ShouldUpgrade()
+	assert.False(t, shouldUpgrade)
+	assert.False(t, index.ShouldDowngrade())
+
+	lock.Lock()
+	ids := make([]uint64, 0, vectors_size-remaining)
+	for i := 0; i < vectors_size-remaining; i++ {
+		ids = append(ids, uint64(i))
+		deleted[uint64(i)] = struct{}{}
+	}
+	lock.Unlock()
+	require.Nil(t, index.Delete(ids...))
+	assert.True(t, index.ShouldDowngrade())
+
+	wg.Add(1)
+	require.Nil(t, index.Downgrade(func() {
+		wg.Done()
+	}))
+	wg.Wait()
+	assert.False(t, index.Upgraded())
+	assert.False(t, index.ShouldDowngrade())
+	shouldUpgrade, _ = index.ShouldUpgrade()
+	assert.True(t, shouldUpgrade)
+	assert.Equal(t, uint64(remaining), index.AlreadyIndexed())
+
+	truths := make([][]uint64, queries_size)
+	compressionhelpers.Concurrently(logger, uint64(len(queries)), func(i uint64) {
+		truths[i], _ = testinghelpers.BruteForce(logger, vectors[vectors_size-remaining:], queries[i], k, distanceWrapper(distancer))
+		for j := range truths[i] {
+			truths[i][j] += uint64(vectors_size - remaining)
+		}
+	})
+
+	t.Run("flat index only contains remaining vectors", func(t *testing.T) {
+		recall, _ := recallAndLatency(queries, k, index, truths)
+		assert.Equal(t, float32(1), recall)
+		for i := 0; i < vectors_size; i++ {
+			assert.Equal(t, i >= vectors_size-remaining, index.ContainsNode(uint64(i)))
+		}
+	})
+
+	t.Run("downgrade is persisted", func(t *testing.T) {
+		require.Nil(t, index.Shutdown(context.Background()))
+		index, err = dynamic.New(config, uc, store)
+		require.Nil(t, err)
+		shouldUpgrade, _ := index.ShouldUpgrade()
+		assert.True(t, shouldUpgrade)
+
+		recall, _ := recallAndLatency(queries, k, index, truths)
+		assert.Equal(t, float32(1), recall)
+	})
+}
+
+func TestDynamicDowngradeFailure(t *testing.T) {
+	currentIndexing := os.Getenv("ASYNC_INDEXING")
+	os.Setenv("ASYNC_INDEXING", "true")
+	defer os.Setenv("ASYNC_INDEXING", currentIndexing)
+	dimensions := 20
+	vectors_size := 1_000
+	queries_size := 10
+	k := 10
+
+	vectors, queries := testinghelpers.RandomVecs(vectors_size, queries_size, dimensions)
+	distancer := distancer.NewL2SquaredProvider()
+	noopCallback := cyclemanager.NewCallbackGroupNoop()
+	store := testinghelpers.NewDummyStore(t)
+	fuc := flatent.UserConfig{}
+	fuc.SetDefaults()
+	hnswuc := hnswent.UserConfig{
+		MaxConnections:        30,
+		EFConstruction:        64,
+		EF:                    32,
+		VectorCacheMaxObjects: 1_000_000,
+	}
+	var failing atomic.Bool
+	config := dynamic.Config{
+		RootPath:              t.TempDir(),
+		ID:                    "downgrade-failure-test",
+		MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+		DistanceProvider:      distancer,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			if failing.Load() && id == uint64(vectors_size/2) {
+				return nil, errors.New("disk failure")
+			}
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk:     TempVectorForIDThunk(vectors),
+		TombstoneCallbacks:       noopCallback,
+		ShardCompactionCallbacks: noopCallback,
+		ShardFlushCallbacks:      noopCallback,
+	}
+	uc := ent.UserConfig{
+		Threshold:          uint64(vectors_size),
+		DowngradeThreshold: uint64(2 * vectors_size),
+		Distance:           distancer.Type(),
+		HnswUC:             hnswuc,
+		FlatUC:             fuc,
+	}
+	index, err := dynamic.New(config, uc, store)
+	require.Nil(t, err)
+
+	compressionhelpers.Concurrently(logger, uint64(vectors_size), func(i uint64) {
+		index.Add(i, vectors[i])
+	})
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	require.Nil(t, index.Upgrade(wg.Done))
+	wg.Wait()
+	shouldUpgrade, _ := index.ShouldUpgrade()
+	require.False(t, shouldUpgrade)
+
+	truths := make([][]uint64, queries_size)
+	compressionhelpers.Concurrently(logger, uint64(len(queries)), func(i uint64) {
+		truths[i], _ = testinghelpers.BruteForce(logger, vectors, queries[i], k, distanceWrapper(distancer))
+	})
+
+	t.Run("failing copy keeps the hnsw index and drops the flat one", func(t *testing.T) {
+		failing.Store(true)
+		called := false
+		err := index.Downgrade(func() { called = true })
+		require.ErrorContains(t, err, "disk failure")
+		assert.True(t, called)
+
+		shouldUpgrade, _ := index.ShouldUpgrade()
+		assert.False(t, shouldUpgrade)
+		assert.Nil(t, store.Bucket(helpers.VectorsBucketLSM))
+		recall, _ := recallAndLatency(queries, k, index, truths)
+		assert.Greater(t, recall, float32(0.9))
+	})
+
+	t.Run("downgrade succeeds once the failure is gone", func(t *testing.T) {
+		failing.Store(false)
+		wg.Add(1)
+		require.Nil(t, index.Downgrade(wg.Done))
+		wg.Wait()
+
+		shouldUpgrade, _ := index.ShouldUpgrade()
+		assert.True(t, shouldUpgrade)
+		assert.Equal(t, uint64(vectors_size), index.AlreadyIndexed())
+		recall, _ := recallAndLatency(queries, k, index, truths)
+		assert.Equal(t, float32(1), recall)
+	})
+}
+
+// downgradableIndex is the part of the dynamic index a downgrade test needs
+type downgradableIndex interface {
+	dynamic.VectorIndex
+	Upgrade(callback func()) error
+	Upgraded() bool
+	ShouldDowngrade() bool
+	Downgrade(callback func()) error
+}
+
+func TestDynamicShouldDowngradeInterval(t *testing.T) {
+	currentIndexing := os.Getenv("ASYNC_INDEXING")
+	os.Setenv("ASYNC_INDEXING", "true")
+	defer os.Setenv("ASYNC_INDEXING", currentIndexing)
+	dimensions := 20
+	vectors_size := 500
+
+	vectors, _ := testinghelpers.RandomVecs(vectors_size, 0, dimensions)
+	distancer := distancer.NewL2SquaredProvider()
+	noopCallback := cyclemanager.NewCallbackGroupNoop()
+	fuc := flatent.UserConfig{}
+	fuc.SetDefaults()
+	hnswuc := hnswent.UserConfig{
+		MaxConnections:        30,
+		EFConstruction:        64,
+		EF:                    32,
+		VectorCacheMaxObjects: 1_000_000,
+	}
+	index, err := dynamic.New(dynamic.Config{
+		RootPath:                 t.TempDir(),
+		ID:                       "downgrade-interval-test",
+		MakeCommitLoggerThunk:    hnsw.MakeNoopCommitLogger,
+		DistanceProvider:         distancer,
+		VectorForIDThunk:         func(ctx context.Context, id uint64) ([]float32, error) { return vectors[int(id)], nil },
+		TempVectorForIDThunk:     TempVectorForIDThunk(vectors),
+		TombstoneCallbacks:       noopCallback,
+		ShardCompactionCallbacks: noopCallback,
+		ShardFlushCallbacks:      noopCallback,
+		DowngradeCheckInterval:   time.Hour,
+	}, ent.UserConfig{
+		Threshold:          uint64(vectors_size),
+		DowngradeThreshold: uint64(vectors_size / 2),
+		Distance:           distancer.Type(),
+		HnswUC:             hnswuc,
+		FlatUC:             fuc,
+	}, testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+
+	compressionhelpers.Concurrently(logger, uint64(vectors_size), func(i uint64) {
+		index.Add(i, vectors[i])
+	})
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	require.Nil(t, index.Upgrade(wg.Done))
+	wg.Wait()
+	assert.False(t, index.ShouldDowngrade())
+
+	ids := make([]uint64, vectors_size)
+	for i := range ids {
+		ids[i] = uint64(i)
+	}
+	require.Nil(t, index.Delete(ids...))
+	// the nodes were counted less than an interval ago
+	assert.False(t, index.ShouldDowngrade())
+}
+
+func TestDynamicDowngradeCompressedHNSW(t *testing.T) {
+	currentIndexing := os.Getenv("ASYNC_INDEXING")
+	os.Setenv("ASYNC_INDEXING", "true")
+	defer os.Setenv("ASYNC_INDEXING", currentIndexing)
+	dimensions := 20
+	vectors_size := 1_000
+	queries_size := 10
+	k := 10
+
+	vectors, queries := testinghelpers.RandomVecs(vectors_size, queries_size, dimensions)
+	distancer := distancer.NewL2SquaredProvider()
+	noopCallback := cyclemanager.NewCallbackGroupNoop()
+
+	newUpgradedIndex := func(t *testing.T, targetVector string, failing *atomic.Bool) (downgradableIndex, *lsmkv.Store) {
+		store := testinghelpers.NewDummyStore(t)
+		fuc := flatent.UserConfig{}
+		fuc.SetDefaults()
+		fuc.BQ.Enabled = true
+		hnswuc := hnswent.UserConfig{
+			MaxConnections:        30,
+			EFConstruction:        64,
+			EF:                    32,
+			VectorCacheMaxObjects: 1_000_000,
+		}
+		hnswuc.BQ.Enabled = true
+		config := dynamic.Config{
+			RootPath:              t.TempDir(),
+			ID:                    "downgrade-compressed-test",
+			TargetVector:          targetVector,
+			MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+			DistanceProvider:      distancer,
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				if failing.Load() && id == uint64(vectors_size/2) {
+					return nil, errors.New("disk failure")
+				}
+				return vectors[int(id)], nil
+			},
+			TempVectorForIDThunk:     TempVectorForIDThunk(vectors),
+			TombstoneCallbacks:       noopCallback,
+			ShardCompactionCallbacks: noopCallback,
+			ShardFlushCallbacks:      noopCallback,
+		}
+		uc := ent.UserConfig{
+			Threshold:          uint64(vectors_size),
+			DowngradeThreshold: uint64(2 * vectors_size),
+			Distance:           distancer.Type(),
+			HnswUC:             hnswuc,
+			FlatUC:             fuc,
+		}
+		index, err := dynamic.New(config, uc, store)
+		require.Nil(t, err)
+
+		compressionhelpers.Concurrently(logger, uint64(vectors_size), func(i uint64) {
+			index.Add(i, vectors[i])
+		})
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		require.Nil(t, index.Upgrade(wg.Done))
+		wg.Wait()
+		require.True(t, index.Upgraded())
+		return index, store
+	}
+
+	search := func(t *testing.T, index dynamic.VectorIndex) [][]uint64 {
+		results := make([][]uint64, len(queries))
+		for i, query := range queries {
+			ids, _, err := index.SearchByVector(query, k, nil)
+			require.Nil(t, err)
+			results[i] = ids
+		}
+		return results
+	}
+
+	t.Run("without a target vector the downgrade is refused", func(t *testing.T) {
+		var failing atomic.Bool
+		index, store := newUpgradedIndex(t, "", &failing)
+		before := search(t, index)
+
+		assert.False(t, index.ShouldDowngrade())
+		called := false
+		err := index.Downgrade(func() { called = true })
+		require.ErrorContains(t, err, "share their compressed bucket")
+		assert.True(t, called)
+
+		assert.True(t, index.Upgraded())
+		assert.NotNil(t, store.Bucket(helpers.VectorsCompressedBucketLSM))
+		assert.Equal(t, before, search(t, index))
+	})
+
+	t.Run("failed downgrade with a target vector keeps the hnsw codes", func(t *testing.T) {
+		var failing atomic.Bool
+		index, store := newUpgradedIndex(t, "named", &failing)
+		before := search(t, index)
+
+		assert.True(t, index.ShouldDowngrade())
+		failing.Store(true)
+		err := index.Downgrade(func() {})
+		require.ErrorContains(t, err, "disk failure")
+
+		assert.True(t, index.Upgraded())
+		assert.NotNil(t, store.Bucket(helpers.VectorsCompressedBucketLSM))
+		assert.Nil(t, store.Bucket(helpers.VectorsCompressedBucketLSM+"_named"))
+		assert.Equal(t, before, search(t, index))
+	})
+}
+
+func TestDynamicReducedStoragePrecision(t *testing.T) {
+	currentIndexing := os.Getenv("ASYNC_INDEXING")
+	os.Setenv("ASYNC_INDEXING", "true")
+	defer os.Setenv("ASYNC_INDEXING", currentIndexing)
+	dimensions := 20
+	vectors_size := 1_000
+	queries_size := 10
+	k := 10
+
+	vectors, queries := testinghelpers.RandomVecs(vectors_size, queries_size, dimensions)
+	distancer := distancer.NewL2SquaredProvider()
+	truths := make([][]uint64, queries_size)
+	compressionhelpers.Concurrently(logger, uint64(len(queries)), func(i uint64) {
+		truths[i], _ = testinghelpers.BruteForce(logger, vectors, queries[i], k, distanceWrapper(distancer))
+	})
+	noopCallback := cyclemanager.NewCallbackGroupNoop()
+	fuc := flatent.UserConfig{}
+	fuc.SetDefaults()
+	hnswuc := hnswent.UserConfig{
+		MaxConnections:        30,
+		EFConstruction:        64,
+		EF:                    32,
+		VectorCacheMaxObjects: 1_000_000,
+	}
+	index, err := dynamic.New(dynamic.Config{
+		RootPath:              t.TempDir(),
+		ID:                    "precision-test",
+		MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+		DistanceProvider:      distancer,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk:     TempVectorForIDThunk(vectors),
+		TombstoneCallbacks:       noopCallback,
+		ShardCompactionCallbacks: noopCallback,
+		ShardFlushCallbacks:      noopCallback,
+	}, ent.UserConfig{
+		Threshold:        uint64(vectors_size),
+		Distance:         distancer.Type(),
+		StoragePrecision: "float16",
+		HnswUC:           hnswuc,
+		FlatUC:           fuc,
+	}, testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+
+	compressionhelpers.Concurrently(logger, uint64(vectors_size), func(i uint64) {
+		index.Add(i, vectors[i])
+	})
+	recall, _ := recallAndLatency(queries, k, index, truths)
+	assert.True(t, recall > 0.95)
+
+	// the upgrade reads the reduced precision vectors back from the flat
+	// bucket, so they need to be decoded correctly to build the graph
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	require.Nil(t, index.Upgrade(func() {
+		wg.Done()
+	}))
+	wg.Wait()
+	shouldUpgrade, _ := index.ShouldUpgrade()
+	assert.False(t, shouldUpgrade)
+	recall, _ = recallAndLatency(queries, k, index, truths)
+	assert.True(t, recall > 0.9)
+}
+
 func TestDynamicReturnsErrorIfNoAsync(t *testing.T) {
 	currentIndexing := os.Getenv("ASYNC_INDEXING")
 	os.Unsetenv("ASYNC_INDEXING")
@@ -13,6 +13,7 @@ package flat
 
 import (
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/usecases/memwatch"
@@ -24,6 +25,10 @@ type Config struct {
 	Logger           logrus.FieldLogger
 	DistanceProvider distancer.Provider
 	AllocChecker     memwatch.AllocChecker
+	// VectorForIDThunk reads the vectors as they were imported. It is
+	// optional, without it indexes configured with cosine can't be searched
+	// with another distance, as they only store normalized vectors.
+	VectorForIDThunk common.VectorForID[float32]
 }
 
 func (c Config) Validate() error {
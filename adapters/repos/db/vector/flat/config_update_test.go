@@ -47,6 +47,14 @@ func TestFlatUserConfigUpdates(t *testing.T) {
 					"bq is immutable: " +
 						"attempted change from \"true\" to \"false\""),
 			},
+			{
+				name:    "attempting to change rq enabled",
+				initial: ent.UserConfig{RQ: ent.RQUserConfig{Enabled: false}},
+				update:  ent.UserConfig{RQ: ent.RQUserConfig{Enabled: true}},
+				expectedError: errors.Errorf(
+					"rq is immutable: " +
+						"attempted change from \"false\" to \"true\""),
+			},
 			{
 				name:    "attempting to change distance",
 				initial: ent.UserConfig{Distance: "cosine"},
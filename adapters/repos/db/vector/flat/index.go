@@ -34,6 +34,8 @@ import (
 	entcfg "github.com/weaviate/weaviate/entities/config"
 	entlsmkv "github.com/weaviate/weaviate/entities/lsmkv"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 )
@@ -42,7 +44,13 @@ const (
 	compressionBQ   = "bq"
 	compressionPQ   = "pq"
 	compressionSQ   = "sq"
+	compressionRQ   = "rq"
 	compressionNone = "none"
+
+	// rqSeed seeds the random rotation of the rotational quantizer. The flat
+	// index has no commit log to persist a random seed in, so a fixed one is
+	// used which makes the rotation reproducible across restarts.
+	rqSeed = 0x5eed_f1a7
 )
 
 type flat struct {
@@ -53,9 +61,15 @@ type flat struct {
 	store               *lsmkv.Store
 	logger              logrus.FieldLogger
 	distancerProvider   distancer.Provider
+	precision           vectorindexcommon.StoragePrecision
 	trackDimensionsOnce sync.Once
 	rescore             int64
 	bq                  compressionhelpers.BinaryQuantizer
+	rq                  *compressionhelpers.RotationalQuantizer
+	rqBits              int
+	rqOnce              sync.Once
+	rqErr               error
+	vectorForID         common.VectorForID[float32]
 
 	pqResults *common.PqMaxPool
 	pool      *pools
@@ -79,16 +93,24 @@ func New(cfg Config, uc flatent.UserConfig, store *lsmkv.Store) (*flat, error) {
 		logger = l
 	}
 
+	precision, err := vectorindexcommon.ParseStoragePrecision(uc.StoragePrecision)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
 	index := &flat{
 		id:                cfg.ID,
 		targetVector:      cfg.TargetVector,
 		logger:            logger,
 		distancerProvider: cfg.DistanceProvider,
+		precision:         precision,
 		rescore:           extractCompressionRescore(uc),
 		pqResults:         common.NewPqMaxPool(100),
 		compression:       extractCompression(uc),
 		pool:              newPools(),
 		store:             store,
+		rqBits:            uc.RQ.Bits,
+		vectorForID:       cfg.VectorForIDThunk,
 	}
 	if err := index.initBuckets(context.Background()); err != nil {
 		return nil, fmt.Errorf("init flat index buckets: %w", err)
@@ -126,6 +148,10 @@ func extractCompression(uc flatent.UserConfig) string {
 		return compressionSQ
 	}
 
+	if uc.RQ.Enabled {
+		return compressionRQ
+	}
+
 	return compressionNone
 }
 
@@ -138,6 +164,8 @@ func extractCompressionRescore(uc flatent.UserConfig) int64 {
 		return int64(uc.BQ.RescoreLimit)
 	case compressionSQ:
 		return int64(uc.SQ.RescoreLimit)
+	case compressionRQ:
+		return int64(uc.RQ.RescoreLimit)
 	default:
 		return 0
 	}
@@ -161,6 +189,21 @@ func (index *flat) isBQ() bool {
 	return index.compression == compressionBQ
 }
 
+func (index *flat) isRQ() bool {
+	return index.compression == compressionRQ
+}
+
+// rotationalQuantizer lazily creates the rotational quantizer once the
+// dimensions are known, either from the first insert or, after a restart,
+// from the first query.
+func (index *flat) rotationalQuantizer(dims int) (*compressionhelpers.RotationalQuantizer, error) {
+	index.rqOnce.Do(func() {
+		index.rq, index.rqErr = compressionhelpers.NewRotationalQuantizer(dims,
+			index.rqBits, rqSeed, index.distancerProvider)
+	})
+	return index.rq, index.rqErr
+}
+
 func (index *flat) isBQCached() bool {
 	return index.bqCache != nil
 }
@@ -196,7 +239,7 @@ func (index *flat) initBuckets(ctx context.Context) error {
 	); err != nil {
 		return fmt.Errorf("Create or load flat vectors bucket: %w", err)
 	}
-	if index.isBQ() {
+	if index.isBQ() || index.isRQ() {
 		if err := index.store.CreateOrLoadBucket(ctx, index.getCompressedBucketName(),
 			lsmkv.WithForceCompation(forceCompaction),
 			lsmkv.WithUseBloomFilter(false),
@@ -241,13 +284,6 @@ func byteSliceFromUint64Slice(vector []uint64, slice []byte) []byte {
 	return slice
 }
 
-func byteSliceFromFloat32Slice(vector []float32, slice []byte) []byte {
-	for i := range vector {
-		binary.LittleEndian.PutUint32(slice[i*4:], math.Float32bits(vector[i]))
-	}
-	return slice
-}
-
 func uint64SliceFromByteSlice(vector []byte, slice []uint64) []uint64 {
 	for i := range slice {
 		slice[i] = binary.LittleEndian.Uint64(vector[i*8:])
@@ -274,8 +310,9 @@ func (index *flat) Add(id uint64, vector []float32) error {
 		return errors.Errorf("insert called with a vector of the wrong size")
 	}
 	vector = index.normalized(vector)
-	slice := make([]byte, len(vector)*4)
-	index.storeVector(id, byteSliceFromFloat32Slice(vector, slice))
+	slice := make([]byte, index.precision.EncodedLen(len(vector)))
+	index.precision.Encode(vector, slice)
+	index.storeVector(id, slice)
 
 	if index.isBQ() {
 		vectorBQ := index.bq.Encode(vector)
@@ -286,6 +323,14 @@ func (index *flat) Add(id uint64, vector []float32) error {
 		slice = make([]byte, len(vectorBQ)*8)
 		index.storeCompressedVector(id, byteSliceFromUint64Slice(vectorBQ, slice))
 	}
+
+	if index.isRQ() {
+		rq, err := index.rotationalQuantizer(len(vector))
+		if err != nil {
+			return err
+		}
+		index.storeCompressedVector(id, rq.Encode(vector))
+	}
 	newCount := atomic.LoadUint64(&index.count)
 	atomic.StoreUint64(&index.count, newCount+1)
 	return nil
@@ -303,7 +348,7 @@ func (index *flat) Delete(ids ...uint64) error {
 			return err
 		}
 
-		if index.isBQ() {
+		if index.isBQ() || index.isRQ() {
 			if err := index.store.Bucket(index.getCompressedBucketName()).Delete(idBytes); err != nil {
 				return err
 			}
@@ -326,6 +371,8 @@ func (index *flat) SearchByVector(vector []float32, k int, allow helpers.AllowLi
 	switch index.compression {
 	case compressionBQ:
 		return index.searchByVectorBQ(vector, k, allow)
+	case compressionRQ:
+		return index.searchByVectorRQ(vector, k, allow)
 	case compressionPQ:
 		// use uncompressed for now
 		fallthrough
@@ -352,6 +399,12 @@ func (index *flat) searchByVector(vector []float32, k int, allow helpers.AllowLi
 }
 
 func (index *flat) createDistanceCalc(vector []float32) distanceCalc {
+	if index.precision != vectorindexcommon.PrecisionFloat32 {
+		// reduced precision vectors are compared without decoding them first
+		return distancer.NewReducedPrecisionDistancer(index.distancerProvider,
+			index.precision, vector).Distance
+	}
+
 	return func(vecAsBytes []byte) (float32, error) {
 		vecSlice := index.pool.float32SlicePool.Get(len(vecAsBytes) / 4)
 		defer index.pool.float32SlicePool.Put(vecSlice)
@@ -361,6 +414,98 @@ func (index *flat) createDistanceCalc(vector []float32) distanceCalc {
 	}
 }
 
+// SearchByVectorWithDistancer is a brute force search like SearchByVector,
+// but it uses the given distancer instead of the one the index was configured
+// with. Compressed vectors only approximate the configured distance, so the
+// uncompressed vectors are always scanned. Indexes configured with cosine
+// only store normalized vectors, for any other distance the vectors are read
+// from the objects instead.
+func (index *flat) SearchByVectorWithDistancer(vector []float32, k int,
+	allow helpers.AllowList, provider distancer.Provider,
+) ([]uint64, []float32, error) {
+	heap := index.pqResults.GetMax(k)
+	defer index.pqResults.Put(heap)
+
+	if index.distancerProvider.Type() == "cosine-dot" && provider.Type() != "cosine-dot" {
+		if err := index.findTopObjectVectors(heap, allow, k, vector, provider); err != nil {
+			return nil, nil, err
+		}
+	} else if err := index.findTopVectors(heap, allow, k,
+		index.store.Bucket(index.getBucketName()).Cursor,
+		index.createDistanceCalcWithDistancer(vector, provider),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists := index.extractHeap(heap)
+	return ids, dists, nil
+}
+
+// findTopObjectVectors populates the heap like findTopVectors, but the
+// distances are calculated on the vectors of the objects rather than the ones
+// stored in the index
+func (index *flat) findTopObjectVectors(heap *priorityqueue.Queue[any],
+	allow helpers.AllowList, limit int, vector []float32, provider distancer.Provider,
+) error {
+	if index.vectorForID == nil {
+		return errors.Errorf("distance metric %q can't override cosine: "+
+			"the index only stores normalized vectors", provider.Type())
+	}
+
+	return index.scanVectors(allow, index.store.Bucket(index.getBucketName()).Cursor,
+		func(id uint64, _ []byte) error {
+			candidate, err := index.vectorForID(context.Background(), id)
+			if err != nil {
+				var e storobj.ErrNotFound
+				if errors.As(err, &e) {
+					// the object was deleted concurrently
+					return nil
+				}
+				return err
+			}
+			distance, err := provider.SingleDist(vector, candidate)
+			if err != nil {
+				return err
+			}
+			index.insertToHeap(heap, limit, id, distance)
+			return nil
+		})
+}
+
+// SearchByVectorDistanceWithDistancer is the equivalent of
+// SearchByVectorDistance for SearchByVectorWithDistancer
+func (index *flat) SearchByVectorDistanceWithDistancer(vector []float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+	provider distancer.Provider,
+) ([]uint64, []float32, error) {
+	return index.searchByVectorDistance(targetDistance, maxLimit,
+		func(k int) ([]uint64, []float32, error) {
+			return index.SearchByVectorWithDistancer(vector, k, allow, provider)
+		})
+}
+
+func (index *flat) createDistanceCalcWithDistancer(vector []float32,
+	provider distancer.Provider,
+) distanceCalc {
+	// the stored vectors are only normalized if the index itself uses cosine
+	normalize := provider.Type() == "cosine-dot" &&
+		index.distancerProvider.Type() != "cosine-dot"
+	if provider.Type() == "cosine-dot" {
+		vector = distancer.Normalize(vector)
+	}
+
+	return func(vecAsBytes []byte) (float32, error) {
+		vecSlice := index.pool.float32SlicePool.Get(index.precision.Dims(len(vecAsBytes)))
+		defer index.pool.float32SlicePool.Put(vecSlice)
+
+		candidate := index.precision.Decode(vecAsBytes, vecSlice.slice)
+		if normalize {
+			candidate = distancer.Normalize(candidate)
+		}
+		return provider.SingleDist(vector, candidate)
+	}
+}
+
 func (index *flat) searchByVectorBQ(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
 	rescore := index.searchTimeRescore(k)
 	heap := index.pqResults.GetMax(rescore)
@@ -382,6 +527,44 @@ func (index *flat) searchByVectorBQ(vector []float32, k int, allow helpers.Allow
 		}
 	}
 
+	if err := index.rescoreHeap(heap, k, vector); err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists := index.extractHeap(heap)
+	return ids, dists, nil
+}
+
+func (index *flat) searchByVectorRQ(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	rescore := index.searchTimeRescore(k)
+	heap := index.pqResults.GetMax(rescore)
+	defer index.pqResults.Put(heap)
+
+	vector = index.normalized(vector)
+	rq, err := index.rotationalQuantizer(len(vector))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rqDistancer := rq.NewDistancer(vector)
+	if err := index.findTopVectors(heap, allow, rescore,
+		index.store.Bucket(index.getCompressedBucketName()).Cursor,
+		rqDistancer.Distance,
+	); err != nil {
+		return nil, nil, err
+	}
+
+	if err := index.rescoreHeap(heap, k, vector); err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists := index.extractHeap(heap)
+	return ids, dists, nil
+}
+
+// rescoreHeap replaces the estimated distances of the candidates in the heap
+// with exact distances to the uncompressed vectors and keeps the best k
+func (index *flat) rescoreHeap(heap *priorityqueue.Queue[any], k int, vector []float32) error {
 	distanceCalc := index.createDistanceCalc(vector)
 	idsSlice := index.pool.uint64SlicePool.Get(heap.Len())
 	defer index.pool.uint64SlicePool.Put(idsSlice)
@@ -392,17 +575,15 @@ func (index *flat) searchByVectorBQ(vector []float32, k int, allow helpers.Allow
 	for _, id := range idsSlice.slice {
 		candidateAsBytes, err := index.vectorById(id)
 		if err != nil {
-			return nil, nil, err
+			return err
 		}
 		distance, err := distanceCalc(candidateAsBytes)
 		if err != nil {
-			return nil, nil, err
+			return err
 		}
 		index.insertToHeap(heap, k, id, distance)
 	}
-
-	ids, dists := index.extractHeap(heap)
-	return ids, dists, nil
+	return nil
 }
 
 func (index *flat) createDistanceCalcBQ(vectorBQ []uint64) distanceCalc {
@@ -415,6 +596,12 @@ func (index *flat) createDistanceCalcBQ(vectorBQ []uint64) distanceCalc {
 	}
 }
 
+// decodeVector converts a vector read from the main bucket back to float32
+func (index *flat) decodeVector(vecAsBytes []byte) []float32 {
+	return index.precision.Decode(vecAsBytes,
+		make([]float32, index.precision.Dims(len(vecAsBytes))))
+}
+
 func (index *flat) vectorById(id uint64) ([]byte, error) {
 	idSlice := index.pool.byteSlicePool.Get(8)
 	defer index.pool.byteSlicePool.Put(idSlice)
@@ -428,6 +615,20 @@ func (index *flat) vectorById(id uint64) ([]byte, error) {
 func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 	allow helpers.AllowList, limit int, cursorFn func() *lsmkv.CursorReplace,
 	distanceCalc distanceCalc,
+) error {
+	return index.scanVectors(allow, cursorFn, func(id uint64, v []byte) error {
+		distance, err := distanceCalc(v)
+		if err != nil {
+			return err
+		}
+		index.insertToHeap(heap, limit, id, distance)
+		return nil
+	})
+}
+
+// scanVectors calls fn for all allowed vectors of the cursor
+func (index *flat) scanVectors(allow helpers.AllowList,
+	cursorFn func() *lsmkv.CursorReplace, fn func(id uint64, vecAsBytes []byte) error,
 ) error {
 	var key []byte
 	var v []byte
@@ -458,11 +659,9 @@ func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 	for ; key != nil && (allow == nil || id <= allowMax); key, v = cursor.Next() {
 		id = binary.BigEndian.Uint64(key)
 		if allow == nil || allow.Contains(id) {
-			distance, err := distanceCalc(v)
-			if err != nil {
+			if err := fn(id, v); err != nil {
 				return err
 			}
-			index.insertToHeap(heap, limit, id, distance)
 		}
 	}
 	return nil
@@ -546,6 +745,15 @@ func (index *flat) normalized(vector []float32) []float32 {
 }
 
 func (index *flat) SearchByVectorDistance(vector []float32, targetDistance float32, maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error) {
+	return index.searchByVectorDistance(targetDistance, maxLimit,
+		func(k int) ([]uint64, []float32, error) {
+			return index.SearchByVector(vector, k, allow)
+		})
+}
+
+func (index *flat) searchByVectorDistance(targetDistance float32, maxLimit int64,
+	search func(k int) ([]uint64, []float32, error),
+) ([]uint64, []float32, error) {
 	var (
 		searchParams = newSearchByDistParams(maxLimit)
 
@@ -555,7 +763,7 @@ func (index *flat) SearchByVectorDistance(vector []float32, targetDistance float
 
 	recursiveSearch := func() (bool, error) {
 		totalLimit := searchParams.TotalLimit()
-		ids, dist, err := index.SearchByVector(vector, totalLimit, allow)
+		ids, dist, err := search(totalLimit)
 		if err != nil {
 			return false, errors.Wrap(err, "vector search")
 		}
@@ -717,7 +925,7 @@ func (index *flat) ContainsNode(id uint64) bool {
 	// logic modeled after SearchByVector which indicates that the PQ bucket is
 	// the same as the uncompressed bucket "for now"
 	switch index.compression {
-	case compressionBQ:
+	case compressionBQ, compressionRQ:
 		bucketName = index.getCompressedBucketName()
 	case compressionPQ:
 		// use uncompressed for now
@@ -781,6 +989,10 @@ func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) e
 			name:     "distance",
 			accessor: func(c flatent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			name:     "storagePrecision",
+			accessor: func(c flatent.UserConfig) interface{} { return c.StoragePrecision },
+		},
 		{
 			name:     "pq.cache",
 			accessor: func(c flatent.UserConfig) interface{} { return c.PQ.Cache },
@@ -793,6 +1005,14 @@ func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) e
 			name:     "bq",
 			accessor: func(c flatent.UserConfig) interface{} { return c.BQ.Enabled },
 		},
+		{
+			name:     "rq",
+			accessor: func(c flatent.UserConfig) interface{} { return c.RQ.Enabled },
+		},
+		{
+			name:     "rq.bits",
+			accessor: func(c flatent.UserConfig) interface{} { return c.RQ.Bits },
+		},
 		// as of v1.25.2, updating the BQ cache setting is now possible.
 		// Note that the change does not take effect until the tenant is
 		// reloaded, either from a complete restart or from
@@ -818,7 +1038,7 @@ func (index *flat) QueryVectorDistancer(queryVector []float32) common.QueryVecto
 		if err != nil {
 			return 0, err
 		}
-		dist, err := index.distancerProvider.SingleDist(queryVector, float32SliceFromByteSlice(vec, make([]float32, len(vec)/4)))
+		dist, err := index.distancerProvider.SingleDist(queryVector, index.decodeVector(vec))
 		if err != nil {
 			return 0, err
 		}
@@ -848,7 +1068,7 @@ func (index *flat) QueryVectorDistancer(queryVector []float32) common.QueryVecto
 			if err != nil {
 				return 0, err
 			}
-			dist, err := index.distancerProvider.SingleDist(queryVector, float32SliceFromByteSlice(vec, make([]float32, len(vec)/4)))
+			dist, err := index.distancerProvider.SingleDist(queryVector, index.decodeVector(vec))
 			if err != nil {
 				return 0, err
 			}
@@ -32,10 +32,13 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 )
 
@@ -69,6 +72,10 @@ func run(dirName string, logger *logrus.Logger, compression string, vectorCache
 	bq := flatent.CompressionUserConfig{
 		Enabled: false,
 	}
+	rq := flatent.RQUserConfig{
+		Enabled: false,
+		Bits:    flatent.DefaultRQBits,
+	}
 	switch compression {
 	case compressionPQ:
 		pq.Enabled = true
@@ -78,6 +85,9 @@ func run(dirName string, logger *logrus.Logger, compression string, vectorCache
 		bq.Enabled = true
 		bq.RescoreLimit = 100 * k
 		bq.Cache = vectorCache
+	case compressionRQ:
+		rq.Enabled = true
+		rq.RescoreLimit = 10 * k
 	}
 	index, err := New(Config{
 		ID:               runId,
@@ -85,6 +95,7 @@ func run(dirName string, logger *logrus.Logger, compression string, vectorCache
 	}, flatent.UserConfig{
 		PQ: pq,
 		BQ: bq,
+		RQ: rq,
 	}, store)
 	if err != nil {
 		return 0, 0, err
@@ -170,17 +181,20 @@ func Test_NoRaceFlatIndex(t *testing.T) {
 	}
 
 	extraVectorsForDelete, _ := testinghelpers.RandomVecs(5_000, 0, dimensions)
-	for _, compression := range []string{compressionNone, compressionBQ} {
+	for _, compression := range []string{compressionNone, compressionBQ, compressionRQ} {
 		t.Run("compression: "+compression, func(t *testing.T) {
 			for _, cache := range []bool{false, true} {
 				t.Run("cache: "+strconv.FormatBool(cache), func(t *testing.T) {
-					if compression == compressionNone && cache == true {
+					if compression != compressionBQ && cache == true {
 						return
 					}
 					targetRecall := float32(0.99)
 					if compression == compressionBQ {
 						targetRecall = 0.8
 					}
+					if compression == compressionRQ {
+						targetRecall = 0.95
+					}
 					t.Run("recall", func(t *testing.T) {
 						recall, latency, err := run(dirName, logger, compression, cache, vectors, queries, k, truths, nil, nil, distancer)
 						require.Nil(t, err)
@@ -202,10 +216,13 @@ func Test_NoRaceFlatIndex(t *testing.T) {
 			}
 		})
 	}
-	for _, compression := range []string{compressionNone, compressionBQ} {
+	for _, compression := range []string{compressionNone, compressionBQ, compressionRQ} {
 		t.Run("compression: "+compression, func(t *testing.T) {
 			for _, cache := range []bool{false, true} {
 				t.Run("cache: "+strconv.FormatBool(cache), func(t *testing.T) {
+					if compression == compressionRQ && cache == true {
+						return
+					}
 					from := 0
 					to := 3_000
 					for i := range queries {
@@ -220,6 +237,9 @@ func Test_NoRaceFlatIndex(t *testing.T) {
 					if compression == compressionBQ {
 						targetRecall = 0.8
 					}
+					if compression == compressionRQ {
+						targetRecall = 0.95
+					}
 
 					t.Run("recall on filtered", func(t *testing.T) {
 						recall, latency, err := run(dirName, logger, compression, cache, vectors, queries, k, truths, nil, allowIds, distancer)
@@ -298,3 +318,209 @@ func TestFlat_QueryVectorDistancer(t *testing.T) {
 		})
 	}
 }
+
+func TestFlat_SearchByVectorWithDistancer(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vectors, queries := testinghelpers.RandomVecs(200, 5, 16)
+
+	cases := []struct {
+		name      string
+		bq        bool
+		override  distancer.Provider
+		normalize bool
+	}{
+		{name: "dot override", override: distancer.NewDotProductProvider()},
+		{name: "cosine override", override: distancer.NewCosineDistanceProvider(), normalize: true},
+		{name: "dot override with bq", bq: true, override: distancer.NewDotProductProvider()},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			dirName := t.TempDir()
+			store, err := lsmkv.New(dirName, dirName, logger, nil,
+				cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+			require.Nil(t, err)
+			defer store.Shutdown(context.Background())
+
+			index, err := New(Config{
+				ID:               "id",
+				DistanceProvider: distancer.NewL2SquaredProvider(),
+			}, flatent.UserConfig{
+				BQ: flatent.CompressionUserConfig{Enabled: tt.bq, RescoreLimit: 10},
+			}, store)
+			require.Nil(t, err)
+
+			for i, vec := range vectors {
+				require.Nil(t, index.Add(uint64(i), vec))
+			}
+
+			// the cosine distancer expects normalized input, the index
+			// itself stores the vectors as they were added
+			distance := func(x, y []float32) float32 {
+				if tt.normalize {
+					x, y = distancer.Normalize(x), distancer.Normalize(y)
+				}
+				dist, _ := tt.override.SingleDist(x, y)
+				return dist
+			}
+
+			for _, query := range queries {
+				truth, _ := testinghelpers.BruteForce(logger, vectors, query, 10, distance)
+
+				ids, dists, err := index.SearchByVectorWithDistancer(query, 10, nil, tt.override)
+				require.Nil(t, err)
+				assert.Equal(t, truth, ids)
+				for i, id := range ids {
+					assert.InDelta(t, distance(query, vectors[id]), dists[i], 1e-4)
+				}
+			}
+		})
+	}
+}
+
+func TestFlat_ReducedStoragePrecision(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vectors, queries := testinghelpers.RandomVecs(200, 5, 32)
+
+	providers := []distancer.Provider{
+		distancer.NewL2SquaredProvider(),
+		distancer.NewDotProductProvider(),
+		distancer.NewCosineDistanceProvider(),
+	}
+	precisions := []string{
+		vectorindexcommon.StoragePrecisionFloat16,
+		vectorindexcommon.StoragePrecisionBFloat16,
+		vectorindexcommon.StoragePrecisionInt8,
+	}
+	for _, provider := range providers {
+		for _, name := range precisions {
+			t.Run(fmt.Sprintf("%s %s", provider.Type(), name), func(t *testing.T) {
+				precision, err := vectorindexcommon.ParseStoragePrecision(name)
+				require.Nil(t, err)
+
+				dirName := t.TempDir()
+				store, err := lsmkv.New(dirName, dirName, logger, nil,
+					cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+				require.Nil(t, err)
+				defer store.Shutdown(context.Background())
+
+				index, err := New(Config{
+					ID:               "id",
+					DistanceProvider: provider,
+				}, flatent.UserConfig{
+					StoragePrecision: name,
+				}, store)
+				require.Nil(t, err)
+
+				// the truth is calculated on the vectors as they end up on disk
+				stored := make([][]float32, len(vectors))
+				for i, vec := range vectors {
+					require.Nil(t, index.Add(uint64(i), vec))
+					if provider.Type() == "cosine-dot" {
+						vec = distancer.Normalize(vec)
+					}
+					stored[i] = precision.Round(vec)
+				}
+
+				for _, query := range queries {
+					if provider.Type() == "cosine-dot" {
+						query = distancer.Normalize(query)
+					}
+					truth, _ := testinghelpers.BruteForce(logger, stored, query, 10,
+						distanceWrapper(provider))
+
+					ids, dists, err := index.SearchByVector(query, 10, nil)
+					require.Nil(t, err)
+					assert.Equal(t, truth, ids)
+
+					queryDistancer := index.QueryVectorDistancer(query)
+					for i, id := range ids {
+						want, _ := provider.SingleDist(query, stored[id])
+						assert.InDelta(t, want, dists[i], 1e-4)
+
+						dist, err := queryDistancer.DistanceToNode(id)
+						require.Nil(t, err)
+						assert.InDelta(t, want, dist, 1e-4)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestFlat_SearchByVectorWithDistancer_CosineIndex(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	// vectors which aren't of unit length are normalized on insert
+	vectors := [][]float32{{4, 3}, {10, 0}, {0, 0.5}, {1, 1}}
+	query := []float32{2, 1}
+	vectorForID := func(ctx context.Context, id uint64) ([]float32, error) {
+		if id == 3 {
+			// deleted after the index was searched
+			return nil, storobj.NewErrNotFoundf(id, "deleted")
+		}
+		return vectors[id], nil
+	}
+
+	newIndex := func(t *testing.T, vectorForID common.VectorForID[float32]) *flat {
+		dirName := t.TempDir()
+		store, err := lsmkv.New(dirName, dirName, logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+		require.Nil(t, err)
+		t.Cleanup(func() { store.Shutdown(context.Background()) })
+
+		index, err := New(Config{
+			ID:               "id",
+			DistanceProvider: distancer.NewCosineDistanceProvider(),
+			VectorForIDThunk: vectorForID,
+		}, flatent.UserConfig{}, store)
+		require.Nil(t, err)
+
+		for i, vec := range vectors {
+			require.Nil(t, index.Add(uint64(i), vec))
+		}
+		return index
+	}
+
+	t.Run("overrides read the vectors of the objects", func(t *testing.T) {
+		index := newIndex(t, vectorForID)
+
+		for _, override := range []distancer.Provider{
+			distancer.NewL2SquaredProvider(),
+			distancer.NewDotProductProvider(),
+			distancer.NewManhattanProvider(),
+		} {
+			truth, _ := testinghelpers.BruteForce(logger, vectors[:3], query, 3,
+				distanceWrapper(override))
+
+			ids, dists, err := index.SearchByVectorWithDistancer(query, 3, nil, override)
+			require.Nil(t, err, override.Type())
+			assert.Equal(t, truth, ids, override.Type())
+			for i, id := range ids {
+				want, _ := override.SingleDist(query, vectors[id])
+				assert.InDelta(t, want, dists[i], 1e-5, override.Type())
+			}
+		}
+	})
+
+	t.Run("overrides fail without the vectors of the objects", func(t *testing.T) {
+		index := newIndex(t, nil)
+
+		_, _, err := index.SearchByVectorWithDistancer(query, 3, nil,
+			distancer.NewL2SquaredProvider())
+		assert.ErrorContains(t, err, "can't override cosine")
+	})
+
+	t.Run("cosine is searched on the index", func(t *testing.T) {
+		index := newIndex(t, nil)
+
+		ids, dists, err := index.SearchByVectorWithDistancer(query, 3, nil,
+			distancer.NewCosineDistanceProvider())
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 3, 1}, ids)
+		for i, id := range ids {
+			want, _ := distancer.NewCosineDistanceProvider().SingleDist(
+				distancer.Normalize(query), distancer.Normalize(vectors[id]))
+			assert.InDelta(t, want, dists[i], 1e-5)
+		}
+	})
+}
@@ -12,7 +12,9 @@
 package hnsw
 
 import (
+	"context"
 	"flag"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -28,7 +30,13 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"gopkg.in/yaml.v2"
 )
 
@@ -139,6 +147,108 @@ Ex: go test -v -benchmem -bench ^BenchmarkHnswNeurips23$ -download`, step.Datase
 	}
 }
 
+// BenchmarkHnswFilteredSearch compares the filter strategies on filters of
+// different selectivities. Besides the latency it reports the recall against
+// a brute force search over the matching vectors.
+func BenchmarkHnswFilteredSearch(b *testing.B) {
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(10_000, 100, 32)
+	k := 10
+
+	for _, strategy := range []string{ent.FilterStrategySweeping, ent.FilterStrategyAcorn} {
+		index := createFilteredHnswIndexForTests(b, vectors, strategy)
+
+		for _, selectivity := range []int{1, 5, 10, 50} {
+			allowList := allowListWithSelectivity(len(vectors), selectivity)
+			truths := make([][]uint64, len(queries))
+			for i := range queries {
+				truths[i] = filteredBruteForce(vectors, queries[i], k, allowList)
+			}
+
+			b.Run(fmt.Sprintf("%s/selectivity=%d%%", strategy, selectivity), func(b *testing.B) {
+				var relevant uint64
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					query := i % len(queries)
+					results, _, err := index.SearchByVector(queries[query], k, allowList)
+					require.NoError(b, err)
+					relevant += testinghelpers.MatchesInLists(truths[query], results)
+				}
+				b.ReportMetric(float64(relevant)/float64(b.N*k), "recall")
+			})
+		}
+	}
+}
+
+func createFilteredHnswIndexForTests(t testing.TB, vectors [][]float32, filterStrategy string) *hnsw {
+	logger, _ := test.NewNullLogger()
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+	uc.EF = 64
+	// always traverse the graph, no matter how restrictive the filter is
+	uc.FlatSearchCutoff = 0
+	uc.FilterStrategy = filterStrategy
+
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "filtered",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			if int(id) >= len(vectors) {
+				return nil, storobj.NewErrNotFoundf(id, "out of range")
+			}
+			return vectors[int(id)], nil
+		},
+	}, uc, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+
+	compressionhelpers.Concurrently(logger, uint64(len(vectors)), func(id uint64) {
+		require.Nil(t, index.Add(id, vectors[id]))
+	})
+	return index
+}
+
+// allowListWithSelectivity allows the given percentage of ids, spread
+// uniformly over the id range.
+func allowListWithSelectivity(size int, percent int) helpers.AllowList {
+	allowList := helpers.NewAllowList()
+	for id := 0; id < size; id++ {
+		if id%100 < percent {
+			allowList.Insert(uint64(id))
+		}
+	}
+	return allowList
+}
+
+func filteredBruteForce(vectors [][]float32, query []float32, k int, allowList helpers.AllowList) []uint64 {
+	type distanceAndID struct {
+		distance float32
+		id       uint64
+	}
+
+	matches := make([]distanceAndID, 0, allowList.Len())
+	for id, vector := range vectors {
+		if !allowList.Contains(uint64(id)) {
+			continue
+		}
+		distance, _ := distancer.NewL2SquaredProvider().SingleDist(query, vector)
+		matches = append(matches, distanceAndID{distance: distance, id: uint64(id)})
+	}
+	sort.Slice(matches, func(a, b int) bool {
+		return matches[a].distance < matches[b].distance
+	})
+
+	ids := make([]uint64, 0, k)
+	for i := 0; i < k && i < len(matches); i++ {
+		ids = append(ids, matches[i].id)
+	}
+	return ids
+}
+
 func downloadDataset(t testing.TB, name string) {
 	t.Helper()
 
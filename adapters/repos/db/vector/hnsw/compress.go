@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 
@@ -36,7 +37,7 @@ func (h *hnsw) calculateOptimalSegments(dims int) int {
 }
 
 func (h *hnsw) compress(cfg ent.UserConfig) error {
-	if !cfg.PQ.Enabled && !cfg.BQ.Enabled && !cfg.SQ.Enabled {
+	if !cfg.PQ.Enabled && !cfg.BQ.Enabled && !cfg.SQ.Enabled && !cfg.RQ.Enabled {
 		return nil
 	}
 
@@ -74,31 +75,26 @@ func (h *hnsw) compress(cfg ent.UserConfig) error {
 				break
 			}
 		}
-		if cfg.PQ.Enabled {
-			dims := int(h.dims)
-
-			if cfg.PQ.Segments <= 0 {
-				cfg.PQ.Segments = h.calculateOptimalSegments(dims)
-				h.pqConfig.Segments = cfg.PQ.Segments
-			}
-
-			var err error
-			h.compressor, err = compressionhelpers.NewHNSWPQCompressor(
-				cfg.PQ, h.distancerProvider, dims, 1e12, h.logger, cleanData, h.store,
-				h.allocChecker)
-			if err != nil {
-				return fmt.Errorf("compressing vectors: %w", err)
-			}
-		} else if cfg.SQ.Enabled {
-			var err error
-			h.compressor, err = compressionhelpers.NewHNSWSQCompressor(
-				h.distancerProvider, 1e12, h.logger, cleanData, h.store,
-				h.allocChecker)
-			if err != nil {
-				return fmt.Errorf("compressing vectors: %w", err)
-			}
+		var err error
+		h.compressor, err = h.newTrainedCompressor(cfg, cleanData)
+		if err != nil {
+			return fmt.Errorf("compressing vectors: %w", err)
+		}
+		if err := h.compressor.PersistCompression(h.commitLog); err != nil {
+			return fmt.Errorf("persist compression: %w", err)
+		}
+	} else if cfg.RQ.Enabled {
+		if h.isEmpty() {
+			return errors.New("compress command cannot be executed before inserting some data")
+		}
+		var err error
+		h.compressor, err = h.newTrainedCompressor(cfg, nil)
+		if err != nil {
+			return fmt.Errorf("compressing vectors: %w", err)
+		}
+		if err := h.compressor.PersistCompression(h.commitLog); err != nil {
+			return fmt.Errorf("persist compression: %w", err)
 		}
-		h.compressor.PersistCompression(h.commitLog)
 	} else {
 		var err error
 		h.compressor, err = compressionhelpers.NewBQCompressor(
@@ -119,3 +115,32 @@ func (h *hnsw) compress(cfg ent.UserConfig) error {
 	h.cache.Drop()
 	return nil
 }
+
+// newTrainedCompressor creates a PQ, SQ or RQ compressor fitted to the given
+// training data. These are the compressions which persist their state in the
+// commit log.
+func (h *hnsw) newTrainedCompressor(cfg ent.UserConfig, data [][]float32) (compressionhelpers.VectorCompressor, error) {
+	dims := int(h.dims)
+	switch {
+	case cfg.PQ.Enabled:
+		if cfg.PQ.Segments <= 0 {
+			cfg.PQ.Segments = h.calculateOptimalSegments(dims)
+			h.pqConfig.Segments = cfg.PQ.Segments
+		}
+		return compressionhelpers.NewHNSWPQCompressor(
+			cfg.PQ, h.distancerProvider, dims, 1e12, h.logger, data, h.store,
+			h.allocChecker)
+	case cfg.SQ.Enabled:
+		return compressionhelpers.NewHNSWSQCompressor(
+			h.distancerProvider, 1e12, h.logger, data, h.store,
+			h.allocChecker)
+	case cfg.RQ.Enabled:
+		// the rotation is derived from the seed, which is persisted in the
+		// commit log to restore the same quantizer on startup
+		return compressionhelpers.NewHNSWRQCompressor(
+			h.distancerProvider, dims, cfg.RQ.Bits, rand.Uint64(), 1e12,
+			h.logger, h.store, h.allocChecker)
+	default:
+		return nil, errors.New("no trainable compression enabled")
+	}
+}
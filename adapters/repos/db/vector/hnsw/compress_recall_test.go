@@ -143,3 +143,106 @@ func Test_NoRaceCompressionRecall(t *testing.T) {
 		wg.Wait()
 	}
 }
+
+func Test_NoRaceRQCompressionRecall(t *testing.T) {
+	efConstruction := 64
+	ef := 64
+	maxNeighbors := 32
+	dimensions := 64
+	vectors_size := 10000
+	queries_size := 100
+	vectors, queries := testinghelpers.RandomVecs(vectors_size, queries_size, dimensions)
+	testinghelpers.Normalize(vectors)
+	testinghelpers.Normalize(queries)
+	k := 10
+
+	logger, _ := test.NewNullLogger()
+
+	distancers := []distancer.Provider{
+		distancer.NewL2SquaredProvider(),
+		distancer.NewCosineDistanceProvider(),
+		distancer.NewDotProductProvider(),
+	}
+
+	// fewer bits lose more precision, which rescoring more candidates has to
+	// make up for. Only the ef closest candidates can be rescored.
+	bitsAndRecall := []struct {
+		bits         int
+		rescoreLimit int
+	}{
+		{bits: 8, rescoreLimit: 100},
+		{bits: 4, rescoreLimit: 100},
+		{bits: 2, rescoreLimit: 200},
+		{bits: 1, rescoreLimit: 800},
+	}
+
+	for _, distancer := range distancers {
+		truths := make([][]uint64, queries_size)
+		compressionhelpers.Concurrently(logger, uint64(len(queries)), func(i uint64) {
+			truths[i], _ = testinghelpers.BruteForce(logger, vectors, queries[i], k, distanceWrapper(distancer))
+		})
+
+		for _, tt := range bitsAndRecall {
+			t.Run(fmt.Sprintf("%s/%d bits", distancer.Type(), tt.bits), func(t *testing.T) {
+				uc := ent.UserConfig{
+					MaxConnections:        maxNeighbors,
+					EFConstruction:        efConstruction,
+					EF:                    max(ef, tt.rescoreLimit),
+					VectorCacheMaxObjects: 10e12,
+				}
+				index, err := hnsw.New(hnsw.Config{
+					RootPath:              t.TempDir(),
+					ID:                    "recallbenchmark",
+					MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+					ClassName:             "clasRecallBenchmark",
+					ShardName:             "shardRecallBenchmark",
+					DistanceProvider:      distancer,
+					VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+						if int(id) >= len(vectors) {
+							return nil, storobj.NewErrNotFoundf(id, "out of range")
+						}
+						return vectors[int(id)], nil
+					},
+					TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+						copy(container.Slice, vectors[int(id)])
+						return container.Slice, nil
+					},
+				}, uc, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+					cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+				if err != nil {
+					t.Fatal(err)
+				}
+				compressionhelpers.Concurrently(logger, uint64(vectors_size), func(id uint64) {
+					index.Add(id, vectors[id])
+				})
+
+				uc.RQ = ent.RQConfig{
+					Enabled:      true,
+					Bits:         tt.bits,
+					RescoreLimit: tt.rescoreLimit,
+				}
+				wg := sync.WaitGroup{}
+				wg.Add(1)
+				index.UpdateUserConfig(uc, func() {
+					defer wg.Done()
+
+					var relevant uint64
+					var mu sync.Mutex
+					compressionhelpers.Concurrently(logger, uint64(len(queries)), func(i uint64) {
+						results, _, _ := index.SearchByVector(queries[i], k, nil)
+						matches := testinghelpers.MatchesInLists(truths[i], results)
+						mu.Lock()
+						relevant += matches
+						mu.Unlock()
+					})
+
+					recall := float32(relevant) / float32(k*queries_size)
+					fmt.Println(recall)
+					assert.True(t, index.Compressed())
+					assert.Greater(t, recall, float32(0.9))
+				})
+				wg.Wait()
+			})
+		}
+	}
+}
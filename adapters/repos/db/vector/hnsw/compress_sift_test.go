@@ -408,6 +408,96 @@ func TestHnswPqSift(t *testing.T) {
 	}
 }
 
+func TestHnswRqSift(t *testing.T) {
+	params := []struct {
+		bits         int
+		rescoreLimit int
+	}{
+		{bits: 8, rescoreLimit: 100},
+		{bits: 4, rescoreLimit: 200},
+		{bits: 2, rescoreLimit: 400},
+		{bits: 1, rescoreLimit: 800},
+	}
+	efConstruction := 64
+	maxNeighbors := 32
+	dimensions := 128
+	vectors_size := 1000000
+	queries_size := 1000
+	switch_at := 200000
+	fmt.Println("Sift1M RQ")
+	before := time.Now()
+	vectors, queries := testinghelpers.ReadVecs(vectors_size, queries_size, dimensions, "sift", "../diskAnn/testdata")
+	k := 100
+	logger, _ := test.NewNullLogger()
+	distancer := distancer.NewL2SquaredProvider()
+	truths := testinghelpers.BuildTruths(logger, queries_size, vectors_size, queries, vectors, k, distanceWrapper(distancer), "../diskAnn/testdata")
+	fmt.Printf("generating data took %s\n", time.Since(before))
+	for _, p := range params {
+		fmt.Println(p.bits, "bits")
+		uc := ent.UserConfig{
+			MaxConnections:        maxNeighbors,
+			EFConstruction:        efConstruction,
+			EF:                    p.rescoreLimit,
+			VectorCacheMaxObjects: 10e12,
+		}
+		index, _ := hnsw.New(hnsw.Config{
+			RootPath:              t.TempDir(),
+			ID:                    "recallbenchmark",
+			MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+			DistanceProvider:      distancer,
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+		}, uc, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+		init := time.Now()
+		compressionhelpers.Concurrently(logger, uint64(switch_at), func(id uint64) {
+			index.Add(id, vectors[id])
+		})
+		before = time.Now()
+		fmt.Println("Start compressing...")
+
+		// RQ doesn't need training data, the vectors are compressed as soon
+		// as the config is updated
+		uc.RQ = ent.RQConfig{
+			Enabled:      true,
+			Bits:         p.bits,
+			RescoreLimit: p.rescoreLimit,
+		}
+		compressed := make(chan struct{})
+		index.UpdateUserConfig(uc, func() { close(compressed) })
+		<-compressed
+		fmt.Printf("Time to compress: %s", time.Since(before))
+		fmt.Println()
+		compressionhelpers.Concurrently(logger, uint64(vectors_size-switch_at), func(id uint64) {
+			idx := switch_at + int(id)
+			index.Add(uint64(idx), vectors[idx])
+		})
+		fmt.Printf("Building the index took %s\n", time.Since(init))
+
+		var relevant uint64
+		var querying time.Duration
+		var mu sync.Mutex
+		compressionhelpers.Concurrently(logger, uint64(len(queries)), func(i uint64) {
+			before := time.Now()
+			results, _, _ := index.SearchByVector(queries[i], k, nil)
+			took := time.Since(before)
+			matches := testinghelpers.MatchesInLists(truths[i], results)
+			mu.Lock()
+			querying += took
+			relevant += matches
+			mu.Unlock()
+		})
+
+		recall := float32(relevant) / float32(k*queries_size)
+		latency := float32(querying.Microseconds()) / float32(queries_size)
+		fmt.Println(recall, latency)
+		assert.True(t, index.Compressed())
+		assert.True(t, recall > 0.9)
+		assert.True(t, latency < 100000)
+	}
+}
+
 func TestHnswPqSiftDeletes(t *testing.T) {
 	defer func(path string) {
 		err := os.RemoveAll(path)
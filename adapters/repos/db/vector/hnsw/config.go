@@ -37,6 +37,9 @@ type Config struct {
 	PrometheusMetrics     *monitoring.PrometheusMetrics
 	AllocChecker          memwatch.AllocChecker
 	WaitForCachePrefill   bool
+	// TargetVector is only used to name the buckets the index creates in the
+	// shard's store, so that the indexes of a shard don't share them
+	TargetVector string
 
 	// metadata for monitoring
 	ShardName string
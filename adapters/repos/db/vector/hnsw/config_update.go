@@ -56,6 +56,14 @@ func ValidateUserConfigUpdate(initial, updated config.VectorIndexConfig) error {
 			name:     "distance",
 			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			name:     "storagePrecision",
+			accessor: func(c ent.UserConfig) interface{} { return c.StoragePrecision },
+		},
+		{
+			name:     "multivector",
+			accessor: func(c ent.UserConfig) interface{} { return c.Multivector },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -99,8 +107,9 @@ func (h *hnsw) UpdateUserConfig(updated config.VectorIndexConfig, callback func(
 	atomic.StoreInt64(&h.efMax, int64(parsed.DynamicEFMax))
 	atomic.StoreInt64(&h.efFactor, int64(parsed.DynamicEFFactor))
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
+	h.acornSearch.Store(parsed.FilterStrategy == ent.FilterStrategyAcorn)
 
-	if !parsed.PQ.Enabled && !parsed.BQ.Enabled && !parsed.SQ.Enabled {
+	if !parsed.PQ.Enabled && !parsed.BQ.Enabled && !parsed.SQ.Enabled && !parsed.RQ.Enabled {
 		callback()
 		return nil
 	}
@@ -108,6 +117,7 @@ func (h *hnsw) UpdateUserConfig(updated config.VectorIndexConfig, callback func(
 	h.pqConfig = parsed.PQ
 	h.sqConfig = parsed.SQ
 	h.bqConfig = parsed.BQ
+	h.rqConfig = parsed.RQ
 	if asyncEnabled() {
 		callback()
 		return nil
@@ -148,6 +158,7 @@ func (h *hnsw) compressThenCallback(callback func()) {
 		PQ: h.pqConfig,
 		BQ: h.bqConfig,
 		SQ: h.sqConfig,
+		RQ: h.rqConfig,
 	}
 	if err := h.compress(uc); err != nil {
 		h.logger.Error(err)
@@ -63,6 +63,14 @@ func TestUserConfigUpdates(t *testing.T) {
 					"distance is immutable: " +
 						"attempted change from \"cosine\" to \"l2-squared\""),
 			},
+			{
+				name:    "attempting to enable multivector",
+				initial: ent.UserConfig{},
+				update:  ent.UserConfig{Multivector: ent.MultivectorConfig{Enabled: true, Aggregation: "maxSim"}},
+				expectedError: errors.Errorf(
+					"multivector is immutable: " +
+						"attempted change from \"{false }\" to \"{true maxSim}\""),
+			},
 			{
 				name:          "changing ef",
 				initial:       ent.UserConfig{EF: 100},
@@ -38,6 +38,7 @@ type DeserializationResult struct {
 	EntrypointChanged bool
 	CompressionPQData *compressionhelpers.PQData
 	CompressionSQData *compressionhelpers.SQData
+	CompressionRQData *compressionhelpers.RQData
 	Compressed        bool
 
 	// If there is no entry for the links at a level to be replaced, we must
@@ -148,6 +149,9 @@ func (d *Deserializer) Do(fd *bufio.Reader, initialState *DeserializationResult,
 		case AddSQ:
 			err = d.ReadSQ(fd, out)
 			readThisRound = 10
+		case AddRQ:
+			err = d.ReadRQ(fd, out)
+			readThisRound = 13
 		default:
 			err = errors.Errorf("unrecognized commit type %d", ct)
 		}
@@ -591,7 +595,11 @@ func (d *Deserializer) ReadPQ(r io.Reader, res *DeserializationResult) (int, err
 	}
 	res.Compressed = true
 
+	// a later compression record replaces an earlier one, e.g. after the
+	// index has been requantized
 	res.CompressionPQData = &pqData
+	res.CompressionSQData = nil
+	res.CompressionRQData = nil
 
 	return totalRead, nil
 }
@@ -614,6 +622,33 @@ func (d *Deserializer) ReadSQ(r io.Reader, res *DeserializationResult) error {
 		B:          b,
 		Dimensions: dims,
 	}
+	res.CompressionPQData = nil
+	res.CompressionRQData = nil
+	res.Compressed = true
+
+	return nil
+}
+
+func (d *Deserializer) ReadRQ(r io.Reader, res *DeserializationResult) error {
+	dims, err := d.readUint32(r)
+	if err != nil {
+		return err
+	}
+	bits, err := d.readByte(r)
+	if err != nil {
+		return err
+	}
+	seed, err := d.readUint64(r)
+	if err != nil {
+		return err
+	}
+	res.CompressionRQData = &compressionhelpers.RQData{
+		Dimensions: dims,
+		Bits:       bits,
+		Seed:       seed,
+	}
+	res.CompressionPQData = nil
+	res.CompressionSQData = nil
 	res.Compressed = true
 
 	return nil
@@ -660,6 +695,19 @@ func (d *Deserializer) readFloat32(r io.Reader) (float32, error) {
 	return value, nil
 }
 
+func (d *Deserializer) readUint32(r io.Reader) (uint32, error) {
+	var value uint32
+	d.resetResusableBuffer(4)
+	_, err := io.ReadFull(r, d.reusableBuffer)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to read uint32")
+	}
+
+	value = binary.LittleEndian.Uint32(d.reusableBuffer)
+
+	return value, nil
+}
+
 func (d *Deserializer) readUint16(r io.Reader) (uint16, error) {
 	var value uint16
 	d.resetResusableBuffer(2)
@@ -494,3 +494,48 @@ func TestDeserializerTotalReadPQ(t *testing.T) {
 		t.Logf("deserializeSize: %v\n", deserializeSize)
 	})
 }
+
+func TestDeserializerTotalReadRQ(t *testing.T) {
+	rootPath := t.TempDir()
+	ctx := context.Background()
+
+	logger, _ := test.NewNullLogger()
+	commitLogger, err := NewCommitLogger(rootPath, "tmpLogger", logger,
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+
+	rqData := compressionhelpers.RQData{
+		Dimensions: 100_000,
+		Bits:       4,
+		Seed:       0xdeadbeef,
+	}
+
+	t.Run("add rq data to the first log", func(t *testing.T) {
+		require.Nil(t, commitLogger.AddRQCompression(rqData))
+		require.Nil(t, commitLogger.Flush())
+		require.Nil(t, commitLogger.Shutdown(ctx))
+	})
+
+	t.Run("deserialize the first log", func(t *testing.T) {
+		nullLogger, _ := test.NewNullLogger()
+		commitLoggerPath := rootPath + "/tmpLogger.hnsw.commitlog.d"
+
+		fileName, found, err := getCurrentCommitLogFileName(commitLoggerPath)
+		require.Nil(t, err)
+		require.True(t, found)
+
+		fd, err := os.Open(commitLoggerPath + "/" + fileName)
+		require.Nil(t, err)
+
+		defer fd.Close()
+		fdBuf := bufio.NewReaderSize(fd, 256*1024)
+
+		res, deserializeSize, err := NewDeserializer(nullLogger).Do(fdBuf, nil, true)
+		require.Nil(t, err)
+
+		require.Equal(t, 14, deserializeSize)
+		require.True(t, res.Compressed)
+		require.NotNil(t, res.CompressionRQData)
+		require.Equal(t, rqData, *res.CompressionRQData)
+	})
+}
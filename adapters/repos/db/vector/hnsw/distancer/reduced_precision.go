@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+// ReducedPrecisionDistancer computes the distance between a float32 query and
+// vectors stored with a reduced precision. The stored vectors are decoded
+// element by element while computing the distance, so they never have to be
+// materialized as []float32. Metrics without a specialized implementation
+// fall back to decoding the vector and using the provider.
+type ReducedPrecisionDistancer struct {
+	query     []float32
+	precision common.StoragePrecision
+	provider  Provider
+}
+
+func NewReducedPrecisionDistancer(provider Provider,
+	precision common.StoragePrecision, query []float32,
+) *ReducedPrecisionDistancer {
+	return &ReducedPrecisionDistancer{
+		query:     query,
+		precision: precision,
+		provider:  provider,
+	}
+}
+
+func (d *ReducedPrecisionDistancer) Distance(encoded []byte) (float32, error) {
+	if dims := d.precision.Dims(len(encoded)); dims != len(d.query) {
+		return 0, errors.Wrapf(ErrVectorLength, "%d vs %d", len(d.query), dims)
+	}
+
+	switch d.provider.Type() {
+	case "l2-squared":
+		return d.l2Squared(encoded), nil
+	case "dot":
+		return -d.dot(encoded), nil
+	case "cosine-dot":
+		return 1 - d.dot(encoded), nil
+	default:
+		vec := d.precision.Decode(encoded, make([]float32, len(d.query)))
+		return d.provider.SingleDist(d.query, vec)
+	}
+}
+
+func (d *ReducedPrecisionDistancer) dot(encoded []byte) float32 {
+	switch d.precision {
+	case common.PrecisionFloat16:
+		return float16Dot(d.query, encoded)
+	case common.PrecisionBFloat16:
+		return bfloat16Dot(d.query, encoded)
+	case common.PrecisionInt8:
+		return int8Dot(d.query, encoded)
+	default:
+		vec := d.precision.Decode(encoded, make([]float32, len(d.query)))
+		return dotProductImplementation(d.query, vec)
+	}
+}
+
+func (d *ReducedPrecisionDistancer) l2Squared(encoded []byte) float32 {
+	switch d.precision {
+	case common.PrecisionFloat16:
+		return float16L2Squared(d.query, encoded)
+	case common.PrecisionBFloat16:
+		return bfloat16L2Squared(d.query, encoded)
+	case common.PrecisionInt8:
+		return int8L2Squared(d.query, encoded)
+	default:
+		vec := d.precision.Decode(encoded, make([]float32, len(d.query)))
+		return l2SquaredImpl(d.query, vec)
+	}
+}
+
+func float16Dot(a []float32, b []byte) float32 {
+	var sum float32
+	for i := range a {
+		sum += a[i] * common.Float16ToFloat32(binary.LittleEndian.Uint16(b[i*2:]))
+	}
+	return sum
+}
+
+func float16L2Squared(a []float32, b []byte) float32 {
+	var sum float32
+	for i := range a {
+		diff := a[i] - common.Float16ToFloat32(binary.LittleEndian.Uint16(b[i*2:]))
+		sum += diff * diff
+	}
+	return sum
+}
+
+func bfloat16Dot(a []float32, b []byte) float32 {
+	var sum float32
+	for i := range a {
+		sum += a[i] * common.BFloat16ToFloat32(binary.LittleEndian.Uint16(b[i*2:]))
+	}
+	return sum
+}
+
+func bfloat16L2Squared(a []float32, b []byte) float32 {
+	var sum float32
+	for i := range a {
+		diff := a[i] - common.BFloat16ToFloat32(binary.LittleEndian.Uint16(b[i*2:]))
+		sum += diff * diff
+	}
+	return sum
+}
+
+// int8Dot applies the scale once to the sum instead of to every element
+func int8Dot(a []float32, b []byte) float32 {
+	scale := math.Float32frombits(binary.LittleEndian.Uint32(b))
+	b = b[4:]
+
+	var sum float32
+	for i := range a {
+		sum += a[i] * float32(int8(b[i]))
+	}
+	return sum * scale
+}
+
+func int8L2Squared(a []float32, b []byte) float32 {
+	scale := math.Float32frombits(binary.LittleEndian.Uint32(b))
+	b = b[4:]
+
+	var sum float32
+	for i := range a {
+		diff := a[i] - float32(int8(b[i]))*scale
+		sum += diff * diff
+	}
+	return sum
+}
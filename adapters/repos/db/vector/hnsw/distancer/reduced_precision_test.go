@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func TestReducedPrecisionDistancer(t *testing.T) {
+	r := getRandomSeed()
+	dims := 97
+
+	randomVector := func() []float32 {
+		vec := make([]float32, dims)
+		for i := range vec {
+			vec[i] = r.Float32()*2 - 1
+		}
+		return vec
+	}
+
+	providers := []Provider{
+		NewL2SquaredProvider(),
+		NewDotProductProvider(),
+		NewCosineDistanceProvider(),
+		NewManhattanProvider(),
+	}
+	precisions := []common.StoragePrecision{
+		common.PrecisionFloat32,
+		common.PrecisionFloat16,
+		common.PrecisionBFloat16,
+		common.PrecisionInt8,
+	}
+
+	for _, provider := range providers {
+		for _, precision := range precisions {
+			t.Run(provider.Type()+" "+precision.String(), func(t *testing.T) {
+				for i := 0; i < 20; i++ {
+					query, vec := randomVector(), randomVector()
+					if provider.Type() == "cosine-dot" {
+						query, vec = Normalize(query), Normalize(vec)
+					}
+
+					encoded := make([]byte, precision.EncodedLen(dims))
+					precision.Encode(vec, encoded)
+
+					dist, err := NewReducedPrecisionDistancer(provider, precision, query).Distance(encoded)
+					require.Nil(t, err)
+
+					// the distance is computed against the stored vector, not
+					// the original one
+					control, err := provider.SingleDist(query, precision.Round(vec))
+					require.Nil(t, err)
+					assert.InDelta(t, control, dist, 1e-4)
+				}
+			})
+		}
+	}
+
+	t.Run("without matching dimensions", func(t *testing.T) {
+		encoded := make([]byte, common.PrecisionFloat16.EncodedLen(dims-1))
+		_, err := NewReducedPrecisionDistancer(NewL2SquaredProvider(),
+			common.PrecisionFloat16, randomVector()).Distance(encoded)
+		assert.ErrorIs(t, err, ErrVectorLength)
+	})
+}
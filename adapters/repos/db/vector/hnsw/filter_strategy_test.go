@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !race
+
+package hnsw
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func Test_NoRaceFilterStrategyRecall(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(5_000, 50, 16)
+	k := 10
+
+	for _, strategy := range []string{ent.FilterStrategySweeping, ent.FilterStrategyAcorn} {
+		index := createFilteredHnswIndexForTests(t, vectors, strategy)
+
+		for _, selectivity := range []int{2, 10, 50} {
+			t.Run(fmt.Sprintf("%s/selectivity=%d%%", strategy, selectivity), func(t *testing.T) {
+				allowList := allowListWithSelectivity(len(vectors), selectivity)
+
+				var relevant uint64
+				for _, query := range queries {
+					results, _, err := index.SearchByVector(query, k, allowList)
+					require.NoError(t, err)
+					require.Len(t, results, k)
+					for _, id := range results {
+						assert.True(t, allowList.Contains(id), "result %d does not match the filter", id)
+					}
+					relevant += testinghelpers.MatchesInLists(filteredBruteForce(vectors, query, k, allowList), results)
+				}
+
+				recall := float32(relevant) / float32(k*len(queries))
+				assert.Greater(t, recall, float32(0.9))
+			})
+		}
+	}
+}
+
+func TestFilterStrategyUpdate(t *testing.T) {
+	vectors, _ := testinghelpers.RandomVecsFixedSeed(100, 0, 4)
+	index := createFilteredHnswIndexForTests(t, vectors, ent.FilterStrategySweeping)
+	assert.False(t, index.acornSearch.Load())
+
+	uc := ent.NewDefaultUserConfig()
+	uc.FilterStrategy = ent.FilterStrategyAcorn
+	require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+	assert.True(t, index.acornSearch.Load())
+}
@@ -0,0 +1,333 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math/rand"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+)
+
+// recallEstimateK is the number of neighbors compared per sample when
+// estimating the recall of the graph
+const recallEstimateK = 10
+
+// MaxRecallSamples limits the number of samples of a recall estimate, every
+// sample is compared with every vector of the graph
+const MaxRecallSamples = 1000
+
+// HealthReport describes the state of an hnsw graph. It helps to tell if an
+// index should be compacted (many tombstones) or rebuilt (unreachable nodes,
+// poor recall).
+type HealthReport struct {
+	Nodes       int           `json:"nodes"`
+	Tombstones  int           `json:"tombstones"`
+	Layers      []LayerHealth `json:"layers"`
+	Unreachable int           `json:"unreachable"`
+	Entrypoint  uint64        `json:"entrypoint"`
+	Compressed  bool          `json:"compressed"`
+	Compression string        `json:"compression,omitempty"`
+	// CommitLogSize is the size of all commit log files on disk in bytes
+	CommitLogSize int64 `json:"commitLogSize"`
+	// EstimatedRecall is only set if samples were requested, it compares the
+	// results of searching for the vectors of random nodes with a brute force
+	// search over the uncompressed vectors
+	EstimatedRecall *float32 `json:"estimatedRecall,omitempty"`
+}
+
+type LayerHealth struct {
+	Level            int     `json:"level"`
+	Nodes            int     `json:"nodes"`
+	AverageOutDegree float64 `json:"averageOutDegree"`
+}
+
+// Health inspects the graph and reports on its structure. With recallSamples
+// greater than zero the recall is estimated, which requires a pass over all
+// vectors and is therefore considerably more expensive. At most
+// MaxRecallSamples samples are taken.
+func (h *hnsw) Health(ctx context.Context, recallSamples int) (*HealthReport, error) {
+	recallSamples = min(recallSamples, MaxRecallSamples)
+
+	h.RLock()
+	size := len(h.nodes)
+	entrypoint := h.entryPointID
+	h.RUnlock()
+
+	h.tombstoneLock.RLock()
+	tombstones := len(h.tombstones)
+	h.tombstoneLock.RUnlock()
+
+	report := &HealthReport{
+		Tombstones:  tombstones,
+		Entrypoint:  entrypoint,
+		Compressed:  h.compressed.Load(),
+		Compression: h.compressionType(),
+	}
+
+	var degrees []int
+	for id := 0; id < size; id++ {
+		connections := h.connectionsOf(uint64(id))
+		if connections == nil {
+			continue
+		}
+		report.Nodes++
+		for level, conns := range connections {
+			if level == len(report.Layers) {
+				report.Layers = append(report.Layers, LayerHealth{Level: level})
+				degrees = append(degrees, 0)
+			}
+			report.Layers[level].Nodes++
+			degrees[level] += len(conns)
+		}
+	}
+	for level := range report.Layers {
+		report.Layers[level].AverageOutDegree = float64(degrees[level]) /
+			float64(report.Layers[level].Nodes)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	report.Unreachable = h.countUnreachable(size, entrypoint)
+
+	commitLogSize, err := h.commitLogSize()
+	if err != nil {
+		return nil, errors.Wrap(err, "commit log size")
+	}
+	report.CommitLogSize = commitLogSize
+
+	// the recall can only be estimated if a sample has other nodes to find
+	if recallSamples > 0 && report.Nodes > 1 {
+		recall, err := h.estimateRecall(ctx, size, recallSamples)
+		if err != nil {
+			return nil, errors.Wrap(err, "estimate recall")
+		}
+		report.EstimatedRecall = &recall
+	}
+
+	return report, nil
+}
+
+// connectionsOf returns a copy of the connections of the node on all levels
+// or nil if there is no such node
+func (h *hnsw) connectionsOf(id uint64) [][]uint64 {
+	h.shardedNodeLocks.RLock(id)
+	node := h.nodes[id]
+	h.shardedNodeLocks.RUnlock(id)
+	if node == nil {
+		return nil
+	}
+
+	node.Lock()
+	defer node.Unlock()
+	connections := make([][]uint64, len(node.connections))
+	for level, conns := range node.connections {
+		connections[level] = append([]uint64(nil), conns...)
+	}
+	return connections
+}
+
+// countUnreachable counts the nodes without a tombstone which can't be reached
+// from the entrypoint on any level
+func (h *hnsw) countUnreachable(size int, entrypoint uint64) int {
+	reached := make([]bool, size)
+	queue := []uint64{entrypoint}
+	if int(entrypoint) < size {
+		reached[entrypoint] = true
+	}
+	for len(queue) > 0 {
+		id := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		if int(id) >= size {
+			continue
+		}
+		for _, conns := range h.connectionsOf(id) {
+			for _, neighbor := range conns {
+				if int(neighbor) < size && !reached[neighbor] {
+					reached[neighbor] = true
+					queue = append(queue, neighbor)
+				}
+			}
+		}
+	}
+
+	unreachable := 0
+	for id := 0; id < size; id++ {
+		if reached[id] {
+			continue
+		}
+		h.shardedNodeLocks.RLock(uint64(id))
+		exists := h.nodes[id] != nil
+		h.shardedNodeLocks.RUnlock(uint64(id))
+		if exists && !h.hasTombstone(uint64(id)) {
+			unreachable++
+		}
+	}
+	return unreachable
+}
+
+func (h *hnsw) compressionType() string {
+	if !h.compressed.Load() {
+		return ""
+	}
+	// same precedence as on compression
+	switch {
+	case h.pqConfig.Enabled:
+		return "pq"
+	case h.sqConfig.Enabled:
+		return "sq"
+	case h.rqConfig.Enabled:
+		return "rq"
+	default:
+		return "bq"
+	}
+}
+
+func (h *hnsw) commitLogSize() (int64, error) {
+	dir := commitLogDirectory(h.commitLog.RootPath(), h.commitLog.ID())
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// noop commit logger or nothing written yet
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	var size int64
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return 0, err
+		}
+		size += info.Size()
+	}
+	return size, nil
+}
+
+// estimateRecall searches for the vectors of randomly sampled nodes and
+// compares the results to the exact nearest neighbors. The exact neighbors of
+// all samples are determined in a single pass over the vectors, so that every
+// vector only needs to be loaded once.
+func (h *hnsw) estimateRecall(ctx context.Context, size, samples int) (float32, error) {
+	ids := make([]uint64, 0, samples)
+	picked := map[uint64]struct{}{}
+	for attempts := 0; len(ids) < samples && attempts < 10*samples; attempts++ {
+		id := uint64(rand.Intn(size))
+		if _, ok := picked[id]; ok || h.nodeByID(id) == nil || h.hasTombstone(id) {
+			continue
+		}
+		picked[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return 0, errors.New("no nodes to sample")
+	}
+
+	container := h.pools.tempVectors.Get(int(h.dims))
+	defer h.pools.tempVectors.Put(container)
+
+	queries := make([][]float32, len(ids))
+	for i, id := range ids {
+		vec, err := h.exactVector(ctx, id, container)
+		if err != nil {
+			return 0, errors.Wrapf(err, "get vector of sample %d", id)
+		}
+		queries[i] = append([]float32(nil), vec...)
+	}
+
+	truths := make([]*priorityqueue.Queue[any], len(queries))
+	for i := range truths {
+		truths[i] = priorityqueue.NewMax[any](recallEstimateK)
+	}
+	for id := 0; id < size; id++ {
+		if id%1000 == 0 {
+			if err := ctx.Err(); err != nil {
+				return 0, err
+			}
+		}
+		if h.nodeByID(uint64(id)) == nil || h.hasTombstone(uint64(id)) {
+			continue
+		}
+		vec, err := h.exactVector(ctx, uint64(id), container)
+		if err != nil {
+			// deleted in the meantime
+			continue
+		}
+		for i, query := range queries {
+			// a sample is always its own nearest neighbor
+			if uint64(id) == ids[i] {
+				continue
+			}
+			dist, err := h.distancerProvider.SingleDist(query, vec)
+			if err != nil {
+				return 0, err
+			}
+			if truths[i].Len() < recallEstimateK {
+				truths[i].Insert(uint64(id), dist)
+			} else if truths[i].Top().Dist > dist {
+				truths[i].Pop()
+				truths[i].Insert(uint64(id), dist)
+			}
+		}
+	}
+
+	var relevant, retrieved int
+	for i, query := range queries {
+		truth := map[uint64]struct{}{}
+		for truths[i].Len() > 0 {
+			truth[truths[i].Pop().ID] = struct{}{}
+		}
+
+		// one more result is requested, as the sample itself is skipped
+		results, _, err := h.SearchByVector(query, recallEstimateK+1, nil)
+		if err != nil {
+			return 0, err
+		}
+		found := 0
+		for _, id := range results {
+			if id == ids[i] || found == recallEstimateK {
+				continue
+			}
+			found++
+			if _, ok := truth[id]; ok {
+				relevant++
+			}
+		}
+		retrieved += len(truth)
+	}
+
+	return float32(relevant) / float32(retrieved), nil
+}
+
+// exactVector returns the uncompressed vector of the node. The returned slice
+// may be backed by the container and is only valid until its next use.
+func (h *hnsw) exactVector(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+	var vec []float32
+	var err error
+	if h.compressed.Load() {
+		vec, err = h.TempVectorForIDThunk(ctx, id, container)
+	} else {
+		vec, err = h.vectorForID(ctx, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return h.normalizeVec(vec), nil
+}
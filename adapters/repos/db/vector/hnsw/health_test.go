@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestHealth(t *testing.T) {
+	ctx := context.Background()
+	vectors, _ := testinghelpers.RandomVecsFixedSeed(500, 0, 8)
+	index := createFilteredHnswIndexForTests(t, vectors, ent.DefaultFilterStrategy)
+
+	t.Run("without recall estimate", func(t *testing.T) {
+		report, err := index.Health(ctx, 0)
+		require.Nil(t, err)
+
+		assert.Equal(t, 500, report.Nodes)
+		assert.Equal(t, 0, report.Tombstones)
+		assert.Equal(t, 0, report.Unreachable)
+		assert.Equal(t, index.entryPointID, report.Entrypoint)
+		assert.False(t, report.Compressed)
+		assert.Empty(t, report.Compression)
+		assert.Nil(t, report.EstimatedRecall)
+
+		require.Len(t, report.Layers, index.currentMaximumLayer+1)
+		assert.Equal(t, 500, report.Layers[0].Nodes)
+		assert.Greater(t, report.Layers[0].AverageOutDegree, float64(0))
+		for level := 1; level < len(report.Layers); level++ {
+			assert.LessOrEqual(t, report.Layers[level].Nodes, report.Layers[level-1].Nodes)
+		}
+	})
+
+	t.Run("with recall estimate", func(t *testing.T) {
+		report, err := index.Health(ctx, 20)
+		require.Nil(t, err)
+		require.NotNil(t, report.EstimatedRecall)
+		assert.Greater(t, *report.EstimatedRecall, float32(0.9))
+	})
+
+	t.Run("with more recall samples than allowed", func(t *testing.T) {
+		report, err := index.Health(ctx, MaxRecallSamples+1)
+		require.Nil(t, err)
+		require.NotNil(t, report.EstimatedRecall)
+	})
+
+	t.Run("with tombstones", func(t *testing.T) {
+		require.Nil(t, index.Delete(3, 4))
+
+		report, err := index.Health(ctx, 0)
+		require.Nil(t, err)
+		assert.Equal(t, 2, report.Tombstones)
+		assert.Equal(t, 0, report.Unreachable)
+	})
+
+	t.Run("with an unreachable node", func(t *testing.T) {
+		unreachable := uint64(7)
+		if unreachable == index.entryPointID {
+			unreachable++
+		}
+		for _, node := range index.nodes {
+			if node == nil {
+				continue
+			}
+			for level, conns := range node.connections {
+				filtered := conns[:0]
+				for _, id := range conns {
+					if id != unreachable {
+						filtered = append(filtered, id)
+					}
+				}
+				node.connections[level] = filtered
+			}
+		}
+
+		report, err := index.Health(ctx, 0)
+		require.Nil(t, err)
+		assert.Equal(t, 1, report.Unreachable)
+	})
+}
+
+func TestHealthRecallSingleNode(t *testing.T) {
+	// the only node has no neighbors to find other than itself, which does
+	// not count as a match
+	index := createFilteredHnswIndexForTests(t, [][]float32{{1, 2, 3}}, ent.DefaultFilterStrategy)
+
+	report, err := index.Health(context.Background(), 5)
+	require.Nil(t, err)
+	assert.Equal(t, 1, report.Nodes)
+	assert.Nil(t, report.EstimatedRecall)
+}
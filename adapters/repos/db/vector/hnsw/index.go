@@ -17,6 +17,7 @@ import (
 	"io"
 	"math"
 	"math/rand"
+	"os"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -32,6 +33,7 @@ import (
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/storobj"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 )
@@ -94,6 +96,10 @@ type hnsw struct {
 	// on filtered searches with less than n elements, perform flat search
 	flatSearchCutoff int64
 
+	// on filtered searches above the flat search cutoff, only traverse nodes
+	// matching the filter (see acornNeighbors)
+	acornSearch atomic.Bool
+
 	levelNormalizer float64
 
 	nodes []*vertex
@@ -122,8 +128,9 @@ type hnsw struct {
 	// // for distributed spike, can be used to call a insertExternal on a different graph
 	// insertHook func(node, targetLevel int, neighborsAtLevel map[int][]uint32)
 
-	id       string
-	rootPath string
+	id           string
+	rootPath     string
+	targetVector string
 
 	logger            logrus.FieldLogger
 	distancerProvider distancer.Provider
@@ -164,6 +171,13 @@ type hnsw struct {
 	pqConfig   ent.PQConfig
 	bqConfig   ent.BQConfig
 	sqConfig   ent.SQConfig
+	rqConfig   ent.RQConfig
+
+	// requantizing is set while a new quantizer is built in the background,
+	// requantizeDone and requantizeTotal track its progress
+	requantizing    atomic.Bool
+	requantizeDone  atomic.Uint64
+	requantizeTotal atomic.Uint64
 
 	compressActionLock *sync.RWMutex
 	className          string
@@ -194,6 +208,7 @@ type CommitLogger interface {
 	SwitchCommitLogs(bool) error
 	AddPQCompression(compressionhelpers.PQData) error
 	AddSQCompression(compressionhelpers.SQData) error
+	AddRQCompression(compressionhelpers.RQData) error
 }
 
 type BufferedLinksLogger interface {
@@ -228,8 +243,13 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCallbacks, shardCompactionCallb
 		normalizeOnRead = true
 	}
 
+	precision, err := vectorindexcommon.ParseStoragePrecision(uc.StoragePrecision)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
 	vectorCache := cache.NewShardedFloat32LockCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects,
-		cfg.Logger, normalizeOnRead, cache.DefaultDeletionInterval, cfg.AllocChecker)
+		cfg.Logger, normalizeOnRead, precision, cache.DefaultDeletionInterval, cfg.AllocChecker)
 
 	resetCtx, resetCtxCancel := context.WithCancel(context.Background())
 	shutdownCtx, shutdownCtxCancel := context.WithCancel(context.Background())
@@ -250,6 +270,7 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCallbacks, shardCompactionCallb
 		multiVectorForID:    vectorCache.MultiGet,
 		id:                  cfg.ID,
 		rootPath:            cfg.RootPath,
+		targetVector:        cfg.TargetVector,
 		tombstones:          map[uint64]struct{}{},
 		logger:              cfg.Logger,
 		distancerProvider:   cfg.DistanceProvider,
@@ -278,6 +299,7 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCallbacks, shardCompactionCallb
 		pqConfig:             uc.PQ,
 		bqConfig:             uc.BQ,
 		sqConfig:             uc.SQ,
+		rqConfig:             uc.RQ,
 		shardedNodeLocks:     common.NewDefaultShardedRWLocks(),
 
 		shardCompactionCallbacks: shardCompactionCallbacks,
@@ -286,6 +308,8 @@ func New(cfg Config, uc ent.UserConfig, tombstoneCallbacks, shardCompactionCallb
 		allocChecker:             cfg.AllocChecker,
 	}
 
+	index.acornSearch.Store(uc.FilterStrategy == ent.FilterStrategyAcorn)
+
 	if uc.BQ.Enabled {
 		var err error
 		index.compressor, err = compressionhelpers.NewBQCompressor(
@@ -642,6 +666,10 @@ func (h *hnsw) Drop(ctx context.Context) error {
 		return errors.Wrap(err, "commit log drop")
 	}
 
+	if err := os.Remove(h.requantizeMarkerPath()); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove requantize marker")
+	}
+
 	return nil
 }
 
@@ -692,6 +720,23 @@ func (h *hnsw) ContainsNode(id uint64) bool {
 	return len(h.nodes) > int(id) && h.nodes[id] != nil
 }
 
+// Iterate calls fn with the id of every node which is part of the graph and
+// not tombstoned, it stops as soon as fn returns false.
+func (h *hnsw) Iterate(fn func(id uint64) bool) {
+	h.RLock()
+	size := uint64(len(h.nodes))
+	h.RUnlock()
+
+	for id := uint64(0); id < size; id++ {
+		if h.nodeByID(id) == nil || h.hasTombstone(id) {
+			continue
+		}
+		if !fn(id) {
+			return
+		}
+	}
+}
+
 func (h *hnsw) DistancerProvider() distancer.Provider {
 	return h.distancerProvider
 }
@@ -700,6 +745,10 @@ func (h *hnsw) ShouldUpgrade() (bool, int) {
 	if h.sqConfig.Enabled {
 		return h.sqConfig.Enabled, h.sqConfig.TrainingLimit
 	}
+	if h.rqConfig.Enabled {
+		// no training needed, compress as soon as there is some data
+		return true, 0
+	}
 	return h.pqConfig.Enabled, h.pqConfig.TrainingLimit
 }
 
@@ -708,6 +757,9 @@ func (h *hnsw) ShouldCompressFromConfig(config config.VectorIndexConfig) (bool,
 	if hnswConfig.SQ.Enabled {
 		return hnswConfig.SQ.Enabled, hnswConfig.SQ.TrainingLimit
 	}
+	if hnswConfig.RQ.Enabled {
+		return true, 0
+	}
 	return hnswConfig.PQ.Enabled, hnswConfig.PQ.TrainingLimit
 }
 
@@ -72,6 +72,34 @@ func TestHnswIndex(t *testing.T) {
 	})
 }
 
+func TestHnswIndexIterate(t *testing.T) {
+	index := createEmptyHnswIndexForTests(t, testVectorForID)
+
+	for i, vec := range testVectors {
+		err := index.Add(uint64(i), vec)
+		require.Nil(t, err)
+	}
+	require.Nil(t, index.Delete(1, 4))
+
+	t.Run("skips deleted nodes", func(t *testing.T) {
+		var ids []uint64
+		index.Iterate(func(id uint64) bool {
+			ids = append(ids, id)
+			return true
+		})
+		assert.Equal(t, []uint64{0, 2, 3, 5, 6, 7, 8}, ids)
+	})
+
+	t.Run("stops early", func(t *testing.T) {
+		var ids []uint64
+		index.Iterate(func(id uint64) bool {
+			ids = append(ids, id)
+			return len(ids) < 3
+		})
+		assert.Equal(t, []uint64{0, 2, 3}, ids)
+	})
+}
+
 func TestHnswIndexGrow(t *testing.T) {
 	vector := []float32{0.1, 0.2}
 	vecForIDFn := func(ctx context.Context, id uint64) ([]float32, error) {
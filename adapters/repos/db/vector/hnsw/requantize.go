@@ -0,0 +1,441 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/commitlog"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// Requantize replaces the quantizer of a compressed index with a new one
+// built from the current compression config. This allows retraining the PQ
+// centroids after the data distribution drifted or switching to another
+// compression, e.g. from PQ to SQ, without reimporting the data.
+//
+// The new compressed vectors are encoded in the background from the
+// uncompressed vectors in the object store, while the index keeps serving
+// with the current compressor. Once all vectors are encoded, the index
+// switches to the new compressor atomically. The callback is called once the
+// requantization is complete or has failed.
+//
+// The compressed vectors of all hnsw indexes of a shard are stored in the
+// same bucket, which the switch replaces. Named vectors are therefore not
+// supported, the switch would drop the compressed vectors of the others.
+func (h *hnsw) Requantize(callback func()) error {
+	if h.targetVector != "" {
+		callback()
+		return fmt.Errorf("requantize: not supported for named vector %q", h.targetVector)
+	}
+	if !h.compressed.Load() {
+		callback()
+		return errors.New("requantize: index is not compressed")
+	}
+
+	cfg := ent.UserConfig{
+		PQ: h.pqConfig,
+		SQ: h.sqConfig,
+		RQ: h.rqConfig,
+	}
+	if !cfg.PQ.Enabled && !cfg.SQ.Enabled && !cfg.RQ.Enabled {
+		callback()
+		return errors.New("requantize: one of pq, sq or rq must be enabled")
+	}
+	if cfg.PQ.Enabled {
+		if err := ent.ValidatePQConfig(cfg.PQ); err != nil {
+			callback()
+			return fmt.Errorf("requantize: %w", err)
+		}
+	}
+
+	if !h.requantizing.CompareAndSwap(false, true) {
+		callback()
+		return errors.New("requantize: requantization already in progress")
+	}
+
+	h.logger.WithField("action", "requantize").Info("requantizing vectors")
+	enterrors.GoWrapper(func() {
+		defer callback()
+		defer h.requantizing.Store(false)
+
+		if err := h.requantize(cfg); err != nil {
+			h.logger.WithField("action", "requantize").WithError(err).Error("requantization failed")
+			return
+		}
+		h.logger.WithField("action", "requantize").Info("requantization complete")
+	}, h.logger)
+
+	return nil
+}
+
+// RequantizationProgress returns the share of vectors which have been encoded
+// by a running requantization. The second return value is false if there is
+// no requantization running.
+func (h *hnsw) RequantizationProgress() (float32, bool) {
+	if !h.requantizing.Load() {
+		return 0, false
+	}
+	total := h.requantizeTotal.Load()
+	if total == 0 {
+		return 0, true
+	}
+	return float32(h.requantizeDone.Load()) / float32(total), true
+}
+
+func (h *hnsw) requantize(cfg ent.UserConfig) error {
+	h.RLock()
+	size := len(h.nodes)
+	h.RUnlock()
+
+	h.requantizeDone.Store(0)
+	h.requantizeTotal.Store(uint64(size))
+
+	data, err := h.requantizeTrainingData(cfg, size)
+	if err != nil {
+		return err
+	}
+
+	compressor, err := h.newTrainedCompressor(cfg, data)
+	if err != nil {
+		return fmt.Errorf("create compressor: %w", err)
+	}
+	compressor.GrowCache(uint64(size))
+
+	// The compressed vectors are only added to the cache of the new compressor
+	// for now, switchCompressor writes them to a bucket of their own.
+	encoded := make([]bool, size)
+	err = compressionhelpers.ConcurrentlyWithError(h.logger, uint64(size), func(id uint64) error {
+		defer h.requantizeDone.Add(1)
+
+		vec, err := h.requantizeVector(id)
+		if err != nil || vec == nil {
+			return err
+		}
+		compressor.PreloadCache(id, vec)
+		encoded[id] = true
+		return nil
+	})
+	if err != nil {
+		compressor.Drop()
+		return fmt.Errorf("encode vectors: %w", err)
+	}
+
+	return h.switchCompressor(compressor, encoded)
+}
+
+func (h *hnsw) requantizeTrainingData(cfg ent.UserConfig, size int) ([][]float32, error) {
+	var limit int
+	switch {
+	case cfg.PQ.Enabled:
+		limit = cfg.PQ.TrainingLimit
+	case cfg.SQ.Enabled:
+		limit = cfg.SQ.TrainingLimit
+	default:
+		// rq does not need any training data
+		return nil, nil
+	}
+
+	data := make([][]float32, 0, min(size, limit))
+	for id := 0; id < size && len(data) < limit; id++ {
+		vec, err := h.requantizeVector(uint64(id))
+		if err != nil {
+			return nil, fmt.Errorf("obtain vectors for fitting: %w", err)
+		}
+		if vec != nil {
+			data = append(data, vec)
+		}
+	}
+	if len(data) == 0 {
+		return nil, errors.New("no vectors to fit the quantizer on")
+	}
+	return data, nil
+}
+
+// requantizeVector returns the uncompressed vector of a node from the object
+// store, or nil if the node or object has been deleted
+func (h *hnsw) requantizeVector(id uint64) ([]float32, error) {
+	if err := h.shutdownCtx.Err(); err != nil {
+		return nil, err
+	}
+	if h.nodeByID(id) == nil {
+		return nil, nil
+	}
+
+	vec, err := h.VectorForIDThunk(h.shutdownCtx, id)
+	if err != nil {
+		var e storobj.ErrNotFound
+		if errors.As(err, &e) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get vector of docID %d: %w", id, err)
+	}
+	if len(vec) == 0 {
+		return nil, nil
+	}
+	return h.normalizeVec(vec), nil
+}
+
+// requantizeBucket returns the bucket that holds the compressed vectors of a
+// requantized compressor until it replaces the bucket of the current
+// compressor
+func (h *hnsw) requantizeBucket() string {
+	return helpers.VectorsCompressedBucketLSM + "_requantize"
+}
+
+// requantizeMarkerPath returns the path of the file that marks a switch to a
+// requantized compressor. It is empty while the compressed vectors are being
+// written and holds the compression record of the new compressor once they
+// are complete.
+func (h *hnsw) requantizeMarkerPath() string {
+	return filepath.Join(h.rootPath, fmt.Sprintf("%s.hnsw.requantize", h.id))
+}
+
+// switchCompressor replaces the current compressor with the requantized one.
+// The new compressed vectors are written to a separate bucket while the index
+// keeps serving with the current compressor. Then the compression record of
+// the new compressor is persisted in the commit log and only after that its
+// bucket replaces the one of the current compressor. If anything fails before
+// the compression record has been persisted, the switch is aborted and the
+// current compressor is kept.
+//
+// The marker file makes the switch crash-atomic: on startup a bucket with
+// requantized vectors is only swapped in if the marker holds the compression
+// record that was restored from the commit log, otherwise it is dropped.
+//
+// Inserts, deletes and searches are only blocked while the changes made
+// during the requantization are caught up with and the buckets are swapped.
+func (h *hnsw) switchCompressor(compressor compressionhelpers.VectorCompressor, encoded []bool) error {
+	if err := h.stageRequantizedVectors(compressor, encoded); err != nil {
+		return h.abortRequantization(compressor, err)
+	}
+
+	h.compressActionLock.Lock()
+	defer h.compressActionLock.Unlock()
+
+	if err := h.commitRequantization(compressor, encoded); err != nil {
+		return h.abortRequantization(compressor, err)
+	}
+	return h.swapRequantizedCompressor(compressor)
+}
+
+// stageRequantizedVectors writes the compressed vectors of the new compressor
+// to the requantize bucket
+func (h *hnsw) stageRequantizedVectors(compressor compressionhelpers.VectorCompressor, encoded []bool) error {
+	// an empty marker makes sure the bucket is dropped on startup if we crash
+	// before the switch is committed
+	if err := writeRequantizeMarker(h.requantizeMarkerPath(), nil); err != nil {
+		return fmt.Errorf("write requantize marker: %w", err)
+	}
+	if err := h.store.CreateBucket(context.Background(), h.requantizeBucket()); err != nil {
+		return fmt.Errorf("create bucket for requantized vectors: %w", err)
+	}
+	compressor.UseBucket(h.requantizeBucket())
+
+	for id := range encoded {
+		if !encoded[id] {
+			continue
+		}
+		if err := compressor.PersistCache(uint64(id)); err != nil {
+			return fmt.Errorf("persist compressed vector %d: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// commitRequantization catches up with the nodes which were added or deleted
+// while the vectors were requantized and persists the compression record of
+// the new compressor. The caller must hold the compressActionLock.
+func (h *hnsw) commitRequantization(compressor compressionhelpers.VectorCompressor, encoded []bool) error {
+	h.RLock()
+	size := len(h.nodes)
+	h.RUnlock()
+	compressor.GrowCache(uint64(size))
+
+	for id := 0; id < size; id++ {
+		wasEncoded := id < len(encoded) && encoded[id]
+		if h.nodeByID(uint64(id)) == nil {
+			if wasEncoded {
+				compressor.Delete(context.Background(), uint64(id))
+			}
+			continue
+		}
+		if wasEncoded {
+			continue
+		}
+
+		vec, err := h.requantizeVector(uint64(id))
+		if err != nil {
+			return fmt.Errorf("encode vectors: %w", err)
+		}
+		if vec == nil {
+			continue
+		}
+		compressor.PreloadCache(uint64(id), vec)
+		if err := compressor.PersistCache(uint64(id)); err != nil {
+			return fmt.Errorf("persist compressed vector %d: %w", id, err)
+		}
+	}
+
+	if err := h.store.Bucket(h.requantizeBucket()).WriteWAL(); err != nil {
+		return fmt.Errorf("write requantized vectors: %w", err)
+	}
+	if err := writeRequantizeMarker(h.requantizeMarkerPath(), compressor); err != nil {
+		return fmt.Errorf("write requantize marker: %w", err)
+	}
+	if err := compressor.PersistCompression(h.commitLog); err != nil {
+		return fmt.Errorf("persist compression: %w", err)
+	}
+	if err := h.commitLog.Flush(); err != nil {
+		return fmt.Errorf("persist compression: %w", err)
+	}
+	return nil
+}
+
+// swapRequantizedCompressor replaces the current compressor and its bucket
+// after the switch has been committed. The caller must hold the
+// compressActionLock.
+func (h *hnsw) swapRequantizedCompressor(compressor compressionhelpers.VectorCompressor) error {
+	previous := h.compressor
+	h.compressor = compressor
+	if err := previous.Drop(); err != nil {
+		return err
+	}
+
+	err := h.store.ReplaceBuckets(context.Background(), helpers.VectorsCompressedBucketLSM, h.requantizeBucket())
+	if err != nil {
+		// The switch is completed on startup, until then the compressor has to
+		// keep using the bucket wherever it ended up.
+		if h.store.Bucket(h.requantizeBucket()) == nil {
+			compressor.UseBucket(helpers.VectorsCompressedBucketLSM)
+		}
+		return fmt.Errorf("replace bucket of compressed vectors: %w", err)
+	}
+	compressor.UseBucket(helpers.VectorsCompressedBucketLSM)
+
+	return os.Remove(h.requantizeMarkerPath())
+}
+
+// abortRequantization drops the new compressor and everything written for it,
+// the current compressor is left untouched
+func (h *hnsw) abortRequantization(compressor compressionhelpers.VectorCompressor, err error) error {
+	compressor.Drop()
+	if dropErr := h.store.DropBucket(context.Background(), h.requantizeBucket()); dropErr != nil {
+		h.logger.WithField("action", "requantize").WithError(dropErr).
+			Error("drop bucket of requantized vectors")
+	}
+	if rmErr := os.Remove(h.requantizeMarkerPath()); rmErr != nil && !os.IsNotExist(rmErr) {
+		h.logger.WithField("action", "requantize").WithError(rmErr).
+			Error("remove requantize marker")
+	}
+	return err
+}
+
+// writeRequantizeMarker atomically replaces the marker with one holding the
+// compression record of the compressor, or an empty one if it is nil
+func writeRequantizeMarker(path string, compressor compressionhelpers.VectorCompressor) error {
+	tmpPath := path + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+
+	if compressor != nil {
+		logger := commitlog.NewLoggerWithFile(f)
+		if err := compressor.PersistCompression(logger); err != nil {
+			f.Close()
+			return err
+		}
+		if err := logger.Flush(); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// recoverRequantization finishes or rolls back a switch to a requantized
+// compressor which was interrupted by a crash, see switchCompressor. The
+// switch has been committed if the marker holds the compression record that
+// was restored from the commit log.
+func (h *hnsw) recoverRequantization(state *DeserializationResult) error {
+	marker := h.requantizeMarkerPath()
+	f, err := os.Open(marker)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("open requantize marker: %w", err)
+	}
+	staged, _, err := NewDeserializer(h.logger).Do(bufio.NewReader(f), nil, false)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("read requantize marker: %w", err)
+	}
+	committed := staged.Compressed && state != nil && state.Compressed &&
+		reflect.DeepEqual(staged.CompressionPQData, state.CompressionPQData) &&
+		reflect.DeepEqual(staged.CompressionSQData, state.CompressionSQData) &&
+		reflect.DeepEqual(staged.CompressionRQData, state.CompressionRQData)
+
+	ctx := context.Background()
+	if err := h.store.CreateOrLoadBucket(ctx, helpers.VectorsCompressedBucketLSM); err != nil {
+		return fmt.Errorf("load bucket of compressed vectors: %w", err)
+	}
+	bucketDir := h.store.Bucket(helpers.VectorsCompressedBucketLSM).GetDir()
+	stagedDir := filepath.Join(filepath.Dir(bucketDir), h.requantizeBucket())
+	_, err = os.Stat(stagedDir)
+	staging := err == nil
+
+	logger := h.logger.WithField("action", "requantize_recover")
+	switch {
+	case committed && staging:
+		// ReplaceBuckets moves the replaced bucket aside with this suffix before
+		// deleting it, a leftover would make it fail
+		if err := os.RemoveAll(bucketDir + "___del"); err != nil {
+			return fmt.Errorf("remove replaced bucket of compressed vectors: %w", err)
+		}
+		if err := h.store.CreateOrLoadBucket(ctx, h.requantizeBucket()); err != nil {
+			return fmt.Errorf("load bucket of requantized vectors: %w", err)
+		}
+		if err := h.store.ReplaceBuckets(ctx, helpers.VectorsCompressedBucketLSM, h.requantizeBucket()); err != nil {
+			return fmt.Errorf("replace bucket of compressed vectors: %w", err)
+		}
+		logger.Info("completed interrupted switch to requantized vectors")
+	case staging:
+		if err := os.RemoveAll(stagedDir); err != nil {
+			return fmt.Errorf("remove bucket of requantized vectors: %w", err)
+		}
+		logger.Info("rolled back interrupted switch to requantized vectors")
+	}
+
+	if err := os.RemoveAll(bucketDir + "___del"); err != nil {
+		return fmt.Errorf("remove replaced bucket of compressed vectors: %w", err)
+	}
+	return os.Remove(marker)
+}
@@ -0,0 +1,368 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !race
+
+package hnsw
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func requantizeTestIndex(t *testing.T, vectors [][]float32, uc ent.UserConfig) *hnsw {
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "requantize",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			if int(id) >= len(vectors) {
+				return nil, storobj.NewErrNotFoundf(id, "out of range")
+			}
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+			copy(container.Slice, vectors[int(id)])
+			return container.Slice, nil
+		},
+	}, uc, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	t.Cleanup(func() { index.Shutdown(context.Background()) })
+	return index
+}
+
+func Test_NoRaceRequantize(t *testing.T) {
+	dimensions := 64
+	vectorsSize := 5000
+	queriesSize := 100
+	k := 10
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, queriesSize, dimensions)
+	logger, _ := test.NewNullLogger()
+
+	truths := make([][]uint64, queriesSize)
+	compressionhelpers.Concurrently(logger, uint64(queriesSize), func(i uint64) {
+		truths[i], _ = testinghelpers.BruteForce(logger, vectors, queries[i], k,
+			distanceWrapper(distancer.NewL2SquaredProvider()))
+	})
+
+	recall := func(index *hnsw) float32 {
+		var relevant uint64
+		var mu sync.Mutex
+		compressionhelpers.Concurrently(logger, uint64(queriesSize), func(i uint64) {
+			results, _, _ := index.SearchByVector(queries[i], k, nil)
+			matches := testinghelpers.MatchesInLists(truths[i], results)
+			mu.Lock()
+			relevant += matches
+			mu.Unlock()
+		})
+		return float32(relevant) / float32(k*queriesSize)
+	}
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 32
+	uc.EFConstruction = 64
+	uc.EF = 64
+	uc.VectorCacheMaxObjects = 10e12
+
+	t.Run("requantizing an uncompressed index", func(t *testing.T) {
+		index := requantizeTestIndex(t, vectors, uc)
+		require.Nil(t, index.Add(0, vectors[0]))
+
+		called := false
+		err := index.Requantize(func() { called = true })
+		assert.ErrorContains(t, err, "index is not compressed")
+		assert.True(t, called)
+	})
+
+	t.Run("switching from sq to rq", func(t *testing.T) {
+		index := requantizeTestIndex(t, vectors, uc)
+		compressionhelpers.Concurrently(logger, uint64(vectorsSize), func(id uint64) {
+			index.Add(id, vectors[id])
+		})
+
+		uc := uc
+		uc.SQ.Enabled = true
+		uc.SQ.TrainingLimit = 1000
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		require.Nil(t, index.UpdateUserConfig(uc, wg.Done))
+		wg.Wait()
+		require.True(t, index.Compressed())
+		assert.Greater(t, recall(index), float32(0.9))
+
+		// delete some nodes to make sure they are skipped
+		require.Nil(t, index.Delete(1, 2, 3))
+
+		uc.SQ.Enabled = false
+		uc.RQ.Enabled = true
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+
+		wg.Add(1)
+		require.Nil(t, index.Requantize(wg.Done))
+		wg.Wait()
+
+		_, running := index.RequantizationProgress()
+		assert.False(t, running)
+		assert.True(t, index.Compressed())
+		assert.Greater(t, recall(index), float32(0.85))
+
+		results, _, err := index.SearchByVector(vectors[0], 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0}, results)
+	})
+
+	t.Run("retraining pq", func(t *testing.T) {
+		index := requantizeTestIndex(t, vectors, uc)
+		compressionhelpers.Concurrently(logger, uint64(vectorsSize), func(id uint64) {
+			index.Add(id, vectors[id])
+		})
+
+		uc := uc
+		uc.PQ.Enabled = true
+		uc.PQ.TrainingLimit = 1000
+		uc.PQ.Segments = dimensions / 2
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		require.Nil(t, index.UpdateUserConfig(uc, wg.Done))
+		wg.Wait()
+		require.True(t, index.Compressed())
+
+		wg.Add(2)
+		require.Nil(t, index.Requantize(wg.Done))
+		err := index.Requantize(wg.Done)
+		assert.ErrorContains(t, err, "already in progress")
+		wg.Wait()
+
+		_, running := index.RequantizationProgress()
+		assert.False(t, running)
+		assert.Greater(t, recall(index), float32(0.8))
+	})
+}
+
+type failingCompressionLogger struct {
+	NoopCommitLogger
+}
+
+func (l *failingCompressionLogger) AddRQCompression(data compressionhelpers.RQData) error {
+	return errors.New("no space left on device")
+}
+
+func requantizePersistentTestIndex(t *testing.T, rootPath string, store *lsmkv.Store,
+	vectors [][]float32, uc ent.UserConfig,
+) *hnsw {
+	logger, _ := test.NewNullLogger()
+	index, err := New(Config{
+		RootPath: rootPath,
+		ID:       "requantize",
+		MakeCommitLoggerThunk: func() (CommitLogger, error) {
+			return NewCommitLogger(rootPath, "requantize", logger, cyclemanager.NewCallbackGroupNoop())
+		},
+		DistanceProvider: distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+			copy(container.Slice, vectors[int(id)])
+			return container.Slice, nil
+		},
+	}, uc, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(), store)
+	require.Nil(t, err)
+	return index
+}
+
+func Test_NoRaceRequantizeCrashAtomicity(t *testing.T) {
+	dimensions := 32
+	vectorsSize := 1000
+	queriesSize := 50
+	k := 10
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, queriesSize, dimensions)
+	logger, _ := test.NewNullLogger()
+
+	truths := make([][]uint64, queriesSize)
+	for i := range queries {
+		truths[i], _ = testinghelpers.BruteForce(logger, vectors, queries[i], k,
+			distanceWrapper(distancer.NewL2SquaredProvider()))
+	}
+	recall := func(index *hnsw) float32 {
+		var relevant uint64
+		for i := range queries {
+			results, _, err := index.SearchByVector(queries[i], k, nil)
+			require.Nil(t, err)
+			relevant += testinghelpers.MatchesInLists(truths[i], results)
+		}
+		return float32(relevant) / float32(k*queriesSize)
+	}
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 32
+	uc.EFConstruction = 64
+	uc.EF = 64
+	uc.VectorCacheMaxObjects = 10e12
+	uc.SQ.Enabled = true
+	uc.SQ.TrainingLimit = vectorsSize
+
+	// sqIndex returns an index compressed with sq and the encoded vectors of a
+	// requantization to rq which has not been switched to yet
+	sqIndex := func(t *testing.T, rootPath string, store *lsmkv.Store) (*hnsw, compressionhelpers.VectorCompressor, []bool) {
+		index := requantizePersistentTestIndex(t, rootPath, store, vectors, uc)
+		for id := range vectors {
+			require.Nil(t, index.Add(uint64(id), vectors[id]))
+		}
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		require.Nil(t, index.UpdateUserConfig(uc, wg.Done))
+		wg.Wait()
+		require.True(t, index.Compressed())
+
+		cfg := ent.UserConfig{RQ: ent.RQConfig{Enabled: true, Bits: ent.DefaultRQBits}}
+		compressor, err := index.newTrainedCompressor(cfg, nil)
+		require.Nil(t, err)
+		compressor.GrowCache(uint64(vectorsSize))
+		encoded := make([]bool, vectorsSize)
+		for id := range vectors {
+			vec, err := index.requantizeVector(uint64(id))
+			require.Nil(t, err)
+			compressor.PreloadCache(uint64(id), vec)
+			encoded[id] = true
+		}
+		return index, compressor, encoded
+	}
+
+	crash := func(t *testing.T, index *hnsw, store *lsmkv.Store) {
+		require.Nil(t, index.Flush())
+		require.Nil(t, index.Shutdown(context.Background()))
+		require.Nil(t, store.Shutdown(context.Background()))
+	}
+
+	restart := func(t *testing.T, rootPath, storeDir string) *hnsw {
+		store, err := lsmkv.New(storeDir, storeDir, logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+		require.Nil(t, err)
+		index := requantizePersistentTestIndex(t, rootPath, store, vectors, uc)
+		t.Cleanup(func() {
+			index.Shutdown(context.Background())
+			store.Shutdown(context.Background())
+		})
+		return index
+	}
+
+	assertRecovered := func(t *testing.T, index *hnsw, storeDir string) {
+		require.True(t, index.Compressed())
+		assert.Greater(t, recall(index), float32(0.8))
+		assert.NoFileExists(t, index.requantizeMarkerPath())
+		assert.NoDirExists(t, filepath.Join(storeDir, index.requantizeBucket()))
+		assert.NoDirExists(t, filepath.Join(storeDir, helpers.VectorsCompressedBucketLSM+"___del"))
+	}
+
+	t.Run("failing to persist the compression keeps the current compressor", func(t *testing.T) {
+		index, err := New(Config{
+			RootPath: t.TempDir(),
+			ID:       "requantize",
+			MakeCommitLoggerThunk: func() (CommitLogger, error) {
+				return &failingCompressionLogger{}, nil
+			},
+			DistanceProvider: distancer.NewL2SquaredProvider(),
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+			TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+				copy(container.Slice, vectors[int(id)])
+				return container.Slice, nil
+			},
+		}, uc, cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+		require.Nil(t, err)
+		t.Cleanup(func() { index.Shutdown(context.Background()) })
+		for id := range vectors {
+			require.Nil(t, index.Add(uint64(id), vectors[id]))
+		}
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		require.Nil(t, index.UpdateUserConfig(uc, wg.Done))
+		wg.Wait()
+		previous := index.compressor
+
+		uc := uc
+		uc.SQ.Enabled = false
+		uc.RQ.Enabled = true
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+		wg.Add(1)
+		require.Nil(t, index.Requantize(wg.Done))
+		wg.Wait()
+
+		assert.Equal(t, previous, index.compressor)
+		assert.Nil(t, index.store.Bucket(index.requantizeBucket()))
+		assert.NoFileExists(t, index.requantizeMarkerPath())
+		assert.Greater(t, recall(index), float32(0.8))
+	})
+
+	t.Run("crashing before the switch is committed rolls it back", func(t *testing.T) {
+		rootPath, storeDir := t.TempDir(), t.TempDir()
+		store, err := lsmkv.New(storeDir, storeDir, logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+		require.Nil(t, err)
+		index, compressor, encoded := sqIndex(t, rootPath, store)
+
+		require.Nil(t, index.stageRequantizedVectors(compressor, encoded))
+		// the compression record is written to the marker right before it is
+		// persisted in the commit log
+		require.Nil(t, writeRequantizeMarker(index.requantizeMarkerPath(), compressor))
+		crash(t, index, store)
+
+		assertRecovered(t, restart(t, rootPath, storeDir), storeDir)
+	})
+
+	t.Run("crashing after the switch is committed completes it", func(t *testing.T) {
+		rootPath, storeDir := t.TempDir(), t.TempDir()
+		store, err := lsmkv.New(storeDir, storeDir, logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop())
+		require.Nil(t, err)
+		index, compressor, encoded := sqIndex(t, rootPath, store)
+
+		require.Nil(t, index.stageRequantizedVectors(compressor, encoded))
+		index.compressActionLock.Lock()
+		require.Nil(t, index.commitRequantization(compressor, encoded))
+		index.compressActionLock.Unlock()
+		crash(t, index, store)
+
+		assertRecovered(t, restart(t, rootPath, storeDir), storeDir)
+	})
+}
+
+func TestRequantizeNamedVector(t *testing.T) {
+	index := &hnsw{targetVector: "first"}
+	index.compressed.Store(true)
+
+	called := false
+	err := index.Requantize(func() { called = true })
+	assert.ErrorContains(t, err, "not supported for named vector")
+	assert.True(t, called)
+	assert.False(t, index.requantizing.Load())
+}
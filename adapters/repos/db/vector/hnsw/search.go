@@ -196,6 +196,8 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 		return nil, errors.Wrapf(err, "calculate distance of current last result")
 	}
 	connectionsReusable := make([]uint64, h.maximumConnectionsLayerZero)
+	acorn := level == 0 && allowList != nil && h.acornSearch.Load()
+	var acornNeighbors, acornConnections []uint64
 
 	for candidates.Len() > 0 {
 		var dist float32
@@ -246,7 +248,14 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 		copy(connectionsReusable, candidateNode.connections[level])
 		candidateNode.Unlock()
 
-		for _, neighborID := range connectionsReusable {
+		neighbors := connectionsReusable
+		if acorn {
+			acornNeighbors, acornConnections = h.acornNeighbors(connectionsReusable,
+				visited, allowList, acornNeighbors, acornConnections)
+			neighbors = acornNeighbors
+		}
+
+		for _, neighborID := range neighbors {
 
 			if ok := visited.Visited(neighborID); ok {
 				// skip if we've already visited this neighbor
@@ -276,11 +285,12 @@ func (h *hnsw) searchLayerByVectorWithDistancer(queryVector []float32,
 
 			if distance < worstResultDistance || results.Len() < ef {
 				candidates.Insert(neighborID, distance)
-				if level == 0 && allowList != nil {
+				if level == 0 && allowList != nil && !acorn {
 					// we are on the lowest level containing the actual candidates and we
 					// have an allow list (i.e. the user has probably set some sort of a
 					// filter restricting this search further. As a result we have to
-					// ignore items not on the list
+					// ignore items not on the list. With acorn the neighbors have
+					// already been checked against the list
 					if !allowList.Contains(neighborID) {
 						continue
 					}
@@ -583,6 +593,11 @@ func (h *hnsw) rescore(res *priorityqueue.Queue[any], k int, compressorDistancer
 			res.Pop()
 		}
 	}
+	if h.rqConfig.Enabled && h.rqConfig.RescoreLimit >= k {
+		for res.Len() > h.rqConfig.RescoreLimit {
+			res.Pop()
+		}
+	}
 	ids := make([]uint64, res.Len())
 	i := len(ids) - 1
 	for res.Len() > 0 {
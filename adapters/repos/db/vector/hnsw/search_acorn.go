@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
+)
+
+// acornNeighbors selects the neighbors to evaluate on a filtered search at
+// layer zero in the style of ACORN-1. Only nodes matching the filter are
+// evaluated. Neighbors which don't match are expanded instead, so that the
+// search can reach matching nodes two hops away and does not get stuck in a
+// region of the graph where hardly anything matches. Direct neighbors take
+// precedence over the two-hop neighborhood and the selection is capped at the
+// maximum number of connections of the layer.
+//
+// The given connections are overwritten. The other slices are reused across
+// calls to avoid allocations, the caller must keep the returned ones for the
+// next call. All selected neighbors are guaranteed to match the filter.
+func (h *hnsw) acornNeighbors(connections []uint64, visitedList visited.ListSet,
+	allowList helpers.AllowList, selected, twoHop []uint64,
+) ([]uint64, []uint64) {
+	// checking the allow list is comparatively expensive, so the non-matching
+	// neighbors are collected in the front of connections on the first pass
+	// instead of checking them again
+	selected = selected[:0]
+	rejected := 0
+	for _, id := range connections {
+		if visitedList.Visited(id) {
+			continue
+		}
+		if allowList.Contains(id) {
+			selected = append(selected, id)
+		} else {
+			connections[rejected] = id
+			rejected++
+		}
+	}
+
+	for _, id := range connections[:rejected] {
+		if len(selected) >= h.maximumConnectionsLayerZero {
+			break
+		}
+		// non-matching nodes are never evaluated, but their neighborhood only
+		// needs to be expanded once
+		visitedList.Visit(id)
+
+		h.shardedNodeLocks.RLock(id)
+		node := h.nodes[id]
+		h.shardedNodeLocks.RUnlock(id)
+		if node == nil {
+			continue
+		}
+
+		node.Lock()
+		if len(node.connections) > 0 {
+			twoHop = append(twoHop[:0], node.connections[0]...)
+		} else {
+			twoHop = twoHop[:0]
+		}
+		node.Unlock()
+
+		for _, neighborID := range twoHop {
+			if len(selected) >= h.maximumConnectionsLayerZero {
+				break
+			}
+			if !visitedList.Visited(neighborID) && allowList.Contains(neighborID) {
+				selected = append(selected, neighborID)
+			}
+		}
+	}
+
+	return selected, twoHop
+}
@@ -130,6 +130,10 @@ func (h *hnsw) restoreFromDisk() error {
 	h.tombstones = state.Tombstones
 	h.tombstoneLock.Unlock()
 
+	if err := h.recoverRequantization(state); err != nil {
+		return errors.Wrap(err, "recover requantization")
+	}
+
 	if state.Compressed {
 		h.compressed.Store(state.Compressed)
 		h.cache.Drop()
@@ -173,6 +177,20 @@ func (h *hnsw) restoreFromDisk() error {
 			if err != nil {
 				return errors.Wrap(err, "Restoring compressed data.")
 			}
+		} else if state.CompressionRQData != nil {
+			data := state.CompressionRQData
+			h.dims = int32(data.Dimensions)
+			h.compressor, err = compressionhelpers.RestoreHNSWRQCompressor(
+				h.distancerProvider,
+				1e12,
+				h.logger,
+				*data,
+				h.store,
+				h.allocChecker,
+			)
+			if err != nil {
+				return errors.Wrap(err, "Restoring compressed data.")
+			}
 		} else {
 			return errors.New("unsupported type while loading compression data")
 		}
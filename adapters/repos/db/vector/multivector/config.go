@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// MultiVectorForID returns all vectors stored for a document
+type MultiVectorForID func(ctx context.Context, id uint64) ([][]float32, error)
+
+type Config struct {
+	ID                       string
+	TargetVector             string
+	Logger                   logrus.FieldLogger
+	RootPath                 string
+	ShardName                string
+	ClassName                string
+	PrometheusMetrics        *monitoring.PrometheusMetrics
+	MultiVectorForIDThunk    MultiVectorForID
+	DistanceProvider         distancer.Provider
+	MakeCommitLoggerThunk    hnsw.MakeCommitLogger
+	AllocChecker             memwatch.AllocChecker
+	TombstoneCallbacks       cyclemanager.CycleCallbackGroup
+	ShardCompactionCallbacks cyclemanager.CycleCallbackGroup
+	ShardFlushCallbacks      cyclemanager.CycleCallbackGroup
+}
+
+func (c Config) Validate() error {
+	ec := &errorcompounder.ErrorCompounder{}
+
+	if c.ID == "" {
+		ec.Addf("id cannot be empty")
+	}
+
+	if c.TargetVector == "" {
+		ec.Addf("targetVector cannot be empty")
+	}
+
+	if c.MultiVectorForIDThunk == nil {
+		ec.Addf("multiVectorForIDThunk cannot be nil")
+	}
+
+	if c.DistanceProvider == nil {
+		ec.Addf("distancerProvider cannot be nil")
+	}
+
+	return ec.ToError()
+}
@@ -0,0 +1,701 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	schemaconfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// the state key can't collide with the 8 byte doc id keys
+var stateKey = []byte("state")
+
+type graph interface {
+	Dump(labels ...string)
+	AddBatch(ctx context.Context, id []uint64, vector [][]float32) error
+	Delete(id ...uint64) error
+	SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error)
+	UpdateUserConfig(updated schemaconfig.VectorIndexConfig, callback func()) error
+	Drop(ctx context.Context) error
+	Shutdown(ctx context.Context) error
+	Flush() error
+	SwitchCommitLogs(ctx context.Context) error
+	ListFiles(ctx context.Context, basePath string) ([]string, error)
+	PostStartup()
+	Compressed() bool
+	ValidateBeforeInsert(vector []float32) error
+	AlreadyIndexed() uint64
+	Upgraded() bool
+	Upgrade(callback func()) error
+	ShouldUpgrade() (bool, int)
+	Iterate(fn func(id uint64) bool)
+}
+
+// tokenRange holds the ids of the tokens of a document. The tokens of a
+// document are assigned consecutive ids when it is added.
+type tokenRange struct {
+	docID uint64
+	start uint64
+	count uint32
+}
+
+func (r tokenRange) end() uint64 {
+	return r.start + uint64(r.count)
+}
+
+// Index stores a bag of vectors, e.g. the token embeddings of a late
+// interaction model, for every document. Each vector of the bag is added to
+// an HNSW graph as a token of its own. A search collects the documents of
+// the tokens closest to each query vector and ranks them by MaxSim, the sum
+// of the distances between each query vector and the closest vector of the
+// document.
+type Index struct {
+	sync.RWMutex
+	id                string
+	targetVector      string
+	store             *lsmkv.Store
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	multiVectorForID  MultiVectorForID
+	graph             graph
+
+	docs       map[uint64]tokenRange
+	ranges     []tokenRange // sorted by start, only holds present documents
+	nextToken  uint64
+	liveTokens uint64
+	dims       int32
+}
+
+func New(cfg Config, uc hnswent.UserConfig, store *lsmkv.Store) (*Index, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		l := logrus.New()
+		l.Out = io.Discard
+		logger = l
+	}
+
+	index := &Index{
+		id:                cfg.ID,
+		targetVector:      cfg.TargetVector,
+		store:             store,
+		logger:            logger,
+		distancerProvider: cfg.DistanceProvider,
+		multiVectorForID:  cfg.MultiVectorForIDThunk,
+		docs:              map[uint64]tokenRange{},
+	}
+
+	if err := store.CreateOrLoadBucket(context.Background(), index.bucketName(),
+		lsmkv.WithUseBloomFilter(false),
+		lsmkv.WithCalcCountNetAdditions(false),
+	); err != nil {
+		return nil, fmt.Errorf("create or load multi vector bucket: %w", err)
+	}
+	if err := index.load(); err != nil {
+		return nil, fmt.Errorf("load multi vector tokens: %w", err)
+	}
+
+	graph, err := hnsw.New(hnsw.Config{
+		Logger:                logger,
+		RootPath:              cfg.RootPath,
+		ID:                    cfg.ID,
+		TargetVector:          cfg.TargetVector,
+		ShardName:             cfg.ShardName,
+		ClassName:             cfg.ClassName,
+		PrometheusMetrics:     cfg.PrometheusMetrics,
+		VectorForIDThunk:      index.tokenVector,
+		TempVectorForIDThunk:  index.tempTokenVector,
+		DistanceProvider:      cfg.DistanceProvider,
+		MakeCommitLoggerThunk: cfg.MakeCommitLoggerThunk,
+		AllocChecker:          cfg.AllocChecker,
+	}, uc, cfg.TombstoneCallbacks, cfg.ShardCompactionCallbacks, cfg.ShardFlushCallbacks, store)
+	if err != nil {
+		return nil, err
+	}
+	index.graph = graph
+
+	if err := index.removeOrphanTokens(); err != nil {
+		return nil, fmt.Errorf("remove orphan multi vector tokens: %w", err)
+	}
+
+	return index, nil
+}
+
+func (m *Index) bucketName() string {
+	return fmt.Sprintf("%s_%s", helpers.MultiVectorsBucketLSM, m.targetVector)
+}
+
+// load restores the token ranges of all documents from the bucket
+func (m *Index) load() error {
+	cursor := m.store.Bucket(m.bucketName()).Cursor()
+	defer cursor.Close()
+
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		if string(k) == string(stateKey) {
+			if len(v) != 12 {
+				return fmt.Errorf("invalid state of length %d", len(v))
+			}
+			m.nextToken = binary.BigEndian.Uint64(v)
+			m.dims = int32(binary.BigEndian.Uint32(v[8:]))
+			continue
+		}
+		if len(k) != 8 || len(v) != 12 {
+			return fmt.Errorf("invalid token range of length %d", len(v))
+		}
+		r := tokenRange{
+			docID: binary.BigEndian.Uint64(k),
+			start: binary.BigEndian.Uint64(v),
+			count: binary.BigEndian.Uint32(v[8:]),
+		}
+		m.docs[r.docID] = r
+		m.ranges = append(m.ranges, r)
+		m.liveTokens += uint64(r.count)
+	}
+
+	sort.Slice(m.ranges, func(i, j int) bool {
+		return m.ranges[i].start < m.ranges[j].start
+	})
+	return nil
+}
+
+// removeOrphanTokens deletes the tokens from the graph which don't belong to
+// a stored document. The tokens of a document are stored once they are part
+// of the graph, so a crash in between leaves them without a document. Their
+// ids are never handed out again.
+func (m *Index) removeOrphanTokens() error {
+	var orphans []uint64
+	m.graph.Iterate(func(token uint64) bool {
+		if _, ok := m.rangeOf(token); !ok {
+			orphans = append(orphans, token)
+		}
+		return true
+	})
+	if len(orphans) == 0 {
+		return nil
+	}
+
+	if last := orphans[len(orphans)-1]; last >= m.nextToken {
+		m.nextToken = last + 1
+	}
+	return m.graph.Delete(orphans...)
+}
+
+// rangeOf returns the range containing the token, the caller must hold the
+// lock
+func (m *Index) rangeOf(token uint64) (tokenRange, bool) {
+	i := sort.Search(len(m.ranges), func(i int) bool {
+		return m.ranges[i].end() > token
+	})
+	if i == len(m.ranges) || m.ranges[i].start > token {
+		return tokenRange{}, false
+	}
+	return m.ranges[i], true
+}
+
+func (m *Index) tokenVector(ctx context.Context, token uint64) ([]float32, error) {
+	m.RLock()
+	r, ok := m.rangeOf(token)
+	m.RUnlock()
+	if !ok {
+		return nil, storobj.NewErrNotFoundf(token, "no document for token, it could have been deleted")
+	}
+
+	vectors, err := m.multiVectorForID(ctx, r.docID)
+	if err != nil {
+		return nil, err
+	}
+	pos := token - r.start
+	if pos >= uint64(len(vectors)) {
+		return nil, storobj.NewErrNotFoundf(token, "token %d of doc id %d does not exist", pos, r.docID)
+	}
+	return vectors[pos], nil
+}
+
+func (m *Index) tempTokenVector(ctx context.Context, token uint64, container *common.VectorSlice) ([]float32, error) {
+	return m.tokenVector(ctx, token)
+}
+
+// AddMulti adds the vectors of a document. Adding a document which is
+// already present is a no-op, documents are immutable and updates always
+// use a new doc id.
+func (m *Index) AddMulti(id uint64, vectors [][]float32) error {
+	if len(vectors) == 0 {
+		return nil
+	}
+	if err := m.ValidateMultiBeforeInsert(vectors); err != nil {
+		return err
+	}
+
+	m.Lock()
+	if _, ok := m.docs[id]; ok {
+		m.Unlock()
+		return nil
+	}
+
+	atomic.CompareAndSwapInt32(&m.dims, 0, int32(len(vectors[0])))
+	r := tokenRange{docID: id, start: m.nextToken, count: uint32(len(vectors))}
+	m.nextToken = r.end()
+	m.liveTokens += uint64(r.count)
+	m.docs[id] = r
+	m.ranges = append(m.ranges, r)
+	m.Unlock()
+
+	tokens := make([]uint64, len(vectors))
+	for i := range tokens {
+		tokens[i] = r.start + uint64(i)
+	}
+	if err := m.graph.AddBatch(context.Background(), tokens, vectors); err != nil {
+		m.Lock()
+		m.removeLocked([]tokenRange{r})
+		m.Unlock()
+		// the tokens which made it into the graph must not be found anymore
+		if delErr := m.graph.Delete(tokens...); delErr != nil {
+			m.logger.WithError(delErr).WithField("action", "multi_vector_add").
+				Warnf("delete tokens of doc id %d after failed insert", id)
+		}
+		return err
+	}
+
+	// the tokens are stored only once they are part of the graph, tokens in the
+	// graph without a stored document are removed on startup
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.docs[id]; !ok {
+		// deleted concurrently
+		return nil
+	}
+	return m.persist(r)
+}
+
+// persist stores the tokens of a document, the caller must hold the lock
+func (m *Index) persist(r tokenRange) error {
+	bucket := m.store.Bucket(m.bucketName())
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, r.docID)
+	val := make([]byte, 12)
+	binary.BigEndian.PutUint64(val, r.start)
+	binary.BigEndian.PutUint32(val[8:], r.count)
+	if err := bucket.Put(key, val); err != nil {
+		return fmt.Errorf("store tokens of doc id %d: %w", r.docID, err)
+	}
+
+	state := make([]byte, 12)
+	binary.BigEndian.PutUint64(state, m.nextToken)
+	binary.BigEndian.PutUint32(state[8:], uint32(atomic.LoadInt32(&m.dims)))
+	if err := bucket.Put(stateKey, state); err != nil {
+		return fmt.Errorf("store multi vector state: %w", err)
+	}
+	return nil
+}
+
+func (m *Index) ValidateMultiBeforeInsert(vectors [][]float32) error {
+	if len(vectors) > math.MaxUint16 {
+		return fmt.Errorf("multi vector has %d vectors, at most %d are allowed", len(vectors), math.MaxUint16)
+	}
+	dims := int(atomic.LoadInt32(&m.dims))
+	if dims == 0 && len(vectors) > 0 {
+		dims = len(vectors[0])
+	}
+	for _, vector := range vectors {
+		if len(vector) != dims {
+			return fmt.Errorf("new multi vector has a vector with length %v. "+
+				"Existing vectors have length %v", len(vector), dims)
+		}
+	}
+	return nil
+}
+
+// ValidateBeforeInsert rejects single vectors, a multi vector index only
+// accepts multi vectors
+func (m *Index) ValidateBeforeInsert(vector []float32) error {
+	return fmt.Errorf("target vector %q is a multi vector, single vectors are not supported", m.targetVector)
+}
+
+func (m *Index) Add(id uint64, vector []float32) error {
+	return m.ValidateBeforeInsert(vector)
+}
+
+func (m *Index) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	return m.ValidateBeforeInsert(nil)
+}
+
+func (m *Index) Delete(ids ...uint64) error {
+	var tokens []uint64
+	var removed []tokenRange
+
+	m.Lock()
+	bucket := m.store.Bucket(m.bucketName())
+	key := make([]byte, 8)
+	for _, id := range ids {
+		r, ok := m.docs[id]
+		if !ok {
+			continue
+		}
+
+		binary.BigEndian.PutUint64(key, id)
+		if err := bucket.Delete(key); err != nil {
+			m.removeLocked(removed)
+			m.Unlock()
+			return fmt.Errorf("delete tokens of doc id %d: %w", id, err)
+		}
+
+		removed = append(removed, r)
+		for token := r.start; token < r.end(); token++ {
+			tokens = append(tokens, token)
+		}
+	}
+	m.removeLocked(removed)
+	m.Unlock()
+
+	if len(tokens) == 0 {
+		return nil
+	}
+	return m.graph.Delete(tokens...)
+}
+
+// removeLocked removes the token ranges of deleted documents, the caller must
+// hold the lock
+func (m *Index) removeLocked(removed []tokenRange) {
+	if len(removed) == 0 {
+		return
+	}
+
+	starts := make(map[uint64]struct{}, len(removed))
+	for _, r := range removed {
+		if _, ok := m.docs[r.docID]; !ok {
+			continue
+		}
+		delete(m.docs, r.docID)
+		m.liveTokens -= uint64(r.count)
+		starts[r.start] = struct{}{}
+	}
+
+	ranges := m.ranges[:0]
+	for _, r := range m.ranges {
+		if _, ok := starts[r.start]; !ok {
+			ranges = append(ranges, r)
+		}
+	}
+	for i := len(ranges); i < len(m.ranges); i++ {
+		m.ranges[i] = tokenRange{}
+	}
+	m.ranges = ranges
+}
+
+func (m *Index) ContainsNode(id uint64) bool {
+	m.RLock()
+	defer m.RUnlock()
+	_, ok := m.docs[id]
+	return ok
+}
+
+// SplitQuery splits a multi vector query, which is passed through the search
+// path encoded as a single vector by searchparams.FlattenMultiVector. The
+// vectors of the query must have the length of the indexed vectors.
+func (m *Index) SplitQuery(vector []float32) ([][]float32, error) {
+	dims := int(atomic.LoadInt32(&m.dims))
+	if dims == 0 {
+		return nil, nil
+	}
+
+	query, err := searchparams.SplitMultiVector(vector)
+	if err != nil {
+		return nil, err
+	}
+	if len(query[0]) != dims {
+		return nil, fmt.Errorf("multi vector query has vectors of length %d, "+
+			"but the indexed vectors have length %d", len(query[0]), dims)
+	}
+	return query, nil
+}
+
+func (m *Index) SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	query, err := m.SplitQuery(vector)
+	if err != nil || query == nil {
+		return nil, nil, err
+	}
+	return m.SearchByMultiVector(query, k, allow)
+}
+
+func (m *Index) SearchByVectorDistance(vector []float32, targetDistance float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	query, err := m.SplitQuery(vector)
+	if err != nil || query == nil {
+		return nil, nil, err
+	}
+
+	ids, dists, err := m.SearchByMultiVector(query, int(maxLimit), allow)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, dist := range dists {
+		if dist > targetDistance {
+			return ids[:i], dists[:i], nil
+		}
+	}
+	return ids, dists, nil
+}
+
+// SearchByMultiVector returns the k documents with the lowest MaxSim
+// distance to the query
+func (m *Index) SearchByMultiVector(query [][]float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	if k <= 0 || len(query) == 0 {
+		return nil, nil, nil
+	}
+
+	candidates, err := m.candidates(query, k, allow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	query = m.normalize(query)
+	ctx := context.Background()
+	ids := make([]uint64, 0, len(candidates))
+	dists := make([]float32, 0, len(candidates))
+	for id := range candidates {
+		dist, err := m.distanceToDoc(ctx, query, id)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				continue
+			}
+			return nil, nil, err
+		}
+		ids = append(ids, id)
+		dists = append(dists, dist)
+	}
+
+	sort.Sort(byDistance{ids: ids, dists: dists})
+	if len(ids) > k {
+		ids, dists = ids[:k], dists[:k]
+	}
+	return ids, dists, nil
+}
+
+// candidates returns the documents of the closest tokens of every query
+// vector. As many tokens are retrieved per query vector as k documents hold
+// on average, so that a query vector can't be matched by the tokens of a few
+// documents only.
+func (m *Index) candidates(query [][]float32, k int, allow helpers.AllowList) (map[uint64]struct{}, error) {
+	m.RLock()
+	limit := k
+	if len(m.docs) > 0 {
+		tokensPerDoc := (m.liveTokens + uint64(len(m.docs)) - 1) / uint64(len(m.docs))
+		limit = k * int(tokensPerDoc)
+	}
+	m.RUnlock()
+
+	var tokenAllow helpers.AllowList
+	if allow != nil {
+		tokenAllow = m.tokenAllowList(allow)
+		if tokenAllow.IsEmpty() {
+			return nil, nil
+		}
+	}
+
+	candidates := map[uint64]struct{}{}
+	for _, vector := range query {
+		tokens, _, err := m.graph.SearchByVector(vector, limit, tokenAllow)
+		if err != nil {
+			return nil, err
+		}
+
+		m.RLock()
+		for _, token := range tokens {
+			if r, ok := m.rangeOf(token); ok {
+				candidates[r.docID] = struct{}{}
+			}
+		}
+		m.RUnlock()
+	}
+	return candidates, nil
+}
+
+func (m *Index) tokenAllowList(allow helpers.AllowList) helpers.AllowList {
+	tokens := helpers.NewAllowList()
+
+	m.RLock()
+	defer m.RUnlock()
+
+	it := allow.Iterator()
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		r, ok := m.docs[id]
+		if !ok {
+			continue
+		}
+		for token := r.start; token < r.end(); token++ {
+			tokens.Insert(token)
+		}
+	}
+	return tokens
+}
+
+func (m *Index) normalize(vectors [][]float32) [][]float32 {
+	if m.distancerProvider.Type() != "cosine-dot" {
+		return vectors
+	}
+	// cosine-dot requires normalized vectors, as the dot product and cosine
+	// similarity are only identical if the vector is normalized
+	out := make([][]float32, len(vectors))
+	for i := range vectors {
+		out[i] = distancer.Normalize(vectors[i])
+	}
+	return out
+}
+
+// distanceToDoc returns the MaxSim distance between the normalized query and
+// the vectors of a document
+func (m *Index) distanceToDoc(ctx context.Context, query [][]float32, id uint64) (float32, error) {
+	vectors, err := m.multiVectorForID(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	if len(vectors) == 0 {
+		return 0, storobj.NewErrNotFoundf(id, "no multi vector for doc id")
+	}
+	return m.maxSim(query, m.normalize(vectors))
+}
+
+func (m *Index) maxSim(query, vectors [][]float32) (float32, error) {
+	var sum float32
+	for _, q := range query {
+		best := float32(math.MaxFloat32)
+		for _, vector := range vectors {
+			dist, err := m.distancerProvider.SingleDist(q, vector)
+			if err != nil {
+				return 0, err
+			}
+			if dist < best {
+				best = dist
+			}
+		}
+		sum += best
+	}
+	return sum, nil
+}
+
+// DistanceBetweenVectors returns the MaxSim distance between two multi
+// vectors encoded by searchparams.FlattenMultiVector
+func (m *Index) DistanceBetweenVectors(x, y []float32) (float32, error) {
+	query, err := m.SplitQuery(x)
+	if err != nil {
+		return 0, err
+	}
+	vectors, err := m.SplitQuery(y)
+	if err != nil {
+		return 0, err
+	}
+	return m.maxSim(m.normalize(query), m.normalize(vectors))
+}
+
+func (m *Index) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	query, err := m.SplitQuery(queryVector)
+	query = m.normalize(query)
+	f := func(id uint64) (float32, error) {
+		if err != nil {
+			return 0, err
+		}
+		return m.distanceToDoc(context.Background(), query, id)
+	}
+	return common.QueryVectorDistancer{DistanceFunc: f}
+}
+
+func (m *Index) UpdateUserConfig(updated schemaconfig.VectorIndexConfig, callback func()) error {
+	return m.graph.UpdateUserConfig(updated, callback)
+}
+
+func (m *Index) Drop(ctx context.Context) error {
+	return m.graph.Drop(ctx)
+}
+
+func (m *Index) Shutdown(ctx context.Context) error {
+	return m.graph.Shutdown(ctx)
+}
+
+func (m *Index) Flush() error {
+	return m.graph.Flush()
+}
+
+func (m *Index) SwitchCommitLogs(ctx context.Context) error {
+	return m.graph.SwitchCommitLogs(ctx)
+}
+
+func (m *Index) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	return m.graph.ListFiles(ctx, basePath)
+}
+
+func (m *Index) PostStartup() {
+	m.graph.PostStartup()
+}
+
+func (m *Index) Compressed() bool {
+	return m.graph.Compressed()
+}
+
+func (m *Index) AlreadyIndexed() uint64 {
+	return m.graph.AlreadyIndexed()
+}
+
+func (m *Index) DistancerProvider() distancer.Provider {
+	return m.distancerProvider
+}
+
+func (m *Index) Upgraded() bool {
+	return m.graph.Upgraded()
+}
+
+func (m *Index) Upgrade(callback func()) error {
+	return m.graph.Upgrade(callback)
+}
+
+func (m *Index) ShouldUpgrade() (bool, int) {
+	return m.graph.ShouldUpgrade()
+}
+
+func (m *Index) Dump(labels ...string) {
+	m.graph.Dump(labels...)
+}
+
+type byDistance struct {
+	ids   []uint64
+	dists []float32
+}
+
+func (b byDistance) Len() int           { return len(b.ids) }
+func (b byDistance) Less(i, j int) bool { return b.dists[i] < b.dists[j] }
+func (b byDistance) Swap(i, j int) {
+	b.ids[i], b.ids[j] = b.ids[j], b.ids[i]
+	b.dists[i], b.dists[j] = b.dists[j], b.dists[i]
+}
@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+)
+
+// fakeGraph records the tokens added to and deleted from the graph
+type fakeGraph struct {
+	graph
+	tokens  map[uint64]struct{}
+	deleted []uint64
+	addErr  error
+}
+
+func (g *fakeGraph) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	for _, id := range ids {
+		g.tokens[id] = struct{}{}
+	}
+	return g.addErr
+}
+
+func (g *fakeGraph) Delete(ids ...uint64) error {
+	for _, id := range ids {
+		delete(g.tokens, id)
+	}
+	g.deleted = append(g.deleted, ids...)
+	return nil
+}
+
+func (g *fakeGraph) Iterate(fn func(id uint64) bool) {
+	for id := uint64(0); id < 100; id++ {
+		if _, ok := g.tokens[id]; ok && !fn(id) {
+			return
+		}
+	}
+}
+
+func newTestIndex(t *testing.T, g *fakeGraph) (*Index, *lsmkv.Store) {
+	logger, _ := test.NewNullLogger()
+	store := testinghelpers.NewDummyStore(t)
+	index := &Index{
+		targetVector: "colbert",
+		store:        store,
+		logger:       logger,
+		graph:        g,
+		docs:         map[uint64]tokenRange{},
+	}
+	require.Nil(t, store.CreateOrLoadBucket(context.Background(), index.bucketName()))
+	return index, store
+}
+
+func storedRange(t *testing.T, index *Index, id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	val, err := index.store.Bucket(index.bucketName()).Get(key)
+	require.Nil(t, err)
+	return val
+}
+
+func TestMultiVectorIndexDeleteRemovesRanges(t *testing.T) {
+	g := &fakeGraph{tokens: map[uint64]struct{}{}}
+	index, _ := newTestIndex(t, g)
+
+	require.Nil(t, index.AddMulti(0, [][]float32{{1, 0}, {0, 1}}))
+	require.Nil(t, index.AddMulti(1, [][]float32{{1, 1}}))
+	require.Nil(t, index.AddMulti(2, [][]float32{{0, 1}, {1, 0}}))
+
+	require.Nil(t, index.Delete(0, 2))
+	assert.Equal(t, []tokenRange{{docID: 1, start: 2, count: 1}}, index.ranges)
+	assert.Equal(t, uint64(1), index.liveTokens)
+	assert.ElementsMatch(t, []uint64{0, 1, 3, 4}, g.deleted)
+
+	_, ok := index.rangeOf(3)
+	assert.False(t, ok)
+	r, ok := index.rangeOf(2)
+	require.True(t, ok)
+	assert.Equal(t, uint64(1), r.docID)
+}
+
+func TestMultiVectorIndexFailedAddIsNotStored(t *testing.T) {
+	g := &fakeGraph{tokens: map[uint64]struct{}{}, addErr: errors.New("add failed")}
+	index, _ := newTestIndex(t, g)
+
+	require.NotNil(t, index.AddMulti(0, [][]float32{{1, 0}, {0, 1}}))
+	assert.False(t, index.ContainsNode(0))
+	assert.Empty(t, index.ranges)
+	assert.Nil(t, storedRange(t, index, 0))
+	assert.Empty(t, g.tokens)
+
+	// the tokens of the failed insert are not handed out again
+	g.addErr = nil
+	require.Nil(t, index.AddMulti(1, [][]float32{{1, 1}}))
+	assert.Equal(t, []tokenRange{{docID: 1, start: 2, count: 1}}, index.ranges)
+	assert.NotNil(t, storedRange(t, index, 1))
+}
+
+func TestMultiVectorIndexRemovesOrphanTokens(t *testing.T) {
+	g := &fakeGraph{tokens: map[uint64]struct{}{}}
+	index, _ := newTestIndex(t, g)
+	require.Nil(t, index.AddMulti(0, [][]float32{{1, 0}, {0, 1}}))
+
+	// tokens which were added to the graph before a crash, but whose document
+	// has never been stored
+	g.tokens[2] = struct{}{}
+	g.tokens[3] = struct{}{}
+
+	restored := &Index{
+		targetVector: "colbert",
+		store:        index.store,
+		logger:       index.logger,
+		graph:        g,
+		docs:         map[uint64]tokenRange{},
+	}
+	require.Nil(t, restored.load())
+	assert.Equal(t, uint64(2), restored.nextToken)
+
+	require.Nil(t, restored.removeOrphanTokens())
+	assert.Equal(t, []uint64{2, 3}, g.deleted)
+	assert.Equal(t, uint64(4), restored.nextToken)
+	assert.True(t, restored.ContainsNode(0))
+}
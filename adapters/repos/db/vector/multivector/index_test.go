@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/multivector"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+var docs = map[uint64][][]float32{
+	0: {{1, 0, 0}, {0, 1, 0}},
+	1: {{0, 0, 1}},
+	2: {{1, 0, 0}, {0, 0, 1}, {0, 0.5, 1}},
+}
+
+func newIndex(t *testing.T, store *lsmkv.Store) *multivector.Index {
+	logger, _ := test.NewNullLogger()
+	noopCallback := cyclemanager.NewCallbackGroupNoop()
+	uc := hnswent.NewDefaultUserConfig()
+	uc.Multivector = hnswent.MultivectorConfig{Enabled: true, Aggregation: hnswent.MultivectorAggregationMaxSim}
+
+	index, err := multivector.New(multivector.Config{
+		ID:                    "multivector-test",
+		TargetVector:          "colbert",
+		Logger:                logger,
+		RootPath:              t.TempDir(),
+		MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewDotProductProvider(),
+		MultiVectorForIDThunk: func(ctx context.Context, id uint64) ([][]float32, error) {
+			vectors, ok := docs[id]
+			if !ok {
+				return nil, storobj.NewErrNotFoundf(id, "no multi vector")
+			}
+			return vectors, nil
+		},
+		TombstoneCallbacks:       noopCallback,
+		ShardCompactionCallbacks: noopCallback,
+		ShardFlushCallbacks:      noopCallback,
+	}, uc, store)
+	require.Nil(t, err)
+	return index
+}
+
+func TestMultiVectorIndex(t *testing.T) {
+	store := testinghelpers.NewDummyStore(t)
+	index := newIndex(t, store)
+
+	t.Run("empty index", func(t *testing.T) {
+		ids, _, err := index.SearchByVector([]float32{1, 0, 0}, 10, nil)
+		require.Nil(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("single vectors are rejected", func(t *testing.T) {
+		assert.NotNil(t, index.Add(7, []float32{1, 0, 0}))
+	})
+
+	t.Run("add", func(t *testing.T) {
+		for id, vectors := range docs {
+			require.Nil(t, index.AddMulti(id, vectors))
+		}
+		for id := range docs {
+			assert.True(t, index.ContainsNode(id))
+		}
+		assert.NotNil(t, index.AddMulti(3, [][]float32{{1, 0}}))
+	})
+
+	query := [][]float32{{1, 0, 0}, {0, 1, 0}}
+
+	t.Run("search ranks by MaxSim", func(t *testing.T) {
+		ids, dists, err := index.SearchByVector(searchparams.FlattenMultiVector(query), 2, nil)
+		require.Nil(t, err)
+		// doc 0 matches both query vectors exactly, doc 2 matches the first
+		// one exactly and the second one partially
+		assert.Equal(t, []uint64{0, 2}, ids)
+		assert.Equal(t, []float32{-2, -1.5}, dists)
+	})
+
+	t.Run("search with invalid query length", func(t *testing.T) {
+		_, _, err := index.SearchByVector([]float32{1, 0}, 3, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("search with vectors of a different length", func(t *testing.T) {
+		// the query has as many values as two indexed vectors, but consists of
+		// a single vector of a different length
+		_, _, err := index.SearchByVector(searchparams.FlattenMultiVector([][]float32{{1, 0, 0, 0, 1, 0}}), 3, nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "vectors of length 6")
+	})
+
+	t.Run("search with allow list", func(t *testing.T) {
+		ids, dists, err := index.SearchByVector(searchparams.FlattenMultiVector(query), 3, helpers.NewAllowList(1, 2))
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 1}, ids)
+		assert.Equal(t, []float32{-1.5, 0}, dists)
+	})
+
+	t.Run("distance between vectors", func(t *testing.T) {
+		dist, err := index.DistanceBetweenVectors(searchparams.FlattenMultiVector(query), searchparams.FlattenMultiVector(docs[1]))
+		require.Nil(t, err)
+		assert.Equal(t, float32(0), dist)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.Nil(t, index.Delete(0))
+		assert.False(t, index.ContainsNode(0))
+
+		ids, _, err := index.SearchByVector(searchparams.FlattenMultiVector(query), 3, nil)
+		require.Nil(t, err)
+		require.NotEmpty(t, ids)
+		assert.Equal(t, uint64(2), ids[0])
+		assert.NotContains(t, ids, uint64(0))
+	})
+
+	t.Run("token mapping is restored", func(t *testing.T) {
+		restored := newIndex(t, store)
+		assert.False(t, restored.ContainsNode(0))
+		assert.True(t, restored.ContainsNode(1))
+		assert.True(t, restored.ContainsNode(2))
+
+		query, err := restored.SplitQuery(searchparams.FlattenMultiVector(docs[2]))
+		require.Nil(t, err)
+		assert.Len(t, query, 3)
+	})
+}
@@ -0,0 +1,272 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+// Index stores sparse vectors as an inverted index. Every dimension is a
+// posting list in a map bucket, which maps the doc ids with a weight for the
+// dimension to that weight. A search scores documents by the dot product with
+// the query and finds the top k with WAND, like a BM25 search on the
+// inverted index does.
+//
+// The vectors are also stored by doc id, so the postings of a document can be
+// removed again when it is deleted.
+type Index struct {
+	id                string
+	targetVector      string
+	store             *lsmkv.Store
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+}
+
+func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*Index, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		l := logrus.New()
+		l.Out = io.Discard
+		logger = l
+	}
+
+	index := &Index{
+		id:                cfg.ID,
+		targetVector:      cfg.TargetVector,
+		store:             store,
+		logger:            logger,
+		distancerProvider: distancer.NewDotProductProvider(),
+	}
+
+	if err := store.CreateOrLoadBucket(context.Background(), index.vectorsBucketName(),
+		lsmkv.WithUseBloomFilter(false),
+	); err != nil {
+		return nil, fmt.Errorf("create or load sparse vectors bucket: %w", err)
+	}
+	if err := store.CreateOrLoadBucket(context.Background(), index.postingsBucketName(),
+		lsmkv.WithStrategy(lsmkv.StrategyMapCollection),
+	); err != nil {
+		return nil, fmt.Errorf("create or load sparse postings bucket: %w", err)
+	}
+
+	return index, nil
+}
+
+func (s *Index) vectorsBucketName() string {
+	return fmt.Sprintf("%s_%s", helpers.SparseVectorsBucketLSM, s.targetVector)
+}
+
+func (s *Index) postingsBucketName() string {
+	return fmt.Sprintf("%s_%s", helpers.SparsePostingsBucketLSM, s.targetVector)
+}
+
+func docIDKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+func dimensionKey(index uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, index)
+	return key
+}
+
+// AddSparse adds the sparse vector of a document. Adding a document which is
+// already present is a no-op, documents are immutable and updates always
+// use a new doc id.
+func (s *Index) AddSparse(id uint64, vector ent.Vector) error {
+	if len(vector.Indices) == 0 {
+		return nil
+	}
+	if err := s.ValidateSparseBeforeInsert(vector); err != nil {
+		return err
+	}
+	if s.ContainsNode(id) {
+		return nil
+	}
+
+	key := docIDKey(id)
+	postings := s.store.Bucket(s.postingsBucketName())
+	for i, index := range vector.Indices {
+		weight := make([]byte, 4)
+		binary.LittleEndian.PutUint32(weight, math.Float32bits(vector.Values[i]))
+		if err := postings.MapSet(dimensionKey(index), lsmkv.MapPair{
+			Key:   key,
+			Value: weight,
+		}); err != nil {
+			return fmt.Errorf("add posting of doc id %d for index %d: %w", id, index, err)
+		}
+	}
+
+	// the vector is written last, it marks the document as complete
+	if err := s.store.Bucket(s.vectorsBucketName()).Put(key, vector.Encode()); err != nil {
+		return fmt.Errorf("store sparse vector of doc id %d: %w", id, err)
+	}
+	return nil
+}
+
+func (s *Index) ValidateSparseBeforeInsert(vector ent.Vector) error {
+	return vector.Validate()
+}
+
+// ValidateBeforeInsert rejects dense vectors, a sparse index only accepts
+// sparse vectors
+func (s *Index) ValidateBeforeInsert(vector []float32) error {
+	return fmt.Errorf("target vector %q is a sparse vector, dense vectors are not supported", s.targetVector)
+}
+
+func (s *Index) Add(id uint64, vector []float32) error {
+	return s.ValidateBeforeInsert(vector)
+}
+
+func (s *Index) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	return s.ValidateBeforeInsert(nil)
+}
+
+func (s *Index) Delete(ids ...uint64) error {
+	vectors := s.store.Bucket(s.vectorsBucketName())
+	postings := s.store.Bucket(s.postingsBucketName())
+
+	for _, id := range ids {
+		key := docIDKey(id)
+		encoded, err := vectors.Get(key)
+		if err != nil {
+			return fmt.Errorf("get sparse vector of doc id %d: %w", id, err)
+		}
+		if encoded == nil {
+			continue
+		}
+
+		vector, err := ent.Decode(encoded)
+		if err != nil {
+			return fmt.Errorf("decode sparse vector of doc id %d: %w", id, err)
+		}
+		for _, index := range vector.Indices {
+			if err := postings.MapDeleteKey(dimensionKey(index), key); err != nil {
+				return fmt.Errorf("delete posting of doc id %d for index %d: %w", id, index, err)
+			}
+		}
+		if err := vectors.Delete(key); err != nil {
+			return fmt.Errorf("delete sparse vector of doc id %d: %w", id, err)
+		}
+	}
+	return nil
+}
+
+func (s *Index) ContainsNode(id uint64) bool {
+	v, err := s.store.Bucket(s.vectorsBucketName()).Get(docIDKey(id))
+	return err == nil && v != nil
+}
+
+func (s *Index) AlreadyIndexed() uint64 {
+	return uint64(s.store.Bucket(s.vectorsBucketName()).Count())
+}
+
+func (s *Index) SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	return nil, nil, s.ValidateBeforeInsert(vector)
+}
+
+func (s *Index) SearchByVectorDistance(vector []float32, targetDistance float32,
+	maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return nil, nil, s.ValidateBeforeInsert(vector)
+}
+
+func (s *Index) DistanceBetweenVectors(x, y []float32) (float32, error) {
+	return 0, s.ValidateBeforeInsert(x)
+}
+
+func (s *Index) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	return common.QueryVectorDistancer{
+		DistanceFunc: func(uint64) (float32, error) {
+			return 0, s.ValidateBeforeInsert(queryVector)
+		},
+	}
+}
+
+func (s *Index) DistancerProvider() distancer.Provider {
+	return s.distancerProvider
+}
+
+func (s *Index) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error {
+	defer callback()
+
+	if _, ok := updated.(ent.UserConfig); !ok {
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+	// there are no mutable settings
+	return nil
+}
+
+func (s *Index) Drop(ctx context.Context) error {
+	// nothing to do here
+	// Shard::drop will take care of handling store's buckets
+	return nil
+}
+
+func (s *Index) Flush() error {
+	// nothing to do here
+	// Shard will take care of handling store's buckets
+	return nil
+}
+
+func (s *Index) Shutdown(ctx context.Context) error {
+	// nothing to do here
+	// Shard::shutdown will take care of handling store's buckets
+	return nil
+}
+
+func (s *Index) SwitchCommitLogs(context.Context) error {
+	return nil
+}
+
+func (s *Index) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	// nothing to do here
+	// Shard::ListBackupFiles will take care of handling store's buckets
+	return []string{}, nil
+}
+
+func (s *Index) PostStartup() {}
+
+func (s *Index) Compressed() bool {
+	return false
+}
+
+func (s *Index) Dump(labels ...string) {
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", strings.Join(labels, ", "))
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", s.id)
+	fmt.Printf("Documents: %d\n", s.AlreadyIndexed())
+	fmt.Printf("--------------------------------------------------\n")
+}
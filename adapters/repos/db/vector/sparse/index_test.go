@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse_test
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/sparse"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+func newIndex(t *testing.T, store *lsmkv.Store) *sparse.Index {
+	logger, _ := test.NewNullLogger()
+	index, err := sparse.New(sparse.Config{
+		ID:           "sparse-test",
+		TargetVector: "splade",
+		Logger:       logger,
+	}, ent.NewDefaultUserConfig(), store)
+	require.Nil(t, err)
+	return index
+}
+
+func TestSparseIndex(t *testing.T) {
+	ctx := context.Background()
+	store := testinghelpers.NewDummyStore(t)
+	index := newIndex(t, store)
+
+	docs := map[uint64]ent.Vector{
+		0: {Indices: []uint32{1, 5}, Values: []float32{1, 2}},
+		1: {Indices: []uint32{5}, Values: []float32{0.5}},
+		2: {Indices: []uint32{1, 9, 12}, Values: []float32{3, 1, 1}},
+	}
+
+	t.Run("empty index", func(t *testing.T) {
+		ids, _, err := index.SearchBySparseVector(ctx, docs[0], 10, nil)
+		require.Nil(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("dense vectors are rejected", func(t *testing.T) {
+		assert.NotNil(t, index.Add(7, []float32{1, 0, 0}))
+		_, _, err := index.SearchByVector([]float32{1, 0, 0}, 1, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("add", func(t *testing.T) {
+		for id, vector := range docs {
+			require.Nil(t, index.AddSparse(id, vector))
+		}
+		for id := range docs {
+			assert.True(t, index.ContainsNode(id))
+		}
+		assert.Equal(t, uint64(3), index.AlreadyIndexed())
+		assert.NotNil(t, index.AddSparse(3, ent.Vector{Indices: []uint32{1}}))
+	})
+
+	query := ent.Vector{Indices: []uint32{1, 5}, Values: []float32{1, 1.5}}
+
+	t.Run("search ranks by dot product", func(t *testing.T) {
+		ids, scores, err := index.SearchBySparseVector(ctx, query, 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0, 2, 1}, ids)
+		assert.Equal(t, []float32{4, 3, 0.75}, scores)
+	})
+
+	t.Run("search with limit", func(t *testing.T) {
+		ids, scores, err := index.SearchBySparseVector(ctx, query, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0}, ids)
+		assert.Equal(t, []float32{4}, scores)
+	})
+
+	t.Run("search with allow list", func(t *testing.T) {
+		ids, scores, err := index.SearchBySparseVector(ctx, query, 10, helpers.NewAllowList(1, 2))
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 1}, ids)
+		assert.Equal(t, []float32{3, 0.75}, scores)
+	})
+
+	t.Run("search with invalid query", func(t *testing.T) {
+		_, _, err := index.SearchBySparseVector(ctx, ent.Vector{Indices: []uint32{1, 1}, Values: []float32{1, 1}}, 10, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.Nil(t, index.Delete(0))
+		assert.False(t, index.ContainsNode(0))
+
+		ids, _, err := index.SearchBySparseVector(ctx, query, 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 1}, ids)
+	})
+
+	t.Run("postings are restored", func(t *testing.T) {
+		restored := newIndex(t, store)
+		assert.False(t, restored.ContainsNode(0))
+		assert.True(t, restored.ContainsNode(1))
+
+		ids, _, err := restored.SearchBySparseVector(ctx, query, 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{2, 1}, ids)
+	})
+}
+
+func TestSparseIndex_MatchesBruteForce(t *testing.T) {
+	ctx := context.Background()
+	index := newIndex(t, testinghelpers.NewDummyStore(t))
+	r := rand.New(rand.NewSource(42))
+
+	randomVector := func() ent.Vector {
+		dims := r.Perm(200)[:1+r.Intn(20)]
+		v := ent.Vector{}
+		for _, dim := range dims {
+			v.Indices = append(v.Indices, uint32(dim))
+			v.Values = append(v.Values, r.Float32()*2-0.5)
+		}
+		return v
+	}
+
+	docs := make([]ent.Vector, 1000)
+	for i := range docs {
+		docs[i] = randomVector()
+		require.Nil(t, index.AddSparse(uint64(i), docs[i]))
+	}
+
+	dot := func(a, b ent.Vector) float32 {
+		weights := map[uint32]float32{}
+		for i, index := range a.Indices {
+			weights[index] = a.Values[i]
+		}
+		var score float32
+		for i, index := range b.Indices {
+			score += weights[index] * b.Values[i]
+		}
+		return score
+	}
+
+	for i := 0; i < 20; i++ {
+		query := randomVector()
+		k := 10
+
+		ids, scores, err := index.SearchBySparseVector(ctx, query, k, nil)
+		require.Nil(t, err)
+		require.Len(t, ids, k)
+
+		expected := make([]float32, len(docs))
+		for id := range docs {
+			expected[id] = dot(query, docs[id])
+		}
+		for j, id := range ids {
+			assert.InDelta(t, expected[id], scores[j], 1e-4)
+		}
+		sort.Slice(expected, func(a, b int) bool { return expected[a] > expected[b] })
+		for j := range scores {
+			assert.InDelta(t, expected[j], scores[j], 1e-4)
+		}
+	}
+}
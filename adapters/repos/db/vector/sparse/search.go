@@ -0,0 +1,257 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+// SearchBySparseVector returns the k documents with the highest dot product
+// with the query, together with their scores in descending order
+func (s *Index) SearchBySparseVector(ctx context.Context, query ent.Vector, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if err := query.Validate(); err != nil {
+		return nil, nil, err
+	}
+	if k <= 0 {
+		return nil, nil, nil
+	}
+
+	results := make(terms, 0, len(query.Indices))
+	for i, index := range query.Indices {
+		if query.Values[i] == 0 {
+			continue
+		}
+		t, err := s.createTerm(ctx, index, query.Values[i], allow)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !t.exhausted {
+			results = append(results, t)
+		}
+	}
+
+	topKHeap := getTopKHeap(k, results)
+	ids := make([]uint64, topKHeap.Len())
+	scores := make([]float32, topKHeap.Len())
+	for i := len(ids) - 1; i >= 0; i-- {
+		item := topKHeap.Pop()
+		ids[i] = item.ID
+		scores[i] = item.Dist
+	}
+	return ids, scores, nil
+}
+
+func (s *Index) createTerm(ctx context.Context, index uint32, queryWeight float32,
+	allow helpers.AllowList,
+) (term, error) {
+	t := term{queryWeight: float64(queryWeight)}
+
+	pairs, err := s.store.Bucket(s.postingsBucketName()).MapList(ctx, dimensionKey(index))
+	if err != nil {
+		return t, fmt.Errorf("read postings for index %d: %w", index, err)
+	}
+
+	t.data = make([]posting, 0, len(pairs))
+	for _, pair := range pairs {
+		if len(pair.Key) != 8 || len(pair.Value) != 4 {
+			s.logger.Warnf("Skipping sparse posting for index %d: invalid key or value length", index)
+			continue
+		}
+		id := binary.BigEndian.Uint64(pair.Key)
+		if allow != nil && !allow.Contains(id) {
+			continue
+		}
+		weight := math.Float32frombits(binary.LittleEndian.Uint32(pair.Value))
+		t.data = append(t.data, posting{id: id, weight: weight})
+
+		// the impact can be negative, but a document which doesn't contain the
+		// dimension at all scores 0 for it, so 0 is the lowest bound
+		t.maxImpact = math.Max(t.maxImpact, t.queryWeight*float64(weight))
+	}
+
+	if len(t.data) == 0 {
+		t.exhausted = true
+		return t, nil
+	}
+	t.idPointer = t.data[0].id
+	return t, nil
+}
+
+func getTopKHeap(limit int, results terms) *priorityqueue.Queue[any] {
+	topKHeap := priorityqueue.NewMin[any](limit)
+	worstScore := math.Inf(-1)
+	sort.Sort(results)
+	for {
+		if results.completelyExhausted() || results.pivot(worstScore) {
+			return topKHeap
+		}
+
+		id, score := results.scoreNext()
+
+		if topKHeap.Len() < limit || topKHeap.Top().Dist < float32(score) {
+			topKHeap.Insert(id, float32(score))
+			for topKHeap.Len() > limit {
+				topKHeap.Pop()
+			}
+			// only update the worst score when the queue is full, otherwise
+			// documents could be skipped before k of them have been found
+			if topKHeap.Len() >= limit {
+				worstScore = float64(topKHeap.Top().Dist)
+			}
+		}
+	}
+}
+
+type posting struct {
+	id     uint64
+	weight float32
+}
+
+type term struct {
+	// the highest score the term contributes to any document, used as the
+	// upper bound to skip documents which can't make it into the top k
+	maxImpact   float64
+	queryWeight float64
+
+	idPointer  uint64
+	posPointer uint64
+	data       []posting
+	exhausted  bool
+}
+
+func (t *term) scoreAndAdvance() (uint64, float64) {
+	id := t.idPointer
+	score := t.queryWeight * float64(t.data[t.posPointer].weight)
+
+	// advance
+	t.posPointer++
+	if t.posPointer >= uint64(len(t.data)) {
+		t.exhausted = true
+	} else {
+		t.idPointer = t.data[t.posPointer].id
+	}
+
+	return id, score
+}
+
+func (t *term) advanceAtLeast(minID uint64) {
+	for t.idPointer < minID {
+		t.posPointer++
+		if t.posPointer >= uint64(len(t.data)) {
+			t.exhausted = true
+			return
+		}
+		t.idPointer = t.data[t.posPointer].id
+	}
+}
+
+type terms []term
+
+func (t terms) completelyExhausted() bool {
+	for i := range t {
+		if !t[i].exhausted {
+			return false
+		}
+	}
+	return true
+}
+
+func (t terms) pivot(minScore float64) bool {
+	minID, pivotPoint, abort := t.findMinID(minScore)
+	if abort {
+		return true
+	}
+	if pivotPoint == 0 {
+		return false
+	}
+
+	t.advanceAllAtLeast(minID)
+	sort.Sort(t)
+	return false
+}
+
+func (t terms) advanceAllAtLeast(minID uint64) {
+	for i := range t {
+		t[i].advanceAtLeast(minID)
+	}
+}
+
+func (t terms) findMinID(minScore float64) (uint64, int, bool) {
+	cumScore := float64(0)
+
+	for i, term := range t {
+		if term.exhausted {
+			continue
+		}
+		cumScore += term.maxImpact
+		if cumScore >= minScore {
+			return term.idPointer, i, false
+		}
+	}
+
+	return 0, 0, true
+}
+
+func (t terms) findFirstNonExhausted() (int, bool) {
+	for i := range t {
+		if !t[i].exhausted {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
+
+func (t terms) scoreNext() (uint64, float64) {
+	pos, ok := t.findFirstNonExhausted()
+	if !ok {
+		// done, nothing left to score
+		return 0, 0
+	}
+
+	id := t[pos].idPointer
+	var cumScore float64
+	for i := pos; i < len(t); i++ {
+		if t[i].idPointer != id || t[i].exhausted {
+			continue
+		}
+		_, score := t[i].scoreAndAdvance()
+		cumScore += score
+	}
+
+	sort.Sort(t) // pointer was advanced in scoreAndAdvance
+
+	return id, cumScore
+}
+
+// provide sort interface
+func (t terms) Len() int {
+	return len(t)
+}
+
+func (t terms) Less(i, j int) bool {
+	return t[i].idPointer < t[j].idPointer
+}
+
+func (t terms) Swap(i, j int) {
+	t[i], t[j] = t[j], t[i]
+}
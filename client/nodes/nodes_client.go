@@ -45,6 +45,10 @@ type ClientService interface {
 
 	NodesGetClass(params *NodesGetClassParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesGetClassOK, error)
 
+	NodesRequantize(params *NodesRequantizeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesRequantizeAccepted, error)
+
+	NodesVectorIndexHealth(params *NodesVectorIndexHealthParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesVectorIndexHealthOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -126,6 +130,84 @@ func (a *Client) NodesGetClass(params *NodesGetClassParams, authInfo runtime.Cli
 	panic(msg)
 }
 
+/*
+NodesRequantize Retrains or replaces the quantizer of the compressed vector index of a shard based on the current compression config of the collection. The vectors are re-encoded in the background, the progress is reported in the verbose output of the nodes status. Only the replica of the shard on the node which receives the request is requantized.
+*/
+func (a *Client) NodesRequantize(params *NodesRequantizeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesRequantizeAccepted, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesRequantizeParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.requantize",
+		Method:             "POST",
+		PathPattern:        "/nodes/{className}/shards/{shardName}/requantize",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesRequantizeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesRequantizeAccepted)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.requantize: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+NodesVectorIndexHealth Reports on the structure of the graph of the vector index of a shard and optionally estimates its recall. Only the replica of the shard on the node which receives the request is inspected.
+*/
+func (a *Client) NodesVectorIndexHealth(params *NodesVectorIndexHealthParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*NodesVectorIndexHealthOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewNodesVectorIndexHealthParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "nodes.vectorIndexHealth",
+		Method:             "GET",
+		PathPattern:        "/nodes/{className}/shards/{shardName}/health",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &NodesVectorIndexHealthReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*NodesVectorIndexHealthOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for nodes.vectorIndexHealth: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
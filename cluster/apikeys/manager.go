@@ -0,0 +1,203 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikeys
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+var (
+	ErrBadRequest     = errors.New("bad request")
+	ErrAPIKeyNotFound = errors.New("api key not found")
+)
+
+// storedKey is the metadata of an API key together with the hash of its
+// secret
+type storedKey struct {
+	Key  models.APIKey `json:"key"`
+	Hash string        `json:"hash"`
+}
+
+// Manager holds the dynamic API keys. It is only modified by applying
+// committed RAFT log entries, so every node accepts the same keys.
+type Manager struct {
+	sync.RWMutex
+	keys   map[string]*storedKey // by id
+	hashes map[string]string     // hash to id
+}
+
+func NewManager() *Manager {
+	return &Manager{
+		keys:   map[string]*storedKey{},
+		hashes: map[string]string{},
+	}
+}
+
+// CreateAPIKey stores a new API key, ids and hashes must be unique
+func (m *Manager) CreateAPIKey(cmd *command.ApplyRequest) error {
+	req := command.CreateAPIKeyRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if req.Key == nil || req.Key.ID == "" || req.Hash == "" {
+		return fmt.Errorf("%w: empty api key id or hash", ErrBadRequest)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.keys[req.Key.ID]; ok {
+		return fmt.Errorf("%w: api key %q already exists", ErrBadRequest, req.Key.ID)
+	}
+	if _, ok := m.hashes[req.Hash]; ok {
+		return fmt.Errorf("%w: duplicate api key hash", ErrBadRequest)
+	}
+
+	key := *req.Key
+	key.Key = ""
+	m.keys[key.ID] = &storedKey{Key: key, Hash: req.Hash}
+	m.hashes[req.Hash] = key.ID
+	return nil
+}
+
+// RotateAPIKey replaces the hash of an existing API key
+func (m *Manager) RotateAPIKey(cmd *command.ApplyRequest) error {
+	req := command.RotateAPIKeyRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if req.Hash == "" {
+		return fmt.Errorf("%w: empty api key hash", ErrBadRequest)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	stored, ok := m.keys[req.ID]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrAPIKeyNotFound, req.ID)
+	}
+	if _, ok := m.hashes[req.Hash]; ok {
+		return fmt.Errorf("%w: duplicate api key hash", ErrBadRequest)
+	}
+
+	delete(m.hashes, stored.Hash)
+	rotatedAt := req.RotatedAt
+	stored.Key.RotatedAt = &rotatedAt
+	stored.Hash = req.Hash
+	m.hashes[req.Hash] = req.ID
+	return nil
+}
+
+// RevokeAPIKey deletes an API key
+func (m *Manager) RevokeAPIKey(cmd *command.ApplyRequest) error {
+	req := command.RevokeAPIKeyRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	stored, ok := m.keys[req.ID]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrAPIKeyNotFound, req.ID)
+	}
+	delete(m.hashes, stored.Hash)
+	delete(m.keys, req.ID)
+	return nil
+}
+
+// GetAPIKeys returns the metadata of all API keys sorted by creation time
+func (m *Manager) GetAPIKeys() []*models.APIKey {
+	m.RLock()
+	defer m.RUnlock()
+
+	keys := make([]*models.APIKey, 0, len(m.keys))
+	for _, stored := range m.keys {
+		key := stored.Key
+		keys = append(keys, &key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		ti, tj := time.Time(keys[i].CreatedAt), time.Time(keys[j].CreatedAt)
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return keys[i].ID < keys[j].ID
+	})
+	return keys
+}
+
+// GetAPIKey returns the metadata of an API key or nil if it doesn't exist
+func (m *Manager) GetAPIKey(id string) *models.APIKey {
+	m.RLock()
+	defer m.RUnlock()
+
+	stored, ok := m.keys[id]
+	if !ok {
+		return nil
+	}
+	key := stored.Key
+	return &key
+}
+
+// GetAPIKeyByHash returns the metadata of the API key whose secret has the
+// given hash or nil if there is none
+func (m *Manager) GetAPIKeyByHash(hash string) *models.APIKey {
+	m.RLock()
+	defer m.RUnlock()
+
+	id, ok := m.hashes[hash]
+	if !ok {
+		return nil
+	}
+	key := m.keys[id].Key
+	return &key
+}
+
+// Snapshot returns the JSON encoded state of the manager
+func (m *Manager) Snapshot() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	keys := make([]*storedKey, 0, len(m.keys))
+	for _, stored := range m.keys {
+		keys = append(keys, stored)
+	}
+	return json.Marshal(keys)
+}
+
+// Restore replaces the state of the manager with a state previously returned
+// by Snapshot. An empty state resets the manager.
+func (m *Manager) Restore(data []byte) error {
+	var keys []*storedKey
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &keys); err != nil {
+			return fmt.Errorf("restore api keys snapshot: decode json: %w", err)
+		}
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	m.keys = make(map[string]*storedKey, len(keys))
+	m.hashes = make(map[string]string, len(keys))
+	for _, stored := range keys {
+		m.keys[stored.Key.ID] = stored
+		m.hashes[stored.Hash] = stored.Key.ID
+	}
+	return nil
+}
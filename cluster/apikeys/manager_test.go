@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikeys
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func newCmd(t *testing.T, typ command.ApplyRequest_Type, req interface{}) *command.ApplyRequest {
+	subCommand, err := json.Marshal(req)
+	require.Nil(t, err)
+	return &command.ApplyRequest{Type: typ, SubCommand: subCommand}
+}
+
+func newKey(id, user string, createdAt time.Time) *models.APIKey {
+	return &models.APIKey{ID: id, User: &user, CreatedAt: strfmt.DateTime(createdAt)}
+}
+
+func TestManager(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	create := func(t *testing.T, m *Manager, key *models.APIKey, hash string) error {
+		return m.CreateAPIKey(newCmd(t, command.ApplyRequest_TYPE_CREATE_API_KEY,
+			command.CreateAPIKeyRequest{Key: key, Hash: hash}))
+	}
+
+	t.Run("create and get", func(t *testing.T) {
+		m := NewManager()
+		key := newKey("2", "alice", now)
+		key.Key = "secret"
+		require.Nil(t, create(t, m, key, "hash-2"))
+		require.Nil(t, create(t, m, newKey("1", "bob", now.Add(-time.Hour)), "hash-1"))
+
+		keys := m.GetAPIKeys()
+		require.Len(t, keys, 2)
+		assert.Equal(t, "1", keys[0].ID)
+		assert.Equal(t, "2", keys[1].ID)
+		assert.Empty(t, keys[1].Key, "the secret must not be stored")
+
+		assert.Equal(t, "alice", *m.GetAPIKey("2").User)
+		assert.Equal(t, "2", m.GetAPIKeyByHash("hash-2").ID)
+		assert.Nil(t, m.GetAPIKey("3"))
+		assert.Nil(t, m.GetAPIKeyByHash("hash-3"))
+	})
+
+	t.Run("create duplicates", func(t *testing.T) {
+		m := NewManager()
+		require.Nil(t, create(t, m, newKey("1", "alice", now), "hash-1"))
+
+		assert.ErrorIs(t, create(t, m, newKey("1", "alice", now), "hash-2"), ErrBadRequest)
+		assert.ErrorIs(t, create(t, m, newKey("2", "alice", now), "hash-1"), ErrBadRequest)
+		assert.ErrorIs(t, create(t, m, newKey("", "alice", now), "hash-3"), ErrBadRequest)
+	})
+
+	t.Run("rotate", func(t *testing.T) {
+		m := NewManager()
+		require.Nil(t, create(t, m, newKey("1", "alice", now), "hash-1"))
+
+		rotatedAt := strfmt.DateTime(now.Add(time.Hour))
+		err := m.RotateAPIKey(newCmd(t, command.ApplyRequest_TYPE_ROTATE_API_KEY,
+			command.RotateAPIKeyRequest{ID: "1", Hash: "hash-2", RotatedAt: rotatedAt}))
+		require.Nil(t, err)
+		assert.Nil(t, m.GetAPIKeyByHash("hash-1"))
+		key := m.GetAPIKeyByHash("hash-2")
+		require.NotNil(t, key)
+		assert.Equal(t, rotatedAt.String(), key.RotatedAt.String())
+
+		err = m.RotateAPIKey(newCmd(t, command.ApplyRequest_TYPE_ROTATE_API_KEY,
+			command.RotateAPIKeyRequest{ID: "2", Hash: "hash-3"}))
+		assert.ErrorIs(t, err, ErrAPIKeyNotFound)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		m := NewManager()
+		require.Nil(t, create(t, m, newKey("1", "alice", now), "hash-1"))
+
+		revoke := func(id string) error {
+			return m.RevokeAPIKey(newCmd(t, command.ApplyRequest_TYPE_REVOKE_API_KEY,
+				command.RevokeAPIKeyRequest{ID: id}))
+		}
+		require.Nil(t, revoke("1"))
+		assert.Nil(t, m.GetAPIKey("1"))
+		assert.Nil(t, m.GetAPIKeyByHash("hash-1"))
+		assert.ErrorIs(t, revoke("1"), ErrAPIKeyNotFound)
+	})
+
+	t.Run("snapshot and restore", func(t *testing.T) {
+		m := NewManager()
+		require.Nil(t, create(t, m, newKey("1", "alice", now), "hash-1"))
+		require.Nil(t, create(t, m, newKey("2", "bob", now), "hash-2"))
+
+		data, err := m.Snapshot()
+		require.Nil(t, err)
+
+		restored := NewManager()
+		require.Nil(t, create(t, restored, newKey("3", "carol", now), "hash-3"))
+		require.Nil(t, restored.Restore(data))
+		assert.Equal(t, m.GetAPIKeys(), restored.GetAPIKeys())
+		assert.Equal(t, "1", restored.GetAPIKeyByHash("hash-1").ID)
+		assert.Nil(t, restored.GetAPIKeyByHash("hash-3"))
+
+		require.Nil(t, restored.Restore(nil))
+		assert.Empty(t, restored.GetAPIKeys())
+	})
+}
@@ -12,6 +12,7 @@
 package api
 
 import (
+	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/versioned"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -94,3 +95,36 @@ type QueryShardingStateResponse struct {
 	State   *sharding.State
 	Version uint64
 }
+
+type UpsertRolesRequest struct {
+	Roles []*models.Role
+}
+
+type DeleteRolesRequest struct {
+	Roles []string
+}
+
+// AssignRolesRequest is used to assign roles to and revoke roles from either
+// a user or an OIDC group, exactly one of User and Group is set
+type AssignRolesRequest struct {
+	User, Group string
+	Roles       []string
+}
+
+// CreateAPIKeyRequest stores the metadata of a new API key together with the
+// hex encoded SHA-256 hash of its secret. The secret itself is never part of
+// the log.
+type CreateAPIKeyRequest struct {
+	Key  *models.APIKey
+	Hash string
+}
+
+type RotateAPIKeyRequest struct {
+	ID        string
+	Hash      string
+	RotatedAt strfmt.DateTime
+}
+
+type RevokeAPIKeyRequest struct {
+	ID string
+}
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/cluster/apikeys"
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// CreateAPIKey stores the metadata of a new API key and the hash of its
+// secret
+func (s *Raft) CreateAPIKey(key *models.APIKey, hash string) error {
+	if key == nil || key.ID == "" || hash == "" {
+		return fmt.Errorf("nil api key, empty id or empty hash : %w", apikeys.ErrBadRequest)
+	}
+	return s.executeJSON(cmd.ApplyRequest_TYPE_CREATE_API_KEY, cmd.CreateAPIKeyRequest{Key: key, Hash: hash})
+}
+
+// RotateAPIKey replaces the hash of an API key, the previous secret is
+// rejected as soon as the change is applied
+func (s *Raft) RotateAPIKey(id, hash string, rotatedAt strfmt.DateTime) error {
+	return s.executeJSON(cmd.ApplyRequest_TYPE_ROTATE_API_KEY,
+		cmd.RotateAPIKeyRequest{ID: id, Hash: hash, RotatedAt: rotatedAt})
+}
+
+func (s *Raft) RevokeAPIKey(id string) error {
+	return s.executeJSON(cmd.ApplyRequest_TYPE_REVOKE_API_KEY, cmd.RevokeAPIKeyRequest{ID: id})
+}
+
+// GetAPIKeys reads the API keys committed to the local store, like the role
+// readers it doesn't contact the leader.
+func (s *Raft) GetAPIKeys() []*models.APIKey {
+	return s.store.apiKeyManager.GetAPIKeys()
+}
+
+func (s *Raft) GetAPIKey(id string) *models.APIKey {
+	return s.store.apiKeyManager.GetAPIKey(id)
+}
+
+func (s *Raft) GetAPIKeyByHash(hash string) *models.APIKey {
+	return s.store.apiKeyManager.GetAPIKeyByHash(hash)
+}
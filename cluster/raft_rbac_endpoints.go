@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/rbac"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func (s *Raft) UpsertRoles(roles ...*models.Role) error {
+	for _, role := range roles {
+		if role == nil || role.Name == nil || *role.Name == "" {
+			return fmt.Errorf("nil role or empty role name : %w", rbac.ErrBadRequest)
+		}
+	}
+	return s.executeJSON(cmd.ApplyRequest_TYPE_UPSERT_ROLES, cmd.UpsertRolesRequest{Roles: roles})
+}
+
+func (s *Raft) DeleteRoles(names ...string) error {
+	return s.executeJSON(cmd.ApplyRequest_TYPE_DELETE_ROLES, cmd.DeleteRolesRequest{Roles: names})
+}
+
+func (s *Raft) AssignRolesToUser(user string, roles ...string) error {
+	return s.executeJSON(cmd.ApplyRequest_TYPE_ASSIGN_ROLES, cmd.AssignRolesRequest{User: user, Roles: roles})
+}
+
+func (s *Raft) RevokeRolesFromUser(user string, roles ...string) error {
+	return s.executeJSON(cmd.ApplyRequest_TYPE_REVOKE_ROLES, cmd.AssignRolesRequest{User: user, Roles: roles})
+}
+
+func (s *Raft) AssignRolesToGroup(group string, roles ...string) error {
+	return s.executeJSON(cmd.ApplyRequest_TYPE_ASSIGN_ROLES, cmd.AssignRolesRequest{Group: group, Roles: roles})
+}
+
+func (s *Raft) RevokeRolesFromGroup(group string, roles ...string) error {
+	return s.executeJSON(cmd.ApplyRequest_TYPE_REVOKE_ROLES, cmd.AssignRolesRequest{Group: group, Roles: roles})
+}
+
+func (s *Raft) executeJSON(typ cmd.ApplyRequest_Type, req interface{}) error {
+	subCommand, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       typ,
+		SubCommand: subCommand,
+	}
+	_, err = s.Execute(command)
+	return err
+}
+
+// GetRoles reads the roles committed to the local store. Like the other role
+// readers it doesn't contact the leader, so every node authorizes requests
+// using its own copy of the policy.
+func (s *Raft) GetRoles(names ...string) []*models.Role {
+	return s.store.rbacManager.GetRoles(names...)
+}
+
+func (s *Raft) GetRolesForUser(user string) []*models.Role {
+	return s.store.rbacManager.GetRolesForUser(user)
+}
+
+func (s *Raft) GetRolesForGroup(group string) []*models.Role {
+	return s.store.rbacManager.GetRolesForGroup(group)
+}
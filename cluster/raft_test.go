@@ -19,10 +19,13 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/raft"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/weaviate/weaviate/cluster/apikeys"
 	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/rbac"
 	"github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/cluster/utils"
@@ -277,6 +280,24 @@ func TestRaftEndpoints(t *testing.T) {
 	leaderID := string(stats["leader_id"].(raft.ServerID))
 	assert.Equal(t, m.store.cfg.NodeID, leaderID)
 
+	// roles
+	role := "reader"
+	resource := "objects/*"
+	assert.Nil(t, srv.UpsertRoles(&models.Role{
+		Name:        &role,
+		Permissions: []*models.Permission{{Resource: &resource, Actions: []string{"get"}}},
+	}))
+	assert.Nil(t, srv.AssignRolesToUser("alice", role))
+	assert.ErrorIs(t, srv.AssignRolesToUser("alice", "writer"), rbac.ErrRoleNotFound)
+	assert.Len(t, srv.GetRolesForUser("alice"), 1)
+
+	// api keys
+	user := "alice"
+	assert.Nil(t, srv.CreateAPIKey(&models.APIKey{ID: "key-1", User: &user}, "hash-1"))
+	assert.Nil(t, srv.RotateAPIKey("key-1", "hash-2", strfmt.DateTime(time.Now())))
+	assert.ErrorIs(t, srv.RevokeAPIKey("key-2"), apikeys.ErrAPIKeyNotFound)
+	assert.Nil(t, srv.GetAPIKeyByHash("hash-1"))
+
 	// create snapshot
 	assert.Nil(t, srv.store.raft.Barrier(2*time.Second).Error())
 	assert.Nil(t, srv.store.raft.Snapshot().Error())
@@ -294,6 +315,18 @@ func TestRaftEndpoints(t *testing.T) {
 	tryNTimesWithWait(20, time.Millisecond*100, srv.store.IsLeader)
 	schemaReader = srv.SchemaReader()
 	assert.Equal(t, info, schemaReader.ClassInfo("C"))
+	assert.Equal(t, []string{"objects/*"}, roleResources(srv.GetRolesForUser("alice")))
+	assert.Equal(t, "key-1", srv.GetAPIKeyByHash("hash-2").ID)
+}
+
+func roleResources(roles []*models.Role) []string {
+	var resources []string
+	for _, role := range roles {
+		for _, perm := range role.Permissions {
+			resources = append(resources, *perm.Resource)
+		}
+	}
+	return resources
 }
 
 func TestRaftStoreInit(t *testing.T) {
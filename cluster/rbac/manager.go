@@ -0,0 +1,265 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrRoleNotFound = errors.New("role not found")
+)
+
+// Manager holds the roles and their assignment to users and groups. It is
+// only modified by applying committed RAFT log entries, which guarantees
+// that every node enforces the same policy.
+type Manager struct {
+	sync.RWMutex
+	roles  map[string]*models.Role
+	users  map[string]map[string]struct{}
+	groups map[string]map[string]struct{}
+}
+
+func NewManager() *Manager {
+	return &Manager{
+		roles:  map[string]*models.Role{},
+		users:  map[string]map[string]struct{}{},
+		groups: map[string]map[string]struct{}{},
+	}
+}
+
+// UpsertRoles creates the given roles or replaces the permissions of roles
+// which already exist
+func (m *Manager) UpsertRoles(cmd *command.ApplyRequest) error {
+	req := command.UpsertRolesRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	for _, role := range req.Roles {
+		if role == nil || role.Name == nil || *role.Name == "" {
+			return fmt.Errorf("%w: empty role name", ErrBadRequest)
+		}
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	for _, role := range req.Roles {
+		m.roles[*role.Name] = role
+	}
+	return nil
+}
+
+// DeleteRoles removes the given roles and revokes them from all users and
+// groups. Roles which don't exist are ignored.
+func (m *Manager) DeleteRoles(cmd *command.ApplyRequest) error {
+	req := command.DeleteRolesRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	for _, name := range req.Roles {
+		delete(m.roles, name)
+		revoke(m.users, name)
+		revoke(m.groups, name)
+	}
+	return nil
+}
+
+// AssignRoles assigns roles to a user or group, all roles must exist
+func (m *Manager) AssignRoles(cmd *command.ApplyRequest) error {
+	req, err := parseAssignment(cmd)
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	for _, name := range req.Roles {
+		if _, ok := m.roles[name]; !ok {
+			return fmt.Errorf("%w: %q", ErrRoleNotFound, name)
+		}
+	}
+
+	subjects, subject := m.subjects(req)
+	assigned := subjects[subject]
+	if assigned == nil {
+		assigned = make(map[string]struct{}, len(req.Roles))
+		subjects[subject] = assigned
+	}
+	for _, name := range req.Roles {
+		assigned[name] = struct{}{}
+	}
+	return nil
+}
+
+// RevokeRoles revokes roles from a user or group
+func (m *Manager) RevokeRoles(cmd *command.ApplyRequest) error {
+	req, err := parseAssignment(cmd)
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	subjects, subject := m.subjects(req)
+	assigned := subjects[subject]
+	for _, name := range req.Roles {
+		delete(assigned, name)
+	}
+	if len(assigned) == 0 {
+		delete(subjects, subject)
+	}
+	return nil
+}
+
+// GetRoles returns the roles with the given names sorted by name, unknown
+// names are skipped. All roles are returned if no names are passed.
+func (m *Manager) GetRoles(names ...string) []*models.Role {
+	m.RLock()
+	defer m.RUnlock()
+
+	if len(names) == 0 {
+		names = make([]string, 0, len(m.roles))
+		for name := range m.roles {
+			names = append(names, name)
+		}
+	}
+	return m.rolesByName(names)
+}
+
+// GetRolesForUser returns the roles assigned to a user sorted by name
+func (m *Manager) GetRolesForUser(user string) []*models.Role {
+	m.RLock()
+	defer m.RUnlock()
+	return m.rolesByName(keys(m.users[user]))
+}
+
+// GetRolesForGroup returns the roles assigned to a group sorted by name
+func (m *Manager) GetRolesForGroup(group string) []*models.Role {
+	m.RLock()
+	defer m.RUnlock()
+	return m.rolesByName(keys(m.groups[group]))
+}
+
+func (m *Manager) rolesByName(names []string) []*models.Role {
+	sort.Strings(names)
+	roles := make([]*models.Role, 0, len(names))
+	for _, name := range names {
+		if role, ok := m.roles[name]; ok {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+func (m *Manager) subjects(req command.AssignRolesRequest) (map[string]map[string]struct{}, string) {
+	if req.User != "" {
+		return m.users, req.User
+	}
+	return m.groups, req.Group
+}
+
+func parseAssignment(cmd *command.ApplyRequest) (command.AssignRolesRequest, error) {
+	req := command.AssignRolesRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return req, fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if (req.User == "") == (req.Group == "") {
+		return req, fmt.Errorf("%w: exactly one of user and group must be set", ErrBadRequest)
+	}
+	return req, nil
+}
+
+func revoke(subjects map[string]map[string]struct{}, role string) {
+	for subject, assigned := range subjects {
+		delete(assigned, role)
+		if len(assigned) == 0 {
+			delete(subjects, subject)
+		}
+	}
+}
+
+func keys(set map[string]struct{}) []string {
+	out := make([]string, 0, len(set))
+	for k := range set {
+		out = append(out, k)
+	}
+	return out
+}
+
+type snapshot struct {
+	Roles  map[string]*models.Role `json:"roles"`
+	Users  map[string][]string     `json:"users"`
+	Groups map[string][]string     `json:"groups"`
+}
+
+// Snapshot returns the JSON encoded state of the manager
+func (m *Manager) Snapshot() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	snap := snapshot{
+		Roles:  m.roles,
+		Users:  make(map[string][]string, len(m.users)),
+		Groups: make(map[string][]string, len(m.groups)),
+	}
+	for user, assigned := range m.users {
+		snap.Users[user] = keys(assigned)
+	}
+	for group, assigned := range m.groups {
+		snap.Groups[group] = keys(assigned)
+	}
+	return json.Marshal(&snap)
+}
+
+// Restore replaces the state of the manager with a state previously returned
+// by Snapshot. An empty state resets the manager.
+func (m *Manager) Restore(data []byte) error {
+	snap := snapshot{}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &snap); err != nil {
+			return fmt.Errorf("restore rbac snapshot: decode json: %w", err)
+		}
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	m.roles = snap.Roles
+	if m.roles == nil {
+		m.roles = map[string]*models.Role{}
+	}
+	m.users = toSets(snap.Users)
+	m.groups = toSets(snap.Groups)
+	return nil
+}
+
+func toSets(in map[string][]string) map[string]map[string]struct{} {
+	out := make(map[string]map[string]struct{}, len(in))
+	for subject, roles := range in {
+		set := make(map[string]struct{}, len(roles))
+		for _, role := range roles {
+			set[role] = struct{}{}
+		}
+		out[subject] = set
+	}
+	return out
+}
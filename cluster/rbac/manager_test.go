@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	command "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func newRole(name, resource string) *models.Role {
+	return &models.Role{
+		Name:        &name,
+		Permissions: []*models.Permission{{Resource: &resource, Actions: []string{"get"}}},
+	}
+}
+
+func newCmd(t *testing.T, typ command.ApplyRequest_Type, req interface{}) *command.ApplyRequest {
+	subCommand, err := json.Marshal(req)
+	require.Nil(t, err)
+	return &command.ApplyRequest{Type: typ, SubCommand: subCommand}
+}
+
+func roleNames(roles []*models.Role) []string {
+	names := make([]string, len(roles))
+	for i, role := range roles {
+		names[i] = *role.Name
+	}
+	return names
+}
+
+func TestManager(t *testing.T) {
+	upsert := func(t *testing.T, m *Manager, roles ...*models.Role) {
+		err := m.UpsertRoles(newCmd(t, command.ApplyRequest_TYPE_UPSERT_ROLES,
+			command.UpsertRolesRequest{Roles: roles}))
+		require.Nil(t, err)
+	}
+	assign := func(t *testing.T, m *Manager, req command.AssignRolesRequest) error {
+		return m.AssignRoles(newCmd(t, command.ApplyRequest_TYPE_ASSIGN_ROLES, req))
+	}
+
+	t.Run("upsert and get roles", func(t *testing.T) {
+		m := NewManager()
+		upsert(t, m, newRole("writer", "schema/*"), newRole("reader", "objects/*"))
+		upsert(t, m, newRole("reader", "objects/Article/*"))
+
+		assert.Equal(t, []string{"reader", "writer"}, roleNames(m.GetRoles()))
+		roles := m.GetRoles("reader", "missing")
+		require.Len(t, roles, 1)
+		assert.Equal(t, "objects/Article/*", *roles[0].Permissions[0].Resource)
+	})
+
+	t.Run("upsert role without name", func(t *testing.T) {
+		m := NewManager()
+		err := m.UpsertRoles(newCmd(t, command.ApplyRequest_TYPE_UPSERT_ROLES,
+			command.UpsertRolesRequest{Roles: []*models.Role{{}}}))
+		assert.ErrorIs(t, err, ErrBadRequest)
+	})
+
+	t.Run("assign and revoke", func(t *testing.T) {
+		m := NewManager()
+		upsert(t, m, newRole("writer", "schema/*"), newRole("reader", "objects/*"))
+
+		require.Nil(t, assign(t, m, command.AssignRolesRequest{User: "alice", Roles: []string{"writer", "reader"}}))
+		require.Nil(t, assign(t, m, command.AssignRolesRequest{Group: "readers", Roles: []string{"reader"}}))
+		assert.Equal(t, []string{"reader", "writer"}, roleNames(m.GetRolesForUser("alice")))
+		assert.Equal(t, []string{"reader"}, roleNames(m.GetRolesForGroup("readers")))
+		assert.Empty(t, m.GetRolesForUser("bob"))
+
+		err := m.RevokeRoles(newCmd(t, command.ApplyRequest_TYPE_REVOKE_ROLES,
+			command.AssignRolesRequest{User: "alice", Roles: []string{"writer"}}))
+		require.Nil(t, err)
+		assert.Equal(t, []string{"reader"}, roleNames(m.GetRolesForUser("alice")))
+	})
+
+	t.Run("assign missing role", func(t *testing.T) {
+		m := NewManager()
+		upsert(t, m, newRole("reader", "objects/*"))
+
+		err := assign(t, m, command.AssignRolesRequest{User: "alice", Roles: []string{"reader", "writer"}})
+		assert.ErrorIs(t, err, ErrRoleNotFound)
+		assert.Empty(t, m.GetRolesForUser("alice"))
+	})
+
+	t.Run("assign to user and group", func(t *testing.T) {
+		m := NewManager()
+		upsert(t, m, newRole("reader", "objects/*"))
+
+		err := assign(t, m, command.AssignRolesRequest{User: "alice", Group: "readers", Roles: []string{"reader"}})
+		assert.ErrorIs(t, err, ErrBadRequest)
+		err = assign(t, m, command.AssignRolesRequest{Roles: []string{"reader"}})
+		assert.ErrorIs(t, err, ErrBadRequest)
+	})
+
+	t.Run("delete revokes role", func(t *testing.T) {
+		m := NewManager()
+		upsert(t, m, newRole("writer", "schema/*"), newRole("reader", "objects/*"))
+		require.Nil(t, assign(t, m, command.AssignRolesRequest{User: "alice", Roles: []string{"writer", "reader"}}))
+		require.Nil(t, assign(t, m, command.AssignRolesRequest{Group: "writers", Roles: []string{"writer"}}))
+
+		err := m.DeleteRoles(newCmd(t, command.ApplyRequest_TYPE_DELETE_ROLES,
+			command.DeleteRolesRequest{Roles: []string{"writer"}}))
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"reader"}, roleNames(m.GetRoles()))
+		assert.Equal(t, []string{"reader"}, roleNames(m.GetRolesForUser("alice")))
+		assert.Empty(t, m.GetRolesForGroup("writers"))
+
+		// recreating the role must not restore previous assignments
+		upsert(t, m, newRole("writer", "schema/*"))
+		assert.Empty(t, m.GetRolesForGroup("writers"))
+	})
+
+	t.Run("snapshot and restore", func(t *testing.T) {
+		m := NewManager()
+		upsert(t, m, newRole("writer", "schema/*"), newRole("reader", "objects/*"))
+		require.Nil(t, assign(t, m, command.AssignRolesRequest{User: "alice", Roles: []string{"writer", "reader"}}))
+		require.Nil(t, assign(t, m, command.AssignRolesRequest{Group: "readers", Roles: []string{"reader"}}))
+
+		data, err := m.Snapshot()
+		require.Nil(t, err)
+
+		restored := NewManager()
+		upsert(t, restored, newRole("other", "meta"))
+		require.Nil(t, restored.Restore(data))
+		assert.Equal(t, m.GetRoles(), restored.GetRoles())
+		assert.Equal(t, []string{"reader", "writer"}, roleNames(restored.GetRolesForUser("alice")))
+		assert.Equal(t, []string{"reader"}, roleNames(restored.GetRolesForGroup("readers")))
+
+		require.Nil(t, restored.Restore(nil))
+		assert.Empty(t, restored.GetRoles())
+		assert.Empty(t, restored.GetRolesForUser("alice"))
+	})
+}
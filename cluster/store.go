@@ -27,7 +27,9 @@ import (
 	"github.com/hashicorp/raft"
 	raftbolt "github.com/hashicorp/raft-boltdb/v2"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/cluster/apikeys"
 	"github.com/weaviate/weaviate/cluster/log"
+	"github.com/weaviate/weaviate/cluster/rbac"
 	"github.com/weaviate/weaviate/cluster/resolver"
 	"github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/cluster/types"
@@ -164,6 +166,11 @@ type Store struct {
 	// schemaManager is responsible for applying changes committed by RAFT to the schema representation & querying the
 	// schema
 	schemaManager *schema.SchemaManager
+	// rbacManager holds the roles and role assignments committed by RAFT which are used for authorization
+	rbacManager *rbac.Manager
+
+	// apiKeyManager holds the dynamic API keys committed by RAFT which are used for authentication
+	apiKeyManager *apikeys.Manager
 	// lastAppliedIndexOnStart represents the index of the last applied command when the store is opened.
 	lastAppliedIndexOnStart atomic.Uint64
 	// lastAppliedIndex index of latest update to the store
@@ -184,6 +191,8 @@ func NewFSM(cfg Config) Store {
 		}),
 		schemaManager: schema.NewSchemaManager(
 			cfg.NodeID, cfg.DB, cfg.Parser, cfg.Logger),
+		rbacManager:   rbac.NewManager(),
+		apiKeyManager: apikeys.NewManager(),
 	}
 }
 
@@ -185,6 +185,41 @@ func (st *Store) Apply(l *raft.Log) interface{} {
 			ret.Error = st.schemaManager.UpdateTenantsProcess(&cmd, schemaOnly)
 		}
 
+	case api.ApplyRequest_TYPE_UPSERT_ROLES:
+		f = func() {
+			ret.Error = st.rbacManager.UpsertRoles(&cmd)
+		}
+
+	case api.ApplyRequest_TYPE_DELETE_ROLES:
+		f = func() {
+			ret.Error = st.rbacManager.DeleteRoles(&cmd)
+		}
+
+	case api.ApplyRequest_TYPE_ASSIGN_ROLES:
+		f = func() {
+			ret.Error = st.rbacManager.AssignRoles(&cmd)
+		}
+
+	case api.ApplyRequest_TYPE_REVOKE_ROLES:
+		f = func() {
+			ret.Error = st.rbacManager.RevokeRoles(&cmd)
+		}
+
+	case api.ApplyRequest_TYPE_CREATE_API_KEY:
+		f = func() {
+			ret.Error = st.apiKeyManager.CreateAPIKey(&cmd)
+		}
+
+	case api.ApplyRequest_TYPE_ROTATE_API_KEY:
+		f = func() {
+			ret.Error = st.apiKeyManager.RotateAPIKey(&cmd)
+		}
+
+	case api.ApplyRequest_TYPE_REVOKE_API_KEY:
+		f = func() {
+			ret.Error = st.apiKeyManager.RevokeAPIKey(&cmd)
+		}
+
 	case api.ApplyRequest_TYPE_STORE_SCHEMA_V1:
 		f = func() {
 			ret.Error = st.StoreSchemaV1()
@@ -12,6 +12,8 @@
 package cluster
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"sync"
@@ -34,7 +36,18 @@ import (
 // be implemented to allow for concurrent updates while a snapshot is happening.
 func (st *Store) Snapshot() (raft.FSMSnapshot, error) {
 	st.log.Info("persisting snapshot")
-	return st.schemaManager.Snapshot(), nil
+	rbacState, err := st.rbacManager.Snapshot()
+	if err != nil {
+		return nil, fmt.Errorf("rbac snapshot: %w", err)
+	}
+	apiKeysState, err := st.apiKeyManager.Snapshot()
+	if err != nil {
+		return nil, fmt.Errorf("api keys snapshot: %w", err)
+	}
+	return &snapshot{
+		schema: st.schemaManager.Snapshot(),
+		state:  map[string][]byte{"rbac": rbacState, "apikeys": apiKeysState},
+	}, nil
 }
 
 // Restore is used to restore an FSM from a snapshot. It is not called
@@ -49,12 +62,35 @@ func (st *Store) Restore(rc io.ReadCloser) error {
 			}
 		}()
 
-		if err := st.schemaManager.Restore(rc, st.cfg.Parser); err != nil {
+		data, err := io.ReadAll(rc)
+		if err != nil {
+			return fmt.Errorf("read snapshot: %w", err)
+		}
+
+		if err := st.schemaManager.Restore(io.NopCloser(bytes.NewReader(data)), st.cfg.Parser); err != nil {
 			st.log.WithError(err).Error("restoring schema from snapshot")
 			return fmt.Errorf("restore schema from snapshot: %w", err)
 		}
 		st.log.Info("successfully restored schema from snapshot")
 
+		// snapshots taken before roles and api keys existed don't contain
+		// their keys and reset them
+		var state struct {
+			RBAC    json.RawMessage `json:"rbac"`
+			APIKeys json.RawMessage `json:"apikeys"`
+		}
+		if err := json.Unmarshal(data, &state); err != nil {
+			return fmt.Errorf("decode rbac and api keys snapshot: %w", err)
+		}
+		if err := st.rbacManager.Restore(state.RBAC); err != nil {
+			st.log.WithError(err).Error("restoring rbac from snapshot")
+			return fmt.Errorf("restore rbac from snapshot: %w", err)
+		}
+		if err := st.apiKeyManager.Restore(state.APIKeys); err != nil {
+			st.log.WithError(err).Error("restoring api keys from snapshot")
+			return fmt.Errorf("restore api keys from snapshot: %w", err)
+		}
+
 		if st.reloadDBFromSnapshot() {
 			st.log.WithField("n", st.schemaManager.NewSchemaReader().Len()).
 				Info("successfully reloaded indexes from snapshot")
@@ -77,3 +113,48 @@ func (st *Store) Restore(rc io.ReadCloser) error {
 	wg.Wait()
 	return err
 }
+
+// snapshot persists the schema snapshot with the state of the rbac and api
+// key managers added under top level keys, which keeps the format readable by
+// the schema restore
+type snapshot struct {
+	schema raft.FSMSnapshot
+	state  map[string][]byte
+}
+
+func (s *snapshot) Persist(sink raft.SnapshotSink) error {
+	buf := &bufferSink{id: sink.ID()}
+	if err := s.schema.Persist(buf); err != nil {
+		sink.Cancel()
+		return err
+	}
+
+	state := map[string]json.RawMessage{}
+	if err := json.Unmarshal(buf.Bytes(), &state); err != nil {
+		sink.Cancel()
+		return fmt.Errorf("decode schema snapshot: %w", err)
+	}
+	for key, value := range s.state {
+		state[key] = value
+	}
+
+	if err := json.NewEncoder(sink).Encode(state); err != nil {
+		sink.Cancel()
+		return fmt.Errorf("encode: %w", err)
+	}
+	return sink.Close()
+}
+
+func (s *snapshot) Release() {
+	s.schema.Release()
+}
+
+// bufferSink collects a snapshot in memory
+type bufferSink struct {
+	bytes.Buffer
+	id string
+}
+
+func (b *bufferSink) ID() string    { return b.id }
+func (b *bufferSink) Cancel() error { return nil }
+func (b *bufferSink) Close() error  { return nil }
@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+import "fmt"
+
+// HighlightParams configures the snippets returned by the highlights
+// additional property. Zero values fall back to the defaults.
+type HighlightParams struct {
+	// FragmentSize is the approximate number of characters of a fragment
+	FragmentSize int `json:"fragmentSize"`
+	// Fragments is the maximum number of fragments per property
+	Fragments int `json:"fragments"`
+}
+
+const (
+	DefaultHighlightFragmentSize = 100
+	DefaultHighlightFragments    = 3
+)
+
+func (p *HighlightParams) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.FragmentSize < 0 {
+		return fmt.Errorf("highlights fragmentSize must not be negative, got %d", p.FragmentSize)
+	}
+	if p.Fragments < 0 {
+		return fmt.Errorf("highlights fragments must not be negative, got %d", p.Fragments)
+	}
+	return nil
+}
+
+// Highlight holds the fragments of a property in which the terms of the
+// query are marked
+type Highlight struct {
+	Property  string   `json:"property"`
+	Fragments []string `json:"fragments"`
+}
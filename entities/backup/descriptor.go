@@ -35,6 +35,8 @@ type DistributedBackupDescriptor struct {
 	ServerVersion string                     `json:"serverVersion"`
 	Leader        string                     `json:"leader"`
 	Error         string                     `json:"error"`
+	// BaseBackupID is the backup this incremental backup is based on
+	BaseBackupID string `json:"baseBackupId,omitempty"`
 }
 
 // Len returns how many nodes exist in d
@@ -224,6 +226,25 @@ type ShardDescriptor struct {
 	ShardVersionPath      string `json:"shardVersionPath,omitempty"`
 	Version               []byte `json:"version,omitempty"`
 	Chunk                 int32  `json:"chunk"`
+
+	// Segments maps the relative path of LSM segment files to their location.
+	// Segments that are referenced from a previous backup are not in Files.
+	Segments map[string]*SegmentInfo `json:"segments,omitempty"`
+}
+
+// SegmentInfo identifies an LSM segment file and the chunk containing it
+type SegmentInfo struct {
+	Size     int64  `json:"size"`
+	Checksum uint32 `json:"checksum"`
+	// BackupID is the backup which stores the segment in its chunk Chunk.
+	// It is empty if the segment is stored in the chunk of the shard itself.
+	BackupID string `json:"backupId,omitempty"`
+	Chunk    int32  `json:"chunk,omitempty"`
+}
+
+// IsReference reports whether the segment is stored in another backup
+func (s *SegmentInfo) IsReference() bool {
+	return s.BackupID != ""
 }
 
 // ClearTemporary clears fields that are no longer needed once compression is done.
@@ -259,6 +280,8 @@ type BackupDescriptor struct {
 	Version       string            `json:"version"` //
 	ServerVersion string            `json:"serverVersion"`
 	Error         string            `json:"error"`
+	// BaseBackupID is the backup this incremental backup is based on
+	BaseBackupID string `json:"baseBackupId,omitempty"`
 }
 
 // List all existing classes in d
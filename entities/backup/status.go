@@ -19,6 +19,7 @@ const (
 	Transferred  Status = "TRANSFERRED"
 	Success      Status = "SUCCESS"
 	Failed       Status = "FAILED"
+	Canceled     Status = "CANCELED"
 )
 
 type CreateMeta struct {
@@ -38,6 +38,11 @@ func Class(c *models.Class) *models.Class {
 	if c.ReplicationConfig != nil {
 		replicationConf = &models.ReplicationConfig{Factor: c.ReplicationConfig.Factor}
 	}
+	var objectTTLConf *models.ObjectTTLConfig = nil
+	if c.ObjectTTLConfig != nil {
+		conf := *c.ObjectTTLConfig
+		objectTTLConf = &conf
+	}
 
 	return &models.Class{
 		Class:               c.Class,
@@ -47,6 +52,7 @@ func Class(c *models.Class) *models.Class {
 		VectorIndexConfig:   c.VectorIndexConfig,
 		VectorIndexType:     c.VectorIndexType,
 		ReplicationConfig:   replicationConf,
+		ObjectTTLConfig:     objectTTLConf,
 		Vectorizer:          c.Vectorizer,
 		InvertedIndexConfig: InvertedIndexConfig(c.InvertedIndexConfig),
 		Properties:          properties,
@@ -63,7 +69,17 @@ func Prop(p *models.Property) *models.Property {
 		IndexFilterable:   ptrBoolCopy(p.IndexFilterable),
 		IndexSearchable:   ptrBoolCopy(p.IndexSearchable),
 		IndexRangeFilters: ptrBoolCopy(p.IndexRangeFilters),
+		IndexPositions:    ptrBoolCopy(p.IndexPositions),
+		TextAnalyzer:      textAnalyzerCopy(p.TextAnalyzer),
+	}
+}
+
+func textAnalyzerCopy(c *models.TextAnalyzerConfig) *models.TextAnalyzerConfig {
+	if c == nil {
+		return nil
 	}
+	copied := *c
+	return &copied
 }
 
 func ptrBoolCopy(ptrBool *bool) *bool {
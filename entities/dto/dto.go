@@ -51,6 +51,7 @@ type GetParams struct {
 	Properties              search.SelectProperties
 	NearVector              *searchparams.NearVector
 	NearObject              *searchparams.NearObject
+	NearSparseVector        *searchparams.NearSparseVector
 	KeywordRanking          *searchparams.KeywordRanking
 	HybridSearch            *searchparams.HybridSearch
 	GroupBy                 *searchparams.GroupBy
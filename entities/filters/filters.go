@@ -34,6 +34,7 @@ const (
 	OperatorIsNull
 	ContainsAny
 	ContainsAll
+	OperatorFuzzy
 )
 
 func (o Operator) OnValue() bool {
@@ -48,7 +49,8 @@ func (o Operator) OnValue() bool {
 		OperatorLike,
 		OperatorIsNull,
 		ContainsAny,
-		ContainsAll:
+		ContainsAll,
+		OperatorFuzzy:
 		return true
 	default:
 		return false
@@ -83,6 +85,8 @@ func (o Operator) Name() string {
 		return "ContainsAny"
 	case ContainsAll:
 		return "ContainsAll"
+	case OperatorFuzzy:
+		return "Fuzzy"
 	default:
 		panic("Unknown operator")
 	}
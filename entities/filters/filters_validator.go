@@ -91,6 +91,18 @@ func validateClause(fn func(string) *models.Class, cw *clauseWrapper) error {
 		return nil
 	}
 
+	if cw.getOperator() == OperatorFuzzy {
+		if isPropLengthFilter {
+			return errors.Errorf("operator Fuzzy is not supported when filtering for property length")
+		}
+		switch dt := schema.DataType(prop.DataType[0]); dt {
+		case schema.DataTypeText, schema.DataTypeTextArray:
+			// ok
+		default:
+			return errors.Errorf("operator Fuzzy is only supported on text and text[] properties, got %q", dt)
+		}
+	}
+
 	if isPropLengthFilter {
 		if !cw.isType(schema.DataTypeInt) {
 			return errors.Errorf("Filtering for property length requires IntValue, got %q instead",
@@ -158,6 +158,80 @@ func TestValidatePropertyLength(t *testing.T) {
 	}
 }
 
+func TestValidateFuzzyOperator(t *testing.T) {
+	tests := []struct {
+		name       string
+		prop       schema.PropertyName
+		schemaType schema.DataType
+		value      interface{}
+		valid      bool
+	}{
+		{
+			name:       "Valid text property",
+			prop:       "modelName",
+			schemaType: schema.DataTypeText,
+			value:      "mustnag",
+			valid:      true,
+		},
+		{
+			name:       "Valid text array property",
+			prop:       "tags",
+			schemaType: schema.DataTypeText,
+			value:      "sprots",
+			valid:      true,
+		},
+		{
+			name:       "Invalid datatype (int)",
+			prop:       "horsepower",
+			schemaType: schema.DataTypeInt,
+			value:      300,
+			valid:      false,
+		},
+		{
+			name:       "Invalid property length",
+			prop:       "len(modelName)",
+			schemaType: schema.DataTypeInt,
+			value:      7,
+			valid:      false,
+		},
+		{
+			name:       "Invalid property length with text value",
+			prop:       "len(modelName)",
+			schemaType: schema.DataTypeText,
+			value:      "mustnag",
+			valid:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := Clause{
+				Operator: OperatorFuzzy,
+				Value:    &Value{Value: tt.value, Type: tt.schemaType},
+				On:       &Path{Class: "Car", Property: tt.prop},
+			}
+
+			f := &fakeFinder{}
+			f.On("ReadOnlyClass", mock.Anything).Return(
+				&models.Class{
+					Class: "Car",
+					Properties: []*models.Property{
+						{Name: "modelName", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWord},
+						{Name: "tags", DataType: schema.DataTypeTextArray.PropString(), Tokenization: models.PropertyTokenizationWord},
+						{Name: "horsepower", DataType: []string{"int"}},
+					},
+				},
+			)
+			err := validateClause(f.ReadOnlyClass, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateUUIDFilter(t *testing.T) {
 	tests := []struct {
 		name       string
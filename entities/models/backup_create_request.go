@@ -29,6 +29,9 @@ import (
 // swagger:model BackupCreateRequest
 type BackupCreateRequest struct {
 
+	// The ID of a successful backup on the same backend to create an incremental backup from. Segment files which did not change since the base backup are referenced instead of uploaded again, so the base backup must be kept as long as this backup is needed.
+	BaseBackupID string `json:"baseBackupId,omitempty"`
+
 	// Custom configuration for the backup creation process
 	Config *BackupConfig `json:"config,omitempty"`
 
@@ -46,6 +46,9 @@ type Class struct {
 	// multi tenancy config
 	MultiTenancyConfig *MultiTenancyConfig `json:"multiTenancyConfig,omitempty"`
 
+	// object Ttl config
+	ObjectTTLConfig *ObjectTTLConfig `json:"objectTtlConfig,omitempty"`
+
 	// The properties of the class.
 	Properties []*Property `json:"properties"`
 
@@ -80,6 +83,10 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateObjectTTLConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProperties(formats); err != nil {
 		res = append(res, err)
 	}
@@ -136,6 +143,25 @@ func (m *Class) validateMultiTenancyConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateObjectTTLConfig(formats strfmt.Registry) error {
+	if swag.IsZero(m.ObjectTTLConfig) { // not required
+		return nil
+	}
+
+	if m.ObjectTTLConfig != nil {
+		if err := m.ObjectTTLConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("objectTtlConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("objectTtlConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateProperties(formats strfmt.Registry) error {
 	if swag.IsZero(m.Properties) { // not required
 		return nil
@@ -219,6 +245,10 @@ func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) er
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateObjectTTLConfig(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProperties(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -269,6 +299,22 @@ func (m *Class) contextValidateMultiTenancyConfig(ctx context.Context, formats s
 	return nil
 }
 
+func (m *Class) contextValidateObjectTTLConfig(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ObjectTTLConfig != nil {
+		if err := m.ObjectTTLConfig.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("objectTtlConfig")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("objectTtlConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateProperties(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Properties); i++ {
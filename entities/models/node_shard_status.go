@@ -43,6 +43,9 @@ type NodeShardStatus struct {
 	// The number of objects in shard.
 	ObjectCount int64 `json:"objectCount"`
 
+	// The progress of a running requantization of the shard's vector index, between 0 and 1. Not set if no requantization is running.
+	RequantizationProgress *float32 `json:"requantizationProgress,omitempty"`
+
 	// The status of the vector indexing process.
 	VectorIndexingStatus string `json:"vectorIndexingStatus"`
 
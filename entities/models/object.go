@@ -46,9 +46,15 @@ type Object struct {
 	// Timestamp of the last Object update in milliseconds since epoch UTC.
 	LastUpdateTimeUnix int64 `json:"lastUpdateTimeUnix,omitempty"`
 
+	// This field returns the multi vectors associated with the Object.
+	MultiVectors MultiVectors `json:"multiVectors,omitempty"`
+
 	// properties
 	Properties PropertySchema `json:"properties,omitempty"`
 
+	// This field returns the sparse vectors associated with the Object.
+	SparseVectors SparseVectors `json:"sparseVectors,omitempty"`
+
 	// Name of the Objects tenant.
 	Tenant string `json:"tenant,omitempty"`
 
@@ -74,6 +80,14 @@ func (m *Object) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMultiVectors(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSparseVectors(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateVector(formats); err != nil {
 		res = append(res, err)
 	}
@@ -119,6 +133,44 @@ func (m *Object) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Object) validateMultiVectors(formats strfmt.Registry) error {
+	if swag.IsZero(m.MultiVectors) { // not required
+		return nil
+	}
+
+	if m.MultiVectors != nil {
+		if err := m.MultiVectors.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("multiVectors")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("multiVectors")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Object) validateSparseVectors(formats strfmt.Registry) error {
+	if swag.IsZero(m.SparseVectors) { // not required
+		return nil
+	}
+
+	if m.SparseVectors != nil {
+		if err := m.SparseVectors.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sparseVectors")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("sparseVectors")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Object) validateVector(formats strfmt.Registry) error {
 	if swag.IsZero(m.Vector) { // not required
 		return nil
@@ -163,6 +215,14 @@ func (m *Object) ContextValidate(ctx context.Context, formats strfmt.Registry) e
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateMultiVectors(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSparseVectors(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateVector(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -191,6 +251,34 @@ func (m *Object) contextValidateAdditional(ctx context.Context, formats strfmt.R
 	return nil
 }
 
+func (m *Object) contextValidateMultiVectors(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.MultiVectors.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("multiVectors")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("multiVectors")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *Object) contextValidateSparseVectors(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.SparseVectors.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("sparseVectors")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("sparseVectors")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *Object) contextValidateVector(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.Vector.ContextValidate(ctx, formats); err != nil {
@@ -44,6 +44,9 @@ type Property struct {
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters, bm25 or hybrid search. This property has no affect on vectorization decisions done by modules (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)
 	IndexInverted *bool `json:"indexInverted,omitempty"`
 
+	// Optional. Should the positions of the terms be stored in the searchable index. Defaults to false. Required for phrase and proximity queries in bm25 and hybrid search. Applicable only to searchable properties of data type text and text[].
+	IndexPositions *bool `json:"indexPositions,omitempty"`
+
 	// Optional. Should this property be indexed in the inverted index. Defaults to false. Provides better performance for range queries compared to filterable index in large datasets. Applicable only to properties of data type int, number, date.
 	IndexRangeFilters *bool `json:"indexRangeFilters,omitempty"`
 
@@ -59,6 +62,9 @@ type Property struct {
 	// The properties of the nested object(s). Applies to object and object[] data types.
 	NestedProperties []*NestedProperty `json:"nestedProperties,omitempty"`
 
+	// text analyzer
+	TextAnalyzer *TextAnalyzerConfig `json:"textAnalyzer,omitempty"`
+
 	// Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are `word` (default; splits on any non-alphanumerical, lowercases), `lowercase` (splits on white spaces, lowercases), `whitespace` (splits on white spaces), `field` (trims). Not supported for remaining data types
 	// Enum: [word lowercase whitespace field trigram gse kagome_kr]
 	Tokenization string `json:"tokenization,omitempty"`
@@ -72,6 +78,10 @@ func (m *Property) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTextAnalyzer(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTokenization(formats); err != nil {
 		res = append(res, err)
 	}
@@ -108,6 +118,25 @@ func (m *Property) validateNestedProperties(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Property) validateTextAnalyzer(formats strfmt.Registry) error {
+	if swag.IsZero(m.TextAnalyzer) { // not required
+		return nil
+	}
+
+	if m.TextAnalyzer != nil {
+		if err := m.TextAnalyzer.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("textAnalyzer")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("textAnalyzer")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 var propertyTypeTokenizationPropEnum []interface{}
 
 func init() {
@@ -173,6 +202,10 @@ func (m *Property) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateTextAnalyzer(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -199,6 +232,22 @@ func (m *Property) contextValidateNestedProperties(ctx context.Context, formats
 	return nil
 }
 
+func (m *Property) contextValidateTextAnalyzer(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TextAnalyzer != nil {
+		if err := m.TextAnalyzer.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("textAnalyzer")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("textAnalyzer")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Property) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -44,4 +44,9 @@ type BackupBackend interface {
 
 	Write(ctx context.Context, backupID, key string, r io.ReadCloser) (int64, error)
 	Read(ctx context.Context, backupID, key string, w io.WriteCloser) (int64, error)
+
+	// AllBackupIDs returns the IDs of all backups stored on the backend
+	AllBackupIDs(ctx context.Context) ([]string, error)
+	// Delete removes all objects stored under backupID
+	Delete(ctx context.Context, backupID string) error
 }
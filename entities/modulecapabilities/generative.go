@@ -50,6 +50,28 @@ type GenerativeClient interface {
 	) (*GenerateResponse, error)
 }
 
+// GenerateChunkFn receives the chunks of a streamed generative response in
+// the order they were generated. Returning an error aborts the generation.
+type GenerateChunkFn = func(chunk string) error
+
+// StreamingGenerativeClient is optionally implemented by generative clients
+// which can stream their responses. The methods correspond to the ones of
+// GenerativeClient, they pass every chunk to onChunk as soon as it is
+// received and return the complete response once generation has finished.
+type StreamingGenerativeClient interface {
+	GenerateSingleResultStream(ctx context.Context,
+		textProperties map[string]string, prompt string, requestParams interface{}, debug bool, cfg moduletools.ClassConfig,
+		onChunk GenerateChunkFn,
+	) (*GenerateResponse, error)
+	GenerateAllResultsStream(ctx context.Context,
+		textProperties []map[string]string, task string, requestParams interface{}, debug bool, cfg moduletools.ClassConfig,
+		onChunk GenerateChunkFn,
+	) (*GenerateResponse, error)
+	GenerateStream(ctx context.Context, cfg moduletools.ClassConfig, prompt string, requestParams interface{}, debug bool,
+		onChunk GenerateChunkFn,
+	) (*GenerateResponse, error)
+}
+
 // GenerativeProperty defines all needed additional request / response parameters
 // only client setting is manadatory as we can have generative modules
 // that don't expose any additional request / response params.
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objectttl
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// Enabled returns whether expired objects of class are deleted
+func Enabled(class *models.Class) bool {
+	return class != nil && class.ObjectTTLConfig != nil && class.ObjectTTLConfig.Enabled
+}
+
+// ValidateConfig validates the object TTL config of class against its
+// properties and inverted index config
+func ValidateConfig(class *models.Class) error {
+	if !Enabled(class) {
+		return nil
+	}
+	cfg := class.ObjectTTLConfig
+
+	if cfg.DefaultTTL < 0 {
+		return fmt.Errorf("object ttl: defaultTtl must not be negative, got %d", cfg.DefaultTTL)
+	}
+
+	switch cfg.DeleteOn {
+	case "":
+		return fmt.Errorf("object ttl: deleteOn is required")
+	case filters.InternalPropCreationTimeUnix, filters.InternalPropLastUpdateTimeUnix:
+		if cfg.DefaultTTL == 0 {
+			return fmt.Errorf("object ttl: defaultTtl is required when deleting on %s", cfg.DeleteOn)
+		}
+		if class.InvertedIndexConfig == nil || !class.InvertedIndexConfig.IndexTimestamps {
+			return fmt.Errorf("object ttl: deleting on %s requires 'indexTimestamps' to be enabled", cfg.DeleteOn)
+		}
+		return nil
+	}
+
+	// property names are stored with a lowercase first letter
+	deleteOn := schema.LowercaseFirstLetter(cfg.DeleteOn)
+	for _, prop := range class.Properties {
+		if prop.Name != deleteOn {
+			continue
+		}
+		if dt, ok := schema.AsPrimitive(prop.DataType); !ok || dt != schema.DataTypeDate {
+			return fmt.Errorf("object ttl: property %q must be of type %s", prop.Name, schema.DataTypeDate)
+		}
+		if !isFilterable(prop) {
+			return fmt.Errorf("object ttl: property %q must be filterable", prop.Name)
+		}
+		return nil
+	}
+	return fmt.Errorf("object ttl: property %q not found", cfg.DeleteOn)
+}
+
+func isFilterable(prop *models.Property) bool {
+	return prop.IndexFilterable == nil || *prop.IndexFilterable ||
+		(prop.IndexRangeFilters != nil && *prop.IndexRangeFilters)
+}
+
+// ExpiredFilter returns a filter matching all objects of class which are expired at now
+func ExpiredFilter(class *models.Class, now time.Time) *filters.LocalFilter {
+	cfg := class.ObjectTTLConfig
+	// an object expires once deleteOn + defaultTtl has passed
+	cutoff := now.Add(-time.Duration(cfg.DefaultTTL) * time.Second)
+
+	return &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorLessThanEqual,
+			On: &filters.Path{
+				Class:    schema.ClassName(class.Class),
+				Property: schema.PropertyName(schema.LowercaseFirstLetter(cfg.DeleteOn)),
+			},
+			Value: &filters.Value{
+				Value: cutoff.UTC().Format(time.RFC3339Nano),
+				Type:  schema.DataTypeDate,
+			},
+		},
+	}
+}
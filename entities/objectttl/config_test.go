@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objectttl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestValidateConfig(t *testing.T) {
+	vFalse := false
+	properties := []*models.Property{
+		{Name: "expiresAt", DataType: schema.DataTypeDate.PropString()},
+		{Name: "notFilterable", DataType: schema.DataTypeDate.PropString(), IndexFilterable: &vFalse},
+		{Name: "name", DataType: schema.DataTypeText.PropString()},
+	}
+	timestamps := &models.InvertedIndexConfig{IndexTimestamps: true}
+
+	tests := []struct {
+		name     string
+		cfg      *models.ObjectTTLConfig
+		inverted *models.InvertedIndexConfig
+		err      string
+	}{
+		{name: "not configured"},
+		{name: "disabled", cfg: &models.ObjectTTLConfig{DeleteOn: "unknown"}},
+		{name: "date property", cfg: &models.ObjectTTLConfig{Enabled: true, DeleteOn: "expiresAt"}},
+		{name: "date property with uppercase first letter", cfg: &models.ObjectTTLConfig{Enabled: true, DeleteOn: "ExpiresAt"}},
+		{name: "date property with ttl", cfg: &models.ObjectTTLConfig{Enabled: true, DeleteOn: "expiresAt", DefaultTTL: 60}},
+		{
+			name:     "creation time",
+			cfg:      &models.ObjectTTLConfig{Enabled: true, DeleteOn: filters.InternalPropCreationTimeUnix, DefaultTTL: 60},
+			inverted: timestamps,
+		},
+		{
+			name:     "update time",
+			cfg:      &models.ObjectTTLConfig{Enabled: true, DeleteOn: filters.InternalPropLastUpdateTimeUnix, DefaultTTL: 60},
+			inverted: timestamps,
+		},
+		{
+			name: "timestamps not indexed",
+			cfg:  &models.ObjectTTLConfig{Enabled: true, DeleteOn: filters.InternalPropCreationTimeUnix, DefaultTTL: 60},
+			err:  "indexTimestamps",
+		},
+		{
+			name:     "timestamp without ttl",
+			cfg:      &models.ObjectTTLConfig{Enabled: true, DeleteOn: filters.InternalPropCreationTimeUnix},
+			inverted: timestamps,
+			err:      "defaultTtl is required",
+		},
+		{name: "negative ttl", cfg: &models.ObjectTTLConfig{Enabled: true, DeleteOn: "expiresAt", DefaultTTL: -1}, err: "negative"},
+		{name: "missing deleteOn", cfg: &models.ObjectTTLConfig{Enabled: true}, err: "deleteOn is required"},
+		{name: "unknown property", cfg: &models.ObjectTTLConfig{Enabled: true, DeleteOn: "unknown"}, err: "not found"},
+		{name: "wrong data type", cfg: &models.ObjectTTLConfig{Enabled: true, DeleteOn: "name"}, err: "must be of type date"},
+		{name: "not filterable", cfg: &models.ObjectTTLConfig{Enabled: true, DeleteOn: "notFilterable"}, err: "must be filterable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			class := &models.Class{
+				Class:               "Test",
+				Properties:          properties,
+				InvertedIndexConfig: tt.inverted,
+				ObjectTTLConfig:     tt.cfg,
+			}
+			err := ValidateConfig(class)
+			if tt.err == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestExpiredFilter(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	class := &models.Class{
+		Class:           "Test",
+		ObjectTTLConfig: &models.ObjectTTLConfig{Enabled: true, DeleteOn: "expiresAt", DefaultTTL: 3600},
+	}
+
+	filter := ExpiredFilter(class, now)
+	require.NotNil(t, filter)
+	assert.Equal(t, filters.OperatorLessThanEqual, filter.Root.Operator)
+	assert.Equal(t, &filters.Path{Class: "Test", Property: "expiresAt"}, filter.Root.On)
+	assert.Equal(t, &filters.Value{Value: "2024-05-01T11:00:00Z", Type: schema.DataTypeDate}, filter.Root.Value)
+
+	t.Run("property with uppercase first letter", func(t *testing.T) {
+		class.ObjectTTLConfig.DeleteOn = "ExpiresAt"
+		filter := ExpiredFilter(class, now)
+		require.NotNil(t, filter)
+		assert.Equal(t, &filters.Path{Class: "Test", Property: "expiresAt"}, filter.Root.On)
+	})
+}
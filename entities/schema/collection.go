@@ -90,6 +90,13 @@ type ReplicationConfig struct {
 	Factor int64 `json:"factor,omitempty"`
 }
 
+type TextAnalyzerConfig struct {
+	Lowercase      bool   `json:"lowercase,omitempty"`
+	StopwordPreset string `json:"stopwordPreset,omitempty"`
+	Stemmer        string `json:"stemmer,omitempty"`
+	ASCIIFold      bool   `json:"asciiFold,omitempty"`
+}
+
 type ShardingConfig struct {
 	VirtualPerPhysical  int    `json:"virtualPerPhysical"`
 	DesiredCount        int    `json:"desiredCount"`
@@ -124,6 +131,12 @@ type Property struct {
 	// Optional. Should this property be indexed in the inverted index. Defaults to false. Provides better performance for range queries compared to filterable index in large datasets. Applicable only to properties of data type int, number, date."
 	IndexRangeFilters bool `json:"indexRangeFilters,omitempty"`
 
+	// Optional. Should the positions of the terms be stored in the searchable index. Defaults to false. Required for phrase and proximity queries. Applicable only to searchable properties of data type text and text[].
+	IndexPositions bool `json:"indexPositions,omitempty"`
+
+	// Optional. Token filters applied after tokenization of text and text[] data types.
+	TextAnalyzer *TextAnalyzerConfig `json:"textAnalyzer,omitempty"`
+
 	// Configuration specific to modules this Weaviate instance has installed
 	ModuleConfig map[string]interface{} `json:"moduleConfig,omitempty"`
 
@@ -248,6 +261,19 @@ func PropertyFromModel(m models.Property) Property {
 	} else {
 		p.IndexRangeFilters = false
 	}
+	if m.IndexPositions != nil {
+		p.IndexPositions = *m.IndexPositions
+	} else {
+		p.IndexPositions = false
+	}
+	if m.TextAnalyzer != nil {
+		p.TextAnalyzer = &TextAnalyzerConfig{
+			Lowercase:      m.TextAnalyzer.Lowercase,
+			StopwordPreset: m.TextAnalyzer.StopwordPreset,
+			Stemmer:        m.TextAnalyzer.Stemmer,
+			ASCIIFold:      m.TextAnalyzer.ASCIIFold,
+		}
+	}
 	if v, ok := m.ModuleConfig.(map[string]interface{}); ok {
 		p.ModuleConfig = v
 	}
@@ -279,6 +305,16 @@ func PropertyToModel(p Property) models.Property {
 	m.IndexSearchable = &indexSearchable
 	indexRangeFilters := p.IndexRangeFilters
 	m.IndexRangeFilters = &indexRangeFilters
+	indexPositions := p.IndexPositions
+	m.IndexPositions = &indexPositions
+	if p.TextAnalyzer != nil {
+		m.TextAnalyzer = &models.TextAnalyzerConfig{
+			Lowercase:      p.TextAnalyzer.Lowercase,
+			StopwordPreset: p.TextAnalyzer.StopwordPreset,
+			Stemmer:        p.TextAnalyzer.Stemmer,
+			ASCIIFold:      p.TextAnalyzer.ASCIIFold,
+		}
+	}
 	m.ModuleConfig = p.ModuleConfig
 	m.Name = p.Name
 	m.Tokenization = p.Tokenization
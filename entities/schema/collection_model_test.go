@@ -52,6 +52,52 @@ func TestCollectionFromAndToModel(t *testing.T) {
 			name:       "unknown",
 			inputModel: models.Class{VectorIndexType: "unknown"},
 		},
+		{
+			name: "text property with analyzer",
+			inputModel: models.Class{
+				Properties: []*models.Property{
+					{
+						Name:         "text",
+						DataType:     DataTypeText.PropString(),
+						Tokenization: models.PropertyTokenizationWord,
+						TextAnalyzer: &models.TextAnalyzerConfig{
+							StopwordPreset: "de",
+							Stemmer:        "de",
+							ASCIIFold:      true,
+						},
+					},
+				},
+			},
+			outputModel: models.Class{
+				InvertedIndexConfig: &models.InvertedIndexConfig{
+					Bm25:      &models.BM25Config{B: 0, K1: 0},
+					Stopwords: &models.StopwordConfig{Additions: nil, Preset: "", Removals: nil},
+				},
+				MultiTenancyConfig: &models.MultiTenancyConfig{},
+				ModuleConfig:       emptyModuleConfig,
+				Properties: []*models.Property{
+					{
+						Name:              "text",
+						DataType:          DataTypeText.PropString(),
+						IndexFilterable:   &vTrue,
+						IndexInverted:     &vTrue,
+						IndexSearchable:   &vTrue,
+						IndexRangeFilters: &vFalse,
+						IndexPositions:    &vFalse,
+						Tokenization:      models.PropertyTokenizationWord,
+						ModuleConfig:      emptyModuleConfig,
+						TextAnalyzer: &models.TextAnalyzerConfig{
+							StopwordPreset: "de",
+							Stemmer:        "de",
+							ASCIIFold:      true,
+						},
+					},
+				},
+				ReplicationConfig: &models.ReplicationConfig{},
+				ShardingConfig:    sharding.Config{},
+				VectorIndexType:   "",
+			},
+		},
 
 		{
 			name: "all elements",
@@ -68,6 +114,7 @@ func TestCollectionFromAndToModel(t *testing.T) {
 						IndexFilterable:   &vTrue,
 						IndexSearchable:   &vFalse,
 						IndexRangeFilters: &vFalse,
+						IndexPositions:    &vFalse,
 						Tokenization:      "",
 						NestedProperties: []*models.NestedProperty{
 							{
@@ -219,6 +266,7 @@ func TestCollectionFromAndToModel(t *testing.T) {
 						IndexInverted:     &vTrue,
 						IndexSearchable:   &vFalse,
 						IndexRangeFilters: &vFalse,
+						IndexPositions:    &vFalse,
 						Tokenization:      "",
 						ModuleConfig:      emptyModuleConfig,
 						NestedProperties: []*models.NestedProperty{
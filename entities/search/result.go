@@ -29,6 +29,8 @@ type Result struct {
 	Dist                 float32
 	Vector               []float32
 	Vectors              models.Vectors
+	MultiVectors         models.MultiVectors
+	SparseVectors        models.SparseVectors
 	Beacon               string
 	Certainty            float32
 	Schema               models.PropertySchema
@@ -73,6 +75,8 @@ func (r Result) ObjectWithVector(includeVector bool) *models.Object {
 	if includeVector {
 		t.Vector = r.Vector
 		t.Vectors = r.Vectors
+		t.MultiVectors = r.MultiVectors
+		t.SparseVectors = r.SparseVectors
 	}
 
 	return t
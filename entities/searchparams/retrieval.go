@@ -25,6 +25,55 @@ type NearVector struct {
 	WithDistance    bool                 `json:"-"`
 	VectorPerTarget map[string][]float32 `json:"vectorPerTarget"`
 	TargetVectors   []string             `json:"targetVectors"`
+	// DistanceMetric overrides the distance metric of the vector index for
+	// this query, it is only supported by flat indexes
+	DistanceMetric string `json:"distanceMetric"`
+}
+
+// FlattenMultiVector encodes a multi vector query as a single vector, so
+// that it can be passed through the search path like one. The first element
+// holds the length of the vectors, followed by the concatenated vectors.
+func FlattenMultiVector(vectors [][]float32) []float32 {
+	if len(vectors) == 0 {
+		return nil
+	}
+	out := make([]float32, 0, 1+len(vectors)*len(vectors[0]))
+	out = append(out, float32(len(vectors[0])))
+	for _, vector := range vectors {
+		out = append(out, vector...)
+	}
+	return out
+}
+
+// SplitMultiVector decodes a multi vector query encoded by
+// FlattenMultiVector
+func SplitMultiVector(vector []float32) ([][]float32, error) {
+	if len(vector) < 2 {
+		return nil, fmt.Errorf("multi vector query of length %d is too short", len(vector))
+	}
+	dims := int(vector[0])
+	if dims <= 0 || float32(dims) != vector[0] {
+		return nil, fmt.Errorf("multi vector query has an invalid vector length %v", vector[0])
+	}
+	vector = vector[1:]
+	if len(vector)%dims != 0 {
+		return nil, fmt.Errorf("multi vector query of length %d is not a multiple of the vector length %d",
+			len(vector), dims)
+	}
+
+	vectors := make([][]float32, len(vector)/dims)
+	for i := range vectors {
+		vectors[i] = vector[i*dims : (i+1)*dims]
+	}
+	return vectors, nil
+}
+
+// NearSparseVector searches a sparse vector index for the objects with the
+// highest dot product with the query
+type NearSparseVector struct {
+	Indices      []uint32  `json:"indices"`
+	Values       []float32 `json:"values"`
+	TargetVector string    `json:"targetVector"`
 }
 
 type KeywordRanking struct {
@@ -32,6 +81,37 @@ type KeywordRanking struct {
 	Properties             []string `json:"properties"`
 	Query                  string   `json:"query"`
 	AdditionalExplanations bool     `json:"additionalExplanations"`
+	// Slop is the number of other tokens allowed between the terms of the
+	// quoted phrases in the query
+	Slop int `json:"slop"`
+	// Fuzzy makes the query terms also match the indexed terms within an edit
+	// distance, it is disabled if nil
+	Fuzzy *Fuzziness `json:"fuzzy,omitempty"`
+	// SparseVector is set instead of the query for rankings of type "sparse"
+	SparseVector *NearSparseVector `json:"sparseVector,omitempty"`
+}
+
+// Fuzziness configures typo-tolerant matching of keyword query terms
+type Fuzziness struct {
+	// MaxEdits is the maximum number of inserted, deleted or substituted
+	// characters, it needs to be 1 or 2
+	MaxEdits int `json:"maxEdits"`
+	// PrefixLength is the number of leading characters which have to match
+	// exactly
+	PrefixLength int `json:"prefixLength"`
+}
+
+func (f *Fuzziness) Validate() error {
+	if f == nil {
+		return nil
+	}
+	if f.MaxEdits < 1 || f.MaxEdits > 2 {
+		return fmt.Errorf("fuzzy maxEdits must be 1 or 2, got %d", f.MaxEdits)
+	}
+	if f.PrefixLength < 0 {
+		return fmt.Errorf("fuzzy prefixLength must not be negative, got %d", f.PrefixLength)
+	}
+	return nil
 }
 
 // Indicates whether property should be indexed
@@ -104,8 +184,12 @@ type HybridSearch struct {
 	Properties       []string    `json:"properties"`
 	TargetVectors    []string    `json:"targetVectors"`
 	FusionAlgorithm  int         `json:"fusionalgorithm"`
+	Slop             int         `json:"slop"`
 	NearTextParams   *NearTextParams
 	NearVectorParams *NearVector
+	// NearSparseVectorParams replaces the bm25 query as the sparse side of
+	// the hybrid search
+	NearSparseVectorParams *NearSparseVector
 }
 
 type NearObject struct {
@@ -31,6 +31,8 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 	"github.com/weaviate/weaviate/usecases/byteops"
 )
 
@@ -55,7 +57,13 @@ type Object struct {
 	BelongsToShard    string        `json:"-"`
 	IsConsistent      bool          `json:"-"`
 	DocID             uint64
-	Vectors           map[string][]float32 `json:"vectors"`
+	Vectors           map[string][]float32     `json:"vectors"`
+	MultiVectors      map[string][][]float32   `json:"multiVectors"`
+	SparseVectors     map[string]sparse.Vector `json:"sparseVectors"`
+
+	// VectorPrecisions sets the precision the target vectors are stored with,
+	// vectors without an entry are stored as float32
+	VectorPrecisions map[string]vectorindexcommon.StoragePrecision `json:"-"`
 }
 
 func New(docID uint64) *Object {
@@ -85,12 +93,37 @@ func FromObject(object *models.Object, vector []float32, vectors models.Vectors)
 		}
 	}
 
+	var multiVecs map[string][][]float32
+	if object.MultiVectors != nil {
+		multiVecs = make(map[string][][]float32, len(object.MultiVectors))
+		for targetVector, multiVector := range object.MultiVectors {
+			tokens := make([][]float32, len(multiVector))
+			for i := range multiVector {
+				tokens[i] = multiVector[i]
+			}
+			multiVecs[targetVector] = tokens
+		}
+	}
+
+	var sparseVecs map[string]sparse.Vector
+	if object.SparseVectors != nil {
+		sparseVecs = make(map[string]sparse.Vector, len(object.SparseVectors))
+		for targetVector, sparseVector := range object.SparseVectors {
+			sparseVecs[targetVector] = sparse.Vector{
+				Indices: sparseVector.Indices,
+				Values:  sparseVector.Values,
+			}
+		}
+	}
+
 	return &Object{
 		Object:            *object,
 		Vector:            vector,
 		MarshallerVersion: 1,
 		VectorLen:         len(vector),
 		Vectors:           vecs,
+		MultiVectors:      multiVecs,
+		SparseVectors:     sparseVecs,
 	}
 }
 
@@ -192,11 +225,12 @@ func FromBinaryOptional(data []byte,
 	vectorWeights := rw.ReadBytesFromBuffer(uint64(vectorWeightsLength))
 
 	if len(addProp.Vectors) > 0 {
-		vectors, err := unmarshalTargetVectors(&rw)
+		vectors, precisions, err := unmarshalTargetVectors(&rw)
 		if err != nil {
 			return nil, err
 		}
 		ko.Vectors = vectors
+		ko.VectorPrecisions = precisions
 
 		if vectors != nil {
 			ko.Object.Vectors = make(models.Vectors)
@@ -204,6 +238,20 @@ func FromBinaryOptional(data []byte,
 				ko.Object.Vectors[vecName] = vec
 			}
 		}
+
+		multiVectors, err := unmarshalMultiVectors(&rw)
+		if err != nil {
+			return nil, err
+		}
+		ko.MultiVectors = multiVectors
+		ko.Object.MultiVectors = ko.asMultiVectors(multiVectors)
+
+		sparseVectors, err := unmarshalSparseVectors(&rw)
+		if err != nil {
+			return nil, err
+		}
+		ko.SparseVectors = sparseVectors
+		ko.Object.SparseVectors = ko.asSparseVectors(sparseVectors)
 	}
 
 	// some object members need additional "enrichment". Only do this if necessary, ie if they are actually present
@@ -482,13 +530,15 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 	}
 
 	return &search.Result{
-		ID:        ko.ID(),
-		DocID:     &ko.DocID,
-		ClassName: ko.Class().String(),
-		Schema:    ko.Properties(),
-		Vector:    ko.Vector,
-		Vectors:   ko.asVectors(ko.Vectors),
-		Dims:      ko.VectorLen,
+		ID:            ko.ID(),
+		DocID:         &ko.DocID,
+		ClassName:     ko.Class().String(),
+		Schema:        ko.Properties(),
+		Vector:        ko.Vector,
+		Vectors:       ko.asVectors(ko.Vectors),
+		MultiVectors:  ko.asMultiVectors(ko.MultiVectors),
+		SparseVectors: ko.asSparseVectors(ko.SparseVectors),
+		Dims:          ko.VectorLen,
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
 		Updated:              ko.LastUpdateTimeUnix(),
@@ -512,6 +562,35 @@ func (ko *Object) asVectors(in map[string][]float32) models.Vectors {
 	return nil
 }
 
+func (ko *Object) asMultiVectors(in map[string][][]float32) models.MultiVectors {
+	if len(in) > 0 {
+		out := make(models.MultiVectors, len(in))
+		for targetVector, vectors := range in {
+			multiVector := make(models.MultiVector, len(vectors))
+			for i := range vectors {
+				multiVector[i] = vectors[i]
+			}
+			out[targetVector] = multiVector
+		}
+		return out
+	}
+	return nil
+}
+
+func (ko *Object) asSparseVectors(in map[string]sparse.Vector) models.SparseVectors {
+	if len(in) > 0 {
+		out := make(models.SparseVectors, len(in))
+		for targetVector, vector := range in {
+			out[targetVector] = models.SparseVector{
+				Indices: vector.Indices,
+				Values:  vector.Values,
+			}
+		}
+		return out
+	}
+	return nil
+}
+
 func (ko *Object) SearchResultWithDist(addl additional.Properties, dist float32) search.Result {
 	res := ko.SearchResult(addl, "")
 	res.Dist = dist
@@ -639,6 +718,22 @@ func DocIDAndTimeFromBinary(in []byte) (docID uint64, updateTime int64, err erro
 // n          | []byte        | packed target vectors offsets map { name : offset_in_bytes }
 // 4          | uint32        | length of target vectors segment (in bytes)
 // n          | uint16+[]byte | target vectors segment: sequence of vec_length + vec (uint16 + []byte), (uint16 + []byte) ...
+//
+// The multi vectors are only appended if the object has any:
+// 4          | uint32        | length of packed multi vectors offsets (in bytes)
+// n          | []byte        | packed multi vectors offsets map { name : offset_in_bytes }
+// 4          | uint32        | length of multi vectors segment (in bytes)
+// n          | uint16+uint16+[]byte | multi vectors segment: sequence of vec_count + vec_length + vecs
+//
+// The vector precisions are only appended if any target vector is stored with
+// a precision other than float32. The multi vectors segment is always written
+// in this case, even if it is empty:
+// 4          | uint32        | length of packed vector precisions (in bytes)
+// n          | []byte        | packed vector precisions map { name : precision }
+//
+// Target vectors stored with a reduced precision are encoded as described by
+// vectorindex/common.StoragePrecision, their vec_length is still the number of
+// dimensions.
 
 const (
 	maxVectorLength               int = math.MaxUint16
@@ -648,6 +743,7 @@ const (
 	maxVectorWeightsLength        int = math.MaxUint32
 	maxTargetVectorsSegmentLength int = math.MaxUint32
 	maxTargetVectorsOffsetsLength int = math.MaxUint32
+	maxMultiVectorCount           int = math.MaxUint16
 )
 
 func (ko *Object) MarshalBinary() ([]byte, error) {
@@ -710,16 +806,24 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 	var targetVectorsOffsetsLength uint32
 	var targetVectorsSegmentLength int
 
+	var vectorPrecisions []byte
+
 	targetVectorsOffsetOrder := make([]string, 0, len(ko.Vectors))
 	if len(ko.Vectors) > 0 {
 		offsetsMap := map[string]uint32{}
+		precisionsMap := map[string]vectorindexcommon.StoragePrecision{}
 		for name, vec := range ko.Vectors {
 			if len(vec) > maxVectorLength {
 				return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "vector", len(vec), maxVectorLength)
 			}
 
+			precision := ko.VectorPrecisions[name]
+			if precision != vectorindexcommon.PrecisionFloat32 {
+				precisionsMap[name] = precision
+			}
+
 			offsetsMap[name] = uint32(targetVectorsSegmentLength)
-			targetVectorsSegmentLength += 2 + 4*len(vec) // 2 for vec length + vec bytes
+			targetVectorsSegmentLength += 2 + precision.EncodedLen(len(vec)) // 2 for vec length + vec bytes
 
 			if targetVectorsSegmentLength > maxTargetVectorsSegmentLength {
 				return nil,
@@ -738,8 +842,85 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 			return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "targetVectorsOffsets", len(targetVectorsOffsets), maxTargetVectorsOffsetsLength)
 		}
 		targetVectorsOffsetsLength = uint32(len(targetVectorsOffsets))
+
+		if len(precisionsMap) > 0 {
+			vectorPrecisions, err = msgpack.Marshal(precisionsMap)
+			if err != nil {
+				return nil, fmt.Errorf("could not marshal vector precisions: %w", err)
+			}
+		}
 	}
 
+	var multiVectorsOffsets []byte
+	var multiVectorsSegmentLength int
+
+	multiVectorsOffsetOrder := make([]string, 0, len(ko.MultiVectors))
+	if len(ko.MultiVectors) > 0 {
+		offsetsMap := map[string]uint32{}
+		for name, vecs := range ko.MultiVectors {
+			if len(vecs) > maxMultiVectorCount {
+				return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "multi vector", len(vecs), maxMultiVectorCount)
+			}
+			dims := 0
+			if len(vecs) > 0 {
+				dims = len(vecs[0])
+			}
+			if dims > maxVectorLength {
+				return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "vector", dims, maxVectorLength)
+			}
+			for _, vec := range vecs {
+				if len(vec) != dims {
+					return nil, fmt.Errorf("could not marshal multi vector %q: vectors of different lengths (%d/%d)", name, len(vec), dims)
+				}
+			}
+
+			offsetsMap[name] = uint32(multiVectorsSegmentLength)
+			multiVectorsSegmentLength += 2 + 2 + 4*dims*len(vecs) // 2 for vec count, 2 for vec length + vecs bytes
+
+			if multiVectorsSegmentLength > maxTargetVectorsSegmentLength {
+				return nil,
+					fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)",
+						"multiVectorsSegmentLength", multiVectorsSegmentLength, maxTargetVectorsSegmentLength)
+			}
+
+			multiVectorsOffsetOrder = append(multiVectorsOffsetOrder, name)
+		}
+
+		multiVectorsOffsets, err = msgpack.Marshal(offsetsMap)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal multi vectors offsets: %w", err)
+		}
+		if len(multiVectorsOffsets) > maxTargetVectorsOffsetsLength {
+			return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "multiVectorsOffsets", len(multiVectorsOffsets), maxTargetVectorsOffsetsLength)
+		}
+	}
+
+	var sparseVectors []byte
+	if len(ko.SparseVectors) > 0 {
+		encoded := make(map[string][]byte, len(ko.SparseVectors))
+		for name, vec := range ko.SparseVectors {
+			if len(vec.Indices) != len(vec.Values) {
+				return nil, fmt.Errorf("could not marshal sparse vector %q: %d indices but %d values",
+					name, len(vec.Indices), len(vec.Values))
+			}
+			encoded[name] = vec.Encode()
+		}
+
+		sparseVectors, err = msgpack.Marshal(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal sparse vectors: %w", err)
+		}
+		if len(sparseVectors) > maxTargetVectorsSegmentLength {
+			return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "sparseVectors", len(sparseVectors), maxTargetVectorsSegmentLength)
+		}
+	}
+
+	// the segments after the target vectors are optional, but each of them
+	// requires the ones before it to be present, even if they are empty
+	hasSparseVectors := len(sparseVectors) > 0
+	hasVectorPrecisions := len(vectorPrecisions) > 0 || hasSparseVectors
+	hasMultiVectors := len(multiVectorsOffsetOrder) > 0 || hasVectorPrecisions
+
 	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 +
 		2 + vectorLength*4 +
 		2 + classNameLength +
@@ -748,6 +929,16 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		4 + vectorWeightsLength +
 		4 + targetVectorsOffsetsLength +
 		4 + uint32(targetVectorsSegmentLength)
+	if hasMultiVectors {
+		totalBufferLength += 4 + uint32(len(multiVectorsOffsets)) +
+			4 + uint32(multiVectorsSegmentLength)
+	}
+	if hasVectorPrecisions {
+		totalBufferLength += 4 + uint32(len(vectorPrecisions))
+	}
+	if hasSparseVectors {
+		totalBufferLength += 4 + uint32(len(sparseVectors))
+	}
 
 	byteBuffer := make([]byte, totalBufferLength)
 	rw := byteops.NewReadWriter(byteBuffer)
@@ -800,11 +991,50 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 	rw.WriteUint32(uint32(targetVectorsSegmentLength))
 	for _, name := range targetVectorsOffsetOrder {
 		vec := ko.Vectors[name]
-		vecLen := len(vec)
+		precision := ko.VectorPrecisions[name]
+		encodedLen := precision.EncodedLen(len(vec))
+
+		rw.WriteUint16(uint16(len(vec)))
+		precision.Encode(vec, rw.Buffer[rw.Position:rw.Position+uint64(encodedLen)])
+		rw.MoveBufferPositionForward(uint64(encodedLen))
+	}
 
-		rw.WriteUint16(uint16(vecLen))
-		for j := 0; j < vecLen; j++ {
-			rw.WriteUint32(math.Float32bits(vec[j]))
+	if hasMultiVectors {
+		rw.WriteUint32(uint32(len(multiVectorsOffsets)))
+		err = rw.CopyBytesToBuffer(multiVectorsOffsets)
+		if err != nil {
+			return byteBuffer, errors.Wrap(err, "Could not copy multiVectorsOffsets")
+		}
+
+		rw.WriteUint32(uint32(multiVectorsSegmentLength))
+		for _, name := range multiVectorsOffsetOrder {
+			vecs := ko.MultiVectors[name]
+			vecLen := 0
+			if len(vecs) > 0 {
+				vecLen = len(vecs[0])
+			}
+
+			rw.WriteUint16(uint16(len(vecs)))
+			rw.WriteUint16(uint16(vecLen))
+			for _, vec := range vecs {
+				for j := 0; j < vecLen; j++ {
+					rw.WriteUint32(math.Float32bits(vec[j]))
+				}
+			}
+		}
+	}
+
+	if hasVectorPrecisions {
+		err = rw.CopyBytesToBufferWithUint32LengthIndicator(vectorPrecisions)
+		if err != nil {
+			return byteBuffer, errors.Wrap(err, "Could not copy vectorPrecisions")
+		}
+	}
+
+	if hasSparseVectors {
+		err = rw.CopyBytesToBufferWithUint32LengthIndicator(sparseVectors)
+		if err != nil {
+			return byteBuffer, errors.Wrap(err, "Could not copy sparseVectors")
 		}
 	}
 
@@ -983,11 +1213,24 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		return errors.Wrap(err, "Could not copy vectorWeights")
 	}
 
-	vectors, err := unmarshalTargetVectors(&rw)
+	vectors, precisions, err := unmarshalTargetVectors(&rw)
 	if err != nil {
 		return err
 	}
 	ko.Vectors = vectors
+	ko.VectorPrecisions = precisions
+
+	multiVectors, err := unmarshalMultiVectors(&rw)
+	if err != nil {
+		return err
+	}
+	ko.MultiVectors = multiVectors
+
+	sparseVectors, err := unmarshalSparseVectors(&rw)
+	if err != nil {
+		return err
+	}
+	ko.SparseVectors = sparseVectors
 
 	return ko.parseObject(
 		strfmt.UUID(uuidParsed.String()),
@@ -1000,7 +1243,7 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 	)
 }
 
-func unmarshalTargetVectors(rw *byteops.ReadWriter) (map[string][]float32, error) {
+func unmarshalTargetVectors(rw *byteops.ReadWriter) (map[string][]float32, map[string]vectorindexcommon.StoragePrecision, error) {
 	// This check prevents from panic when somebody is upgrading from version that
 	// didn't have multi vector support. This check is needed bc with named vectors
 	// feature storage object can have vectors data appended at the end of the file
@@ -1012,25 +1255,182 @@ func unmarshalTargetVectors(rw *byteops.ReadWriter) (map[string][]float32, error
 		if len(targetVectorsOffsets) > 0 {
 			var tvOffsets map[string]uint32
 			if err := msgpack.Unmarshal(targetVectorsOffsets, &tvOffsets); err != nil {
-				return nil, fmt.Errorf("Could not unmarshal target vectors offset: %w", err)
+				return nil, nil, fmt.Errorf("Could not unmarshal target vectors offset: %w", err)
+			}
+
+			precisions, err := unmarshalVectorPrecisions(rw.Buffer, pos+uint64(targetVectorsSegmentLength))
+			if err != nil {
+				return nil, nil, err
 			}
 
 			targetVectors := map[string][]float32{}
 			for name, offset := range tvOffsets {
 				rw.MoveBufferToAbsolutePosition(pos + uint64(offset))
-				vecLen := rw.ReadUint16()
-				vec := make([]float32, vecLen)
-				for j := uint16(0); j < vecLen; j++ {
-					vec[j] = math.Float32frombits(rw.ReadUint32())
-				}
-				targetVectors[name] = vec
+				vecLen := int(rw.ReadUint16())
+				precision := precisions[name]
+				encodedLen := uint64(precision.EncodedLen(vecLen))
+				targetVectors[name] = precision.Decode(rw.Buffer[rw.Position:rw.Position+encodedLen],
+					make([]float32, vecLen))
 			}
 
 			rw.MoveBufferToAbsolutePosition(pos + uint64(targetVectorsSegmentLength))
-			return targetVectors, nil
+			return targetVectors, precisions, nil
 		}
 	}
-	return nil, nil
+	return nil, nil, nil
+}
+
+// unmarshalVectorPrecisions reads the vector precisions which are appended
+// after the multi vectors segment. The given position is the end of the target
+// vectors segment, the multi vectors segment is skipped.
+func unmarshalVectorPrecisions(data []byte, pos uint64) (map[string]vectorindexcommon.StoragePrecision, error) {
+	rw := byteops.NewReadWriter(data, byteops.WithPosition(pos))
+	if rw.Position >= uint64(len(rw.Buffer)) {
+		return nil, nil
+	}
+
+	multiVectorsOffsetsLength := uint64(rw.ReadUint32())
+	rw.MoveBufferPositionForward(multiVectorsOffsetsLength)
+	multiVectorsSegmentLength := uint64(rw.ReadUint32())
+	rw.MoveBufferPositionForward(multiVectorsSegmentLength)
+	if rw.Position >= uint64(len(rw.Buffer)) {
+		return nil, nil
+	}
+
+	// the segment is empty if it is only present because of the sparse
+	// vectors which follow it
+	encoded := rw.ReadBytesFromBufferWithUint32LengthIndicator()
+	if len(encoded) == 0 {
+		return nil, nil
+	}
+
+	var precisions map[string]vectorindexcommon.StoragePrecision
+	if err := msgpack.Unmarshal(encoded, &precisions); err != nil {
+		return nil, fmt.Errorf("Could not unmarshal vector precisions: %w", err)
+	}
+	return precisions, nil
+}
+
+// unmarshalMultiVectors reads the multi vectors segment which follows the
+// target vectors segment. Objects without multi vectors don't have it.
+func unmarshalMultiVectors(rw *byteops.ReadWriter) (map[string][][]float32, error) {
+	if rw.Position >= uint64(len(rw.Buffer)) {
+		return nil, nil
+	}
+
+	multiVectorsOffsets := rw.ReadBytesFromBufferWithUint32LengthIndicator()
+	multiVectorsSegmentLength := rw.ReadUint32()
+	pos := rw.Position
+
+	if len(multiVectorsOffsets) == 0 {
+		return nil, nil
+	}
+
+	var offsets map[string]uint32
+	if err := msgpack.Unmarshal(multiVectorsOffsets, &offsets); err != nil {
+		return nil, fmt.Errorf("Could not unmarshal multi vectors offset: %w", err)
+	}
+
+	multiVectors := make(map[string][][]float32, len(offsets))
+	for name, offset := range offsets {
+		rw.MoveBufferToAbsolutePosition(pos + uint64(offset))
+		multiVectors[name] = readMultiVector(rw)
+	}
+
+	rw.MoveBufferToAbsolutePosition(pos + uint64(multiVectorsSegmentLength))
+	return multiVectors, nil
+}
+
+// unmarshalSparseVectors reads the sparse vectors segment which follows the
+// vector precisions. The given position must be the end of the multi vectors
+// segment, the vector precisions are skipped as they are read together with
+// the target vectors.
+func unmarshalSparseVectors(rw *byteops.ReadWriter) (map[string]sparse.Vector, error) {
+	if rw.Position >= uint64(len(rw.Buffer)) {
+		return nil, nil
+	}
+	rw.ReadBytesFromBufferWithUint32LengthIndicator()
+	if rw.Position >= uint64(len(rw.Buffer)) {
+		return nil, nil
+	}
+
+	var encoded map[string][]byte
+	if err := msgpack.Unmarshal(rw.ReadBytesFromBufferWithUint32LengthIndicator(), &encoded); err != nil {
+		return nil, fmt.Errorf("Could not unmarshal sparse vectors: %w", err)
+	}
+
+	sparseVectors := make(map[string]sparse.Vector, len(encoded))
+	for name, bytes := range encoded {
+		vector, err := sparse.Decode(bytes)
+		if err != nil {
+			return nil, fmt.Errorf("Could not decode sparse vector %q: %w", name, err)
+		}
+		sparseVectors[name] = vector
+	}
+	return sparseVectors, nil
+}
+
+func readMultiVector(rw *byteops.ReadWriter) [][]float32 {
+	count := rw.ReadUint16()
+	vecLen := rw.ReadUint16()
+	vecs := make([][]float32, count)
+	for i := range vecs {
+		vec := make([]float32, vecLen)
+		for j := range vec {
+			vec[j] = math.Float32frombits(rw.ReadUint32())
+		}
+		vecs[i] = vec
+	}
+	return vecs
+}
+
+// MultiVectorFromBinary returns the multi vector of the given target vector
+func MultiVectorFromBinary(in []byte, targetVector string) ([][]float32, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	version := in[0]
+	if version != 1 {
+		return nil, errors.Errorf("unsupported marshaller version %d", version)
+	}
+
+	rw := byteops.NewReadWriter(in, byteops.WithPosition(targetVectorsStart(in)))
+	if _, _, err := unmarshalTargetVectors(&rw); err != nil {
+		return nil, errors.Errorf("unable to unmarshal vector for target vector: %s", targetVector)
+	}
+	multiVectors, err := unmarshalMultiVectors(&rw)
+	if err != nil {
+		return nil, errors.Errorf("unable to unmarshal multi vector for target vector: %s", targetVector)
+	}
+	multiVector, ok := multiVectors[targetVector]
+	if !ok {
+		return nil, errors.Errorf("multi vector not found for target vector: %s", targetVector)
+	}
+	return multiVector, nil
+}
+
+// targetVectorsStart returns the position of the target vectors segment
+func targetVectorsStart(in []byte) uint64 {
+	startPos := uint64(1 + 8 + 1 + 16 + 8 + 8) // elements at the start
+	rw := byteops.NewReadWriter(in, byteops.WithPosition(startPos))
+
+	vectorLength := uint64(rw.ReadUint16())
+	rw.MoveBufferPositionForward(vectorLength * 4)
+
+	classnameLength := uint64(rw.ReadUint16())
+	rw.MoveBufferPositionForward(classnameLength)
+
+	schemaLength := uint64(rw.ReadUint32())
+	rw.MoveBufferPositionForward(schemaLength)
+
+	metaLength := uint64(rw.ReadUint32())
+	rw.MoveBufferPositionForward(metaLength)
+
+	vectorWeightsLength := uint64(rw.ReadUint32())
+	rw.MoveBufferPositionForward(vectorWeightsLength)
+
+	return rw.Position
 }
 
 func VectorFromBinary(in []byte, buffer []float32, targetVector string) ([]float32, error) {
@@ -1062,7 +1462,7 @@ func VectorFromBinary(in []byte, buffer []float32, targetVector string) ([]float
 		vectorWeightsLength := uint64(rw.ReadUint32())
 		rw.MoveBufferPositionForward(vectorWeightsLength)
 
-		targetVectors, err := unmarshalTargetVectors(&rw)
+		targetVectors, _, err := unmarshalTargetVectors(&rw)
 		if err != nil {
 			return nil, errors.Errorf("unable to unmarshal vector for target vector: %s", targetVector)
 		}
@@ -1202,6 +1602,9 @@ func (ko *Object) DeepCopyDangerous() *Object {
 		Object:            deepCopyObject(ko.Object),
 		Vector:            deepCopyVector(ko.Vector),
 		Vectors:           deepCopyVectors(ko.Vectors),
+		MultiVectors:      deepCopyMultiVectors(ko.MultiVectors),
+		SparseVectors:     deepCopySparseVectors(ko.SparseVectors),
+		VectorPrecisions:  ko.VectorPrecisions,
 	}
 
 	return o
@@ -1228,6 +1631,64 @@ func deepCopyVectors[V []float32 | models.Vector](orig map[string]V) map[string]
 	return out
 }
 
+func deepCopyMultiVectors(orig map[string][][]float32) map[string][][]float32 {
+	if orig == nil {
+		return nil
+	}
+	out := make(map[string][][]float32, len(orig))
+	for key, vecs := range orig {
+		copied := make([][]float32, len(vecs))
+		for i := range vecs {
+			copied[i] = deepCopyVector(vecs[i])
+		}
+		out[key] = copied
+	}
+	return out
+}
+
+func deepCopyModelMultiVectors(orig models.MultiVectors) models.MultiVectors {
+	if orig == nil {
+		return nil
+	}
+	out := make(models.MultiVectors, len(orig))
+	for key, vecs := range orig {
+		copied := make(models.MultiVector, len(vecs))
+		for i := range vecs {
+			copied[i] = deepCopyVector(vecs[i])
+		}
+		out[key] = copied
+	}
+	return out
+}
+
+func deepCopySparseVectors(orig map[string]sparse.Vector) map[string]sparse.Vector {
+	if orig == nil {
+		return nil
+	}
+	out := make(map[string]sparse.Vector, len(orig))
+	for key, vec := range orig {
+		out[key] = sparse.Vector{
+			Indices: append([]uint32(nil), vec.Indices...),
+			Values:  deepCopyVector(vec.Values),
+		}
+	}
+	return out
+}
+
+func deepCopyModelSparseVectors(orig models.SparseVectors) models.SparseVectors {
+	if orig == nil {
+		return nil
+	}
+	out := make(models.SparseVectors, len(orig))
+	for key, vec := range orig {
+		out[key] = models.SparseVector{
+			Indices: append([]uint32(nil), vec.Indices...),
+			Values:  deepCopyVector(vec.Values),
+		}
+	}
+	return out
+}
+
 func deepCopyObject(orig models.Object) models.Object {
 	return models.Object{
 		Class:              orig.Class,
@@ -1239,6 +1700,8 @@ func deepCopyObject(orig models.Object) models.Object {
 		Additional:         orig.Additional, // WARNING: not a deep copy!!
 		Properties:         deepCopyProperties(orig.Properties),
 		Vectors:            deepCopyVectors(orig.Vectors),
+		MultiVectors:       deepCopyModelMultiVectors(orig.MultiVectors),
+		SparseVectors:      deepCopyModelSparseVectors(orig.SparseVectors),
 	}
 }
 
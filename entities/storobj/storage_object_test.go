@@ -29,6 +29,8 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 )
 
 func TestStorageObjectMarshalling(t *testing.T) {
@@ -743,6 +745,220 @@ func TestVectorFromBinary(t *testing.T) {
 	assert.Equal(t, vector3, outVector3)
 }
 
+func TestMultiVectorMarshalling(t *testing.T) {
+	colbert := models.MultiVector{{1, 2}, {3, 4}, {5, 6}}
+	before := FromObject(
+		&models.Object{
+			Class: "MyFavoriteClass",
+			ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+			MultiVectors: models.MultiVectors{"colbert": colbert},
+		},
+		nil,
+		models.Vectors{"vector1": {1, 2, 3}},
+	)
+	before.DocID = 7
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("full unmarshalling", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, map[string][]float32{"vector1": {1, 2, 3}}, after.Vectors)
+		assert.Equal(t, map[string][][]float32{"colbert": {{1, 2}, {3, 4}, {5, 6}}}, after.MultiVectors)
+	})
+
+	t.Run("optional unmarshalling", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{Vectors: []string{"colbert"}}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, [][]float32{{1, 2}, {3, 4}, {5, 6}}, after.MultiVectors["colbert"])
+
+		res := after.SearchResult(additional.Properties{}, "")
+		assert.Equal(t, colbert, res.MultiVectors["colbert"])
+	})
+
+	t.Run("multi vector from binary", func(t *testing.T) {
+		out, err := MultiVectorFromBinary(asBinary, "colbert")
+		require.Nil(t, err)
+		assert.Equal(t, [][]float32{{1, 2}, {3, 4}, {5, 6}}, out)
+
+		_, err = MultiVectorFromBinary(asBinary, "vector1")
+		assert.NotNil(t, err)
+
+		vector, err := VectorFromBinary(asBinary, nil, "vector1")
+		require.Nil(t, err)
+		assert.Equal(t, []float32{1, 2, 3}, vector)
+	})
+
+	t.Run("vectors of different lengths", func(t *testing.T) {
+		invalid := FromObject(&models.Object{
+			Class:        "MyFavoriteClass",
+			ID:           strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			MultiVectors: models.MultiVectors{"colbert": {{1, 2}, {3}}},
+		}, nil, nil)
+		_, err := invalid.MarshalBinary()
+		assert.NotNil(t, err)
+	})
+}
+
+func TestVectorPrecisionMarshalling(t *testing.T) {
+	vector := make([]float32, 64)
+	for i := range vector {
+		vector[i] = float32(i)*0.01 - 0.3
+	}
+	vectors := models.Vectors{"full": vector, "half": vector, "brain": vector, "int8": vector}
+	precisions := map[string]vectorindexcommon.StoragePrecision{
+		"half":  vectorindexcommon.PrecisionFloat16,
+		"brain": vectorindexcommon.PrecisionBFloat16,
+		"int8":  vectorindexcommon.PrecisionInt8,
+	}
+	expected := map[string][]float32{"full": vector}
+	for name, precision := range precisions {
+		expected[name] = precision.Round(vectors[name])
+	}
+
+	newObject := func(multiVectors models.MultiVectors) *Object {
+		obj := FromObject(&models.Object{
+			Class:        "MyFavoriteClass",
+			ID:           strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties:   map[string]interface{}{"name": "MyName"},
+			MultiVectors: multiVectors,
+		}, nil, vectors)
+		obj.DocID = 7
+		obj.VectorPrecisions = precisions
+		return obj
+	}
+
+	t.Run("reduced vectors take up less space", func(t *testing.T) {
+		full := newObject(nil)
+		full.VectorPrecisions = nil
+		fullBinary, err := full.MarshalBinary()
+		require.Nil(t, err)
+
+		reducedBinary, err := newObject(nil).MarshalBinary()
+		require.Nil(t, err)
+		assert.Less(t, len(reducedBinary), len(fullBinary))
+	})
+
+	for _, multiVectors := range []models.MultiVectors{nil, {"colbert": {{1, 2}, {3, 4}}}} {
+		t.Run(fmt.Sprintf("with %d multi vectors", len(multiVectors)), func(t *testing.T) {
+			asBinary, err := newObject(multiVectors).MarshalBinary()
+			require.Nil(t, err)
+
+			after, err := FromBinary(asBinary)
+			require.Nil(t, err)
+			assert.Equal(t, expected, after.Vectors)
+			assert.Equal(t, precisions, after.VectorPrecisions)
+			assert.Equal(t, "MyName", after.Properties().(map[string]interface{})["name"])
+			if len(multiVectors) > 0 {
+				assert.Equal(t, [][]float32{{1, 2}, {3, 4}}, after.MultiVectors["colbert"])
+			}
+
+			optional, err := FromBinaryOptional(asBinary,
+				additional.Properties{Vectors: []string{"half"}}, nil)
+			require.Nil(t, err)
+			assert.Equal(t, expected["half"], optional.Vectors["half"])
+
+			for name := range vectors {
+				vector, err := VectorFromBinary(asBinary, nil, name)
+				require.Nil(t, err)
+				assert.Equal(t, expected[name], vector)
+			}
+
+			t.Run("precisions are kept when marshalling again", func(t *testing.T) {
+				again, err := after.MarshalBinary()
+				require.Nil(t, err)
+				assert.Equal(t, len(asBinary), len(again))
+
+				afterAgain, err := FromBinary(again)
+				require.Nil(t, err)
+				assert.Equal(t, expected, afterAgain.Vectors)
+			})
+		})
+	}
+}
+
+func TestSparseVectorMarshalling(t *testing.T) {
+	splade := models.SparseVector{Indices: []uint32{3, 17, 2048}, Values: []float32{0.5, 1.5, 0.25}}
+	expected := map[string]sparse.Vector{
+		"splade": {Indices: []uint32{3, 17, 2048}, Values: []float32{0.5, 1.5, 0.25}},
+	}
+
+	newObject := func(vectors models.Vectors, multiVectors models.MultiVectors) *Object {
+		obj := FromObject(&models.Object{
+			Class:         "MyFavoriteClass",
+			ID:            strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties:    map[string]interface{}{"name": "MyName"},
+			MultiVectors:  multiVectors,
+			SparseVectors: models.SparseVectors{"splade": splade},
+		}, nil, vectors)
+		obj.DocID = 7
+		return obj
+	}
+
+	tests := []struct {
+		name         string
+		vectors      models.Vectors
+		multiVectors models.MultiVectors
+		precisions   map[string]vectorindexcommon.StoragePrecision
+	}{
+		{name: "only sparse vectors"},
+		{name: "with target vectors", vectors: models.Vectors{"vector1": {1, 2, 3}}},
+		{
+			name:         "with multi vectors",
+			vectors:      models.Vectors{"vector1": {1, 2, 3}},
+			multiVectors: models.MultiVectors{"colbert": {{1, 2}, {3, 4}}},
+		},
+		{
+			name:       "with vector precisions",
+			vectors:    models.Vectors{"vector1": {1, 2, 3}},
+			precisions: map[string]vectorindexcommon.StoragePrecision{"vector1": vectorindexcommon.PrecisionFloat16},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			before := newObject(test.vectors, test.multiVectors)
+			before.VectorPrecisions = test.precisions
+			asBinary, err := before.MarshalBinary()
+			require.Nil(t, err)
+
+			after, err := FromBinary(asBinary)
+			require.Nil(t, err)
+			assert.Equal(t, expected, after.SparseVectors)
+			assert.Equal(t, test.precisions, after.VectorPrecisions)
+			assert.Equal(t, "MyName", after.Properties().(map[string]interface{})["name"])
+			if len(test.multiVectors) > 0 {
+				assert.Equal(t, [][]float32{{1, 2}, {3, 4}}, after.MultiVectors["colbert"])
+			}
+
+			optional, err := FromBinaryOptional(asBinary,
+				additional.Properties{Vectors: []string{"splade"}}, nil)
+			require.Nil(t, err)
+			assert.Equal(t, expected, optional.SparseVectors)
+
+			res := optional.SearchResult(additional.Properties{}, "")
+			assert.Equal(t, splade, res.SparseVectors["splade"])
+
+			for name := range test.vectors {
+				vector, err := VectorFromBinary(asBinary, nil, name)
+				require.Nil(t, err)
+				assert.Len(t, vector, 3)
+			}
+		})
+	}
+
+	t.Run("deep copy", func(t *testing.T) {
+		obj := newObject(nil, nil)
+		copied := obj.DeepCopyDangerous()
+		copied.SparseVectors["splade"].Values[0] = 7
+		assert.Equal(t, float32(0.5), obj.SparseVectors["splade"].Values[0])
+	})
+}
+
 func TestStorageInvalidObjectMarshalling(t *testing.T) {
 	t.Run("invalid className", func(t *testing.T) {
 		invalidClassName := make([]byte, maxClassNameLength+1)
@@ -31,6 +31,18 @@ const (
 	DefaultDistanceMetric        = DistanceCosine
 )
 
+// ValidateRQDistance ensures that the rotational quantizer can estimate the
+// distance, it is derived from the dot product of the rotated vectors
+func ValidateRQDistance(distance string) error {
+	switch distance {
+	case DistanceCosine, DistanceDot, DistanceL2Squared:
+		return nil
+	default:
+		return errors.Errorf("rq is not supported with distance %q, must be one of %q, %q or %q",
+			distance, DistanceCosine, DistanceDot, DistanceL2Squared)
+	}
+}
+
 // Tries to parse the int value from the map, if it overflows math.MaxInt64, it
 // uses math.MaxInt64 instead. This is to protect from rounding errors from
 // json marshalling where the type may be assumed as float64
@@ -67,6 +79,35 @@ func OptionalIntFromMap(in map[string]interface{}, name string,
 	return nil
 }
 
+func OptionalFloatFromMap(in map[string]interface{}, name string,
+	setFn func(v float64),
+) error {
+	value, ok := in[name]
+	if !ok {
+		return nil
+	}
+
+	var asFloat64 float64
+	var err error
+
+	// depending on whether we get the results from disk or from the REST API,
+	// numbers may be represented slightly differently
+	switch typed := value.(type) {
+	case json.Number:
+		asFloat64, err = typed.Float64()
+	case float64:
+		asFloat64 = typed
+	default:
+		return errors.Errorf("%q must be a number, got %T", name, value)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "json.Number to float64 for %q", name)
+	}
+
+	setFn(asFloat64)
+	return nil
+}
+
 func OptionalBoolFromMap(in map[string]interface{}, name string,
 	setFn func(v bool),
 ) error {
@@ -0,0 +1,283 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+const (
+	StoragePrecisionFloat32  = "float32"
+	StoragePrecisionFloat16  = "float16"
+	StoragePrecisionBFloat16 = "bfloat16"
+	StoragePrecisionInt8     = "int8"
+
+	DefaultStoragePrecision = StoragePrecisionFloat32
+)
+
+// StoragePrecision is the precision the raw vectors are stored with. The
+// numeric values are persisted in the object binary format, do not reorder.
+type StoragePrecision uint8
+
+const (
+	PrecisionFloat32 StoragePrecision = iota
+	PrecisionFloat16
+	PrecisionBFloat16
+	PrecisionInt8
+)
+
+// StoragePrecisionConfig is implemented by the vector index configs which
+// allow storing the raw vectors with a reduced precision
+type StoragePrecisionConfig interface {
+	StoragePrecisionName() string
+}
+
+// ParseStoragePrecision turns the user-facing name into a StoragePrecision,
+// an empty name is treated as the default float32
+func ParseStoragePrecision(name string) (StoragePrecision, error) {
+	switch name {
+	case "", StoragePrecisionFloat32:
+		return PrecisionFloat32, nil
+	case StoragePrecisionFloat16:
+		return PrecisionFloat16, nil
+	case StoragePrecisionBFloat16:
+		return PrecisionBFloat16, nil
+	case StoragePrecisionInt8:
+		return PrecisionInt8, nil
+	default:
+		return PrecisionFloat32, fmt.Errorf("invalid storagePrecision %q, must be one of %q, %q, %q or %q",
+			name, StoragePrecisionFloat32, StoragePrecisionFloat16, StoragePrecisionBFloat16, StoragePrecisionInt8)
+	}
+}
+
+// StoragePrecisionOf returns the storage precision of the given vector index
+// config. Configs which don't support the setting always use float32.
+func StoragePrecisionOf(cfg interface{}) (StoragePrecision, error) {
+	pc, ok := cfg.(StoragePrecisionConfig)
+	if !ok {
+		return PrecisionFloat32, nil
+	}
+	return ParseStoragePrecision(pc.StoragePrecisionName())
+}
+
+func (p StoragePrecision) String() string {
+	switch p {
+	case PrecisionFloat16:
+		return StoragePrecisionFloat16
+	case PrecisionBFloat16:
+		return StoragePrecisionBFloat16
+	case PrecisionInt8:
+		return StoragePrecisionInt8
+	default:
+		return StoragePrecisionFloat32
+	}
+}
+
+// EncodedLen is the number of bytes a vector with the given dimensions takes
+// up in this precision. Int8 vectors are prefixed with their float32 scale.
+func (p StoragePrecision) EncodedLen(dims int) int {
+	switch p {
+	case PrecisionFloat16, PrecisionBFloat16:
+		return 2 * dims
+	case PrecisionInt8:
+		return 4 + dims
+	default:
+		return 4 * dims
+	}
+}
+
+// Dims is the inverse of EncodedLen
+func (p StoragePrecision) Dims(encodedLen int) int {
+	switch p {
+	case PrecisionFloat16, PrecisionBFloat16:
+		return encodedLen / 2
+	case PrecisionInt8:
+		if encodedLen < 4 {
+			return 0
+		}
+		return encodedLen - 4
+	default:
+		return encodedLen / 4
+	}
+}
+
+// Encode writes the vector into out, which needs to be at least
+// EncodedLen(len(vec)) bytes long
+func (p StoragePrecision) Encode(vec []float32, out []byte) {
+	switch p {
+	case PrecisionFloat16:
+		for i, v := range vec {
+			binary.LittleEndian.PutUint16(out[i*2:], Float16FromFloat32(v))
+		}
+	case PrecisionBFloat16:
+		for i, v := range vec {
+			binary.LittleEndian.PutUint16(out[i*2:], BFloat16FromFloat32(v))
+		}
+	case PrecisionInt8:
+		scale := Int8Scale(vec)
+		binary.LittleEndian.PutUint32(out, math.Float32bits(scale))
+		for i, v := range vec {
+			out[4+i] = byte(Int8FromFloat32(v, scale))
+		}
+	default:
+		for i, v := range vec {
+			binary.LittleEndian.PutUint32(out[i*4:], math.Float32bits(v))
+		}
+	}
+}
+
+// Decode reads an encoded vector into out, which needs to have a length of
+// Dims(len(in))
+func (p StoragePrecision) Decode(in []byte, out []float32) []float32 {
+	switch p {
+	case PrecisionFloat16:
+		for i := range out {
+			out[i] = Float16ToFloat32(binary.LittleEndian.Uint16(in[i*2:]))
+		}
+	case PrecisionBFloat16:
+		for i := range out {
+			out[i] = BFloat16ToFloat32(binary.LittleEndian.Uint16(in[i*2:]))
+		}
+	case PrecisionInt8:
+		scale := math.Float32frombits(binary.LittleEndian.Uint32(in))
+		for i := range out {
+			out[i] = float32(int8(in[4+i])) * scale
+		}
+	default:
+		for i := range out {
+			out[i] = math.Float32frombits(binary.LittleEndian.Uint32(in[i*4:]))
+		}
+	}
+	return out
+}
+
+// Round returns the vector as it would be read back after storing it with
+// this precision. Float32 vectors are returned as is, all others are copied.
+func (p StoragePrecision) Round(vec []float32) []float32 {
+	if p == PrecisionFloat32 {
+		return vec
+	}
+	buf := make([]byte, p.EncodedLen(len(vec)))
+	p.Encode(vec, buf)
+	return p.Decode(buf, make([]float32, len(vec)))
+}
+
+// Float16FromFloat32 converts to IEEE 754 half precision, rounding to the
+// nearest even value. Values out of range become infinity.
+func Float16FromFloat32(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23&0xff) - 127 + 15
+	mant := bits & 0x7fffff
+
+	switch {
+	case bits&0x7fffffff > 0x7f800000:
+		// NaN, keep it quiet
+		return sign | 0x7e00
+	case exp >= 0x1f:
+		return sign | 0x7c00
+	case exp <= 0:
+		// subnormal in half precision
+		if exp < -10 {
+			return sign
+		}
+		mant |= 0x800000
+		shift := uint32(14 - exp)
+		half := uint16(mant >> shift)
+		rem := mant & (1<<shift - 1)
+		halfway := uint32(1) << (shift - 1)
+		if rem > halfway || (rem == halfway && half&1 == 1) {
+			half++
+		}
+		return sign | half
+	}
+
+	half := sign | uint16(exp)<<10 | uint16(mant>>13)
+	rem := mant & 0x1fff
+	if rem > 0x1000 || (rem == 0x1000 && half&1 == 1) {
+		// a carry into the exponent correctly rounds up to the next power of
+		// two or to infinity
+		half++
+	}
+	return half
+}
+
+// Float16ToFloat32 converts from IEEE 754 half precision, the conversion is
+// exact
+func Float16ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		// subnormal, normalize it for float32
+		e := uint32(127 - 14)
+		for mant&0x400 == 0 {
+			mant <<= 1
+			e--
+		}
+		return math.Float32frombits(sign | e<<23 | (mant&0x3ff)<<13)
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	default:
+		return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+	}
+}
+
+// BFloat16FromFloat32 keeps the upper 16 bits of the float32, rounding to the
+// nearest even value
+func BFloat16FromFloat32(f float32) uint16 {
+	bits := math.Float32bits(f)
+	if bits&0x7fffffff > 0x7f800000 {
+		return uint16(bits>>16) | 0x40
+	}
+	bits += 0x7fff + (bits>>16)&1
+	return uint16(bits >> 16)
+}
+
+func BFloat16ToFloat32(b uint16) float32 {
+	return math.Float32frombits(uint32(b) << 16)
+}
+
+// Int8Scale is the factor int8 encoded values of the vector are multiplied
+// with, chosen so the largest absolute value maps to 127
+func Int8Scale(vec []float32) float32 {
+	var maxAbs float32
+	for _, v := range vec {
+		if v < 0 {
+			v = -v
+		}
+		if v > maxAbs {
+			maxAbs = v
+		}
+	}
+	return maxAbs / 127
+}
+
+func Int8FromFloat32(f, scale float32) int8 {
+	if scale == 0 {
+		return 0
+	}
+	q := math.Round(float64(f / scale))
+	if q > 127 {
+		q = 127
+	} else if q < -127 {
+		q = -127
+	}
+	return int8(q)
+}
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStoragePrecision(t *testing.T) {
+	for _, name := range []string{"", "float32", "float16", "bfloat16", "int8"} {
+		p, err := ParseStoragePrecision(name)
+		require.Nil(t, err)
+		if name != "" {
+			assert.Equal(t, name, p.String())
+		}
+	}
+
+	_, err := ParseStoragePrecision("float64")
+	assert.NotNil(t, err)
+}
+
+func TestFloat16Conversion(t *testing.T) {
+	t.Run("all half precision values survive a round trip", func(t *testing.T) {
+		for i := 0; i <= math.MaxUint16; i++ {
+			h := uint16(i)
+			f := Float16ToFloat32(h)
+			if math.IsNaN(float64(f)) {
+				continue
+			}
+			require.Equal(t, h, Float16FromFloat32(f), "value %x", h)
+		}
+	})
+
+	t.Run("known values", func(t *testing.T) {
+		assert.Equal(t, uint16(0x3c00), Float16FromFloat32(1))
+		assert.Equal(t, uint16(0xc000), Float16FromFloat32(-2))
+		assert.Equal(t, uint16(0x7bff), Float16FromFloat32(65504))
+		assert.Equal(t, uint16(0x7c00), Float16FromFloat32(65520))
+		assert.Equal(t, uint16(0x0001), Float16FromFloat32(float32(math.Pow(2, -24))))
+		assert.Equal(t, uint16(0x0000), Float16FromFloat32(1e-9))
+		assert.Equal(t, float32(0.333251953125), Float16ToFloat32(Float16FromFloat32(1.0/3)))
+	})
+}
+
+func TestBFloat16Conversion(t *testing.T) {
+	for i := 0; i <= math.MaxUint16; i++ {
+		b := uint16(i)
+		f := BFloat16ToFloat32(b)
+		if math.IsNaN(float64(f)) {
+			continue
+		}
+		require.Equal(t, b, BFloat16FromFloat32(f), "value %x", b)
+	}
+
+	assert.Equal(t, float32(1), BFloat16ToFloat32(BFloat16FromFloat32(1)))
+	assert.InDelta(t, 0.3333, BFloat16ToFloat32(BFloat16FromFloat32(1.0/3)), 1e-3)
+}
+
+func TestStoragePrecisionEncoding(t *testing.T) {
+	vec := []float32{0.5, -1.25, 3.75, 0, 1e-3, -0.001, 2.5}
+
+	cases := []struct {
+		precision StoragePrecision
+		delta     float64
+	}{
+		{precision: PrecisionFloat32, delta: 0},
+		{precision: PrecisionFloat16, delta: 2e-3},
+		{precision: PrecisionBFloat16, delta: 2e-2},
+		{precision: PrecisionInt8, delta: 3.75 / 127 / 2},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.precision.String(), func(t *testing.T) {
+			buf := make([]byte, tt.precision.EncodedLen(len(vec)))
+			tt.precision.Encode(vec, buf)
+			require.Equal(t, len(vec), tt.precision.Dims(len(buf)))
+
+			decoded := tt.precision.Decode(buf, make([]float32, len(vec)))
+			for i := range vec {
+				assert.InDelta(t, vec[i], decoded[i], tt.delta+1e-7)
+			}
+
+			rounded := tt.precision.Round(vec)
+			assert.Equal(t, decoded, rounded)
+			assert.Equal(t, rounded, tt.precision.Round(rounded))
+		})
+	}
+
+	t.Run("int8 of a zero vector", func(t *testing.T) {
+		assert.Equal(t, []float32{0, 0, 0}, PrecisionInt8.Round([]float32{0, 0, 0}))
+	})
+}
@@ -15,9 +15,11 @@ import (
 	"fmt"
 
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 )
 
 const (
@@ -25,6 +27,8 @@ const (
 	VectorIndexTypeHNSW    = "hnsw"
 	VectorIndexTypeFLAT    = "flat"
 	VectorIndexTypeDYNAMIC = "dynamic"
+	VectorIndexTypeDISKANN = "diskann"
+	VectorIndexTypeSPARSE  = "sparse"
 )
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -41,7 +45,11 @@ func ParseAndValidateConfig(input interface{}, vectorIndexType string) (schemaCo
 		return flat.ParseAndValidateConfig(input)
 	case VectorIndexTypeDYNAMIC:
 		return dynamic.ParseAndValidateConfig(input)
+	case VectorIndexTypeDISKANN:
+		return diskann.ParseAndValidateConfig(input)
+	case VectorIndexTypeSPARSE:
+		return sparse.ParseAndValidateConfig(input)
 	default:
-		return nil, fmt.Errorf("invalid vector index %q. Supported types are hnsw and flat", vectorIndexType)
+		return nil, fmt.Errorf("invalid vector index %q. Supported types are hnsw, flat, dynamic, diskann and sparse", vectorIndexType)
 	}
 }
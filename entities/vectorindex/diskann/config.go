@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"fmt"
+
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	DefaultMaxDegree        = 64
+	DefaultBuildListSize    = 128
+	DefaultSearchListSize   = 100
+	DefaultAlpha            = 1.2
+	DefaultFlatSearchCutoff = 40000
+	DefaultRQBits           = 8
+)
+
+// RQConfig configures the rotational quantizer which is used for the
+// compressed vectors that are kept in memory. The full vectors are only
+// read from disk to rescore the candidates of a search.
+type RQConfig struct {
+	Bits int `json:"bits"`
+}
+
+// UserConfig bundles all values settable by a user in the per-class settings
+type UserConfig struct {
+	Distance         string   `json:"distance"`
+	MaxDegree        int      `json:"maxDegree"`
+	BuildListSize    int      `json:"buildListSize"`
+	SearchListSize   int      `json:"searchListSize"`
+	Alpha            float64  `json:"alpha"`
+	FlatSearchCutoff int      `json:"flatSearchCutoff"`
+	RQ               RQConfig `json:"rq"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "diskann"
+}
+
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = common.DefaultDistanceMetric
+	u.MaxDegree = DefaultMaxDegree
+	u.BuildListSize = DefaultBuildListSize
+	u.SearchListSize = DefaultSearchListSize
+	u.Alpha = DefaultAlpha
+	u.FlatSearchCutoff = DefaultFlatSearchCutoff
+	u.RQ.Bits = DefaultRQBits
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := common.OptionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "maxDegree", func(v int) {
+		uc.MaxDegree = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "buildListSize", func(v int) {
+		uc.BuildListSize = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "searchListSize", func(v int) {
+		uc.SearchListSize = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalFloatFromMap(asMap, "alpha", func(v float64) {
+		uc.Alpha = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := common.OptionalIntFromMap(asMap, "flatSearchCutoff", func(v int) {
+		uc.FlatSearchCutoff = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if rqConfig, ok := asMap["rq"].(map[string]interface{}); ok {
+		if err := common.OptionalIntFromMap(rqConfig, "bits", func(v int) {
+			uc.RQ.Bits = v
+		}); err != nil {
+			return uc, err
+		}
+	}
+
+	return uc, uc.validate()
+}
+
+func (u UserConfig) validate() error {
+	if u.MaxDegree < 2 {
+		return fmt.Errorf("maxDegree must be at least 2, got %d", u.MaxDegree)
+	}
+	if u.BuildListSize < u.MaxDegree {
+		return fmt.Errorf("buildListSize (%d) must not be lower than maxDegree (%d)",
+			u.BuildListSize, u.MaxDegree)
+	}
+	if u.SearchListSize < 1 {
+		return fmt.Errorf("searchListSize must be at least 1, got %d", u.SearchListSize)
+	}
+	if u.Alpha < 1 {
+		return fmt.Errorf("alpha must be at least 1, got %v", u.Alpha)
+	}
+	switch u.RQ.Bits {
+	case 1, 2, 4, 8:
+	default:
+		return fmt.Errorf("invalid rq bits %d, must be one of 1, 2, 4 or 8", u.RQ.Bits)
+	}
+	// the in-memory vectors are always rq compressed
+	return common.ValidateRQDistance(u.Distance)
+}
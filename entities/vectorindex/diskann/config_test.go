@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func Test_DiskANNUserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:  "nothing specified, all defaults",
+			input: nil,
+			expected: UserConfig{
+				Distance:         common.DefaultDistanceMetric,
+				MaxDegree:        DefaultMaxDegree,
+				BuildListSize:    DefaultBuildListSize,
+				SearchListSize:   DefaultSearchListSize,
+				Alpha:            DefaultAlpha,
+				FlatSearchCutoff: DefaultFlatSearchCutoff,
+				RQ:               RQConfig{Bits: DefaultRQBits},
+			},
+		},
+		{
+			name: "all fields specified",
+			input: map[string]interface{}{
+				"distance":         "l2-squared",
+				"maxDegree":        json.Number("32"),
+				"buildListSize":    float64(64),
+				"searchListSize":   json.Number("50"),
+				"alpha":            json.Number("1.5"),
+				"flatSearchCutoff": float64(1000),
+				"rq": map[string]interface{}{
+					"bits": float64(4),
+				},
+			},
+			expected: UserConfig{
+				Distance:         common.DistanceL2Squared,
+				MaxDegree:        32,
+				BuildListSize:    64,
+				SearchListSize:   50,
+				Alpha:            1.5,
+				FlatSearchCutoff: 1000,
+				RQ:               RQConfig{Bits: 4},
+			},
+		},
+		{
+			name: "build list smaller than the degree",
+			input: map[string]interface{}{
+				"maxDegree":     float64(64),
+				"buildListSize": float64(32),
+			},
+			expectErr:    true,
+			expectErrMsg: "buildListSize (32) must not be lower than maxDegree (64)",
+		},
+		{
+			name: "alpha below 1",
+			input: map[string]interface{}{
+				"alpha": float64(0.5),
+			},
+			expectErr:    true,
+			expectErrMsg: "alpha must be at least 1",
+		},
+		{
+			name: "alpha is not a number",
+			input: map[string]interface{}{
+				"alpha": "1.5",
+			},
+			expectErr:    true,
+			expectErrMsg: `"alpha" must be a number, got string`,
+		},
+		{
+			name: "invalid rq bits",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"bits": float64(3),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid rq bits 3",
+		},
+		{
+			name: "distance rq can't estimate",
+			input: map[string]interface{}{
+				"distance": "manhattan",
+			},
+			expectErr:    true,
+			expectErrMsg: `rq is not supported with distance "manhattan"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
@@ -25,10 +25,17 @@ const (
 )
 
 type UserConfig struct {
-	Distance  string          `json:"distance"`
-	Threshold uint64          `json:"threshold"`
-	HnswUC    hnsw.UserConfig `json:"hnsw"`
-	FlatUC    flat.UserConfig `json:"flat"`
+	Distance  string `json:"distance"`
+	Threshold uint64 `json:"threshold"`
+	// StoragePrecision applies to both underlying indexes, it overrides
+	// whatever is configured in the hnsw and flat sections
+	StoragePrecision string `json:"storagePrecision"`
+	// DowngradeThreshold is the number of vectors below which an upgraded
+	// index is migrated back to flat. It must be lower than Threshold to avoid
+	// flapping between both index types, 0 disables the downgrade.
+	DowngradeThreshold uint64          `json:"downgradeThreshold"`
+	HnswUC             hnsw.UserConfig `json:"hnsw"`
+	FlatUC             flat.UserConfig `json:"flat"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -41,10 +48,15 @@ func (u UserConfig) DistanceName() string {
 	return u.Distance
 }
 
+func (u UserConfig) StoragePrecisionName() string {
+	return u.StoragePrecision
+}
+
 // SetDefaults in the user-specifyable part of the config
 func (u *UserConfig) SetDefaults() {
 	u.Threshold = DefaultThreshold
 	u.Distance = common.DefaultDistanceMetric
+	u.StoragePrecision = common.DefaultStoragePrecision
 	u.HnswUC = hnsw.NewDefaultUserConfig()
 	u.FlatUC = flat.NewDefaultUserConfig()
 }
@@ -76,12 +88,32 @@ func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig,
 		return uc, err
 	}
 
+	if err := common.OptionalStringFromMap(asMap, "storagePrecision", func(v string) {
+		uc.StoragePrecision = v
+	}); err != nil {
+		return uc, err
+	}
+	if _, err := common.ParseStoragePrecision(uc.StoragePrecision); err != nil {
+		return uc, err
+	}
+
 	if err := common.OptionalIntFromMap(asMap, "threshold", func(v int) {
 		uc.Threshold = uint64(v)
 	}); err != nil {
 		return uc, err
 	}
 
+	if err := common.OptionalIntFromMap(asMap, "downgradeThreshold", func(v int) {
+		uc.DowngradeThreshold = uint64(v)
+	}); err != nil {
+		return uc, err
+	}
+
+	if uc.DowngradeThreshold != 0 && uc.DowngradeThreshold >= uc.Threshold {
+		return uc, fmt.Errorf("downgradeThreshold (%d) must be lower than threshold (%d)",
+			uc.DowngradeThreshold, uc.Threshold)
+	}
+
 	hnswConfig, ok := asMap["hnsw"]
 	if ok && hnswConfig != nil {
 		hnswUC, err := hnsw.ParseAndValidateConfig(hnswConfig)
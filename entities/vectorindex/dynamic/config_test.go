@@ -35,8 +35,9 @@ func Test_DynamicUserConfig(t *testing.T) {
 			name:  "nothing specified, all defaults",
 			input: nil,
 			expected: UserConfig{
-				Distance:  common.DefaultDistanceMetric,
-				Threshold: DefaultThreshold,
+				Distance:         common.DefaultDistanceMetric,
+				StoragePrecision: common.DefaultStoragePrecision,
+				Threshold:        DefaultThreshold,
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
 					MaxConnections:         hnsw.DefaultMaxConnections,
@@ -45,10 +46,12 @@ func Test_DynamicUserConfig(t *testing.T) {
 					EF:                     hnsw.DefaultEF,
 					Skip:                   hnsw.DefaultSkip,
 					FlatSearchCutoff:       hnsw.DefaultFlatSearchCutoff,
+					FilterStrategy:         hnsw.DefaultFilterStrategy,
 					DynamicEFMin:           hnsw.DefaultDynamicEFMin,
 					DynamicEFMax:           hnsw.DefaultDynamicEFMax,
 					DynamicEFFactor:        hnsw.DefaultDynamicEFFactor,
 					Distance:               common.DefaultDistanceMetric,
+					StoragePrecision:       common.DefaultStoragePrecision,
 					PQ: hnsw.PQConfig{
 						Enabled:       hnsw.DefaultPQEnabled,
 						Segments:      hnsw.DefaultPQSegments,
@@ -64,10 +67,16 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 				},
 				FlatUC: flat.UserConfig{
 					VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
 					Distance:              common.DefaultDistanceMetric,
+					StoragePrecision:      common.DefaultStoragePrecision,
 					PQ: flat.CompressionUserConfig{
 						Enabled:      flat.DefaultCompressionEnabled,
 						RescoreLimit: flat.DefaultCompressionRescore,
@@ -83,6 +92,11 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: flat.RQUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+						Bits:         flat.DefaultRQBits,
+					},
 				},
 			},
 		},
@@ -92,8 +106,82 @@ func Test_DynamicUserConfig(t *testing.T) {
 				"threshold": float64(100),
 			},
 			expected: UserConfig{
-				Distance:  common.DefaultDistanceMetric,
-				Threshold: 100,
+				Distance:         common.DefaultDistanceMetric,
+				StoragePrecision: common.DefaultStoragePrecision,
+				Threshold:        100,
+				HnswUC: hnsw.UserConfig{
+					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
+					MaxConnections:         hnsw.DefaultMaxConnections,
+					EFConstruction:         hnsw.DefaultEFConstruction,
+					VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+					EF:                     hnsw.DefaultEF,
+					Skip:                   hnsw.DefaultSkip,
+					FlatSearchCutoff:       hnsw.DefaultFlatSearchCutoff,
+					FilterStrategy:         hnsw.DefaultFilterStrategy,
+					DynamicEFMin:           hnsw.DefaultDynamicEFMin,
+					DynamicEFMax:           hnsw.DefaultDynamicEFMax,
+					DynamicEFFactor:        hnsw.DefaultDynamicEFFactor,
+					Distance:               common.DefaultDistanceMetric,
+					StoragePrecision:       common.DefaultStoragePrecision,
+					PQ: hnsw.PQConfig{
+						Enabled:       hnsw.DefaultPQEnabled,
+						Segments:      hnsw.DefaultPQSegments,
+						Centroids:     hnsw.DefaultPQCentroids,
+						TrainingLimit: hnsw.DefaultPQTrainingLimit,
+						Encoder: hnsw.PQEncoder{
+							Type:         hnsw.DefaultPQEncoderType,
+							Distribution: hnsw.DefaultPQEncoderDistribution,
+						},
+					},
+					SQ: hnsw.SQConfig{
+						Enabled:       hnsw.DefaultSQEnabled,
+						TrainingLimit: hnsw.DefaultSQTrainingLimit,
+						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
+					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
+				},
+				FlatUC: flat.UserConfig{
+					VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
+					Distance:              common.DefaultDistanceMetric,
+					StoragePrecision:      common.DefaultStoragePrecision,
+					PQ: flat.CompressionUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+						Cache:        flat.DefaultVectorCache,
+					},
+					BQ: flat.CompressionUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+						Cache:        flat.DefaultVectorCache,
+					},
+					SQ: flat.CompressionUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+						Cache:        flat.DefaultVectorCache,
+					},
+					RQ: flat.RQUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+						Bits:         flat.DefaultRQBits,
+					},
+				},
+			},
+		},
+		{
+			name: "downgrade threshold is properly set",
+			input: map[string]interface{}{
+				"threshold":          float64(100),
+				"downgradeThreshold": float64(50),
+			},
+			expected: UserConfig{
+				Distance:           common.DefaultDistanceMetric,
+				StoragePrecision:   common.DefaultStoragePrecision,
+				Threshold:          100,
+				DowngradeThreshold: 50,
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
 					MaxConnections:         hnsw.DefaultMaxConnections,
@@ -102,10 +190,12 @@ func Test_DynamicUserConfig(t *testing.T) {
 					EF:                     hnsw.DefaultEF,
 					Skip:                   hnsw.DefaultSkip,
 					FlatSearchCutoff:       hnsw.DefaultFlatSearchCutoff,
+					FilterStrategy:         hnsw.DefaultFilterStrategy,
 					DynamicEFMin:           hnsw.DefaultDynamicEFMin,
 					DynamicEFMax:           hnsw.DefaultDynamicEFMax,
 					DynamicEFFactor:        hnsw.DefaultDynamicEFFactor,
 					Distance:               common.DefaultDistanceMetric,
+					StoragePrecision:       common.DefaultStoragePrecision,
 					PQ: hnsw.PQConfig{
 						Enabled:       hnsw.DefaultPQEnabled,
 						Segments:      hnsw.DefaultPQSegments,
@@ -121,10 +211,16 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 				},
 				FlatUC: flat.UserConfig{
 					VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
 					Distance:              common.DefaultDistanceMetric,
+					StoragePrecision:      common.DefaultStoragePrecision,
 					PQ: flat.CompressionUserConfig{
 						Enabled:      flat.DefaultCompressionEnabled,
 						RescoreLimit: flat.DefaultCompressionRescore,
@@ -140,6 +236,11 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: flat.RQUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+						Bits:         flat.DefaultRQBits,
+					},
 				},
 			},
 		},
@@ -169,8 +270,9 @@ func Test_DynamicUserConfig(t *testing.T) {
 				},
 			},
 			expected: UserConfig{
-				Distance:  common.DefaultDistanceMetric,
-				Threshold: DefaultThreshold,
+				Distance:         common.DefaultDistanceMetric,
+				StoragePrecision: common.DefaultStoragePrecision,
+				Threshold:        DefaultThreshold,
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: 11,
 					MaxConnections:         12,
@@ -178,10 +280,12 @@ func Test_DynamicUserConfig(t *testing.T) {
 					VectorCacheMaxObjects:  14,
 					EF:                     15,
 					FlatSearchCutoff:       16,
+					FilterStrategy:         hnsw.DefaultFilterStrategy,
 					DynamicEFMin:           17,
 					DynamicEFMax:           18,
 					DynamicEFFactor:        19,
 					Distance:               common.DefaultDistanceMetric,
+					StoragePrecision:       common.DefaultStoragePrecision,
 					PQ: hnsw.PQConfig{
 						Enabled:       true,
 						Segments:      64,
@@ -197,10 +301,16 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 				},
 				FlatUC: flat.UserConfig{
 					VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
 					Distance:              common.DefaultDistanceMetric,
+					StoragePrecision:      common.DefaultStoragePrecision,
 					PQ: flat.CompressionUserConfig{
 						Enabled:      flat.DefaultCompressionEnabled,
 						RescoreLimit: flat.DefaultCompressionRescore,
@@ -216,6 +326,11 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: flat.RQUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+						Bits:         flat.DefaultRQBits,
+					},
 				},
 			},
 		},
@@ -233,8 +348,9 @@ func Test_DynamicUserConfig(t *testing.T) {
 				},
 			},
 			expected: UserConfig{
-				Distance:  common.DefaultDistanceMetric,
-				Threshold: DefaultThreshold,
+				Distance:         common.DefaultDistanceMetric,
+				StoragePrecision: common.DefaultStoragePrecision,
+				Threshold:        DefaultThreshold,
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
 					MaxConnections:         hnsw.DefaultMaxConnections,
@@ -243,10 +359,12 @@ func Test_DynamicUserConfig(t *testing.T) {
 					EF:                     hnsw.DefaultEF,
 					Skip:                   hnsw.DefaultSkip,
 					FlatSearchCutoff:       hnsw.DefaultFlatSearchCutoff,
+					FilterStrategy:         hnsw.DefaultFilterStrategy,
 					DynamicEFMin:           hnsw.DefaultDynamicEFMin,
 					DynamicEFMax:           hnsw.DefaultDynamicEFMax,
 					DynamicEFFactor:        hnsw.DefaultDynamicEFFactor,
 					Distance:               common.DefaultDistanceMetric,
+					StoragePrecision:       common.DefaultStoragePrecision,
 					PQ: hnsw.PQConfig{
 						Enabled:       hnsw.DefaultPQEnabled,
 						Segments:      hnsw.DefaultPQSegments,
@@ -262,10 +380,16 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 				},
 				FlatUC: flat.UserConfig{
 					VectorCacheMaxObjects: 100,
 					Distance:              common.DefaultDistanceMetric,
+					StoragePrecision:      common.DefaultStoragePrecision,
 					PQ: flat.CompressionUserConfig{
 						Enabled:      false,
 						RescoreLimit: flat.DefaultCompressionRescore,
@@ -281,6 +405,11 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: flat.RQUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+						Bits:         flat.DefaultRQBits,
+					},
 				},
 			},
 		},
@@ -300,6 +429,15 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expectErr:    true,
 			expectErrMsg: "PQ is not currently supported for flat indices",
 		},
+		{
+			name: "downgrade threshold not lower than threshold returns error",
+			input: map[string]interface{}{
+				"threshold":          float64(100),
+				"downgradeThreshold": float64(100),
+			},
+			expectErr:    true,
+			expectErrMsg: "downgradeThreshold (100) must be lower than threshold (100)",
+		},
 	}
 
 	for _, test := range tests {
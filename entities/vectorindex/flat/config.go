@@ -24,6 +24,7 @@ const (
 	DefaultVectorCacheMaxObjects = 1e12
 	DefaultCompressionEnabled    = false
 	DefaultCompressionRescore    = -1 // indicates "let Weaviate pick"
+	DefaultRQBits                = 8
 )
 
 type CompressionUserConfig struct {
@@ -32,12 +33,22 @@ type CompressionUserConfig struct {
 	Cache        bool `json:"cache"`
 }
 
+// RQUserConfig configures the rotational quantizer, which encodes every
+// dimension with the given number of bits. Vectors are not cached in memory.
+type RQUserConfig struct {
+	Enabled      bool `json:"enabled"`
+	RescoreLimit int  `json:"rescoreLimit"`
+	Bits         int  `json:"bits"`
+}
+
 type UserConfig struct {
 	Distance              string                `json:"distance"`
+	StoragePrecision      string                `json:"storagePrecision"`
 	VectorCacheMaxObjects int                   `json:"vectorCacheMaxObjects"`
 	PQ                    CompressionUserConfig `json:"pq"`
 	BQ                    CompressionUserConfig `json:"bq"`
 	SQ                    CompressionUserConfig `json:"sq"`
+	RQ                    RQUserConfig          `json:"rq"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -50,18 +61,26 @@ func (u UserConfig) DistanceName() string {
 	return u.Distance
 }
 
+func (u UserConfig) StoragePrecisionName() string {
+	return u.StoragePrecision
+}
+
 // SetDefaults in the user-specifyable part of the config
 func (u *UserConfig) SetDefaults() {
 	u.PQ.Cache = DefaultVectorCache
 	u.BQ.Cache = DefaultVectorCache
 	u.VectorCacheMaxObjects = DefaultVectorCacheMaxObjects
 	u.Distance = vectorindexcommon.DefaultDistanceMetric
+	u.StoragePrecision = vectorindexcommon.DefaultStoragePrecision
 	u.PQ.Enabled = DefaultCompressionEnabled
 	u.PQ.RescoreLimit = DefaultCompressionRescore
 	u.BQ.Enabled = DefaultCompressionEnabled
 	u.BQ.RescoreLimit = DefaultCompressionRescore
 	u.SQ.Enabled = DefaultCompressionEnabled
 	u.SQ.RescoreLimit = DefaultCompressionRescore
+	u.RQ.Enabled = DefaultCompressionEnabled
+	u.RQ.RescoreLimit = DefaultCompressionRescore
+	u.RQ.Bits = DefaultRQBits
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -85,6 +104,15 @@ func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig,
 		return uc, err
 	}
 
+	if err := vectorindexcommon.OptionalStringFromMap(asMap, "storagePrecision", func(v string) {
+		uc.StoragePrecision = v
+	}); err != nil {
+		return uc, err
+	}
+	if _, err := vectorindexcommon.ParseStoragePrecision(uc.StoragePrecision); err != nil {
+		return uc, err
+	}
+
 	if err := vectorindexcommon.OptionalIntFromMap(asMap, "vectorCacheMaxObjects", func(v int) {
 		uc.VectorCacheMaxObjects = v
 	}); err != nil {
@@ -122,12 +150,45 @@ func parseCompressionMap(in interface{}, cuc *CompressionUserConfig) error {
 	return nil
 }
 
+func parseRQMap(in interface{}, rq *RQUserConfig) error {
+	configMap, ok := in.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := vectorindexcommon.OptionalBoolFromMap(configMap, "enabled", func(v bool) {
+		rq.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(configMap, "rescoreLimit", func(v int) {
+		rq.RescoreLimit = v
+	}); err != nil {
+		return err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(configMap, "bits", func(v int) {
+		rq.Bits = v
+	}); err != nil {
+		return err
+	}
+
+	switch rq.Bits {
+	case 1, 2, 4, 8:
+		return nil
+	default:
+		return fmt.Errorf("invalid rq bits %d, must be one of 1, 2, 4 or 8", rq.Bits)
+	}
+}
+
 func parseCompression(in map[string]interface{}, uc *UserConfig) error {
 	pqConfigValue, pqOk := in["pq"]
 	bqConfigValue, bqOk := in["bq"]
 	sqConfigValue, sqOk := in["sq"]
+	rqConfigValue, rqOk := in["rq"]
 
-	if !pqOk && !bqOk && !sqOk {
+	if !pqOk && !bqOk && !sqOk && !rqOk {
 		return nil
 	}
 
@@ -152,8 +213,18 @@ func parseCompression(in map[string]interface{}, uc *UserConfig) error {
 		}
 	}
 
+	if rqOk {
+		err := parseRQMap(rqConfigValue, &uc.RQ)
+		if err != nil {
+			return err
+		}
+	}
+
 	compressionConfigs := []CompressionUserConfig{uc.PQ, uc.BQ, uc.SQ}
 	totalEnabled := 0
+	if uc.RQ.Enabled {
+		totalEnabled++
+	}
 
 	for _, compressionConfig := range compressionConfigs {
 		if compressionConfig.Cache && !compressionConfig.Enabled {
@@ -168,6 +239,12 @@ func parseCompression(in map[string]interface{}, uc *UserConfig) error {
 		return errors.New("cannot enable multiple quantization methods at the same time")
 	}
 
+	if uc.RQ.Enabled {
+		if err := vectorindexcommon.ValidateRQDistance(uc.Distance); err != nil {
+			return err
+		}
+	}
+
 	// TODO: remove once PQ and SQ are supported
 	if uc.PQ.Enabled {
 		return errors.New("PQ is not currently supported for flat indices")
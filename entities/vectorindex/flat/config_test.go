@@ -35,6 +35,7 @@ func Test_FlatUserConfig(t *testing.T) {
 			expected: UserConfig{
 				VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
 				Distance:              common.DefaultDistanceMetric,
+				StoragePrecision:      common.DefaultStoragePrecision,
 				PQ: CompressionUserConfig{
 					Enabled:      DefaultCompressionEnabled,
 					RescoreLimit: DefaultCompressionRescore,
@@ -50,6 +51,11 @@ func Test_FlatUserConfig(t *testing.T) {
 					RescoreLimit: DefaultCompressionRescore,
 					Cache:        DefaultVectorCache,
 				},
+				RQ: RQUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Bits:         DefaultRQBits,
+				},
 			},
 		},
 		{
@@ -66,6 +72,7 @@ func Test_FlatUserConfig(t *testing.T) {
 			expected: UserConfig{
 				VectorCacheMaxObjects: 100,
 				Distance:              common.DefaultDistanceMetric,
+				StoragePrecision:      common.DefaultStoragePrecision,
 				PQ: CompressionUserConfig{
 					Enabled:      false,
 					RescoreLimit: DefaultCompressionRescore,
@@ -81,8 +88,123 @@ func Test_FlatUserConfig(t *testing.T) {
 					RescoreLimit: DefaultCompressionRescore,
 					Cache:        DefaultVectorCache,
 				},
+				RQ: RQUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Bits:         DefaultRQBits,
+				},
+			},
+		},
+		{
+			name: "rq enabled",
+			input: map[string]interface{}{
+				"vectorCacheMaxObjects": float64(100),
+				"rq": map[string]interface{}{
+					"enabled":      true,
+					"rescoreLimit": float64(50),
+					"bits":         float64(4),
+				},
+			},
+			expected: UserConfig{
+				VectorCacheMaxObjects: 100,
+				Distance:              common.DefaultDistanceMetric,
+				StoragePrecision:      common.DefaultStoragePrecision,
+				PQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				BQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				SQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				RQ: RQUserConfig{
+					Enabled:      true,
+					RescoreLimit: 50,
+					Bits:         4,
+				},
+			},
+		},
+		{
+			name: "reduced storage precision",
+			input: map[string]interface{}{
+				"storagePrecision": "float16",
+			},
+			expected: UserConfig{
+				VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
+				Distance:              common.DefaultDistanceMetric,
+				StoragePrecision:      common.StoragePrecisionFloat16,
+				PQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				BQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				SQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				RQ: RQUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Bits:         DefaultRQBits,
+				},
 			},
 		},
+		{
+			name: "invalid storage precision",
+			input: map[string]interface{}{
+				"storagePrecision": "float64",
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid storagePrecision \"float64\"",
+		},
+		{
+			name: "rq with invalid bits",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"enabled": true,
+					"bits":    float64(5),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid rq bits 5, must be one of 1, 2, 4 or 8",
+		},
+		{
+			name: "rq with unsupported distance",
+			input: map[string]interface{}{
+				"distance": "manhattan",
+				"rq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "rq is not supported with distance \"manhattan\"",
+		},
+		{
+			name: "rq and bq enabled",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"enabled": true,
+				},
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "cannot enable multiple quantization methods at the same time",
+		},
 		{
 			name: "sq enabled",
 			input: map[string]interface{}{
@@ -30,6 +30,14 @@ const (
 	DefaultDynamicEFFactor        = 8
 	DefaultSkip                   = false
 	DefaultFlatSearchCutoff       = 40000
+	DefaultFilterStrategy         = FilterStrategySweeping
+
+	// FilterStrategySweeping traverses the graph as usual and skips nodes that
+	// don't match the filter when collecting results
+	FilterStrategySweeping = "sweeping"
+	// FilterStrategyAcorn only traverses nodes that match the filter and
+	// reaches them through the neighbors of non-matching nodes
+	FilterStrategyAcorn = "acorn"
 
 	// Fail validation if those criteria are not met
 	MinmumMaxConnections = 4
@@ -38,20 +46,24 @@ const (
 
 // UserConfig bundles all values settable by a user in the per-class settings
 type UserConfig struct {
-	Skip                   bool     `json:"skip"`
-	CleanupIntervalSeconds int      `json:"cleanupIntervalSeconds"`
-	MaxConnections         int      `json:"maxConnections"`
-	EFConstruction         int      `json:"efConstruction"`
-	EF                     int      `json:"ef"`
-	DynamicEFMin           int      `json:"dynamicEfMin"`
-	DynamicEFMax           int      `json:"dynamicEfMax"`
-	DynamicEFFactor        int      `json:"dynamicEfFactor"`
-	VectorCacheMaxObjects  int      `json:"vectorCacheMaxObjects"`
-	FlatSearchCutoff       int      `json:"flatSearchCutoff"`
-	Distance               string   `json:"distance"`
-	PQ                     PQConfig `json:"pq"`
-	BQ                     BQConfig `json:"bq"`
-	SQ                     SQConfig `json:"sq"`
+	Skip                   bool              `json:"skip"`
+	CleanupIntervalSeconds int               `json:"cleanupIntervalSeconds"`
+	MaxConnections         int               `json:"maxConnections"`
+	EFConstruction         int               `json:"efConstruction"`
+	EF                     int               `json:"ef"`
+	DynamicEFMin           int               `json:"dynamicEfMin"`
+	DynamicEFMax           int               `json:"dynamicEfMax"`
+	DynamicEFFactor        int               `json:"dynamicEfFactor"`
+	VectorCacheMaxObjects  int               `json:"vectorCacheMaxObjects"`
+	FlatSearchCutoff       int               `json:"flatSearchCutoff"`
+	FilterStrategy         string            `json:"filterStrategy"`
+	Distance               string            `json:"distance"`
+	StoragePrecision       string            `json:"storagePrecision"`
+	PQ                     PQConfig          `json:"pq"`
+	BQ                     BQConfig          `json:"bq"`
+	SQ                     SQConfig          `json:"sq"`
+	RQ                     RQConfig          `json:"rq"`
+	Multivector            MultivectorConfig `json:"multivector"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -64,6 +76,10 @@ func (u UserConfig) DistanceName() string {
 	return u.Distance
 }
 
+func (u UserConfig) StoragePrecisionName() string {
+	return u.StoragePrecision
+}
+
 // SetDefaults in the user-specifyable part of the config
 func (u *UserConfig) SetDefaults() {
 	u.MaxConnections = DefaultMaxConnections
@@ -76,7 +92,9 @@ func (u *UserConfig) SetDefaults() {
 	u.DynamicEFMin = DefaultDynamicEFMin
 	u.Skip = DefaultSkip
 	u.FlatSearchCutoff = DefaultFlatSearchCutoff
+	u.FilterStrategy = DefaultFilterStrategy
 	u.Distance = vectorIndexCommon.DefaultDistanceMetric
+	u.StoragePrecision = vectorIndexCommon.DefaultStoragePrecision
 	u.PQ = PQConfig{
 		Enabled:        DefaultPQEnabled,
 		BitCompression: DefaultPQBitCompression,
@@ -96,6 +114,11 @@ func (u *UserConfig) SetDefaults() {
 		TrainingLimit: DefaultSQTrainingLimit,
 		RescoreLimit:  DefaultSQRescoreLimit,
 	}
+	u.RQ = RQConfig{
+		Enabled:      DefaultRQEnabled,
+		Bits:         DefaultRQBits,
+		RescoreLimit: DefaultRQRescoreLimit,
+	}
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -167,6 +190,12 @@ func ParseAndValidateConfig(input interface{}) (config.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "filterStrategy", func(v string) {
+		uc.FilterStrategy = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := vectorIndexCommon.OptionalBoolFromMap(asMap, "skip", func(v bool) {
 		uc.Skip = v
 	}); err != nil {
@@ -179,6 +208,12 @@ func ParseAndValidateConfig(input interface{}) (config.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "storagePrecision", func(v string) {
+		uc.StoragePrecision = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := parsePQMap(asMap, &uc.PQ); err != nil {
 		return uc, err
 	}
@@ -191,6 +226,14 @@ func ParseAndValidateConfig(input interface{}) (config.VectorIndexConfig, error)
 		return uc, err
 	}
 
+	if err := parseRQMap(asMap, &uc.RQ); err != nil {
+		return uc, err
+	}
+
+	if err := parseMultivectorMap(asMap, &uc.Multivector); err != nil {
+		return uc, err
+	}
+
 	return uc, uc.validate()
 }
 
@@ -210,6 +253,19 @@ func (u *UserConfig) validate() error {
 		))
 	}
 
+	if u.FilterStrategy != FilterStrategySweeping && u.FilterStrategy != FilterStrategyAcorn {
+		errMsgs = append(errMsgs, fmt.Sprintf(
+			"filterStrategy must be one of %q or %q, got %q",
+			FilterStrategySweeping, FilterStrategyAcorn, u.FilterStrategy,
+		))
+	}
+
+	if _, err := vectorIndexCommon.ParseStoragePrecision(u.StoragePrecision); err != nil {
+		errMsgs = append(errMsgs, err.Error())
+	} else if u.Multivector.Enabled && u.StoragePrecision != vectorIndexCommon.StoragePrecisionFloat32 {
+		errMsgs = append(errMsgs, "storagePrecision is not supported for multi vectors")
+	}
+
 	if len(errMsgs) > 0 {
 		return fmt.Errorf("invalid hnsw config: %s",
 			strings.Join(errMsgs, ", "))
@@ -225,10 +281,17 @@ func (u *UserConfig) validate() error {
 	if u.SQ.Enabled {
 		enabled++
 	}
+	if u.RQ.Enabled {
+		enabled++
+	}
 	if enabled > 1 {
 		return fmt.Errorf("invalid hnsw config: more than a single compression methods enabled")
 	}
 
+	if err := ValidateRQConfig(u.RQ, u.Distance); err != nil {
+		return fmt.Errorf("invalid hnsw config: %w", err)
+	}
+
 	return nil
 }
 
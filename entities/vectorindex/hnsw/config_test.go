@@ -42,10 +42,12 @@ func Test_UserConfig(t *testing.T) {
 				EF:                     DefaultEF,
 				Skip:                   DefaultSkip,
 				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           DefaultDynamicEFMin,
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
 				Distance:               common.DefaultDistanceMetric,
+				StoragePrecision:       common.DefaultStoragePrecision,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -62,6 +64,11 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 			},
 		},
 
@@ -77,10 +84,12 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
 				EF:                     DefaultEF,
 				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           DefaultDynamicEFMin,
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
 				Distance:               common.DefaultDistanceMetric,
+				StoragePrecision:       common.DefaultStoragePrecision,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -97,6 +106,11 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 			},
 		},
 
@@ -122,11 +136,13 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Skip:                   true,
 				Distance:               "l2-squared",
+				StoragePrecision:       common.DefaultStoragePrecision,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -143,6 +159,11 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 			},
 		},
 
@@ -168,11 +189,13 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Skip:                   true,
 				Distance:               "manhattan",
+				StoragePrecision:       common.DefaultStoragePrecision,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -189,6 +212,11 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 			},
 		},
 
@@ -214,11 +242,13 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Skip:                   true,
 				Distance:               "hamming",
+				StoragePrecision:       common.DefaultStoragePrecision,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -235,6 +265,11 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 			},
 		},
 
@@ -259,10 +294,12 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               common.DefaultDistanceMetric,
+				StoragePrecision:       common.DefaultStoragePrecision,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -279,6 +316,11 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 			},
 		},
 
@@ -313,10 +355,12 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               common.DefaultDistanceMetric,
+				StoragePrecision:       common.DefaultStoragePrecision,
 				PQ: PQConfig{
 					Enabled:       true,
 					Segments:      64,
@@ -332,6 +376,11 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 			},
 		},
 
@@ -365,10 +414,12 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               common.DefaultDistanceMetric,
+				StoragePrecision:       common.DefaultStoragePrecision,
 				PQ: PQConfig{
 					Enabled:       true,
 					Segments:      64,
@@ -384,6 +435,11 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 			},
 		},
 
@@ -436,10 +492,12 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  math.MaxInt64,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               common.DefaultDistanceMetric,
+				StoragePrecision:       common.DefaultStoragePrecision,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -456,6 +514,11 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 			},
 		},
 		{
@@ -517,10 +580,12 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               common.DefaultDistanceMetric,
+				StoragePrecision:       common.DefaultStoragePrecision,
 				PQ: PQConfig{
 					Enabled:       false,
 					Segments:      0,
@@ -539,6 +604,11 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 			},
 		},
 		{
@@ -564,10 +634,12 @@ func Test_UserConfig(t *testing.T) {
 				VectorCacheMaxObjects:  14,
 				EF:                     15,
 				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
 				DynamicEFMin:           17,
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               common.DefaultDistanceMetric,
+				StoragePrecision:       common.DefaultStoragePrecision,
 				PQ: PQConfig{
 					Enabled:       false,
 					Segments:      0,
@@ -583,8 +655,88 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
+			},
+		},
+		{
+			name: "with rq",
+			input: map[string]interface{}{
+				"cleanupIntervalSeconds": float64(11),
+				"maxConnections":         float64(12),
+				"efConstruction":         float64(13),
+				"vectorCacheMaxObjects":  float64(14),
+				"ef":                     float64(15),
+				"flatSearchCutoff":       float64(16),
+				"dynamicEfMin":           float64(17),
+				"dynamicEfMax":           float64(18),
+				"dynamicEfFactor":        float64(19),
+				"rq": map[string]interface{}{
+					"enabled":      true,
+					"bits":         float64(4),
+					"rescoreLimit": float64(50),
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: 11,
+				MaxConnections:         12,
+				EFConstruction:         13,
+				VectorCacheMaxObjects:  14,
+				EF:                     15,
+				FlatSearchCutoff:       16,
+				FilterStrategy:         DefaultFilterStrategy,
+				DynamicEFMin:           17,
+				DynamicEFMax:           18,
+				DynamicEFFactor:        19,
+				Distance:               common.DefaultDistanceMetric,
+				StoragePrecision:       common.DefaultStoragePrecision,
+				PQ: PQConfig{
+					Enabled:       false,
+					Segments:      0,
+					Centroids:     DefaultPQCentroids,
+					TrainingLimit: DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				RQ: RQConfig{
+					Enabled:      true,
+					Bits:         4,
+					RescoreLimit: 50,
+				},
 			},
 		},
+		{
+			name: "with invalid rq bits",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"enabled": true,
+					"bits":    float64(3),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid rq bits 3, must be one of 1, 2, 4 or 8",
+		},
+		{
+			name: "with rq and an unsupported distance",
+			input: map[string]interface{}{
+				"distance": "hamming",
+				"rq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: rq is not supported with distance \"hamming\"",
+		},
 		{
 			name: "with invalid compression",
 			input: map[string]interface{}{
@@ -601,6 +753,176 @@ func Test_UserConfig(t *testing.T) {
 			expectErr:    true,
 			expectErrMsg: "invalid hnsw config: more than a single compression methods enabled",
 		},
+		{
+			name: "with multivector",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         DefaultFilterStrategy,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				StoragePrecision:       common.DefaultStoragePrecision,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
+				Multivector: MultivectorConfig{
+					Enabled:     true,
+					Aggregation: MultivectorAggregationMaxSim,
+				},
+			},
+		},
+		{
+			name: "with invalid multivector aggregation",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled":     true,
+					"aggregation": "sum",
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "multivector aggregation must be",
+		},
+		{
+			name: "with acorn filter strategy",
+			input: map[string]interface{}{
+				"filterStrategy": "acorn",
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         FilterStrategyAcorn,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				StoragePrecision:       common.DefaultStoragePrecision,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
+			},
+		},
+		{
+			name: "with invalid filter strategy",
+			input: map[string]interface{}{
+				"filterStrategy": "random",
+			},
+			expectErr:    true,
+			expectErrMsg: "filterStrategy must be one of \"sweeping\" or \"acorn\"",
+		},
+		{
+			name: "with int8 storage precision",
+			input: map[string]interface{}{
+				"storagePrecision": "int8",
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				FilterStrategy:         DefaultFilterStrategy,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				StoragePrecision:       common.StoragePrecisionInt8,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
+			},
+		},
+		{
+			name: "with invalid storage precision",
+			input: map[string]interface{}{
+				"storagePrecision": "int4",
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid storagePrecision \"int4\"",
+		},
+		{
+			name: "with storage precision and multivector",
+			input: map[string]interface{}{
+				"storagePrecision": "float16",
+				"multivector": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "storagePrecision is not supported for multi vectors",
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	DefaultMultivectorAggregation = MultivectorAggregationMaxSim
+
+	MultivectorAggregationMaxSim = "maxSim"
+)
+
+// MultivectorConfig turns a named vector into a multi vector, each object
+// then holds a bag of token vectors instead of a single vector. The tokens
+// are indexed individually and the candidates are scored with the configured
+// aggregation. It is disabled in the zero value.
+type MultivectorConfig struct {
+	Enabled     bool   `json:"enabled"`
+	Aggregation string `json:"aggregation"`
+}
+
+func parseMultivectorMap(in map[string]interface{}, multivector *MultivectorConfig) error {
+	multivectorConfigValue, ok := in["multivector"]
+	if !ok {
+		return nil
+	}
+
+	multivectorConfigMap, ok := multivectorConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := common.OptionalBoolFromMap(multivectorConfigMap, "enabled", func(v bool) {
+		multivector.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalStringFromMap(multivectorConfigMap, "aggregation", func(v string) {
+		multivector.Aggregation = v
+	}); err != nil {
+		return err
+	}
+
+	if !multivector.Enabled {
+		return nil
+	}
+	if multivector.Aggregation == "" {
+		multivector.Aggregation = DefaultMultivectorAggregation
+	}
+	if multivector.Aggregation != MultivectorAggregationMaxSim {
+		return fmt.Errorf("invalid hnsw config: multivector aggregation must be %q, got %q",
+			MultivectorAggregationMaxSim, multivector.Aggregation)
+	}
+	return nil
+}
+
+// IsMultivector returns whether a parsed vector index config stores multi
+// vectors
+func IsMultivector(cfg interface{}) bool {
+	uc, ok := cfg.(UserConfig)
+	return ok && uc.Multivector.Enabled
+}
@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	DefaultRQEnabled      = false
+	DefaultRQBits         = 8
+	DefaultRQRescoreLimit = 20
+)
+
+// RQConfig configures the rotational quantizer. It doesn't need any training
+// data, so vectors are compressed as soon as the index holds some data.
+type RQConfig struct {
+	Enabled      bool `json:"enabled"`
+	Bits         int  `json:"bits"`
+	RescoreLimit int  `json:"rescoreLimit"`
+}
+
+func parseRQMap(in map[string]interface{}, rq *RQConfig) error {
+	rqConfigValue, ok := in["rq"]
+	if !ok {
+		return nil
+	}
+
+	rqConfigMap, ok := rqConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := common.OptionalBoolFromMap(rqConfigMap, "enabled", func(v bool) {
+		rq.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(rqConfigMap, "bits", func(v int) {
+		rq.Bits = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(rqConfigMap, "rescoreLimit", func(v int) {
+		rq.RescoreLimit = v
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateRQConfig validates the number of bits and, if RQ is enabled, that
+// the rotational quantizer supports the distance of the index
+func ValidateRQConfig(cfg RQConfig, distance string) error {
+	switch cfg.Bits {
+	case 1, 2, 4, 8:
+	default:
+		return fmt.Errorf("invalid rq bits %d, must be one of 1, 2, 4 or 8", cfg.Bits)
+	}
+
+	if cfg.Enabled {
+		return common.ValidateRQDistance(distance)
+	}
+	return nil
+}
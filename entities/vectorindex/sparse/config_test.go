@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func Test_SparseUserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:     "nothing specified, all defaults",
+			input:    nil,
+			expected: UserConfig{Distance: common.DistanceDot},
+		},
+		{
+			name:     "dot distance",
+			input:    map[string]interface{}{"distance": "dot"},
+			expected: UserConfig{Distance: common.DistanceDot},
+		},
+		{
+			name:         "unsupported distance",
+			input:        map[string]interface{}{"distance": "cosine"},
+			expectErrMsg: `sparse vectors only support the "dot" distance, got "cosine"`,
+		},
+		{
+			name:         "invalid input",
+			input:        "sparse",
+			expectErrMsg: "input must be a non-nil map",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErrMsg != "" {
+				assert.EqualError(t, err, test.expectErrMsg)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// Vector is a sparse vector, such as the term weights produced by SPLADE.
+// Indices holds the dimensions with a non-zero weight and Values the weight
+// for each of them.
+type Vector struct {
+	Indices []uint32  `json:"indices"`
+	Values  []float32 `json:"values"`
+}
+
+// Validate makes sure every index has a weight and occurs only once
+func (v Vector) Validate() error {
+	if len(v.Indices) != len(v.Values) {
+		return fmt.Errorf("sparse vector has %d indices but %d values",
+			len(v.Indices), len(v.Values))
+	}
+
+	seen := make(map[uint32]struct{}, len(v.Indices))
+	for i, index := range v.Indices {
+		if _, ok := seen[index]; ok {
+			return fmt.Errorf("sparse vector contains index %d more than once", index)
+		}
+		seen[index] = struct{}{}
+
+		if math.IsNaN(float64(v.Values[i])) || math.IsInf(float64(v.Values[i]), 0) {
+			return fmt.Errorf("sparse vector has an invalid value for index %d", index)
+		}
+	}
+	return nil
+}
+
+// Encode packs the vector as all indices as little endian uint32 followed by
+// all values as little endian float32
+func (v Vector) Encode() []byte {
+	out := make([]byte, 8*len(v.Indices))
+	for i, index := range v.Indices {
+		binary.LittleEndian.PutUint32(out[i*4:], index)
+	}
+	offset := 4 * len(v.Indices)
+	for i, value := range v.Values {
+		binary.LittleEndian.PutUint32(out[offset+i*4:], math.Float32bits(value))
+	}
+	return out
+}
+
+// Decode is the inverse of Vector.Encode
+func Decode(in []byte) (Vector, error) {
+	if len(in)%8 != 0 {
+		return Vector{}, fmt.Errorf("invalid sparse vector length %d, must be a multiple of 8", len(in))
+	}
+
+	n := len(in) / 8
+	v := Vector{
+		Indices: make([]uint32, n),
+		Values:  make([]float32, n),
+	}
+	for i := range v.Indices {
+		v.Indices[i] = binary.LittleEndian.Uint32(in[i*4:])
+	}
+	offset := 4 * n
+	for i := range v.Values {
+		v.Values[i] = math.Float32frombits(binary.LittleEndian.Uint32(in[offset+i*4:]))
+	}
+	return v, nil
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVector_EncodeDecode(t *testing.T) {
+	vec := Vector{
+		Indices: []uint32{7, 2, 30522},
+		Values:  []float32{0.5, 1.25, -3},
+	}
+
+	encoded := vec.Encode()
+	assert.Len(t, encoded, 24)
+
+	decoded, err := Decode(encoded)
+	require.Nil(t, err)
+	assert.Equal(t, vec, decoded)
+
+	empty, err := Decode(nil)
+	require.Nil(t, err)
+	assert.Empty(t, empty.Indices)
+
+	_, err = Decode(encoded[:7])
+	assert.EqualError(t, err, "invalid sparse vector length 7, must be a multiple of 8")
+}
+
+func TestVector_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		vec    Vector
+		errMsg string
+	}{
+		{
+			name: "valid",
+			vec:  Vector{Indices: []uint32{1, 2}, Values: []float32{0.1, 0.2}},
+		},
+		{
+			name: "empty",
+			vec:  Vector{},
+		},
+		{
+			name:   "length mismatch",
+			vec:    Vector{Indices: []uint32{1, 2}, Values: []float32{0.1}},
+			errMsg: "sparse vector has 2 indices but 1 values",
+		},
+		{
+			name:   "duplicate index",
+			vec:    Vector{Indices: []uint32{1, 1}, Values: []float32{0.1, 0.2}},
+			errMsg: "sparse vector contains index 1 more than once",
+		},
+		{
+			name:   "NaN value",
+			vec:    Vector{Indices: []uint32{4}, Values: []float32{float32(math.NaN())}},
+			errMsg: "sparse vector has an invalid value for index 4",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.vec.Validate()
+			if test.errMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.EqualError(t, err, test.errMsg)
+			}
+		})
+	}
+}
@@ -20,9 +20,19 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WeaviateClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
+	SearchStream(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (Weaviate_SearchStreamClient, error)
 	BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error)
 	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error)
 	TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsGetReply, error)
+	Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateReply, error)
+	GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaReply, error)
+	CreateClass(ctx context.Context, in *CreateClassRequest, opts ...grpc.CallOption) (*CreateClassReply, error)
+	UpdateClass(ctx context.Context, in *UpdateClassRequest, opts ...grpc.CallOption) (*UpdateClassReply, error)
+	DeleteClass(ctx context.Context, in *DeleteClassRequest, opts ...grpc.CallOption) (*DeleteClassReply, error)
+	AddProperty(ctx context.Context, in *AddPropertyRequest, opts ...grpc.CallOption) (*AddPropertyReply, error)
+	CreateTenants(ctx context.Context, in *CreateTenantsRequest, opts ...grpc.CallOption) (*CreateTenantsReply, error)
+	UpdateTenants(ctx context.Context, in *UpdateTenantsRequest, opts ...grpc.CallOption) (*UpdateTenantsReply, error)
+	DeleteTenants(ctx context.Context, in *DeleteTenantsRequest, opts ...grpc.CallOption) (*DeleteTenantsReply, error)
 }
 
 type weaviateClient struct {
@@ -42,6 +52,38 @@ func (c *weaviateClient) Search(ctx context.Context, in *SearchRequest, opts ...
 	return out, nil
 }
 
+func (c *weaviateClient) SearchStream(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (Weaviate_SearchStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[0], "/weaviate.v1.Weaviate/SearchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateSearchStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Weaviate_SearchStreamClient interface {
+	Recv() (*SearchStreamReply, error)
+	grpc.ClientStream
+}
+
+type weaviateSearchStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateSearchStreamClient) Recv() (*SearchStreamReply, error) {
+	m := new(SearchStreamReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *weaviateClient) BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error) {
 	out := new(BatchObjectsReply)
 	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/BatchObjects", in, out, opts...)
@@ -69,14 +111,105 @@ func (c *weaviateClient) TenantsGet(ctx context.Context, in *TenantsGetRequest,
 	return out, nil
 }
 
+func (c *weaviateClient) Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateReply, error) {
+	out := new(AggregateReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/Aggregate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) GetSchema(ctx context.Context, in *GetSchemaRequest, opts ...grpc.CallOption) (*GetSchemaReply, error) {
+	out := new(GetSchemaReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/GetSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) CreateClass(ctx context.Context, in *CreateClassRequest, opts ...grpc.CallOption) (*CreateClassReply, error) {
+	out := new(CreateClassReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/CreateClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) UpdateClass(ctx context.Context, in *UpdateClassRequest, opts ...grpc.CallOption) (*UpdateClassReply, error) {
+	out := new(UpdateClassReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/UpdateClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) DeleteClass(ctx context.Context, in *DeleteClassRequest, opts ...grpc.CallOption) (*DeleteClassReply, error) {
+	out := new(DeleteClassReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/DeleteClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) AddProperty(ctx context.Context, in *AddPropertyRequest, opts ...grpc.CallOption) (*AddPropertyReply, error) {
+	out := new(AddPropertyReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/AddProperty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) CreateTenants(ctx context.Context, in *CreateTenantsRequest, opts ...grpc.CallOption) (*CreateTenantsReply, error) {
+	out := new(CreateTenantsReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/CreateTenants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) UpdateTenants(ctx context.Context, in *UpdateTenantsRequest, opts ...grpc.CallOption) (*UpdateTenantsReply, error) {
+	out := new(UpdateTenantsReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/UpdateTenants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) DeleteTenants(ctx context.Context, in *DeleteTenantsRequest, opts ...grpc.CallOption) (*DeleteTenantsReply, error) {
+	out := new(DeleteTenantsReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/DeleteTenants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility
 type WeaviateServer interface {
 	Search(context.Context, *SearchRequest) (*SearchReply, error)
+	SearchStream(*SearchRequest, Weaviate_SearchStreamServer) error
 	BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error)
 	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error)
 	TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error)
+	Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error)
+	GetSchema(context.Context, *GetSchemaRequest) (*GetSchemaReply, error)
+	CreateClass(context.Context, *CreateClassRequest) (*CreateClassReply, error)
+	UpdateClass(context.Context, *UpdateClassRequest) (*UpdateClassReply, error)
+	DeleteClass(context.Context, *DeleteClassRequest) (*DeleteClassReply, error)
+	AddProperty(context.Context, *AddPropertyRequest) (*AddPropertyReply, error)
+	CreateTenants(context.Context, *CreateTenantsRequest) (*CreateTenantsReply, error)
+	UpdateTenants(context.Context, *UpdateTenantsRequest) (*UpdateTenantsReply, error)
+	DeleteTenants(context.Context, *DeleteTenantsRequest) (*DeleteTenantsReply, error)
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -87,6 +220,10 @@ func (UnimplementedWeaviateServer) Search(context.Context, *SearchRequest) (*Sea
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
 
+func (UnimplementedWeaviateServer) SearchStream(*SearchRequest, Weaviate_SearchStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SearchStream not implemented")
+}
+
 func (UnimplementedWeaviateServer) BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchObjects not implemented")
 }
@@ -98,6 +235,42 @@ func (UnimplementedWeaviateServer) BatchDelete(context.Context, *BatchDeleteRequ
 func (UnimplementedWeaviateServer) TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TenantsGet not implemented")
 }
+
+func (UnimplementedWeaviateServer) Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Aggregate not implemented")
+}
+
+func (UnimplementedWeaviateServer) GetSchema(context.Context, *GetSchemaRequest) (*GetSchemaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
+}
+
+func (UnimplementedWeaviateServer) CreateClass(context.Context, *CreateClassRequest) (*CreateClassReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClass not implemented")
+}
+
+func (UnimplementedWeaviateServer) UpdateClass(context.Context, *UpdateClassRequest) (*UpdateClassReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClass not implemented")
+}
+
+func (UnimplementedWeaviateServer) DeleteClass(context.Context, *DeleteClassRequest) (*DeleteClassReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClass not implemented")
+}
+
+func (UnimplementedWeaviateServer) AddProperty(context.Context, *AddPropertyRequest) (*AddPropertyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProperty not implemented")
+}
+
+func (UnimplementedWeaviateServer) CreateTenants(context.Context, *CreateTenantsRequest) (*CreateTenantsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTenants not implemented")
+}
+
+func (UnimplementedWeaviateServer) UpdateTenants(context.Context, *UpdateTenantsRequest) (*UpdateTenantsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenants not implemented")
+}
+
+func (UnimplementedWeaviateServer) DeleteTenants(context.Context, *DeleteTenantsRequest) (*DeleteTenantsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTenants not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 
 // UnsafeWeaviateServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +302,27 @@ func _Weaviate_Search_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_SearchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).SearchStream(m, &weaviateSearchStreamServer{stream})
+}
+
+type Weaviate_SearchStreamServer interface {
+	Send(*SearchStreamReply) error
+	grpc.ServerStream
+}
+
+type weaviateSearchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateSearchStreamServer) Send(m *SearchStreamReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Weaviate_BatchObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchObjectsRequest)
 	if err := dec(in); err != nil {
@@ -183,6 +377,168 @@ func _Weaviate_TenantsGet_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_Aggregate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).Aggregate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/Aggregate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).Aggregate(ctx, req.(*AggregateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/GetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).GetSchema(ctx, req.(*GetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_CreateClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).CreateClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/CreateClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).CreateClass(ctx, req.(*CreateClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_UpdateClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).UpdateClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/UpdateClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).UpdateClass(ctx, req.(*UpdateClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_DeleteClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).DeleteClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/DeleteClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).DeleteClass(ctx, req.(*DeleteClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_AddProperty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPropertyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).AddProperty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/AddProperty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).AddProperty(ctx, req.(*AddPropertyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_CreateTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).CreateTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/CreateTenants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).CreateTenants(ctx, req.(*CreateTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_UpdateTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).UpdateTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/UpdateTenants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).UpdateTenants(ctx, req.(*UpdateTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_DeleteTenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).DeleteTenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/DeleteTenants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).DeleteTenants(ctx, req.(*DeleteTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -206,7 +562,49 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TenantsGet",
 			Handler:    _Weaviate_TenantsGet_Handler,
 		},
+		{
+			MethodName: "Aggregate",
+			Handler:    _Weaviate_Aggregate_Handler,
+		},
+		{
+			MethodName: "GetSchema",
+			Handler:    _Weaviate_GetSchema_Handler,
+		},
+		{
+			MethodName: "CreateClass",
+			Handler:    _Weaviate_CreateClass_Handler,
+		},
+		{
+			MethodName: "UpdateClass",
+			Handler:    _Weaviate_UpdateClass_Handler,
+		},
+		{
+			MethodName: "DeleteClass",
+			Handler:    _Weaviate_DeleteClass_Handler,
+		},
+		{
+			MethodName: "AddProperty",
+			Handler:    _Weaviate_AddProperty_Handler,
+		},
+		{
+			MethodName: "CreateTenants",
+			Handler:    _Weaviate_CreateTenants_Handler,
+		},
+		{
+			MethodName: "UpdateTenants",
+			Handler:    _Weaviate_UpdateTenants_Handler,
+		},
+		{
+			MethodName: "DeleteTenants",
+			Handler:    _Weaviate_DeleteTenants_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SearchStream",
+			Handler:       _Weaviate_SearchStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/weaviate.proto",
 }
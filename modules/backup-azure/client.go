@@ -25,6 +25,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/backup"
 )
@@ -259,6 +260,55 @@ func (a *azureClient) Read(ctx context.Context, backupID, key string, w io.Write
 	return read, nil
 }
 
+func (a *azureClient) AllBackupIDs(ctx context.Context) ([]string, error) {
+	prefix := ""
+	if a.config.BackupPath != "" {
+		prefix = a.makeObjectName() + "/"
+	}
+
+	var ids []string
+	containerClient := a.client.ServiceClient().NewContainerClient(a.config.Container)
+	pager := containerClient.NewListBlobsHierarchyPager("/", &container.ListBlobsHierarchyOptions{
+		Prefix: to.Ptr(prefix),
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, backup.NewErrInternal(errors.Wrapf(err, "list blobs '%s'", prefix))
+		}
+		for _, blobPrefix := range page.Segment.BlobPrefixes {
+			if blobPrefix.Name != nil {
+				ids = append(ids, strings.TrimSuffix(strings.TrimPrefix(*blobPrefix.Name, prefix), "/"))
+			}
+		}
+	}
+	return ids, nil
+}
+
+func (a *azureClient) Delete(ctx context.Context, backupID string) error {
+	prefix := a.makeObjectName(backupID) + "/"
+
+	pager := a.client.NewListBlobsFlatPager(a.config.Container, &azblob.ListBlobsFlatOptions{
+		Prefix: to.Ptr(prefix),
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list blobs '%s'", prefix))
+		}
+		for _, blob := range page.Segment.BlobItems {
+			if blob.Name == nil {
+				continue
+			}
+			if _, err := a.client.DeleteBlob(ctx, a.config.Container, *blob.Name, nil); err != nil &&
+				!bloberror.HasCode(err, bloberror.BlobNotFound) {
+				return backup.NewErrInternal(errors.Wrapf(err, "delete blob '%s'", *blob.Name))
+			}
+		}
+	}
+	return nil
+}
+
 func (a *azureClient) SourceDataPath() string {
 	return a.dataPath
 }
@@ -204,6 +204,32 @@ func (m *Module) Read(ctx context.Context, backupID, key string, w io.WriteClose
 	return read, err
 }
 
+func (m *Module) AllBackupIDs(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(m.backupsPath)
+	if os.IsNotExist(err) {
+		// nothing has been backed up yet
+		return []string{}, nil
+	} else if err != nil {
+		return nil, backup.NewErrInternal(errors.Wrapf(err, "read dir '%s'", m.backupsPath))
+	}
+
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			ids = append(ids, entry.Name())
+		}
+	}
+	return ids, nil
+}
+
+func (m *Module) Delete(ctx context.Context, backupID string) error {
+	backupPath := m.makeBackupDirPath(backupID)
+	if err := os.RemoveAll(backupPath); err != nil {
+		return errors.Wrapf(err, "remove dir '%s'", backupPath)
+	}
+	return nil
+}
+
 func (m *Module) SourceDataPath() string {
 	return m.dataPath
 }
@@ -52,3 +52,38 @@ func TestBackend_StoreBackup(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestBackend_ListAndDeleteBackups(t *testing.T) {
+	ctx := context.Background()
+	module := New()
+	assert.Nil(t, module.initBackupBackend(ctx, t.TempDir()))
+
+	assert.Nil(t, module.PutObject(ctx, "backup-1", "backup_config.json", []byte("{}")))
+	assert.Nil(t, module.PutObject(ctx, "backup-2/node-1", "chunk-1", []byte("data")))
+	assert.Nil(t, module.PutObject(ctx, "backup-2/node-2", "chunk-1", []byte("data")))
+	// files in the root directory are not backups
+	assert.Nil(t, os.WriteFile(filepath.Join(module.backupsPath, "file"), []byte("data"), os.ModePerm))
+
+	ids, err := module.AllBackupIDs(ctx)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"backup-1", "backup-2"}, ids)
+
+	assert.Nil(t, module.Delete(ctx, "backup-2/node-1"))
+	_, err = os.Stat(filepath.Join(module.backupsPath, "backup-2", "node-1"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = module.GetObject(ctx, "backup-2/node-2", "chunk-1")
+	assert.Nil(t, err)
+
+	// deleting a missing backup is not an error
+	assert.Nil(t, module.Delete(ctx, "backup-3"))
+}
+
+func TestBackend_ListBackupsWithoutBackupsDir(t *testing.T) {
+	ctx := context.Background()
+	module := New()
+	module.backupsPath = filepath.Join(t.TempDir(), "backups")
+
+	ids, err := module.AllBackupIDs(ctx)
+	assert.Nil(t, err)
+	assert.Empty(t, ids)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"golang.org/x/oauth2/google"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
 )
 
@@ -368,6 +369,58 @@ func (g *gcsClient) Read(ctx context.Context, backupID, key string, w io.WriteCl
 	return read, nil
 }
 
+func (g *gcsClient) AllBackupIDs(ctx context.Context) ([]string, error) {
+	bucket, err := g.findBucket(ctx)
+	if err != nil {
+		return nil, backup.NewErrInternal(errors.Wrap(err, "find bucket"))
+	}
+
+	prefix := ""
+	if g.config.BackupPath != "" {
+		prefix = g.makeObjectName() + "/"
+	}
+
+	var ids []string
+	// with a delimiter, each backup is returned once as a synthetic prefix entry
+	it := bucket.Objects(ctx, &storage.Query{Prefix: prefix, Delimiter: "/"})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, backup.NewErrInternal(errors.Wrapf(err, "list objects '%s'", prefix))
+		}
+		if attrs.Prefix != "" {
+			ids = append(ids, strings.TrimSuffix(strings.TrimPrefix(attrs.Prefix, prefix), "/"))
+		}
+	}
+	return ids, nil
+}
+
+func (g *gcsClient) Delete(ctx context.Context, backupID string) error {
+	bucket, err := g.findBucket(ctx)
+	if err != nil {
+		return backup.NewErrInternal(errors.Wrap(err, "find bucket"))
+	}
+
+	prefix := g.makeObjectName(backupID) + "/"
+	it := bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list objects '%s'", prefix))
+		}
+		if err := bucket.Object(attrs.Name).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return backup.NewErrInternal(errors.Wrapf(err, "delete object '%s'", attrs.Name))
+		}
+	}
+	return nil
+}
+
 func (g *gcsClient) SourceDataPath() string {
 	return g.dataPath
 }
@@ -19,6 +19,7 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"strings"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
@@ -224,6 +225,49 @@ func (s *s3Client) Read(ctx context.Context, backupID, key string, w io.WriteClo
 	return read, nil
 }
 
+func (s *s3Client) AllBackupIDs(ctx context.Context) ([]string, error) {
+	prefix := ""
+	if s.config.BackupPath != "" {
+		prefix = s.makeObjectName() + "/"
+	}
+
+	// stop the listing when returning early
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var ids []string
+	opts := minio.ListObjectsOptions{Prefix: prefix, Recursive: false}
+	for obj := range s.client.ListObjects(ctx, s.config.Bucket, opts) {
+		if obj.Err != nil {
+			return nil, backup.NewErrInternal(errors.Wrapf(obj.Err, "list objects '%s'", prefix))
+		}
+		// each backup is stored under its own prefix
+		if strings.HasSuffix(obj.Key, "/") {
+			ids = append(ids, strings.TrimSuffix(strings.TrimPrefix(obj.Key, prefix), "/"))
+		}
+	}
+	return ids, nil
+}
+
+func (s *s3Client) Delete(ctx context.Context, backupID string) error {
+	prefix := s.makeObjectName(backupID) + "/"
+
+	// stop the listing when returning early
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	opts := minio.ListObjectsOptions{Prefix: prefix, Recursive: true}
+	for obj := range s.client.ListObjects(ctx, s.config.Bucket, opts) {
+		if obj.Err != nil {
+			return backup.NewErrInternal(errors.Wrapf(obj.Err, "list objects '%s'", prefix))
+		}
+		if err := s.client.RemoveObject(ctx, s.config.Bucket, obj.Key, minio.RemoveObjectOptions{}); err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "remove object '%s'", obj.Key))
+		}
+	}
+	return nil
+}
+
 func (s *s3Client) SourceDataPath() string {
 	return s.dataPath
 }
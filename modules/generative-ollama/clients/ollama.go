@@ -65,6 +65,31 @@ func (v *ollama) GenerateAllResults(ctx context.Context, textProperties []map[st
 }
 
 func (v *ollama) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
+	return v.generate(ctx, cfg, prompt, options, debug, nil)
+}
+
+func (v *ollama) GenerateSingleResultStream(ctx context.Context, textProperties map[string]string, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig, onChunk modulecapabilities.GenerateChunkFn) (*modulecapabilities.GenerateResponse, error) {
+	forPrompt, err := v.generateForPrompt(textProperties, prompt)
+	if err != nil {
+		return nil, err
+	}
+	return v.GenerateStream(ctx, cfg, forPrompt, options, debug, onChunk)
+}
+
+func (v *ollama) GenerateAllResultsStream(ctx context.Context, textProperties []map[string]string, task string, options interface{}, debug bool, cfg moduletools.ClassConfig, onChunk modulecapabilities.GenerateChunkFn) (*modulecapabilities.GenerateResponse, error) {
+	forTask, err := v.generatePromptForTask(textProperties, task)
+	if err != nil {
+		return nil, err
+	}
+	return v.GenerateStream(ctx, cfg, forTask, options, debug, onChunk)
+}
+
+func (v *ollama) GenerateStream(ctx context.Context, cfg moduletools.ClassConfig, prompt string, options interface{}, debug bool, onChunk modulecapabilities.GenerateChunkFn) (*modulecapabilities.GenerateResponse, error) {
+	return v.generate(ctx, cfg, prompt, options, debug, onChunk)
+}
+
+// generate streams the response if onChunk is set
+func (v *ollama) generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, options interface{}, debug bool, onChunk modulecapabilities.GenerateChunkFn) (*modulecapabilities.GenerateResponse, error) {
 	settings := config.NewClassSettings(cfg)
 	params := v.getParameters(cfg, options)
 	debugInformation := v.getDebugInformation(debug, prompt)
@@ -73,7 +98,7 @@ func (v *ollama) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	input := generateInput{
 		Model:  params.Model,
 		Prompt: prompt,
-		Stream: false,
+		Stream: onChunk != nil,
 	}
 	if params.Temperature != nil {
 		input.Options = &generateOptions{Temperature: params.Temperature}
@@ -97,6 +122,17 @@ func (v *ollama) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	}
 	defer res.Body.Close()
 
+	if onChunk != nil && res.StatusCode == 200 {
+		textResponse, err := v.readStream(res.Body, onChunk)
+		if err != nil {
+			return nil, err
+		}
+		return &modulecapabilities.GenerateResponse{
+			Result: &textResponse,
+			Debug:  debugInformation,
+		}, nil
+	}
+
 	bodyBytes, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, errors.Wrap(err, "read response body")
@@ -123,6 +159,35 @@ func (v *ollama) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	}, nil
 }
 
+// readStream reads the newline delimited JSON objects Ollama sends when
+// streaming and returns the complete response once generation is done
+func (v *ollama) readStream(body io.Reader, onChunk modulecapabilities.GenerateChunkFn) (string, error) {
+	var text strings.Builder
+	decoder := json.NewDecoder(body)
+	for {
+		var chunk generateResponse
+		if err := decoder.Decode(&chunk); err != nil {
+			if errors.Is(err, io.EOF) {
+				return "", errors.New("connection to Ollama API closed before generation finished")
+			}
+			return "", errors.Wrap(err, "unmarshal response chunk")
+		}
+
+		if chunk.Error != "" {
+			return "", errors.Errorf("connection to Ollama API failed with error: %s", chunk.Error)
+		}
+		if chunk.Response != "" {
+			text.WriteString(chunk.Response)
+			if err := onChunk(chunk.Response); err != nil {
+				return "", err
+			}
+		}
+		if chunk.Done {
+			return text.String(), nil
+		}
+	}
+}
+
 func (v *ollama) getParameters(cfg moduletools.ClassConfig, options interface{}) ollamaparams.Params {
 	settings := config.NewClassSettings(cfg)
 
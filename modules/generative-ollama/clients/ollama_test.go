@@ -14,6 +14,7 @@ package ollama
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -83,6 +84,100 @@ func TestGetAnswer(t *testing.T) {
 	}
 }
 
+func TestGetAnswerStream(t *testing.T) {
+	textProperties := []map[string]string{{"prop": "My name is john"}}
+
+	tests := []struct {
+		name           string
+		chunks         []generateResponse
+		expectedChunks []string
+		expectedErr    string
+	}{
+		{
+			name: "when the server streams the answer",
+			chunks: []generateResponse{
+				{Response: "John"}, {Response: " is"}, {Response: " your name"}, {Done: true},
+			},
+			expectedChunks: []string{"John", " is", " your name"},
+		},
+		{
+			name: "when the server fails while streaming",
+			chunks: []generateResponse{
+				{Response: "John"}, {Error: "model crashed"},
+			},
+			expectedChunks: []string{"John"},
+			expectedErr:    "model crashed",
+		},
+		{
+			name: "when the server closes the stream early",
+			chunks: []generateResponse{
+				{Response: "John"},
+			},
+			expectedChunks: []string{"John"},
+			expectedErr:    "closed before generation finished",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(&testStreamHandler{t: t, chunks: test.chunks})
+			defer server.Close()
+
+			c := New(time.Minute, nullLogger())
+
+			var chunks []string
+			settings := &fakeClassConfig{apiEndpoint: server.URL}
+			res, err := c.GenerateAllResultsStream(context.Background(), textProperties, "What is my name?", nil, false, settings,
+				func(chunk string) error {
+					chunks = append(chunks, chunk)
+					return nil
+				})
+
+			assert.Equal(t, test.expectedChunks, chunks)
+			if test.expectedErr != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+			} else {
+				require.Nil(t, err)
+				assert.Equal(t, "John is your name", *res.Result)
+			}
+		})
+	}
+
+	t.Run("when the consumer aborts", func(t *testing.T) {
+		server := httptest.NewServer(&testStreamHandler{t: t, chunks: []generateResponse{
+			{Response: "John"}, {Response: " is"}, {Done: true},
+		}})
+		defer server.Close()
+
+		c := New(time.Minute, nullLogger())
+
+		settings := &fakeClassConfig{apiEndpoint: server.URL}
+		_, err := c.GenerateStream(context.Background(), settings, "What is my name?", nil, false,
+			func(chunk string) error {
+				return errors.New("client gone")
+			})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "client gone")
+	})
+}
+
+type testStreamHandler struct {
+	t      *testing.T
+	chunks []generateResponse
+}
+
+func (f *testStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var input generateInput
+	require.Nil(f.t, json.NewDecoder(r.Body).Decode(&input))
+	assert.True(f.t, input.Stream)
+
+	encoder := json.NewEncoder(w)
+	for _, chunk := range f.chunks {
+		require.Nil(f.t, encoder.Encode(chunk))
+		w.(http.Flusher).Flush()
+	}
+}
+
 type testAnswerHandler struct {
 	t *testing.T
 	// the test handler will report as not ready before the time has passed
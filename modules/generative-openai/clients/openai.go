@@ -12,6 +12,7 @@
 package clients
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -93,6 +94,31 @@ func (v *openai) GenerateAllResults(ctx context.Context, textProperties []map[st
 }
 
 func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
+	return v.generate(ctx, cfg, prompt, options, debug, nil)
+}
+
+func (v *openai) GenerateSingleResultStream(ctx context.Context, textProperties map[string]string, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig, onChunk modulecapabilities.GenerateChunkFn) (*modulecapabilities.GenerateResponse, error) {
+	forPrompt, err := v.generateForPrompt(textProperties, prompt)
+	if err != nil {
+		return nil, err
+	}
+	return v.GenerateStream(ctx, cfg, forPrompt, options, debug, onChunk)
+}
+
+func (v *openai) GenerateAllResultsStream(ctx context.Context, textProperties []map[string]string, task string, options interface{}, debug bool, cfg moduletools.ClassConfig, onChunk modulecapabilities.GenerateChunkFn) (*modulecapabilities.GenerateResponse, error) {
+	forTask, err := v.generatePromptForTask(textProperties, task)
+	if err != nil {
+		return nil, err
+	}
+	return v.GenerateStream(ctx, cfg, forTask, options, debug, onChunk)
+}
+
+func (v *openai) GenerateStream(ctx context.Context, cfg moduletools.ClassConfig, prompt string, options interface{}, debug bool, onChunk modulecapabilities.GenerateChunkFn) (*modulecapabilities.GenerateResponse, error) {
+	return v.generate(ctx, cfg, prompt, options, debug, onChunk)
+}
+
+// generate streams the response if onChunk is set
+func (v *openai) generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, options interface{}, debug bool, onChunk modulecapabilities.GenerateChunkFn) (*modulecapabilities.GenerateResponse, error) {
 	settings := config.NewClassSettings(cfg)
 	params := v.getParameters(cfg, options)
 	debugInformation := v.getDebugInformation(debug, prompt)
@@ -106,6 +132,13 @@ func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	if err != nil {
 		return nil, errors.Wrap(err, "generate input")
 	}
+	if onChunk != nil {
+		input.Stream = true
+		if !settings.IsAzure() {
+			// Azure only accepts stream options in recent API versions
+			input.StreamOptions = &streamOptions{IncludeUsage: true}
+		}
+	}
 
 	body, err := json.Marshal(input)
 	if err != nil {
@@ -133,6 +166,19 @@ func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	}
 	defer res.Body.Close()
 
+	if onChunk != nil && res.StatusCode == 200 {
+		textResponse, usage, err := v.readStream(res.Body, onChunk, settings.IsAzure())
+		if err != nil {
+			return nil, err
+		}
+		trimmedResponse := strings.Trim(textResponse, "\n")
+		return &modulecapabilities.GenerateResponse{
+			Result: &trimmedResponse,
+			Debug:  debugInformation,
+			Params: v.getResponseParams(usage),
+		}, nil
+	}
+
 	bodyBytes, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, errors.Wrap(err, "read response body")
@@ -175,6 +221,55 @@ func (v *openai) Generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	}, nil
 }
 
+// readStream reads the server-sent events of a streamed completion and
+// returns the complete response and the token usage, if reported, once the
+// stream is done
+func (v *openai) readStream(body io.Reader, onChunk modulecapabilities.GenerateChunkFn, isAzure bool) (string, *usage, error) {
+	var text strings.Builder
+	var tokenUsage *usage
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		data, ok := strings.CutPrefix(scanner.Text(), "data:")
+		if !ok {
+			// empty lines separate events, other fields are not used
+			continue
+		}
+		data = strings.TrimSpace(data)
+		if data == "[DONE]" {
+			return text.String(), tokenUsage, nil
+		}
+
+		var event generateResponse
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			return "", nil, errors.Wrap(err, "unmarshal response chunk")
+		}
+		if event.Error != nil {
+			return "", nil, v.getError(http.StatusOK, event.Error, isAzure)
+		}
+		if event.Usage != nil {
+			tokenUsage = event.Usage
+		}
+		for _, choice := range event.Choices {
+			chunk := choice.Text
+			if choice.Delta != nil {
+				chunk = choice.Delta.Content
+			}
+			if chunk == "" {
+				continue
+			}
+			text.WriteString(chunk)
+			if err := onChunk(chunk); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", nil, errors.Wrap(err, "read response stream")
+	}
+	return "", nil, errors.New("connection to OpenAI API closed before generation finished")
+}
+
 func (v *openai) getParameters(cfg moduletools.ClassConfig, options interface{}) openaiparams.Params {
 	settings := config.NewClassSettings(cfg)
 
@@ -382,19 +477,24 @@ func (v *openai) getOpenAIOrganization(ctx context.Context) string {
 }
 
 type generateInput struct {
-	Prompt           string    `json:"prompt,omitempty"`
-	Messages         []message `json:"messages,omitempty"`
-	Stream           bool      `json:"stream,omitempty"`
-	Model            string    `json:"model,omitempty"`
-	FrequencyPenalty *float64  `json:"frequency_penalty,omitempty"`
-	Logprobs         *bool     `json:"logprobs,omitempty"`
-	TopLogprobs      *int      `json:"top_logprobs,omitempty"`
-	MaxTokens        *int      `json:"max_tokens,omitempty"`
-	N                *int      `json:"n,omitempty"`
-	PresencePenalty  *float64  `json:"presence_penalty,omitempty"`
-	Stop             []string  `json:"stop,omitempty"`
-	Temperature      *float64  `json:"temperature,omitempty"`
-	TopP             *float64  `json:"top_p,omitempty"`
+	Prompt           string         `json:"prompt,omitempty"`
+	Messages         []message      `json:"messages,omitempty"`
+	Stream           bool           `json:"stream,omitempty"`
+	StreamOptions    *streamOptions `json:"stream_options,omitempty"`
+	Model            string         `json:"model,omitempty"`
+	FrequencyPenalty *float64       `json:"frequency_penalty,omitempty"`
+	Logprobs         *bool          `json:"logprobs,omitempty"`
+	TopLogprobs      *int           `json:"top_logprobs,omitempty"`
+	MaxTokens        *int           `json:"max_tokens,omitempty"`
+	N                *int           `json:"n,omitempty"`
+	PresencePenalty  *float64       `json:"presence_penalty,omitempty"`
+	Stop             []string       `json:"stop,omitempty"`
+	Temperature      *float64       `json:"temperature,omitempty"`
+	TopP             *float64       `json:"top_p,omitempty"`
+}
+
+type streamOptions struct {
+	IncludeUsage bool `json:"include_usage"`
 }
 
 type message struct {
@@ -415,6 +515,7 @@ type choice struct {
 	Logprobs     string
 	Text         string   `json:"text,omitempty"`
 	Message      *message `json:"message,omitempty"`
+	Delta        *message `json:"delta,omitempty"`
 }
 
 type openAIApiError struct {
@@ -14,9 +14,12 @@ package clients
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"strings"
 	"testing"
@@ -142,6 +145,154 @@ func TestGetAnswer(t *testing.T) {
 	})
 }
 
+func TestGetAnswerStream(t *testing.T) {
+	textProperties := []map[string]string{{"prop": "My name is john"}}
+	// the legacy completions API doesn't need the tokenizer to count tokens
+	cfg := fakeClassConfig{"model": "text-davinci-003"}
+	newClient := func(serverURL string) *openai {
+		c := New("openAIApiKey", "", "", 0, nullLogger())
+		c.buildUrl = func(isLegacy bool, resourceName, deploymentID, baseURL, apiVersion string) (string, error) {
+			return url.JoinPath(serverURL, "/v1/completions")
+		}
+		return c
+	}
+	text := func(content string) string {
+		return fmt.Sprintf(`{"choices":[{"index":0,"text":%q}]}`, content)
+	}
+
+	t.Run("when the server streams the answer", func(t *testing.T) {
+		server := httptest.NewServer(&testStreamHandler{t: t, events: []string{
+			text("John"), text(" is"), text(" your name"), "[DONE]",
+		}})
+		defer server.Close()
+
+		var chunks []string
+		res, err := newClient(server.URL).GenerateAllResultsStream(context.Background(), textProperties,
+			"What is my name?", nil, false, cfg, func(chunk string) error {
+				chunks = append(chunks, chunk)
+				return nil
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"John", " is", " your name"}, chunks)
+		assert.Equal(t, "John is your name", *res.Result)
+	})
+
+	t.Run("when the server sends an error while streaming", func(t *testing.T) {
+		server := httptest.NewServer(&testStreamHandler{t: t, events: []string{
+			text("John"), `{"error":{"message":"server overloaded"}}`,
+		}})
+		defer server.Close()
+
+		_, err := newClient(server.URL).GenerateStream(context.Background(), cfg, "What is my name?", nil, false,
+			func(chunk string) error { return nil })
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "server overloaded")
+	})
+
+	t.Run("when the server closes the stream early", func(t *testing.T) {
+		server := httptest.NewServer(&testStreamHandler{t: t, events: []string{text("John")}})
+		defer server.Close()
+
+		_, err := newClient(server.URL).GenerateStream(context.Background(), cfg, "What is my name?", nil, false,
+			func(chunk string) error { return nil })
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "closed before generation finished")
+	})
+
+	t.Run("when the server rejects the request", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"error":{"message":"rate limit reached"}}`))
+		}))
+		defer server.Close()
+
+		_, err := newClient(server.URL).GenerateStream(context.Background(), cfg, "What is my name?", nil, false,
+			func(chunk string) error { return nil })
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "status: 429 error: rate limit reached")
+	})
+
+	t.Run("when the consumer aborts", func(t *testing.T) {
+		server := httptest.NewServer(&testStreamHandler{t: t, events: []string{
+			text("John"), text(" is"), "[DONE]",
+		}})
+		defer server.Close()
+
+		_, err := newClient(server.URL).GenerateStream(context.Background(), cfg, "What is my name?", nil, false,
+			func(chunk string) error { return errors.New("client gone") })
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "client gone")
+	})
+
+	t.Run("chat completion deltas and usage", func(t *testing.T) {
+		events := strings.Join([]string{
+			`data: {"choices":[{"index":0,"delta":{"role":"assistant","content":""}}]}`,
+			`data: {"choices":[{"index":0,"delta":{"content":"John"}}]}`,
+			`data: {"choices":[{"index":0,"delta":{"content":" is"}}]}`,
+			`data: {"choices":[],"usage":{"prompt_tokens":10,"completion_tokens":2,"total_tokens":12}}`,
+			`data: [DONE]`,
+		}, "\n\n")
+
+		var chunks []string
+		result, tokenUsage, err := newClient("").readStream(strings.NewReader(events), func(chunk string) error {
+			chunks = append(chunks, chunk)
+			return nil
+		}, false)
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"John", " is"}, chunks)
+		assert.Equal(t, "John is", result)
+		require.NotNil(t, tokenUsage)
+		assert.Equal(t, 12, *tokenUsage.TotalTokens)
+	})
+}
+
+type fakeClassConfig map[string]interface{}
+
+func (cfg fakeClassConfig) Class() map[string]interface{} {
+	return cfg
+}
+
+func (cfg fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return cfg
+}
+
+func (cfg fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
+
+func (cfg fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (cfg fakeClassConfig) TargetVector() string {
+	return ""
+}
+
+type testStreamHandler struct {
+	t      *testing.T
+	events []string
+}
+
+func (f *testStreamHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var input generateInput
+	require.Nil(f.t, json.NewDecoder(r.Body).Decode(&input))
+	assert.True(f.t, input.Stream)
+	require.NotNil(f.t, input.StreamOptions)
+	assert.True(f.t, input.StreamOptions.IncludeUsage)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	for _, event := range f.events {
+		fmt.Fprintf(w, "data: %s\n\n", event)
+		w.(http.Flusher).Flush()
+	}
+}
+
 type testAnswerHandler struct {
 	t *testing.T
 	// the test handler will report as not ready before the time has passed
@@ -50,6 +50,7 @@ func testGetSchemaWithoutClient(t *testing.T) {
 					"dynamicEfMax":           float64(500),
 					"dynamicEfFactor":        float64(8),
 					"distance":               "cosine",
+					"filterStrategy":         "sweeping",
 					"bq": map[string]interface{}{
 						"enabled": false,
 					},
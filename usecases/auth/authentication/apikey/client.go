@@ -15,6 +15,7 @@ import (
 	"crypto/sha256"
 	"crypto/subtle"
 	"fmt"
+	"time"
 
 	errors "github.com/go-openapi/errors"
 	"github.com/weaviate/weaviate/entities/models"
@@ -24,6 +25,7 @@ import (
 type Client struct {
 	config     config.APIKey
 	keystorage [][sha256.Size]byte
+	dynamic    KeyStore
 }
 
 func New(cfg config.Config) (*Client, error) {
@@ -53,6 +55,11 @@ func (c *Client) validateConfig() error {
 		return nil
 	}
 
+	if c.config.DynamicEnabled() && len(c.config.AllowedKeys) == 0 && len(c.config.Users) == 0 {
+		// all keys are created at runtime
+		return nil
+	}
+
 	if len(c.config.AllowedKeys) < 1 {
 		return fmt.Errorf("need at least one valid allowed key")
 	}
@@ -86,13 +93,29 @@ func (c *Client) ValidateAndExtract(token string, scopes []string) (*models.Prin
 	}
 
 	tokenPos, ok := c.isTokenAllowed(token)
-	if !ok {
-		return nil, errors.New(401, "invalid api key, please provide a valid api key")
+	if ok {
+		return &models.Principal{
+			Username: c.getUser(tokenPos),
+		}, nil
+	}
+
+	if c.dynamic != nil {
+		key := c.dynamic.GetAPIKeyByHash(HashKey(token))
+		if key != nil {
+			if key.ExpiresAt != nil && !time.Now().Before(time.Time(*key.ExpiresAt)) {
+				return nil, errors.New(401, "api key has expired, please provide a valid api key")
+			}
+			return &models.Principal{Username: *key.User}, nil
+		}
 	}
 
-	return &models.Principal{
-		Username: c.getUser(tokenPos),
-	}, nil
+	return nil, errors.New(401, "invalid api key, please provide a valid api key")
+}
+
+// SetKeyStore enables dynamic API keys. The store is only available once the
+// cluster service was started, which happens after the client is created.
+func (c *Client) SetKeyStore(store KeyStore) {
+	c.dynamic = store
 }
 
 func (c *Client) isTokenAllowed(token string) (int, bool) {
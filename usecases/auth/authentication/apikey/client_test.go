@@ -13,6 +13,9 @@ package apikey
 
 import (
 	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -164,6 +167,65 @@ func Test_APIKeyClient(t *testing.T) {
 			expectConfigErr:    true,
 			expectConfigErrMsg: "length of users and keys must match, alternatively provide single user for all keys",
 		},
+		{
+			name: "dynamic keys only",
+			config: config.APIKey{
+				Enabled: true,
+				Dynamic: true,
+			},
+			expectConfigErr: false,
+			validate: func(t *testing.T, c *Client) {
+				store := newFakeKeyStore()
+				store.add("dynamic-key", "alice", nil)
+				expired := strfmt.DateTime(time.Now().Add(-time.Minute))
+				store.add("expired-key", "bob", &expired)
+				c.SetKeyStore(store)
+
+				p, err := c.ValidateAndExtract("dynamic-key", nil)
+				require.Nil(t, err)
+				assert.Equal(t, "alice", p.Username)
+
+				_, err = c.ValidateAndExtract("expired-key", nil)
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), "expired")
+				_, err = c.ValidateAndExtract("other-key", nil)
+				require.NotNil(t, err)
+			},
+		},
+		{
+			name: "static and dynamic keys",
+			config: config.APIKey{
+				Enabled:     true,
+				Dynamic:     true,
+				AllowedKeys: []string{"secret-key"},
+				Users:       []string{"jane"},
+			},
+			expectConfigErr: false,
+			validate: func(t *testing.T, c *Client) {
+				store := newFakeKeyStore()
+				store.add("dynamic-key", "alice", nil)
+				c.SetKeyStore(store)
+
+				p, err := c.ValidateAndExtract("secret-key", nil)
+				require.Nil(t, err)
+				assert.Equal(t, "jane", p.Username)
+
+				p, err = c.ValidateAndExtract("dynamic-key", nil)
+				require.Nil(t, err)
+				assert.Equal(t, "alice", p.Username)
+			},
+		},
+		{
+			name: "dynamic keys with invalid static key",
+			config: config.APIKey{
+				Enabled:     true,
+				Dynamic:     true,
+				AllowedKeys: []string{""},
+				Users:       []string{"jane"},
+			},
+			expectConfigErr:    true,
+			expectConfigErrMsg: "keys cannot have length 0",
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,195 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+)
+
+var (
+	ErrNotFound      = errors.New("api key not found")
+	ErrUnprocessable = errors.New("unprocessable")
+)
+
+// secretSize is the number of random bytes of a generated secret
+const secretSize = 32
+
+// KeyStore provides the dynamic API keys
+type KeyStore interface {
+	GetAPIKeys() []*models.APIKey
+	GetAPIKey(id string) *models.APIKey
+	GetAPIKeyByHash(hash string) *models.APIKey
+}
+
+// KeyController replicates changes to the dynamic API keys to every node in
+// the cluster
+type KeyController interface {
+	CreateAPIKey(key *models.APIKey, hash string) error
+	RotateAPIKey(id, hash string, rotatedAt strfmt.DateTime) error
+	RevokeAPIKey(id string) error
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+}
+
+// impersonationAuthorizer is implemented by authorizers which know the
+// permissions of every user, such as rbac. A key acts as its user, so
+// creating one for another user must not grant more than the creator holds.
+type impersonationAuthorizer interface {
+	AuthorizeImpersonation(principal *models.Principal, user string) error
+}
+
+// HashKey returns the hex encoded SHA-256 hash under which a secret is stored
+func HashKey(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(hash[:])
+}
+
+// Manager implements the management of dynamic API keys. Listing is
+// authorized on "apikeys", all other operations on "apikeys/<user>" and
+// "apikeys/<user>/<id>", so that the keys of a single user can be delegated.
+type Manager struct {
+	authorizer authorizer
+	store      KeyStore
+	controller KeyController
+	now        func() time.Time
+}
+
+func NewManager(authorizer authorizer, store KeyStore, controller KeyController) *Manager {
+	return &Manager{
+		authorizer: authorizer,
+		store:      store,
+		controller: controller,
+		now:        time.Now,
+	}
+}
+
+// List returns the metadata of all keys
+func (m *Manager) List(principal *models.Principal) ([]*models.APIKey, error) {
+	if err := m.authorizer.Authorize(principal, "list", "apikeys"); err != nil {
+		return nil, err
+	}
+	return m.store.GetAPIKeys(), nil
+}
+
+// Create creates a key for the user of the passed key. The returned key
+// contains the secret, which is not stored and can't be retrieved again.
+func (m *Manager) Create(principal *models.Principal, key *models.APIKey) (*models.APIKey, error) {
+	if key == nil || key.User == nil || *key.User == "" {
+		return nil, fmt.Errorf("%w: user is required", ErrUnprocessable)
+	}
+	if *key.User == rbac.AnonymousPrincipalUsername {
+		return nil, fmt.Errorf("%w: user %q is reserved for anonymous access", ErrUnprocessable, *key.User)
+	}
+	if err := m.authorizer.Authorize(principal, "create", userPath(*key.User)); err != nil {
+		return nil, err
+	}
+	if ia, ok := m.authorizer.(impersonationAuthorizer); ok {
+		if err := ia.AuthorizeImpersonation(principal, *key.User); err != nil {
+			return nil, err
+		}
+	}
+
+	now := m.now()
+	if key.ExpiresAt != nil && !now.Before(time.Time(*key.ExpiresAt)) {
+		return nil, fmt.Errorf("%w: expiresAt must be in the future", ErrUnprocessable)
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, err
+	}
+	created := &models.APIKey{
+		ID:          uuid.New().String(),
+		User:        key.User,
+		Description: key.Description,
+		CreatedAt:   strfmt.DateTime(now.UTC()),
+		ExpiresAt:   key.ExpiresAt,
+	}
+	if err := m.controller.CreateAPIKey(created, HashKey(secret)); err != nil {
+		return nil, err
+	}
+
+	created.Key = secret
+	return created, nil
+}
+
+// Rotate replaces the secret of a key. The returned key contains the new
+// secret.
+func (m *Manager) Rotate(principal *models.Principal, id string) (*models.APIKey, error) {
+	key, err := m.authorizedKey(principal, "update", id)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return nil, err
+	}
+	rotatedAt := strfmt.DateTime(m.now().UTC())
+	if err := m.controller.RotateAPIKey(id, HashKey(secret), rotatedAt); err != nil {
+		return nil, err
+	}
+
+	key.RotatedAt = &rotatedAt
+	key.Key = secret
+	return key, nil
+}
+
+// Revoke deletes a key
+func (m *Manager) Revoke(principal *models.Principal, id string) error {
+	if _, err := m.authorizedKey(principal, "delete", id); err != nil {
+		return err
+	}
+	return m.controller.RevokeAPIKey(id)
+}
+
+// authorizedKey returns the key with the given id if the principal is allowed
+// to perform verb on it. Unknown ids are authorized on "apikeys" so that
+// they don't reveal anything to unauthorized principals.
+func (m *Manager) authorizedKey(principal *models.Principal, verb, id string) (*models.APIKey, error) {
+	key := m.store.GetAPIKey(id)
+	if key == nil {
+		if err := m.authorizer.Authorize(principal, verb, "apikeys"); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %q", ErrNotFound, id)
+	}
+
+	if err := m.authorizer.Authorize(principal, verb, keyPath(*key.User, id)); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func newSecret() (string, error) {
+	b := make([]byte, secretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate secret: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func userPath(user string) string    { return "apikeys/" + user }
+func keyPath(user, id string) string { return userPath(user) + "/" + id }
@@ -0,0 +1,244 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package apikey
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
+)
+
+// fakeKeyStore implements both KeyStore and KeyController
+type fakeKeyStore struct {
+	keys   map[string]*models.APIKey
+	hashes map[string]string
+}
+
+func newFakeKeyStore() *fakeKeyStore {
+	return &fakeKeyStore{keys: map[string]*models.APIKey{}, hashes: map[string]string{}}
+}
+
+func (f *fakeKeyStore) add(secret, user string, expiresAt *strfmt.DateTime) *models.APIKey {
+	key := &models.APIKey{ID: secret + "-id", User: &user, ExpiresAt: expiresAt}
+	f.keys[key.ID] = key
+	f.hashes[HashKey(secret)] = key.ID
+	return key
+}
+
+func (f *fakeKeyStore) GetAPIKeys() []*models.APIKey {
+	keys := make([]*models.APIKey, 0, len(f.keys))
+	for _, key := range f.keys {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func (f *fakeKeyStore) GetAPIKey(id string) *models.APIKey {
+	if key, ok := f.keys[id]; ok {
+		copied := *key
+		return &copied
+	}
+	return nil
+}
+
+func (f *fakeKeyStore) GetAPIKeyByHash(hash string) *models.APIKey {
+	return f.GetAPIKey(f.hashes[hash])
+}
+
+func (f *fakeKeyStore) CreateAPIKey(key *models.APIKey, hash string) error {
+	copied := *key
+	f.keys[key.ID] = &copied
+	f.hashes[hash] = key.ID
+	return nil
+}
+
+func (f *fakeKeyStore) RotateAPIKey(id, hash string, rotatedAt strfmt.DateTime) error {
+	for h, keyID := range f.hashes {
+		if keyID == id {
+			delete(f.hashes, h)
+		}
+	}
+	f.hashes[hash] = id
+	f.keys[id].RotatedAt = &rotatedAt
+	return nil
+}
+
+func (f *fakeKeyStore) RevokeAPIKey(id string) error {
+	for h, keyID := range f.hashes {
+		if keyID == id {
+			delete(f.hashes, h)
+		}
+	}
+	delete(f.keys, id)
+	return nil
+}
+
+// fakeRoleReader assigns roles to users directly
+type fakeRoleReader struct {
+	users map[string][]*models.Role
+}
+
+func (f *fakeRoleReader) GetRoles(names ...string) []*models.Role { return nil }
+
+func (f *fakeRoleReader) GetRolesForUser(user string) []*models.Role { return f.users[user] }
+
+func (f *fakeRoleReader) GetRolesForGroup(group string) []*models.Role { return nil }
+
+// fakeAuthorizer allows every request of the admin and requests of other
+// users on their own keys
+type fakeAuthorizer struct{}
+
+func (fakeAuthorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	own := userPath(principal.Username)
+	if principal.Username == "admin" || resource == own || strings.HasPrefix(resource, own+"/") {
+		return nil
+	}
+	return errors.NewForbidden(principal, verb, resource)
+}
+
+func Test_APIKeyManager(t *testing.T) {
+	admin := &models.Principal{Username: "admin"}
+	alice := &models.Principal{Username: "alice"}
+	user := func(name string) *string { return &name }
+
+	t.Run("create and authenticate", func(t *testing.T) {
+		store := newFakeKeyStore()
+		m := NewManager(fakeAuthorizer{}, store, store)
+
+		key, err := m.Create(alice, &models.APIKey{User: user("alice"), Description: "ci"})
+		require.Nil(t, err)
+		assert.NotEmpty(t, key.ID)
+		assert.NotEmpty(t, key.Key)
+		assert.Equal(t, "ci", key.Description)
+		assert.False(t, time.Time(key.CreatedAt).IsZero())
+
+		stored := store.GetAPIKeyByHash(HashKey(key.Key))
+		require.NotNil(t, stored)
+		assert.Equal(t, key.ID, stored.ID)
+		assert.Empty(t, stored.Key, "the secret must not be stored")
+	})
+
+	t.Run("create without user", func(t *testing.T) {
+		store := newFakeKeyStore()
+		m := NewManager(fakeAuthorizer{}, store, store)
+
+		_, err := m.Create(admin, &models.APIKey{})
+		assert.ErrorIs(t, err, ErrUnprocessable)
+	})
+
+	t.Run("create key for the anonymous user", func(t *testing.T) {
+		store := newFakeKeyStore()
+		m := NewManager(fakeAuthorizer{}, store, store)
+
+		_, err := m.Create(admin, &models.APIKey{User: user("anonymous")})
+		assert.ErrorIs(t, err, ErrUnprocessable)
+		assert.Empty(t, store.GetAPIKeys())
+	})
+
+	t.Run("create expired key", func(t *testing.T) {
+		store := newFakeKeyStore()
+		m := NewManager(fakeAuthorizer{}, store, store)
+
+		expiresAt := strfmt.DateTime(time.Now().Add(-time.Hour))
+		_, err := m.Create(admin, &models.APIKey{User: user("alice"), ExpiresAt: &expiresAt})
+		assert.ErrorIs(t, err, ErrUnprocessable)
+	})
+
+	t.Run("create key for other user", func(t *testing.T) {
+		store := newFakeKeyStore()
+		m := NewManager(fakeAuthorizer{}, store, store)
+
+		_, err := m.Create(alice, &models.APIKey{User: user("bob")})
+		assert.Equal(t, errors.NewForbidden(alice, "create", "apikeys/bob"), err)
+		assert.Empty(t, store.keys)
+	})
+
+	t.Run("create key for root user", func(t *testing.T) {
+		reader := &fakeRoleReader{users: map[string][]*models.Role{
+			"keymanager": {{Permissions: []*models.Permission{{
+				Resource: user("apikeys/**"), Actions: []string{rbac.AllActions},
+			}}}},
+		}}
+		authorizer := rbac.New(rbac.Config{Enabled: true, RootUsers: []string{"root"}}, reader)
+		store := newFakeKeyStore()
+		m := NewManager(authorizer, store, store)
+
+		keyManager := &models.Principal{Username: "keymanager"}
+		_, err := m.Create(keyManager, &models.APIKey{User: user("root")})
+		assert.Equal(t, errors.NewForbidden(keyManager, "impersonate", "users/root"), err)
+		assert.Empty(t, store.keys)
+
+		_, err = m.Create(keyManager, &models.APIKey{User: user("keymanager")})
+		assert.Nil(t, err)
+	})
+
+	t.Run("rotate", func(t *testing.T) {
+		store := newFakeKeyStore()
+		m := NewManager(fakeAuthorizer{}, store, store)
+		key, err := m.Create(admin, &models.APIKey{User: user("alice")})
+		require.Nil(t, err)
+
+		rotated, err := m.Rotate(alice, key.ID)
+		require.Nil(t, err)
+		assert.Equal(t, key.ID, rotated.ID)
+		assert.NotEqual(t, key.Key, rotated.Key)
+		assert.NotNil(t, rotated.RotatedAt)
+		assert.Nil(t, store.GetAPIKeyByHash(HashKey(key.Key)))
+		assert.NotNil(t, store.GetAPIKeyByHash(HashKey(rotated.Key)))
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		store := newFakeKeyStore()
+		m := NewManager(fakeAuthorizer{}, store, store)
+		key, err := m.Create(admin, &models.APIKey{User: user("bob")})
+		require.Nil(t, err)
+
+		err = m.Revoke(alice, key.ID)
+		assert.Equal(t, errors.NewForbidden(alice, "delete", "apikeys/bob/"+key.ID), err)
+
+		require.Nil(t, m.Revoke(admin, key.ID))
+		assert.Nil(t, store.GetAPIKeyByHash(HashKey(key.Key)))
+
+		err = m.Revoke(admin, key.ID)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("unknown keys are not revealed", func(t *testing.T) {
+		store := newFakeKeyStore()
+		m := NewManager(fakeAuthorizer{}, store, store)
+
+		_, err := m.Rotate(alice, "unknown")
+		assert.Equal(t, errors.NewForbidden(alice, "update", "apikeys"), err)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		store := newFakeKeyStore()
+		m := NewManager(fakeAuthorizer{}, store, store)
+		_, err := m.Create(admin, &models.APIKey{User: user("alice")})
+		require.Nil(t, err)
+
+		keys, err := m.List(admin)
+		require.Nil(t, err)
+		require.Len(t, keys, 1)
+		assert.Empty(t, keys[0].Key)
+
+		_, err = m.List(alice)
+		assert.Equal(t, errors.NewForbidden(alice, "list", "apikeys"), err)
+	})
+}
@@ -14,6 +14,7 @@ package authorization
 import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -24,12 +25,17 @@ type Authorizer interface {
 	Authorize(principal *models.Principal, verb, resource string) error
 }
 
-// New Authorizer based on the application-wide config
-func New(cfg config.Config) Authorizer {
+// New Authorizer based on the application-wide config, roles are only used
+// if rbac is enabled
+func New(cfg config.Config, roles rbac.RoleReader) Authorizer {
 	if cfg.Authorization.AdminList.Enabled {
 		return adminlist.New(cfg.Authorization.AdminList)
 	}
 
+	if cfg.Authorization.RBAC.Enabled {
+		return rbac.New(cfg.Authorization.RBAC, roles)
+	}
+
 	return &DummyAuthorizer{}
 }
 
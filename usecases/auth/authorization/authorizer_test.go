@@ -16,6 +16,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/weaviate/weaviate/usecases/auth/authorization/adminlist"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/rbac"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -23,7 +24,7 @@ func Test_Authorizer(t *testing.T) {
 	t.Run("when no authz is configured", func(t *testing.T) {
 		cfg := config.Config{}
 
-		authorizer := New(cfg)
+		authorizer := New(cfg, nil)
 
 		t.Run("it uses the dummy authorizer", func(t *testing.T) {
 			_, ok := authorizer.(*DummyAuthorizer)
@@ -45,9 +46,25 @@ func Test_Authorizer(t *testing.T) {
 			},
 		}
 
-		authorizer := New(cfg)
+		authorizer := New(cfg, nil)
 
 		_, ok := authorizer.(*adminlist.Authorizer)
 		assert.Equal(t, true, ok)
 	})
+
+	t.Run("when rbac is configured", func(t *testing.T) {
+		cfg := config.Config{
+			Authorization: config.Authorization{
+				RBAC: rbac.Config{
+					Enabled:   true,
+					RootUsers: []string{"alice"},
+				},
+			},
+		}
+
+		authorizer := New(cfg, nil)
+
+		_, ok := authorizer.(*rbac.Authorizer)
+		assert.Equal(t, true, ok)
+	})
 }
@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+// AnonymousPrincipalUsername is the user whose roles are granted to requests
+// without a principal. The name is reserved: an authenticated principal with
+// this username is never granted anything, so neither can obtain the
+// permissions of the other.
+const AnonymousPrincipalUsername = "anonymous"
+
+// RoleReader provides the roles and their assignments to users and groups
+type RoleReader interface {
+	GetRoles(names ...string) []*models.Role
+	GetRolesForUser(user string) []*models.Role
+	GetRolesForGroup(group string) []*models.Role
+}
+
+// Authorizer grants access based on the roles assigned to a user and the
+// groups of the user
+type Authorizer struct {
+	rootUsers  map[string]struct{}
+	rootGroups map[string]struct{}
+	roles      RoleReader
+}
+
+// New Authorizer using role-based access control
+func New(cfg Config, roles RoleReader) *Authorizer {
+	a := &Authorizer{
+		rootUsers:  make(map[string]struct{}, len(cfg.RootUsers)),
+		rootGroups: make(map[string]struct{}, len(cfg.RootGroups)),
+		roles:      roles,
+	}
+	for _, user := range cfg.RootUsers {
+		a.rootUsers[user] = struct{}{}
+	}
+	for _, group := range cfg.RootGroups {
+		a.rootGroups[group] = struct{}{}
+	}
+	return a
+}
+
+// Authorize gives full access to root users and groups. Any other subject is
+// allowed if one of the permissions of a role assigned to the user or one of
+// their groups allows the verb on the resource.
+func (a *Authorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if principal != nil && principal.Username == AnonymousPrincipalUsername {
+		return errors.NewForbidden(principal, verb, resource)
+	}
+	if principal == nil {
+		principal = &models.Principal{Username: AnonymousPrincipalUsername}
+	}
+
+	if a.isRoot(principal) {
+		return nil
+	}
+	for _, roles := range a.rolesOf(principal) {
+		if anyAllows(roles, verb, resource) {
+			return nil
+		}
+	}
+
+	return errors.NewForbidden(principal, verb, resource)
+}
+
+// AuthorizePermissions makes sure that the principal holds all of the
+// permissions itself, so that nobody can grant permissions they don't have by
+// creating or assigning a role. Every action of a permission must be allowed
+// by a single permission of the principal on all resources it matches.
+func (a *Authorizer) AuthorizePermissions(principal *models.Principal, permissions []*models.Permission) error {
+	reserved := principal != nil && principal.Username == AnonymousPrincipalUsername
+	if principal == nil {
+		principal = &models.Principal{Username: AnonymousPrincipalUsername}
+	}
+
+	var held [][]*models.Role
+	if !reserved {
+		if a.isRoot(principal) {
+			return nil
+		}
+		held = a.rolesOf(principal)
+	}
+	for _, perm := range permissions {
+		if perm == nil || perm.Resource == nil {
+			continue
+		}
+	actions:
+		for _, action := range perm.Actions {
+			for _, roles := range held {
+				if anyCovers(roles, action, *perm.Resource) {
+					continue actions
+				}
+			}
+			return errors.NewForbidden(principal, action, *perm.Resource)
+		}
+	}
+	return nil
+}
+
+// AuthorizeImpersonation makes sure that the principal may act as the user,
+// e.g. by creating an API key for them. A principal may always act as
+// themselves, as any other user only if they hold all of the permissions of
+// that user. Root users and the anonymous user can't be impersonated.
+func (a *Authorizer) AuthorizeImpersonation(principal *models.Principal, user string) error {
+	if principal == nil {
+		principal = &models.Principal{Username: AnonymousPrincipalUsername}
+	}
+	if principal.Username == user && user != AnonymousPrincipalUsername {
+		return nil
+	}
+	if _, ok := a.rootUsers[user]; ok || user == AnonymousPrincipalUsername {
+		return errors.NewForbidden(principal, "impersonate", "users/"+user)
+	}
+
+	var permissions []*models.Permission
+	for _, role := range a.roles.GetRolesForUser(user) {
+		permissions = append(permissions, role.Permissions...)
+	}
+	return a.AuthorizePermissions(principal, permissions)
+}
+
+func (a *Authorizer) isRoot(principal *models.Principal) bool {
+	if _, ok := a.rootUsers[principal.Username]; ok {
+		return true
+	}
+	for _, group := range principal.Groups {
+		if _, ok := a.rootGroups[group]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// rolesOf returns the roles of the user followed by the roles of each of
+// their groups
+func (a *Authorizer) rolesOf(principal *models.Principal) [][]*models.Role {
+	roles := [][]*models.Role{a.roles.GetRolesForUser(principal.Username)}
+	for _, group := range principal.Groups {
+		roles = append(roles, a.roles.GetRolesForGroup(group))
+	}
+	return roles
+}
+
+func anyAllows(roles []*models.Role, verb, resource string) bool {
+	for _, role := range roles {
+		for _, perm := range role.Permissions {
+			if allows(perm, verb, resource) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func anyCovers(roles []*models.Role, action, pattern string) bool {
+	for _, role := range roles {
+		for _, perm := range role.Permissions {
+			if covers(perm, action, pattern) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,353 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+type fakeRoleReader struct {
+	roles  map[string]*models.Role
+	users  map[string][]string
+	groups map[string][]string
+}
+
+func (f *fakeRoleReader) GetRoles(names ...string) []*models.Role {
+	if len(names) == 0 {
+		for name := range f.roles {
+			names = append(names, name)
+		}
+	}
+	return f.byName(names)
+}
+
+func (f *fakeRoleReader) GetRolesForUser(user string) []*models.Role {
+	return f.byName(f.users[user])
+}
+
+func (f *fakeRoleReader) GetRolesForGroup(group string) []*models.Role {
+	return f.byName(f.groups[group])
+}
+
+func (f *fakeRoleReader) byName(names []string) []*models.Role {
+	var roles []*models.Role
+	for _, name := range names {
+		if role, ok := f.roles[name]; ok {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+func newRole(name string, perms ...*models.Permission) *models.Role {
+	return &models.Role{Name: &name, Permissions: perms}
+}
+
+func newPermission(resource string, actions ...string) *models.Permission {
+	return &models.Permission{Resource: &resource, Actions: actions}
+}
+
+func Test_RBAC_Authorizer(t *testing.T) {
+	reader := &fakeRoleReader{
+		roles: map[string]*models.Role{
+			"reader": newRole("reader", newPermission("objects/Article/**", "get", "list")),
+			"editor": newRole("editor", newPermission("schema/Article", AllActions)),
+			"public": newRole("public", newPermission("meta", "get")),
+		},
+		users: map[string][]string{
+			"alice":                    {"reader"},
+			AnonymousPrincipalUsername: {"public"},
+		},
+		groups: map[string][]string{
+			"editors": {"editor"},
+		},
+	}
+	cfg := Config{Enabled: true, RootUsers: []string{"root"}, RootGroups: []string{"admins"}}
+	authorizer := New(cfg, reader)
+
+	tests := []struct {
+		name      string
+		principal *models.Principal
+		verb      string
+		resource  string
+		allowed   bool
+	}{
+		{
+			name:      "root user",
+			principal: &models.Principal{Username: "root"},
+			verb:      "delete",
+			resource:  "schema/Article",
+			allowed:   true,
+		},
+		{
+			name:      "member of root group",
+			principal: &models.Principal{Username: "bob", Groups: []string{"admins"}},
+			verb:      "delete",
+			resource:  "schema/Article",
+			allowed:   true,
+		},
+		{
+			name:      "user role allows object",
+			principal: &models.Principal{Username: "alice"},
+			verb:      "get",
+			resource:  "objects/Article/tenant1/123",
+			allowed:   true,
+		},
+		{
+			name:      "user role allows listing the class",
+			principal: &models.Principal{Username: "alice"},
+			verb:      "list",
+			resource:  "objects/Article",
+			allowed:   true,
+		},
+		{
+			name:      "user role does not allow verb",
+			principal: &models.Principal{Username: "alice"},
+			verb:      "delete",
+			resource:  "objects/Article/123",
+		},
+		{
+			name:      "user role does not allow other class",
+			principal: &models.Principal{Username: "alice"},
+			verb:      "get",
+			resource:  "objects/Paragraph/123",
+		},
+		{
+			name:      "group role allows all actions",
+			principal: &models.Principal{Username: "bob", Groups: []string{"readers", "editors"}},
+			verb:      "update",
+			resource:  "schema/Article",
+			allowed:   true,
+		},
+		{
+			name:      "group role does not allow sub resources",
+			principal: &models.Principal{Username: "bob", Groups: []string{"editors"}},
+			verb:      "update",
+			resource:  "schema/Article/tenants",
+		},
+		{
+			name:     "anonymous",
+			verb:     "get",
+			resource: "meta",
+			allowed:  true,
+		},
+		{
+			name:     "anonymous without permission",
+			verb:     "get",
+			resource: "schema",
+		},
+		{
+			name:      "authenticated user with the anonymous name",
+			principal: &models.Principal{Username: AnonymousPrincipalUsername},
+			verb:      "get",
+			resource:  "meta",
+		},
+		{
+			name:      "user without roles",
+			principal: &models.Principal{Username: "carol"},
+			verb:      "get",
+			resource:  "meta",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := authorizer.Authorize(test.principal, test.verb, test.resource)
+			if test.allowed {
+				assert.Nil(t, err)
+				return
+			}
+
+			principal := test.principal
+			if principal == nil {
+				principal = &models.Principal{Username: AnonymousPrincipalUsername}
+			}
+			assert.Equal(t, errors.NewForbidden(principal, test.verb, test.resource), err)
+		})
+	}
+}
+
+func Test_RBAC_AuthorizePermissions(t *testing.T) {
+	reader := &fakeRoleReader{
+		roles: map[string]*models.Role{
+			"reader": newRole("reader", newPermission("objects/Article/**", "get", "list")),
+			"editor": newRole("editor", newPermission("schema/Article*", AllActions)),
+			"public": newRole("public", newPermission("meta", "get")),
+		},
+		users: map[string][]string{
+			"alice":                    {"reader"},
+			AnonymousPrincipalUsername: {"public"},
+		},
+		groups: map[string][]string{
+			"editors": {"editor"},
+		},
+	}
+	cfg := Config{Enabled: true, RootUsers: []string{"root"}}
+	authorizer := New(cfg, reader)
+
+	tests := []struct {
+		name        string
+		principal   *models.Principal
+		permissions []*models.Permission
+		allowed     bool
+	}{
+		{
+			name:        "root user",
+			principal:   &models.Principal{Username: "root"},
+			permissions: []*models.Permission{newPermission("**", AllActions)},
+			allowed:     true,
+		},
+		{
+			name:        "held permission",
+			principal:   &models.Principal{Username: "alice"},
+			permissions: []*models.Permission{newPermission("objects/Article/**", "get")},
+			allowed:     true,
+		},
+		{
+			name:        "narrower resource",
+			principal:   &models.Principal{Username: "alice"},
+			permissions: []*models.Permission{newPermission("objects/Article/tenant1/*", "list")},
+			allowed:     true,
+		},
+		{
+			name:      "permissions of user and group",
+			principal: &models.Principal{Username: "alice", Groups: []string{"editors"}},
+			permissions: []*models.Permission{
+				newPermission("objects/Article/*", "get"),
+				newPermission("schema/ArticleV2", "delete"),
+			},
+			allowed: true,
+		},
+		{
+			name:        "verb not held",
+			principal:   &models.Principal{Username: "alice"},
+			permissions: []*models.Permission{newPermission("objects/Article/**", "delete")},
+		},
+		{
+			name:        "all actions not held",
+			principal:   &models.Principal{Username: "alice"},
+			permissions: []*models.Permission{newPermission("objects/Article/**", AllActions)},
+		},
+		{
+			name:        "admin role",
+			principal:   &models.Principal{Username: "alice"},
+			permissions: []*models.Permission{newPermission("**", "get")},
+		},
+		{
+			name:        "wider resource",
+			principal:   &models.Principal{Username: "alice"},
+			permissions: []*models.Permission{newPermission("objects/*/**", "get")},
+		},
+		{
+			name:        "wildcard segment does not cover multiple segments",
+			principal:   &models.Principal{Username: "bob", Groups: []string{"editors"}},
+			permissions: []*models.Permission{newPermission("schema/Article*/**", "get")},
+		},
+		{
+			name:        "parent of a wildcard resource",
+			principal:   &models.Principal{Username: "alice"},
+			permissions: []*models.Permission{newPermission("objects/*", "list")},
+		},
+		{
+			name:        "authenticated user with the anonymous name",
+			principal:   &models.Principal{Username: AnonymousPrincipalUsername},
+			permissions: []*models.Permission{newPermission("meta", "get")},
+		},
+		{
+			name:        "anonymous",
+			permissions: []*models.Permission{newPermission("meta", "get")},
+			allowed:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := authorizer.AuthorizePermissions(test.principal, test.permissions)
+			if test.allowed {
+				assert.Nil(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func Test_RBAC_AuthorizeImpersonation(t *testing.T) {
+	reader := &fakeRoleReader{
+		roles: map[string]*models.Role{
+			"reader":     newRole("reader", newPermission("objects/Article/**", "get", "list")),
+			"keyManager": newRole("keyManager", newPermission("apikeys/**", AllActions)),
+		},
+		users: map[string][]string{
+			"alice": {"reader", "keyManager"},
+			"bob":   {"reader"},
+			"carol": {"keyManager"},
+		},
+	}
+	cfg := Config{Enabled: true, RootUsers: []string{"root"}}
+	authorizer := New(cfg, reader)
+
+	tests := []struct {
+		name      string
+		principal *models.Principal
+		user      string
+		allowed   bool
+	}{
+		{
+			name:      "themselves",
+			principal: &models.Principal{Username: "carol"},
+			user:      "carol",
+			allowed:   true,
+		},
+		{
+			name:      "user with held permissions",
+			principal: &models.Principal{Username: "alice"},
+			user:      "bob",
+			allowed:   true,
+		},
+		{
+			name:      "user with permissions not held",
+			principal: &models.Principal{Username: "carol"},
+			user:      "alice",
+		},
+		{
+			name:      "root user",
+			principal: &models.Principal{Username: "alice"},
+			user:      "root",
+		},
+		{
+			name:      "anonymous user",
+			principal: &models.Principal{Username: "alice"},
+			user:      AnonymousPrincipalUsername,
+		},
+		{
+			name:      "anonymous user by themselves",
+			principal: &models.Principal{Username: AnonymousPrincipalUsername},
+			user:      AnonymousPrincipalUsername,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := authorizer.AuthorizeImpersonation(test.principal, test.user)
+			if test.allowed {
+				assert.Nil(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Validation(t *testing.T) {
+	t.Run("with a root user", func(t *testing.T) {
+		cfg := Config{Enabled: true, RootUsers: []string{"alice"}}
+		assert.Nil(t, cfg.Validate())
+	})
+
+	t.Run("with a root group", func(t *testing.T) {
+		cfg := Config{Enabled: true, RootGroups: []string{"admins"}}
+		assert.Nil(t, cfg.Validate())
+	})
+
+	t.Run("without root users and groups", func(t *testing.T) {
+		cfg := Config{Enabled: true}
+		assert.ErrorContains(t, cfg.Validate(), "at least one root user or root group is required")
+	})
+}
@@ -0,0 +1,209 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+var (
+	ErrRoleNotFound  = errors.New("role not found")
+	ErrRoleExists    = errors.New("role already exists")
+	ErrUnprocessable = errors.New("unprocessable")
+)
+
+// Controller replicates changes to the roles and their assignments to every
+// node in the cluster
+type Controller interface {
+	UpsertRoles(roles ...*models.Role) error
+	DeleteRoles(names ...string) error
+	AssignRolesToUser(user string, roles ...string) error
+	RevokeRolesFromUser(user string, roles ...string) error
+	AssignRolesToGroup(group string, roles ...string) error
+	RevokeRolesFromGroup(group string, roles ...string) error
+}
+
+type authorizer interface {
+	Authorize(principal *models.Principal, verb, resource string) error
+	AuthorizePermissions(principal *models.Principal, permissions []*models.Permission) error
+}
+
+// Manager implements the management of roles and role assignments. Roles are
+// authorized on "roles" and "roles/<name>", assignments on "users/<name>" and
+// "groups/<name>" as well as "update" on each assigned role. Nobody can create
+// or assign a role with permissions they don't hold themselves.
+type Manager struct {
+	authorizer authorizer
+	reader     RoleReader
+	controller Controller
+}
+
+func NewManager(authorizer authorizer, reader RoleReader, controller Controller) *Manager {
+	return &Manager{authorizer: authorizer, reader: reader, controller: controller}
+}
+
+func (m *Manager) GetRoles(principal *models.Principal) ([]*models.Role, error) {
+	if err := m.authorizer.Authorize(principal, "list", "roles"); err != nil {
+		return nil, err
+	}
+	return m.reader.GetRoles(), nil
+}
+
+func (m *Manager) GetRole(principal *models.Principal, name string) (*models.Role, error) {
+	if err := m.authorizer.Authorize(principal, "get", rolePath(name)); err != nil {
+		return nil, err
+	}
+	return m.getRole(name)
+}
+
+func (m *Manager) CreateRole(principal *models.Principal, role *models.Role) error {
+	if role == nil || role.Name == nil {
+		return fmt.Errorf("%w: role name is required", ErrUnprocessable)
+	}
+	if err := m.authorizer.Authorize(principal, "create", rolePath(*role.Name)); err != nil {
+		return err
+	}
+	if err := ValidateRole(role); err != nil {
+		return fmt.Errorf("%w: %w", ErrUnprocessable, err)
+	}
+	if err := m.authorizer.AuthorizePermissions(principal, role.Permissions); err != nil {
+		return err
+	}
+	if _, err := m.getRole(*role.Name); err == nil {
+		return fmt.Errorf("%w: %q", ErrRoleExists, *role.Name)
+	}
+	return m.controller.UpsertRoles(role)
+}
+
+// UpdateRole replaces the permissions of an existing role
+func (m *Manager) UpdateRole(principal *models.Principal, name string, role *models.Role) error {
+	if err := m.authorizer.Authorize(principal, "update", rolePath(name)); err != nil {
+		return err
+	}
+	if role == nil {
+		return fmt.Errorf("%w: role is required", ErrUnprocessable)
+	}
+	if role.Name != nil && *role.Name != name {
+		return fmt.Errorf("%w: role name %q does not match %q", ErrUnprocessable, *role.Name, name)
+	}
+	role.Name = &name
+	if err := ValidateRole(role); err != nil {
+		return fmt.Errorf("%w: %w", ErrUnprocessable, err)
+	}
+	if err := m.authorizer.AuthorizePermissions(principal, role.Permissions); err != nil {
+		return err
+	}
+	if _, err := m.getRole(name); err != nil {
+		return err
+	}
+	return m.controller.UpsertRoles(role)
+}
+
+// DeleteRole deletes a role and revokes it from all users and groups
+func (m *Manager) DeleteRole(principal *models.Principal, name string) error {
+	if err := m.authorizer.Authorize(principal, "delete", rolePath(name)); err != nil {
+		return err
+	}
+	return m.controller.DeleteRoles(name)
+}
+
+func (m *Manager) GetRolesForUser(principal *models.Principal, user string) ([]*models.Role, error) {
+	if err := m.authorizer.Authorize(principal, "get", userPath(user)); err != nil {
+		return nil, err
+	}
+	return m.reader.GetRolesForUser(user), nil
+}
+
+func (m *Manager) AssignRolesToUser(principal *models.Principal, user string, roles []string) error {
+	if err := m.authorizer.Authorize(principal, "update", userPath(user)); err != nil {
+		return err
+	}
+	if err := m.authorizeAssignment(principal, "user", user, roles); err != nil {
+		return err
+	}
+	return m.controller.AssignRolesToUser(user, roles...)
+}
+
+func (m *Manager) RevokeRolesFromUser(principal *models.Principal, user string, roles []string) error {
+	if err := m.authorizer.Authorize(principal, "update", userPath(user)); err != nil {
+		return err
+	}
+	if err := requireSubject("user", user, roles); err != nil {
+		return err
+	}
+	return m.controller.RevokeRolesFromUser(user, roles...)
+}
+
+func (m *Manager) AssignRolesToGroup(principal *models.Principal, group string, roles []string) error {
+	if err := m.authorizer.Authorize(principal, "update", groupPath(group)); err != nil {
+		return err
+	}
+	if err := m.authorizeAssignment(principal, "group", group, roles); err != nil {
+		return err
+	}
+	return m.controller.AssignRolesToGroup(group, roles...)
+}
+
+func (m *Manager) RevokeRolesFromGroup(principal *models.Principal, group string, roles []string) error {
+	if err := m.authorizer.Authorize(principal, "update", groupPath(group)); err != nil {
+		return err
+	}
+	if err := requireSubject("group", group, roles); err != nil {
+		return err
+	}
+	return m.controller.RevokeRolesFromGroup(group, roles...)
+}
+
+func (m *Manager) getRole(name string) (*models.Role, error) {
+	roles := m.reader.GetRoles(name)
+	if len(roles) == 0 {
+		return nil, fmt.Errorf("%w: %q", ErrRoleNotFound, name)
+	}
+	return roles[0], nil
+}
+
+// authorizeAssignment checks that the subject is set and that the principal
+// may update every role and holds all of their permissions. The roles are
+// checked for existence again when the assignment is applied, this check
+// only allows returning a meaningful error.
+func (m *Manager) authorizeAssignment(principal *models.Principal, kind, subject string, roles []string) error {
+	if err := requireSubject(kind, subject, roles); err != nil {
+		return err
+	}
+	for _, name := range roles {
+		if err := m.authorizer.Authorize(principal, "update", rolePath(name)); err != nil {
+			return err
+		}
+		role, err := m.getRole(name)
+		if err != nil {
+			return err
+		}
+		if err := m.authorizer.AuthorizePermissions(principal, role.Permissions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func requireSubject(kind, subject string, roles []string) error {
+	if subject == "" || len(roles) == 0 {
+		return fmt.Errorf("%w: %s and roles are required", ErrUnprocessable, kind)
+	}
+	return nil
+}
+
+func rolePath(name string) string  { return "roles/" + name }
+func userPath(name string) string  { return "users/" + name }
+func groupPath(name string) string { return "groups/" + name }
@@ -0,0 +1,256 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+)
+
+// fakeController applies changes directly to a fakeRoleReader
+type fakeController struct {
+	reader *fakeRoleReader
+}
+
+func (f *fakeController) UpsertRoles(roles ...*models.Role) error {
+	for _, role := range roles {
+		f.reader.roles[*role.Name] = role
+	}
+	return nil
+}
+
+func (f *fakeController) DeleteRoles(names ...string) error {
+	for _, name := range names {
+		delete(f.reader.roles, name)
+	}
+	return nil
+}
+
+func (f *fakeController) AssignRolesToUser(user string, roles ...string) error {
+	f.reader.users[user] = append(f.reader.users[user], roles...)
+	return nil
+}
+
+func (f *fakeController) RevokeRolesFromUser(user string, roles ...string) error {
+	f.reader.users[user] = without(f.reader.users[user], roles)
+	return nil
+}
+
+func (f *fakeController) AssignRolesToGroup(group string, roles ...string) error {
+	f.reader.groups[group] = append(f.reader.groups[group], roles...)
+	return nil
+}
+
+func (f *fakeController) RevokeRolesFromGroup(group string, roles ...string) error {
+	f.reader.groups[group] = without(f.reader.groups[group], roles)
+	return nil
+}
+
+func without(in, remove []string) []string {
+	var out []string
+outer:
+	for _, s := range in {
+		for _, r := range remove {
+			if s == r {
+				continue outer
+			}
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+func newTestManager() (*Manager, *fakeRoleReader) {
+	reader := &fakeRoleReader{
+		roles: map[string]*models.Role{
+			"reader": newRole("reader", newPermission("objects/*", "get", "list")),
+			"admin":  newRole("admin", newPermission("**", AllActions)),
+			// may manage all users, groups and roles, but only holds the permissions
+			// of the reader role
+			"usermanager": newRole("usermanager",
+				newPermission("users/*", "update"),
+				newPermission("groups/*", "update"),
+				newPermission("roles/*", "create", "update")),
+		},
+		users: map[string][]string{
+			"alice":   {"reader"},
+			"mallory": {"reader", "usermanager"},
+		},
+		groups: map[string][]string{},
+	}
+	authorizer := New(Config{Enabled: true, RootUsers: []string{"root"}}, reader)
+	return NewManager(authorizer, reader, &fakeController{reader: reader}), reader
+}
+
+func Test_Manager(t *testing.T) {
+	root := &models.Principal{Username: "root"}
+	alice := &models.Principal{Username: "alice"}
+	mallory := &models.Principal{Username: "mallory"}
+
+	t.Run("create role", func(t *testing.T) {
+		m, reader := newTestManager()
+
+		err := m.CreateRole(root, newRole("editor", newPermission("schema/*", AllActions)))
+		require.Nil(t, err)
+		assert.Contains(t, reader.roles, "editor")
+	})
+
+	t.Run("create existing role", func(t *testing.T) {
+		m, _ := newTestManager()
+
+		err := m.CreateRole(root, newRole("reader", newPermission("schema/*", AllActions)))
+		assert.ErrorIs(t, err, ErrRoleExists)
+	})
+
+	t.Run("create invalid role", func(t *testing.T) {
+		m, _ := newTestManager()
+
+		err := m.CreateRole(root, newRole("editor", newPermission("schema/*", "read")))
+		assert.ErrorIs(t, err, ErrUnprocessable)
+	})
+
+	t.Run("create role without permission", func(t *testing.T) {
+		m, reader := newTestManager()
+
+		err := m.CreateRole(alice, newRole("editor", newPermission("schema/*", AllActions)))
+		assert.Equal(t, errors.NewForbidden(alice, "create", "roles/editor"), err)
+		assert.NotContains(t, reader.roles, "editor")
+	})
+
+	t.Run("get role", func(t *testing.T) {
+		m, _ := newTestManager()
+
+		role, err := m.GetRole(root, "reader")
+		require.Nil(t, err)
+		assert.Equal(t, "reader", *role.Name)
+
+		_, err = m.GetRole(root, "editor")
+		assert.ErrorIs(t, err, ErrRoleNotFound)
+	})
+
+	t.Run("update role", func(t *testing.T) {
+		m, reader := newTestManager()
+
+		err := m.UpdateRole(root, "reader", &models.Role{
+			Permissions: []*models.Permission{newPermission("objects/Article/*", "get")},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "objects/Article/*", *reader.roles["reader"].Permissions[0].Resource)
+	})
+
+	t.Run("update role with mismatching name", func(t *testing.T) {
+		m, _ := newTestManager()
+
+		err := m.UpdateRole(root, "reader", newRole("editor", newPermission("schema/*", AllActions)))
+		assert.ErrorIs(t, err, ErrUnprocessable)
+	})
+
+	t.Run("update missing role", func(t *testing.T) {
+		m, _ := newTestManager()
+
+		err := m.UpdateRole(root, "editor", newRole("editor", newPermission("schema/*", AllActions)))
+		assert.ErrorIs(t, err, ErrRoleNotFound)
+	})
+
+	t.Run("assign and revoke roles", func(t *testing.T) {
+		m, _ := newTestManager()
+
+		require.Nil(t, m.AssignRolesToUser(root, "bob", []string{"reader"}))
+		roles, err := m.GetRolesForUser(root, "bob")
+		require.Nil(t, err)
+		require.Len(t, roles, 1)
+		assert.Equal(t, "reader", *roles[0].Name)
+
+		require.Nil(t, m.RevokeRolesFromUser(root, "bob", []string{"reader"}))
+		roles, err = m.GetRolesForUser(root, "bob")
+		require.Nil(t, err)
+		assert.Empty(t, roles)
+	})
+
+	t.Run("assign missing role", func(t *testing.T) {
+		m, _ := newTestManager()
+
+		err := m.AssignRolesToGroup(root, "editors", []string{"editor"})
+		assert.ErrorIs(t, err, ErrRoleNotFound)
+	})
+
+	t.Run("assign without subject", func(t *testing.T) {
+		m, _ := newTestManager()
+
+		err := m.AssignRolesToUser(root, "", []string{"reader"})
+		assert.ErrorIs(t, err, ErrUnprocessable)
+	})
+
+	t.Run("assign without permission", func(t *testing.T) {
+		m, _ := newTestManager()
+
+		err := m.AssignRolesToUser(alice, "alice", []string{"reader"})
+		assert.Equal(t, errors.NewForbidden(alice, "update", "users/alice"), err)
+	})
+
+	t.Run("assign role without permission on the role", func(t *testing.T) {
+		m, reader := newTestManager()
+		reader.roles["self"] = newRole("self", newPermission("users/alice", "update"))
+		reader.users["alice"] = append(reader.users["alice"], "self")
+
+		err := m.AssignRolesToUser(alice, "alice", []string{"admin"})
+		assert.Equal(t, errors.NewForbidden(alice, "update", "roles/admin"), err)
+		assert.NotContains(t, reader.users["alice"], "admin")
+	})
+
+	t.Run("escalate by assigning a role with more permissions", func(t *testing.T) {
+		m, reader := newTestManager()
+
+		err := m.AssignRolesToUser(mallory, "mallory", []string{"admin"})
+		assert.Equal(t, errors.NewForbidden(mallory, AllActions, "**"), err)
+		assert.NotContains(t, reader.users["mallory"], "admin")
+
+		err = m.AssignRolesToGroup(mallory, "accomplices", []string{"admin"})
+		assert.Equal(t, errors.NewForbidden(mallory, AllActions, "**"), err)
+		assert.Empty(t, reader.groups["accomplices"])
+	})
+
+	t.Run("assign a role with held permissions", func(t *testing.T) {
+		m, reader := newTestManager()
+
+		require.Nil(t, m.AssignRolesToUser(mallory, "bob", []string{"reader"}))
+		assert.Equal(t, []string{"reader"}, reader.users["bob"])
+	})
+
+	t.Run("escalate by creating a role with more permissions", func(t *testing.T) {
+		m, reader := newTestManager()
+
+		err := m.CreateRole(mallory, newRole("backdoor", newPermission("schema/*", "delete")))
+		assert.Equal(t, errors.NewForbidden(mallory, "delete", "schema/*"), err)
+		assert.NotContains(t, reader.roles, "backdoor")
+	})
+
+	t.Run("escalate by updating a role with more permissions", func(t *testing.T) {
+		m, reader := newTestManager()
+
+		err := m.UpdateRole(mallory, "reader", newRole("reader", newPermission("objects/*", AllActions)))
+		assert.Equal(t, errors.NewForbidden(mallory, AllActions, "objects/*"), err)
+		assert.Equal(t, []string{"get", "list"}, reader.roles["reader"].Permissions[0].Actions)
+	})
+
+	t.Run("create a role with held permissions", func(t *testing.T) {
+		m, reader := newTestManager()
+
+		err := m.CreateRole(mallory, newRole("lister", newPermission("objects/Article", "list")))
+		require.Nil(t, err)
+		assert.Contains(t, reader.roles, "lister")
+	})
+}
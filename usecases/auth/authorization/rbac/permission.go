@@ -0,0 +1,174 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// AllActions can be used in a permission instead of a list of verbs
+const AllActions = "*"
+
+// actions contains every verb passed to the Authorizer
+var actions = map[string]struct{}{
+	"get": {}, "list": {}, "create": {}, "update": {}, "delete": {},
+	"head": {}, "validate": {}, "add": {}, "restore": {},
+}
+
+// ValidateRole makes sure a role has a name and only contains permissions
+// with known actions and a resource
+func ValidateRole(role *models.Role) error {
+	if role == nil || role.Name == nil || *role.Name == "" {
+		return fmt.Errorf("role name is required")
+	}
+	if strings.Contains(*role.Name, "/") {
+		return fmt.Errorf("role name %q must not contain '/'", *role.Name)
+	}
+	if len(role.Permissions) == 0 {
+		return fmt.Errorf("role %q: at least one permission is required", *role.Name)
+	}
+
+	for i, perm := range role.Permissions {
+		if perm == nil || perm.Resource == nil || *perm.Resource == "" {
+			return fmt.Errorf("role %q: permission %d: resource is required", *role.Name, i)
+		}
+		if len(perm.Actions) == 0 {
+			return fmt.Errorf("role %q: permission %d: at least one action is required", *role.Name, i)
+		}
+		for _, action := range perm.Actions {
+			if _, ok := actions[action]; !ok && action != AllActions {
+				return fmt.Errorf("role %q: permission %d: unknown action %q", *role.Name, i, action)
+			}
+		}
+	}
+
+	return nil
+}
+
+// allows returns whether the permission allows the verb on the resource
+func allows(perm *models.Permission, verb, resource string) bool {
+	if perm == nil || perm.Resource == nil {
+		return false
+	}
+
+	allowed := false
+	for _, action := range perm.Actions {
+		if action == verb || action == AllActions {
+			allowed = true
+			break
+		}
+	}
+
+	return allowed && matchResource(*perm.Resource, resource)
+}
+
+// covers returns whether the permission allows the action on every resource
+// the pattern matches. AllActions is only covered by AllActions.
+func covers(perm *models.Permission, action, pattern string) bool {
+	if perm == nil || perm.Resource == nil {
+		return false
+	}
+
+	allowed := false
+	for _, a := range perm.Actions {
+		if a == action || a == AllActions {
+			allowed = true
+			break
+		}
+	}
+
+	return allowed && coversResource(*perm.Resource, pattern)
+}
+
+// coversResource returns whether every resource matched by the covered
+// pattern is also matched by the pattern. Matching the covered pattern like a
+// resource is enough for that: each '*' in it has to be matched by a '*' of
+// the pattern, which matches whatever the '*' stands for as well. Only a "**"
+// segment, which spans segments, needs a "**" segment in the pattern.
+func coversResource(pattern, covered string) bool {
+	if parent, ok := strings.CutSuffix(covered, "/*"); ok && !coversResource(pattern, parent) {
+		return false
+	}
+	if !strings.Contains(covered, "*") {
+		return matchResource(pattern, covered)
+	}
+
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(covered, "/"))
+}
+
+// matchResource matches a resource against a pattern segment by segment.
+// Within a segment '*' matches any sequence of characters, but never a '/',
+// so "objects/Article*" doesn't match "objects/ArticleV2/123". A segment that
+// is exactly "**" matches any number of segments, including none, so that
+// "schema/Article/**" covers the schema, shards and tenants of a collection.
+// A pattern ending in "/*" also matches the resource it is a child of, so that
+// "objects/Article/*" covers listing "objects/Article" as well as the objects
+// inside it.
+func matchResource(pattern, resource string) bool {
+	if parent, ok := strings.CutSuffix(pattern, "/*"); ok && parent == resource {
+		return true
+	}
+
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(resource, "/"))
+}
+
+func matchSegments(pattern, resource []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for skip := 0; skip <= len(resource); skip++ {
+				if matchSegments(pattern[1:], resource[skip:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		// a "**" segment only occurs in patterns passed to coversResource
+		if len(resource) == 0 || resource[0] == "**" || !matchSegment(pattern[0], resource[0]) {
+			return false
+		}
+		pattern, resource = pattern[1:], resource[1:]
+	}
+
+	return len(resource) == 0
+}
+
+// matchSegment matches a single segment in which '*' matches any sequence of
+// characters
+func matchSegment(pattern, segment string) bool {
+	p, s := 0, 0
+	star, next := -1, 0
+	for s < len(segment) {
+		switch {
+		case p < len(pattern) && pattern[p] == '*':
+			star, next = p, s
+			p++
+		case p < len(pattern) && pattern[p] == segment[s]:
+			p++
+			s++
+		case star >= 0:
+			// let the last '*' consume one more character and retry
+			next++
+			p, s = star+1, next
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_MatchResource(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		resource string
+		match    bool
+	}{
+		{pattern: "*", resource: "schema", match: true},
+		{pattern: "*", resource: "schema/Article", match: false},
+		{pattern: "**", resource: "schema/Article", match: true},
+		{pattern: "**", resource: "", match: true},
+		{pattern: "schema", resource: "schema", match: true},
+		{pattern: "schema", resource: "schema/Article", match: false},
+		{pattern: "schema/*", resource: "schema", match: true},
+		{pattern: "schema/*", resource: "schema/Article", match: true},
+		{pattern: "schema/*", resource: "schemas", match: false},
+		{pattern: "objects/Article/*", resource: "objects/Article", match: true},
+		{pattern: "objects/Article/*", resource: "objects/ArticleV2/123", match: false},
+		{pattern: "schema/*/tenants/t1", resource: "schema/Article/tenants/t1", match: true},
+		{pattern: "schema/*/tenants/t1", resource: "schema/Article/tenants/t2", match: false},
+		{pattern: "roles/*", resource: "users/alice", match: false},
+		{pattern: "", resource: "", match: true},
+		{pattern: "", resource: "schema", match: false},
+		// '*' matches within a segment only
+		{pattern: "schema/*", resource: "schema/Article/tenants/t1", match: false},
+		{pattern: "objects/*", resource: "objects/Article/tenantA/123", match: false},
+		{pattern: "objects/Article/*", resource: "objects/Article/tenantA/123", match: false},
+		{pattern: "schema/*/tenants/*", resource: "schema/A/tenants/tenants/t2", match: false},
+		{pattern: "schema/*t1", resource: "schema/Article/tenants/t1", match: false},
+		{pattern: "backups/*/restore", resource: "backups/s3/id/restore", match: false},
+		// '*' as a name prefix doesn't collide with longer names
+		{pattern: "objects/Article*", resource: "objects/Article", match: true},
+		{pattern: "objects/Article*", resource: "objects/ArticleV2", match: true},
+		{pattern: "objects/Article*", resource: "objects/ArticleV2/123", match: false},
+		{pattern: "collections/Article*", resource: "collections/ArticleSecret/shards/s1", match: false},
+		{pattern: "schema/Article/*", resource: "schema/ArticleSecret", match: false},
+		{pattern: "schema/Article/*", resource: "schema/ArticleSecret/shards", match: false},
+		{pattern: "schema/Article/**", resource: "schema/ArticleSecret/shards", match: false},
+		{pattern: "schema/*Secret", resource: "schema/ArticleSecret", match: true},
+		{pattern: "schema/A*e", resource: "schema/Article", match: true},
+		{pattern: "schema/A*e", resource: "schema/Articles", match: false},
+		// '**' matches any number of segments
+		{pattern: "schema/**", resource: "schema", match: true},
+		{pattern: "schema/**", resource: "schema/Article/tenants/t1", match: true},
+		{pattern: "schema/**", resource: "schemas/Article", match: false},
+		{pattern: "objects/Article/**", resource: "objects/Article/tenantA/123", match: true},
+		{pattern: "backups/**/restore", resource: "backups/s3/id/restore", match: true},
+		{pattern: "backups/**/restore", resource: "backups/restore", match: true},
+		{pattern: "backups/**/restore", resource: "backups/s3/id", match: false},
+		{pattern: "schema/**/tenants/*", resource: "schema/A/tenants/tenants/t2", match: true},
+		{pattern: "schema/Article**", resource: "schema/ArticleSecret/shards", match: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern+" "+test.resource, func(t *testing.T) {
+			assert.Equal(t, test.match, matchResource(test.pattern, test.resource))
+		})
+	}
+}
+
+func Test_CoversResource(t *testing.T) {
+	tests := []struct {
+		pattern string
+		covered string
+		covers  bool
+	}{
+		{pattern: "**", covered: "**", covers: true},
+		{pattern: "**", covered: "schema/*/tenants/*", covers: true},
+		{pattern: "schema/Article", covered: "schema/Article", covers: true},
+		{pattern: "schema/Article", covered: "schema/*", covers: false},
+		{pattern: "schema/*", covered: "schema/Article", covers: true},
+		{pattern: "schema/*", covered: "schema/Art*", covers: true},
+		{pattern: "schema/*", covered: "schema/*", covers: true},
+		{pattern: "schema/*", covered: "schema/**", covers: false},
+		{pattern: "schema/*", covered: "**", covers: false},
+		{pattern: "schema/Art*", covered: "schema/*", covers: false},
+		{pattern: "schema/Art*", covered: "schema/Article*", covers: true},
+		{pattern: "schema/*le", covered: "schema/Art*", covers: false},
+		{pattern: "schema/**", covered: "schema/Article/**", covers: true},
+		{pattern: "schema/Article/**", covered: "schema/**", covers: false},
+		{pattern: "schema/*/*", covered: "schema/*", covers: false},
+		{pattern: "schema/*", covered: "schema/*/*", covers: false},
+		{pattern: "objects/Article/*", covered: "objects/Article/*", covers: true},
+		{pattern: "objects/*/*", covered: "objects/*", covers: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern+" "+test.covered, func(t *testing.T) {
+			assert.Equal(t, test.covers, coversResource(test.pattern, test.covered))
+		})
+	}
+}
+
+func Test_ValidateRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		role    *models.Role
+		wantErr string
+	}{
+		{
+			name: "valid",
+			role: newRole("reader", newPermission("objects/*", "get", "list"), newPermission("meta", AllActions)),
+		},
+		{
+			name:    "nil role",
+			wantErr: "role name is required",
+		},
+		{
+			name:    "empty name",
+			role:    newRole("", newPermission("meta", "get")),
+			wantErr: "role name is required",
+		},
+		{
+			name:    "name with slash",
+			role:    newRole("a/b", newPermission("meta", "get")),
+			wantErr: "must not contain '/'",
+		},
+		{
+			name:    "no permissions",
+			role:    newRole("reader"),
+			wantErr: "at least one permission is required",
+		},
+		{
+			name:    "no resource",
+			role:    newRole("reader", newPermission("", "get")),
+			wantErr: "resource is required",
+		},
+		{
+			name:    "no actions",
+			role:    newRole("reader", newPermission("meta")),
+			wantErr: "at least one action is required",
+		},
+		{
+			name:    "unknown action",
+			role:    newRole("reader", newPermission("meta", "read")),
+			wantErr: "unknown action \"read\"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateRole(test.role)
+			if test.wantErr == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, test.wantErr)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package authorization
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// ObjectsPath returns the resource used to authorize operations on the
+// objects of a class. Objects of a tenant are nested below the tenant, so that
+// access can be granted to a single tenant. The class name is uppercased like
+// it is before the class is looked up, so that "article" can't bypass a
+// permission on "Article". Every API authorizing access to objects must use
+// it, so that a role grants the same access through each of them.
+func ObjectsPath(class, tenant string) string {
+	class = schema.UppercaseClassName(class)
+	if tenant == "" {
+		return fmt.Sprintf("objects/%s", class)
+	}
+	return fmt.Sprintf("objects/%s/%s", class, tenant)
+}
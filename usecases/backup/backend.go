@@ -14,7 +14,9 @@ package backup
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"os"
 	"path"
@@ -59,7 +61,10 @@ const (
 	// GlobalBackupFile used by coordinator to store its metadata
 	GlobalBackupFile  = "backup_config.json"
 	GlobalRestoreFile = "restore_config.json"
-	TempDirectory     = ".backup.tmp"
+	// GlobalCancelFile marks a backup as cancelled, so that the coordinator
+	// learns about cancellations requested on other nodes
+	GlobalCancelFile = "backup_cancel.json"
+	TempDirectory    = ".backup.tmp"
 )
 
 var _NUMCPU = runtime.NumCPU()
@@ -94,6 +99,14 @@ func (s *objStore) Initialize(ctx context.Context) error {
 	return s.b.Initialize(ctx, s.BasePath)
 }
 
+// Delete removes all objects stored under the base path
+func (s *objStore) Delete(ctx context.Context) error {
+	if s.BasePath == "" {
+		return fmt.Errorf("delete: empty base path")
+	}
+	return s.b.Delete(ctx, s.BasePath)
+}
+
 // meta marshals and uploads metadata
 func (s *objStore) putMeta(ctx context.Context, key string, desc interface{}) error {
 	bytes, err := json.Marshal(desc)
@@ -143,6 +156,11 @@ func (s *nodeStore) Meta(ctx context.Context, backupID string, adjustBasePath bo
 	return &result, err
 }
 
+// withBackupID returns the store of the same node for the backup with the given id
+func (s *nodeStore) withBackupID(backupID string) nodeStore {
+	return nodeStore{objStore{s.b, fmt.Sprintf("%s/%s", backupID, path.Base(s.BasePath))}}
+}
+
 // meta marshals and uploads metadata
 func (s *nodeStore) PutMeta(ctx context.Context, desc *backup.BackupDescriptor) error {
 	return s.putMeta(ctx, BackupFile, desc)
@@ -170,6 +188,25 @@ func (s *coordStore) Meta(ctx context.Context, filename string) (*backup.Distrib
 	return &result, err
 }
 
+// PutCancel marks the backup as cancelled
+func (s *coordStore) PutCancel(ctx context.Context) error {
+	return s.putMeta(ctx, GlobalCancelFile, struct {
+		RequestedAt time.Time `json:"requestedAt"`
+	}{time.Now().UTC()})
+}
+
+// CancelRequested returns whether the backup has been marked as cancelled
+func (s *coordStore) CancelRequested(ctx context.Context) (bool, error) {
+	if _, err := s.b.GetObject(ctx, s.BasePath, GlobalCancelFile); err != nil {
+		notFoundErr := backup.ErrNotFound{}
+		if errors.As(err, &notFoundErr) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
 // uploader uploads backup artifacts. This includes db files and metadata
 type uploader struct {
 	sourcer  Sourcer
@@ -178,6 +215,10 @@ type uploader struct {
 	zipConfig
 	setStatus func(st backup.Status)
 	log       logrus.FieldLogger
+
+	// baseShards contains the shards of the base backup by class and shard name
+	baseShards map[string]*backup.ShardDescriptor
+	baseID     string
 }
 
 func newUploader(sourcer Sourcer, backend nodeStore,
@@ -193,6 +234,8 @@ func newUploader(sourcer Sourcer, backend nodeStore,
 		}),
 		setstatus,
 		l,
+		nil,
+		"",
 	}
 }
 
@@ -201,6 +244,27 @@ func (u *uploader) withCompression(cfg zipConfig) *uploader {
 	return u
 }
 
+// withBase enables incremental backups: segment files which are identical
+// to the ones stored by the base backup are referenced instead of uploaded.
+// A nil base disables incremental backups.
+func (u *uploader) withBase(base *backup.BackupDescriptor) *uploader {
+	if base == nil {
+		return u
+	}
+	u.baseID = base.ID
+	u.baseShards = make(map[string]*backup.ShardDescriptor)
+	for _, cls := range base.Classes {
+		for _, shard := range cls.Shards {
+			u.baseShards[baseShardKey(cls.Name, shard.Name)] = shard
+		}
+	}
+	return u
+}
+
+func baseShardKey(class, shard string) string {
+	return class + "/" + shard
+}
+
 // all uploads all files in addition to the metadata file
 func (u *uploader) all(ctx context.Context, classes []string, desc *backup.BackupDescriptor) (err error) {
 	u.setStatus(backup.Transferring)
@@ -367,6 +431,9 @@ func (u *uploader) compress(ctx context.Context,
 		defer zip.Close()
 		lastShardSize := int64(0)
 		for shard := range ch {
+			if err := u.reuseSegments(class, shard); err != nil {
+				return err
+			}
 			if _, err := zip.WriteShard(ctx, shard); err != nil {
 				return err
 			}
@@ -399,6 +466,59 @@ func (u *uploader) compress(ctx context.Context,
 	return shards, eg.Wait()
 }
 
+// reuseSegments removes segment files of a shard which are identical to the
+// ones stored by the base backup. They are recorded as references instead.
+// References are resolved to the backup actually storing the segment, so
+// restoring never has to follow more than one reference.
+func (u *uploader) reuseSegments(class string, shard *backup.ShardDescriptor) error {
+	baseShard := u.baseShards[baseShardKey(class, shard.Name)]
+	if baseShard == nil || len(baseShard.Segments) == 0 {
+		return nil
+	}
+	files := make([]string, 0, len(shard.Files))
+	for _, relPath := range shard.Files {
+		seg, ok := baseShard.Segments[relPath]
+		if !ok {
+			files = append(files, relPath)
+			continue
+		}
+		size, checksum, err := fileChecksum(filepath.Join(u.backend.SourceDataPath(), relPath))
+		if err != nil {
+			return fmt.Errorf("checksum %s: %w", relPath, err)
+		}
+		if size != seg.Size || checksum != seg.Checksum {
+			files = append(files, relPath)
+			continue
+		}
+
+		ref := *seg
+		if !ref.IsReference() {
+			ref.BackupID = u.baseID
+			ref.Chunk = baseShard.Chunk
+		}
+		if shard.Segments == nil {
+			shard.Segments = make(map[string]*backup.SegmentInfo)
+		}
+		shard.Segments[relPath] = &ref
+	}
+	shard.Files = files
+	return nil
+}
+
+func fileChecksum(path string) (size int64, checksum uint32, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+
+	h := crc32.NewIEEE()
+	if size, err = io.Copy(h, f); err != nil {
+		return 0, 0, err
+	}
+	return size, h.Sum32(), nil
+}
+
 // fileWriter downloads files from object store and writes files to the destination folder destDir
 type fileWriter struct {
 	sourcer    Sourcer
@@ -493,9 +613,53 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir string, d
 			return err
 		})
 	}
+
+	// segments of an incremental backup stored by other backups
+	for ref, files := range segmentReferences(desc) {
+		store := fw.backend.withBackupID(ref.backupID)
+		chunk := chunkKey(desc.Name, ref.chunk)
+		files := files
+		eg.Go(func() error {
+			uz, w := NewUnzip(classTempDir)
+			enterrors.GoWrapper(func() {
+				store.Read(ctx, chunk, w)
+			}, fw.logger)
+			_, err := uz.ReadChunkFiles(func(name string) bool {
+				_, ok := files[name]
+				return ok
+			})
+			if err != nil {
+				return fmt.Errorf("backup %s: %w", ref.backupID, err)
+			}
+			return nil
+		})
+	}
 	return eg.Wait()
 }
 
+type segmentReference struct {
+	backupID string
+	chunk    int32
+}
+
+// segmentReferences groups the segments referenced by shards of desc by the chunk containing them
+func segmentReferences(desc *backup.ClassDescriptor) map[segmentReference]map[string]struct{} {
+	refs := make(map[segmentReference]map[string]struct{})
+	for _, shard := range desc.Shards {
+		for relPath, seg := range shard.Segments {
+			if !seg.IsReference() {
+				continue
+			}
+			ref := segmentReference{seg.BackupID, seg.Chunk}
+			if refs[ref] == nil {
+				refs[ref] = make(map[string]struct{})
+			}
+			refs[ref][relPath] = struct{}{}
+		}
+	}
+	return refs
+}
+
 func (fw *fileWriter) writeTempShard(ctx context.Context, sd *backup.ShardDescriptor, classTempDir string) error {
 	for _, key := range sd.Files {
 		destPath := path.Join(classTempDir, key)
@@ -119,6 +119,31 @@ func (b *backupper) OnStatus(ctx context.Context, req *StatusRequest) (reqStat,
 	}, nil
 }
 
+// baseDescriptor returns the descriptor this node has stored for the base of
+// an incremental backup. It returns nil if the node did not take part in the
+// base backup or if the base backup cannot be used to reference segments.
+func (b *backupper) baseDescriptor(ctx context.Context, store nodeStore, baseID string) (*backup.BackupDescriptor, error) {
+	baseStore := store.withBackupID(baseID)
+	meta, err := baseStore.Meta(ctx, baseID, false)
+	if err != nil {
+		notFoundErr := backup.ErrNotFound{}
+		if errors.As(err, &notFoundErr) {
+			b.logger.WithField("action", "create_backup").
+				WithField("base_backup_id", baseID).
+				Info("node is not part of the base backup, all files will be uploaded")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get base backup %q: %w", baseID, err)
+	}
+	if meta.Version <= version1 {
+		b.logger.WithField("action", "create_backup").
+			WithField("base_backup_id", baseID).
+			Infof("base backup version %s does not support incremental backups", meta.Version)
+		return nil, nil
+	}
+	return meta, nil
+}
+
 // backup checks if the node is ready to back up (can commit phase)
 //
 // Moreover it starts a goroutine in the background which waits for the
@@ -159,6 +184,7 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 			Classes:       make([]backup.ClassDescriptor, 0, len(req.Classes)),
 			Version:       Version,
 			ServerVersion: config.ServerVersion,
+			BaseBackupID:  req.BaseBackupID,
 		}
 
 		// the coordinator might want to abort the backup
@@ -167,6 +193,15 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 		defer close(done)
 
 		logFields := logrus.Fields{"action": "create_backup", "backup_id": req.ID}
+		if req.BaseBackupID != "" {
+			base, err := b.baseDescriptor(ctx, store, req.BaseBackupID)
+			if err != nil {
+				b.logger.WithFields(logFields).Error(err)
+				b.lastAsyncError = err
+				return
+			}
+			provider.withBase(base)
+		}
 		if err := provider.all(ctx, req.Classes, &result); err != nil {
 			b.logger.WithFields(logFields).Error(err)
 			b.lastAsyncError = err
@@ -37,9 +37,10 @@ const (
 )
 
 var (
-	errCannotCommit = errors.New("cannot commit")
-	errMetaNotFound = errors.New("metadata not found")
-	errUnknownOp    = errors.New("unknown backup operation")
+	errCannotCommit  = errors.New("cannot commit")
+	errMetaNotFound  = errors.New("metadata not found")
+	errUnknownOp     = errors.New("unknown backup operation")
+	errNotInProgress = errors.New("not in progress")
 )
 
 const (
@@ -60,6 +61,46 @@ type participantStatus struct {
 	Reason   string
 }
 
+// cancellation records a user request to cancel the running operation
+type cancellation struct {
+	sync.Mutex
+	id        string
+	nodes     map[string]string
+	requested bool
+}
+
+// start resets the state for a new operation
+func (c *cancellation) start(id string) {
+	c.Lock()
+	defer c.Unlock()
+	c.id, c.nodes, c.requested = id, nil, false
+}
+
+// setNodes records the participants once all of them agreed to commit
+func (c *cancellation) setNodes(nodes map[string]string) {
+	c.Lock()
+	defer c.Unlock()
+	c.nodes = nodes
+}
+
+// request marks operation id as cancelled and returns its participants.
+// It returns false if id is not the current operation.
+func (c *cancellation) request(id string) (map[string]string, bool) {
+	c.Lock()
+	defer c.Unlock()
+	if c.id != id {
+		return nil, false
+	}
+	c.requested = true
+	return c.nodes, true
+}
+
+func (c *cancellation) isRequested(id string) bool {
+	c.Lock()
+	defer c.Unlock()
+	return c.id == id && c.requested
+}
+
 // selector is used to select participant nodes
 type selector interface {
 	// Shards gets all nodes on which this class is sharded
@@ -99,6 +140,7 @@ type coordinator struct {
 	Participants map[string]participantStatus
 	descriptor   *backup.DistributedBackupDescriptor
 	shardSyncChan
+	cancellation cancellation
 
 	// timeouts
 	timeoutNodeDown    time.Duration
@@ -144,6 +186,7 @@ func (c *coordinator) Backup(ctx context.Context, cstore coordStore, req *Reques
 	if prevID := c.lastOp.renew(req.ID, cstore.HomeDir()); prevID != "" {
 		return fmt.Errorf("backup %s already in progress", prevID)
 	}
+	c.cancellation.start(req.ID)
 
 	c.descriptor = &backup.DistributedBackupDescriptor{
 		StartedAt:     time.Now().UTC(),
@@ -153,6 +196,7 @@ func (c *coordinator) Backup(ctx context.Context, cstore coordStore, req *Reques
 		Version:       Version,
 		ServerVersion: config.ServerVersion,
 		Leader:        leader,
+		BaseBackupID:  req.BaseBackupID,
 	}
 
 	for key := range c.Participants {
@@ -169,6 +213,7 @@ func (c *coordinator) Backup(ctx context.Context, cstore coordStore, req *Reques
 		c.lastOp.reset()
 		return fmt.Errorf("cannot init meta file: %w", err)
 	}
+	c.cancellation.setNodes(nodes)
 
 	statusReq := StatusRequest{
 		Method:  OpCreate,
@@ -179,13 +224,35 @@ func (c *coordinator) Backup(ctx context.Context, cstore coordStore, req *Reques
 	f := func() {
 		defer c.lastOp.reset()
 		ctx := context.Background()
-		c.commit(ctx, &statusReq, nodes, false)
 		logFields := logrus.Fields{"action": OpCreate, "backup_id": req.ID}
+		if c.cancellation.isRequested(req.ID) {
+			// cancelled before the participants were known
+			abortReq := &AbortRequest{Method: OpCreate, ID: req.ID, Backend: req.Backend}
+			c.abortAll(ctx, abortReq, nodes)
+		} else {
+			c.commit(ctx, &statusReq, nodes, false)
+		}
+		cancelled := c.cancellation.isRequested(req.ID)
+		if !cancelled {
+			// the cancellation might have been requested on another node
+			var err error
+			if cancelled, err = cstore.CancelRequested(ctx); err != nil {
+				c.log.WithFields(logFields).Errorf("coordinator: get cancellation: %v", err)
+			}
+		}
+		if cancelled {
+			c.descriptor.Status = backup.Canceled
+			c.descriptor.Error = ""
+			c.descriptor.CompletedAt = time.Now().UTC()
+			c.removePartialUploads(ctx, cstore, req.ID)
+		}
 		if err := cstore.PutMeta(ctx, GlobalBackupFile, c.descriptor); err != nil {
 			c.log.WithFields(logFields).Errorf("coordinator: put_meta: %v", err)
 		}
 		if c.descriptor.Status == backup.Success {
 			c.log.WithFields(logFields).Info("coordinator: backup completed successfully")
+		} else if c.descriptor.Status == backup.Canceled {
+			c.log.WithFields(logFields).Info("coordinator: backup cancelled")
 		} else {
 			c.log.WithFields(logFields).Errorf("coordinator: %s", c.descriptor.Error)
 		}
@@ -195,6 +262,59 @@ func (c *coordinator) Backup(ctx context.Context, cstore coordStore, req *Reques
 	return nil
 }
 
+// Cancel aborts the backup operation req.ID if it is still in progress.
+//
+// Participants are told to abort, the running commit phase then removes
+// their partially uploaded files and marks the backup as cancelled. If the
+// backup is coordinated by another node, its participants are resolved from
+// the stored metadata and the backup is marked as cancelled in the backend,
+// where the coordinating node finds it once its participants stopped.
+func (c *coordinator) Cancel(ctx context.Context, store coordStore, req *AbortRequest) error {
+	if st := c.lastOp.get(); st.ID == req.ID {
+		if nodes, ok := c.cancellation.request(req.ID); ok {
+			// nodes is empty if participants haven't agreed to commit yet,
+			// they will then be aborted by the commit phase
+			c.abortAll(ctx, req, nodes)
+			return nil
+		}
+	}
+
+	meta, err := store.Meta(ctx, GlobalBackupFile)
+	if err != nil {
+		return fmt.Errorf("get backup %q: %w", req.ID, err)
+	}
+	if meta.Status != backup.Started && meta.Status != backup.Transferring {
+		return fmt.Errorf("backup %q cannot be cancelled: %w, status: %s",
+			req.ID, errNotInProgress, meta.Status)
+	}
+	if err := store.PutCancel(ctx); err != nil {
+		return fmt.Errorf("cancel backup %q: %w", req.ID, err)
+	}
+	nodes := make(map[string]string, len(meta.Nodes))
+	for node := range meta.Nodes {
+		node = meta.ToMappedNodeName(node)
+		host, found := c.nodeResolver.NodeHostname(node)
+		if !found {
+			return fmt.Errorf("cannot resolve hostname for %q", node)
+		}
+		nodes[node] = host
+	}
+	c.abortAll(ctx, req, nodes)
+	return nil
+}
+
+// removePartialUploads deletes the files uploaded by each participant of a cancelled backup
+func (c *coordinator) removePartialUploads(ctx context.Context, cstore coordStore, id string) {
+	for node := range c.descriptor.Nodes {
+		store := nodeStore{objStore{b: cstore.b, BasePath: fmt.Sprintf("%s/%s", id, node)}}
+		if err := store.Delete(ctx); err != nil {
+			c.log.WithField("action", OpCreate).
+				WithField("backup_id", id).
+				WithField("node", node).Errorf("remove partial uploads: %v", err)
+		}
+	}
+}
+
 // Restore coordinates a distributed restoration among participants
 func (c *coordinator) Restore(
 	ctx context.Context,
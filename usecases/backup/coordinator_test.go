@@ -81,6 +81,7 @@ func TestCoordinatedBackup(t *testing.T) {
 		fc.client.On("Status", any, nodes[1], sReq).Return(sresp, nil)
 		fc.backend.On("HomeDir", backupID).Return("bucket/" + backupID)
 		fc.backend.On("PutObject", any, backupID, GlobalBackupFile, any).Return(nil).Twice()
+		fc.backend.On("GetObject", any, backupID, GlobalCancelFile).Return(nil, backup.ErrNotFound{})
 
 		coordinator := *fc.coordinator()
 		req := newReq(classes, backendName, backupID)
@@ -154,6 +155,7 @@ func TestCoordinatedBackup(t *testing.T) {
 		fc.client.On("Status", any, nodes[1], sReq).Return(sresp, nil)
 		fc.backend.On("HomeDir", backupID).Return("bucket/" + backupID)
 		fc.backend.On("PutObject", any, backupID, GlobalBackupFile, any).Return(nil).Twice()
+		fc.backend.On("GetObject", any, backupID, GlobalCancelFile).Return(nil, backup.ErrNotFound{})
 
 		coordinator := *fc.coordinator()
 		req := newReq(classes, backendName, backupID)
@@ -227,6 +229,7 @@ func TestCoordinatedBackup(t *testing.T) {
 		fc.client.On("Status", any, nodes[1], sReq).Return(sresp, ErrAny)
 		fc.backend.On("HomeDir", backupID).Return("bucket/" + backupID)
 		fc.backend.On("PutObject", any, backupID, GlobalBackupFile, any).Return(nil).Twice()
+		fc.backend.On("GetObject", any, backupID, GlobalCancelFile).Return(nil, backup.ErrNotFound{})
 
 		fc.client.On("Abort", any, nodes[0], abortReq).Return(nil)
 		fc.client.On("Abort", any, nodes[1], abortReq).Return(nil)
@@ -280,6 +283,7 @@ func TestCoordinatedBackup(t *testing.T) {
 		fc.client.On("Status", any, nodes[1], sReq).Return(sresp, nil)
 		fc.backend.On("HomeDir", backupID).Return("bucket/" + backupID)
 		fc.backend.On("PutObject", any, backupID, GlobalBackupFile, any).Return(nil).Twice()
+		fc.backend.On("GetObject", any, backupID, GlobalCancelFile).Return(nil, backup.ErrNotFound{})
 
 		fc.client.On("Abort", any, nodes[0], abortReq).Return(nil)
 		fc.client.On("Abort", any, nodes[1], abortReq).Return(nil)
@@ -117,7 +117,8 @@ func (fb *fakeBackend) PutObject(ctx context.Context, backupID, key string, byte
 		json.Unmarshal(bytes, &fb.meta)
 	} else if key == GlobalBackupFile || key == GlobalRestoreFile {
 		json.Unmarshal(bytes, &fb.glMeta)
-		if fb.glMeta.Status == backup.Success || fb.glMeta.Status == backup.Failed {
+		if fb.glMeta.Status == backup.Success || fb.glMeta.Status == backup.Failed ||
+			fb.glMeta.Status == backup.Canceled {
 			close(fb.doneChan)
 		}
 	}
@@ -134,6 +135,23 @@ func (fb *fakeBackend) GetObject(ctx context.Context, backupID, key string) ([]b
 	return nil, args.Error(1)
 }
 
+func (fb *fakeBackend) AllBackupIDs(ctx context.Context) ([]string, error) {
+	fb.RLock()
+	defer fb.RUnlock()
+	args := fb.Called(ctx)
+	if args.Get(0) != nil {
+		return args.Get(0).([]string), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (fb *fakeBackend) Delete(ctx context.Context, backupID string) error {
+	fb.Lock()
+	defer fb.Unlock()
+	args := fb.Called(ctx, backupID)
+	return args.Error(0)
+}
+
 func (fb *fakeBackend) Initialize(ctx context.Context, backupID string) error {
 	fb.Lock()
 	defer fb.Unlock()
@@ -26,8 +26,10 @@ import (
 // Version of backup structure
 const (
 	// Version > version1 support compression
+	// "2.2" support incremental backups
+	Version = "2.2"
 	// "2.1" support restore on 2 phases
-	Version = "2.1"
+	// Version = "2.1"
 	// "2.0" support compression
 	// Version = "2.0"
 	// version1 store plain files without compression
@@ -139,6 +141,10 @@ type BackupRequest struct {
 	// NodeMapping is a map of node name replacement where key is the old name and value is the new name
 	// No effect if the map is empty
 	NodeMapping map[string]string
+
+	// BaseBackupID is the ID of the backup an incremental backup is based on.
+	// Unchanged segment files are not uploaded again but referenced from it.
+	BaseBackupID string
 }
 
 // OnCanCommit will be triggered when coordinator asks the node to participate
@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -94,11 +95,12 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		return nil, backup.NewErrUnprocessable(fmt.Errorf("init uploader: %w", err))
 	}
 	breq := Request{
-		Method:      OpCreate,
-		ID:          req.ID,
-		Backend:     req.Backend,
-		Classes:     classes,
-		Compression: req.Compression,
+		Method:       OpCreate,
+		ID:           req.ID,
+		Backend:      req.Backend,
+		Classes:      classes,
+		Compression:  req.Compression,
+		BaseBackupID: req.BaseBackupID,
 	}
 	if err := s.backupper.Backup(ctx, store, &breq); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
@@ -215,6 +217,85 @@ func (s *Scheduler) RestorationStatus(ctx context.Context, principal *models.Pri
 	return st, nil
 }
 
+// List returns all backups stored on the given backend.
+// Entries without a coordinator descriptor are not backups and are skipped.
+func (s *Scheduler) List(ctx context.Context, principal *models.Principal, backend string,
+) (_ models.BackupListResponse, err error) {
+	defer func(begin time.Time) {
+		logOperation(s.logger, "list_backups", "", backend, begin, err)
+	}(time.Now())
+	path := fmt.Sprintf("backups/%s", backend)
+	if err := s.authorizer.Authorize(principal, "list", path); err != nil {
+		return nil, err
+	}
+	caps, err := s.backends.BackupBackend(backend)
+	if err != nil {
+		err = fmt.Errorf("no backup provider %q: %w, did you enable the right module?", backend, err)
+		return nil, backup.NewErrUnprocessable(err)
+	}
+	ids, err := caps.AllBackupIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list backups: %w", err)
+	}
+
+	active := s.backupper.lastOp.get()
+	resp := make(models.BackupListResponse, 0, len(ids))
+	for _, id := range ids {
+		store := coordStore{objStore{b: caps, BasePath: id}}
+		meta, err := store.Meta(ctx, GlobalBackupFile)
+		if err != nil {
+			notFoundErr := backup.ErrNotFound{}
+			if errors.As(err, &notFoundErr) {
+				continue
+			}
+			return nil, fmt.Errorf("get backup %q: %w", id, err)
+		}
+		status := meta.Status
+		if active.ID == id && active.Status != "" {
+			status = active.Status
+		}
+		classes := meta.Classes()
+		sort.Strings(classes)
+		resp = append(resp, &models.BackupListResponseItems0{
+			ID:      id,
+			Classes: classes,
+			Status:  string(status),
+		})
+	}
+	return resp, nil
+}
+
+// Cancel aborts a backup which is still in progress and removes its partially uploaded files
+func (s *Scheduler) Cancel(ctx context.Context, principal *models.Principal, backend, backupID string,
+) (err error) {
+	defer func(begin time.Time) {
+		logOperation(s.logger, "try_cancel", backupID, backend, begin, err)
+	}(time.Now())
+	path := fmt.Sprintf("backups/%s/%s", backend, backupID)
+	if err := s.authorizer.Authorize(principal, "delete", path); err != nil {
+		return err
+	}
+	store, err := coordBackend(s.backends, backend, backupID)
+	if err != nil {
+		err = fmt.Errorf("no backup provider %q: %w, did you enable the right module?", backend, err)
+		return backup.NewErrUnprocessable(err)
+	}
+
+	req := &AbortRequest{Method: OpCreate, ID: backupID, Backend: backend}
+	err = s.backupper.Cancel(ctx, store, req)
+	notFoundErr := backup.ErrNotFound{}
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &notFoundErr):
+		return backup.NewErrNotFound(fmt.Errorf("backup %q does not exist: %w", backupID, err))
+	case errors.Is(err, errNotInProgress):
+		return backup.NewErrUnprocessable(err)
+	default:
+		return err
+	}
+}
+
 func coordBackend(provider BackupBackendProvider, backend, id string) (coordStore, error) {
 	caps, err := provider.BackupBackend(backend)
 	if err != nil {
@@ -261,9 +342,34 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if _, ok := err.(backup.ErrNotFound); !ok {
 		return nil, fmt.Errorf("check if backup %q exists at %q: %w", req.ID, destPath, err)
 	}
+	if req.BaseBackupID != "" {
+		if err := validateBaseBackup(ctx, store, req); err != nil {
+			return nil, err
+		}
+	}
 	return classes, nil
 }
 
+// validateBaseBackup makes sure that the base of an incremental backup
+// exists on the same backend and has been completed successfully
+func validateBaseBackup(ctx context.Context, store coordStore, req *BackupRequest) error {
+	if req.BaseBackupID == req.ID {
+		return fmt.Errorf("backup %q cannot be based on itself", req.ID)
+	}
+	if err := validateID(req.BaseBackupID); err != nil {
+		return err
+	}
+	baseStore := coordStore{objStore{store.b, req.BaseBackupID}}
+	meta, err := baseStore.Meta(ctx, GlobalBackupFile)
+	if err != nil {
+		return fmt.Errorf("base backup %q: %w", req.BaseBackupID, err)
+	}
+	if meta.Status != backup.Success {
+		return fmt.Errorf("base backup %q: status is %s, expected %s", req.BaseBackupID, meta.Status, backup.Success)
+	}
+	return nil
+}
+
 func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore, req *BackupRequest) (*backup.DistributedBackupDescriptor, error) {
 	if !store.b.IsExternal() && s.restorer.nodeResolver.NodeCount() > 1 {
 		return nil, errLocalBackendDBRO
@@ -136,6 +136,56 @@ func TestSchedulerValidateCreateBackup(t *testing.T) {
 		assert.Contains(t, err.Error(), fmt.Sprintf("backup %q already exists", id))
 		assert.IsType(t, backup.ErrUnprocessable{}, err)
 	})
+	t.Run("BaseBackupNotFound", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		fs.backend.On("GetObject", ctx, id, mock.Anything).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, "base", mock.Anything).Return(nil, backup.ErrNotFound{})
+		meta, err := fs.scheduler().Backup(ctx, nil, &BackupRequest{
+			Backend:      backendName,
+			ID:           id,
+			Include:      []string{cls},
+			BaseBackupID: "base",
+		})
+
+		assert.Nil(t, meta)
+		assert.ErrorContains(t, err, `base backup "base"`)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+	t.Run("BaseBackupNotSuccessful", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		fs.backend.On("GetObject", ctx, id, mock.Anything).Return(nil, backup.ErrNotFound{})
+		bytes := marshalCoordinatorMeta(backup.DistributedBackupDescriptor{ID: "base", Status: backup.Failed})
+		fs.backend.On("GetObject", ctx, "base", GlobalBackupFile).Return(bytes, nil)
+		meta, err := fs.scheduler().Backup(ctx, nil, &BackupRequest{
+			Backend:      backendName,
+			ID:           id,
+			Include:      []string{cls},
+			BaseBackupID: "base",
+		})
+
+		assert.Nil(t, meta)
+		assert.ErrorContains(t, err, "status is FAILED")
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+	t.Run("BaseBackupIsItself", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.selector.On("Backupable", ctx, []string{cls}).Return(nil)
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		fs.backend.On("GetObject", ctx, id, mock.Anything).Return(nil, backup.ErrNotFound{})
+		meta, err := fs.scheduler().Backup(ctx, nil, &BackupRequest{
+			Backend:      backendName,
+			ID:           id,
+			Include:      []string{cls},
+			BaseBackupID: id,
+		})
+
+		assert.Nil(t, meta)
+		assert.ErrorContains(t, err, "cannot be based on itself")
+	})
 }
 
 func TestSchedulerBackupStatus(t *testing.T) {
@@ -324,6 +374,7 @@ func TestSchedulerCreateBackup(t *testing.T) {
 		fs.client.On("Commit", any, node, sReq).Return(nil)
 		fs.client.On("Status", any, node, sReq).Return(sresp, nil)
 		fs.backend.On("PutObject", any, backupID, GlobalBackupFile, any).Return(nil).Twice()
+		fs.backend.On("GetObject", any, backupID, GlobalCancelFile).Return(nil, backup.ErrNotFound{})
 		m := fs.scheduler()
 		resp1, err := m.Backup(ctx, nil, &req1)
 		assert.Nil(t, err)
@@ -395,6 +446,7 @@ func TestSchedulerCreateBackup(t *testing.T) {
 		fs.client.On("Commit", any, node, sReq).Return(nil)
 		fs.client.On("Status", any, node, sReq).Return(sresp, nil)
 		fs.backend.On("PutObject", any, backupID, GlobalBackupFile, any).Return(nil).Twice()
+		fs.backend.On("GetObject", any, backupID, GlobalCancelFile).Return(nil, backup.ErrNotFound{})
 		s := fs.scheduler()
 		resp, err := s.Backup(ctx, nil, &req)
 		assert.Nil(t, err)
@@ -419,6 +471,176 @@ func TestSchedulerCreateBackup(t *testing.T) {
 	})
 }
 
+func TestSchedulerListBackups(t *testing.T) {
+	t.Parallel()
+	var (
+		backendName = "s3"
+		ctx         = context.Background()
+		starTime    = time.Date(2022, 1, 1, 1, 0, 0, 0, time.UTC)
+	)
+
+	t.Run("GetBackupProvider", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backendErr = ErrAny
+		_, err := fs.scheduler().List(ctx, nil, backendName)
+		assert.NotNil(t, err)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+	})
+
+	t.Run("ListFails", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("AllBackupIDs", ctx).Return(nil, ErrAny)
+		_, err := fs.scheduler().List(ctx, nil, backendName)
+		assert.ErrorIs(t, err, ErrAny)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("AllBackupIDs", ctx).Return([]string{"1", "2", "tmp"}, nil)
+		fs.backend.On("GetObject", ctx, "1", GlobalBackupFile).Return(marshalCoordinatorMeta(
+			backup.DistributedBackupDescriptor{
+				ID: "1", StartedAt: starTime, Status: backup.Success,
+				Nodes: map[string]*backup.NodeDescriptor{"N1": {Classes: []string{"C1", "C2"}}},
+			}), nil)
+		fs.backend.On("GetObject", ctx, "2", GlobalBackupFile).Return(marshalCoordinatorMeta(
+			backup.DistributedBackupDescriptor{
+				ID: "2", StartedAt: starTime, Status: backup.Started,
+				Nodes: map[string]*backup.NodeDescriptor{"N1": {Classes: []string{"C1"}}},
+			}), nil)
+		// not a backup
+		fs.backend.On("GetObject", ctx, "tmp", GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, "tmp", BackupFile).Return(nil, backup.ErrNotFound{})
+
+		s := fs.scheduler()
+		// the status of the running backup is more recent than its metadata
+		s.backupper.lastOp.reqStat = reqStat{ID: "2", Status: backup.Transferring}
+
+		got, err := s.List(ctx, nil, backendName)
+		assert.Nil(t, err)
+		want := models.BackupListResponse{
+			{ID: "1", Classes: []string{"C1", "C2"}, Status: string(backup.Success)},
+			{ID: "2", Classes: []string{"C1"}, Status: string(backup.Transferring)},
+		}
+		assert.Equal(t, want, got)
+	})
+}
+
+func TestSchedulerCancelBackup(t *testing.T) {
+	t.Parallel()
+	var (
+		cls         = "Class-A"
+		node        = "Node-A"
+		backendName = "gcs"
+		backupID    = "1"
+		any         = mock.Anything
+		ctx         = context.Background()
+		path        = "dst/path"
+		req         = BackupRequest{
+			ID:      backupID,
+			Include: []string{cls},
+			Backend: backendName,
+		}
+		cresp    = &CanCommitResponse{Method: OpCreate, ID: backupID, Timeout: 1}
+		sReq     = &StatusRequest{OpCreate, backupID, backendName}
+		abortReq = &AbortRequest{OpCreate, backupID, backendName}
+	)
+
+	t.Run("NotFound", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("GetObject", ctx, backupID, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, backupID, BackupFile).Return(nil, backup.ErrNotFound{})
+		err := fs.scheduler().Cancel(ctx, nil, backendName, backupID)
+		assert.IsType(t, backup.ErrNotFound{}, err)
+	})
+
+	t.Run("AlreadyCompleted", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backend.On("GetObject", ctx, backupID, GlobalBackupFile).Return(marshalCoordinatorMeta(
+			backup.DistributedBackupDescriptor{ID: backupID, Status: backup.Success}), nil)
+		err := fs.scheduler().Cancel(ctx, nil, backendName, backupID)
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		assert.ErrorContains(t, err, string(backup.Success))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		fs := newFakeScheduler(newFakeNodeResolver([]string{node}))
+		fs.selector.On("Backupable", ctx, req.Include).Return(nil)
+		fs.selector.On("Shards", ctx, cls).Return([]string{node}, nil)
+
+		fs.backend.On("GetObject", ctx, backupID, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, backupID, BackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		fs.backend.On("Initialize", ctx, mock.Anything).Return(nil)
+		fs.backend.On("PutObject", any, backupID, GlobalBackupFile, any).Return(nil).Twice()
+		fs.backend.On("GetObject", any, backupID, GlobalCancelFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("Delete", any, backupID+"/"+node).Return(nil)
+		fs.client.On("CanCommit", any, node, any).Return(cresp, nil)
+		fs.client.On("Commit", any, node, sReq).Return(nil)
+		fs.client.On("Status", any, node, sReq).Return(&StatusResponse{Status: backup.Transferring}, nil).Once()
+		fs.client.On("Status", any, node, sReq).Return(&StatusResponse{Status: backup.Failed, Err: "context canceled"}, nil)
+		fs.client.On("Abort", any, node, abortReq).Return(nil)
+
+		s := fs.scheduler()
+		_, err := s.Backup(ctx, nil, &req)
+		assert.Nil(t, err)
+		assert.Nil(t, s.Cancel(ctx, nil, backendName, backupID))
+
+		select {
+		case <-fs.backend.doneChan:
+		case <-time.After(5 * time.Second):
+			t.Fatal("backup has not been cancelled")
+		}
+		assert.Equal(t, backup.Canceled, fs.backend.glMeta.Status)
+		assert.Equal(t, "", fs.backend.glMeta.Error)
+		fs.client.AssertCalled(t, "Abort", any, node, abortReq)
+		fs.backend.AssertCalled(t, "Delete", any, backupID+"/"+node)
+	})
+	t.Run("OnOtherNode", func(t *testing.T) {
+		fs := newFakeScheduler(newFakeNodeResolver([]string{node}))
+		fs.backend.On("GetObject", ctx, backupID, GlobalBackupFile).Return(marshalCoordinatorMeta(
+			backup.DistributedBackupDescriptor{
+				ID: backupID, Status: backup.Started,
+				Nodes: map[string]*backup.NodeDescriptor{node: {Classes: []string{cls}}},
+			}), nil)
+		fs.backend.On("PutObject", any, backupID, GlobalCancelFile, any).Return(nil)
+		fs.client.On("Abort", any, node, abortReq).Return(nil)
+
+		assert.Nil(t, fs.scheduler().Cancel(ctx, nil, backendName, backupID))
+		fs.backend.AssertCalled(t, "PutObject", any, backupID, GlobalCancelFile, any)
+		fs.client.AssertCalled(t, "Abort", any, node, abortReq)
+	})
+
+	t.Run("CoordinatorFindsCancellationOfOtherNode", func(t *testing.T) {
+		fs := newFakeScheduler(newFakeNodeResolver([]string{node}))
+		fs.selector.On("Backupable", ctx, req.Include).Return(nil)
+		fs.selector.On("Shards", ctx, cls).Return([]string{node}, nil)
+
+		fs.backend.On("GetObject", ctx, backupID, GlobalBackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("GetObject", ctx, backupID, BackupFile).Return(nil, backup.ErrNotFound{})
+		fs.backend.On("HomeDir", mock.Anything).Return(path)
+		fs.backend.On("Initialize", ctx, mock.Anything).Return(nil)
+		fs.backend.On("PutObject", any, backupID, GlobalBackupFile, any).Return(nil).Twice()
+		fs.backend.On("GetObject", any, backupID, GlobalCancelFile).Return([]byte("{}"), nil)
+		fs.backend.On("Delete", any, backupID+"/"+node).Return(nil)
+		fs.client.On("CanCommit", any, node, any).Return(cresp, nil)
+		fs.client.On("Commit", any, node, sReq).Return(nil)
+		fs.client.On("Status", any, node, sReq).Return(&StatusResponse{Status: backup.Failed, Err: "context canceled"}, nil)
+		fs.client.On("Abort", any, node, abortReq).Return(nil)
+
+		s := fs.scheduler()
+		_, err := s.Backup(ctx, nil, &req)
+		assert.Nil(t, err)
+
+		select {
+		case <-fs.backend.doneChan:
+		case <-time.After(5 * time.Second):
+			t.Fatal("backup has not been cancelled")
+		}
+		assert.Equal(t, backup.Canceled, fs.backend.glMeta.Status)
+		fs.backend.AssertCalled(t, "Delete", any, backupID+"/"+node)
+	})
+}
+
 func TestSchedulerRestoration(t *testing.T) {
 	var (
 		cls         = "MyClass-A"
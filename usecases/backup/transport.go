@@ -48,6 +48,9 @@ type Request struct {
 
 	// Compression is the compression configuration.
 	Compression
+
+	// BaseBackupID is the ID of the backup an incremental backup is based on
+	BaseBackupID string
 }
 
 type CanCommitResponse struct {
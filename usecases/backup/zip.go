@@ -17,10 +17,13 @@ import (
 	"compress/gzip"
 	"context"
 	"fmt"
+	"hash"
+	"hash/crc32"
 	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -94,13 +97,27 @@ func (z *zip) WriteShard(ctx context.Context, sd *backup.ShardDescriptor) (writt
 
 	}
 
-	n, err = z.WriteRegulars(ctx, sd.Files)
+	// record segments so that later incremental backups can reference them
+	n, err = z.writeRegulars(ctx, sd.Files, func(relPath string, size int64, checksum uint32) {
+		if sd.Segments == nil {
+			sd.Segments = make(map[string]*backup.SegmentInfo)
+		}
+		sd.Segments[relPath] = &backup.SegmentInfo{Size: size, Checksum: checksum}
+	})
 	written += n
 
 	return
 }
 
 func (z *zip) WriteRegulars(ctx context.Context, relPaths []string) (written int64, err error) {
+	return z.writeRegulars(ctx, relPaths, nil)
+}
+
+// writeRegulars writes regular files. If onSegment is not nil, it is called
+// with the size and checksum of every LSM segment file written.
+func (z *zip) writeRegulars(ctx context.Context, relPaths []string,
+	onSegment func(relPath string, size int64, checksum uint32),
+) (written int64, err error) {
 	for _, relPath := range relPaths {
 		if filepath.Base(relPath) == ".DS_Store" {
 			continue
@@ -108,16 +125,28 @@ func (z *zip) WriteRegulars(ctx context.Context, relPaths []string) (written int
 		if err := ctx.Err(); err != nil {
 			return written, err
 		}
-		n, err := z.WriteRegular(relPath)
+		var h hash.Hash32
+		if onSegment != nil && isSegmentFile(relPath) {
+			h = crc32.NewIEEE()
+		}
+		n, err := z.writeRegular(relPath, h)
 		if err != nil {
 			return written, err
 		}
+		if h != nil {
+			onSegment(relPath, n, h.Sum32())
+		}
 		written += n
 	}
 	return written, nil
 }
 
 func (z *zip) WriteRegular(relPath string) (written int64, err error) {
+	return z.writeRegular(relPath, nil)
+}
+
+// writeRegular writes a regular file and feeds its content to h if h is not nil
+func (z *zip) writeRegular(relPath string, h hash.Hash32) (written int64, err error) {
 	// open file for read
 	absPath := filepath.Join(z.sourcePath, relPath)
 	info, err := os.Stat(absPath)
@@ -133,7 +162,17 @@ func (z *zip) WriteRegular(relPath string) (written int64, err error) {
 	}
 	defer f.Close()
 
-	return z.writeOne(info, relPath, f)
+	var r io.Reader = f
+	if h != nil {
+		r = io.TeeReader(f, h)
+	}
+	return z.writeOne(info, relPath, r)
+}
+
+// isSegmentFile reports whether relPath is an immutable LSM segment file
+// (including its bloom filters and count net additions)
+func isSegmentFile(relPath string) bool {
+	return strings.HasPrefix(filepath.Base(relPath), "segment-")
 }
 
 func (z *zip) writeOne(info fs.FileInfo, relPath string, r io.Reader) (written int64, err error) {
@@ -204,6 +243,12 @@ func (u *unzip) Close() (err error) {
 }
 
 func (u *unzip) ReadChunk() (written int64, err error) {
+	return u.ReadChunkFiles(nil)
+}
+
+// ReadChunkFiles extracts the regular files of a chunk for which keep returns
+// true. All files are extracted if keep is nil.
+func (u *unzip) ReadChunkFiles(keep func(name string) bool) (written int64, err error) {
 	if err := u.init(); err != nil {
 		return 0, err
 	}
@@ -228,6 +273,9 @@ func (u *unzip) ReadChunk() (written int64, err error) {
 				return written, fmt.Errorf("crateDir %s: %w", target, err)
 			}
 		case tar.TypeReg:
+			if keep != nil && !keep(header.Name) {
+				continue
+			}
 			if pp := filepath.Dir(target); pp != parentPath {
 				parentPath = pp
 				if err := os.MkdirAll(parentPath, 0o755); err != nil {
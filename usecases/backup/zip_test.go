@@ -103,6 +103,122 @@ func TestZip(t *testing.T) {
 	}
 }
 
+func TestZipSegmentChecksums(t *testing.T) {
+	var (
+		pathNode = "test_data/node1"
+		pathDest = "./test_data/node-unzipped-segments"
+		ctx      = context.Background()
+	)
+	defer os.RemoveAll(pathDest)
+
+	sd, err := getShard(pathNode, "cT9eTErXgmTX")
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := zipShard(t, ctx, pathNode, &sd)
+
+	// every segment file is recorded with its size and checksum
+	segments := segmentFiles(sd.Files)
+	if len(segments) == 0 || len(sd.Segments) != len(segments) {
+		t.Fatalf("number of segments got=%d want=%d", len(sd.Segments), len(segments))
+	}
+	for _, relPath := range segments {
+		seg := sd.Segments[relPath]
+		if seg == nil {
+			t.Fatalf("segment %s not recorded", relPath)
+		}
+		size, checksum, err := fileChecksum(filepath.Join(pathNode, relPath))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if seg.Size != size || seg.Checksum != checksum || seg.IsReference() {
+			t.Errorf("segment %s got=%+v want size=%d checksum=%d", relPath, *seg, size, checksum)
+		}
+	}
+
+	// only selected files are extracted
+	selected := sd.Files[0]
+	uz, wc := NewUnzip(pathDest)
+	go func() {
+		io.Copy(wc, buf)
+		wc.Close()
+	}()
+	if _, err := uz.ReadChunkFiles(func(name string) bool { return name == selected }); err != nil {
+		t.Fatalf("unzip: %v", err)
+	}
+	uz.Close()
+	for _, relPath := range sd.Files {
+		_, err := os.Stat(filepath.Join(pathDest, relPath))
+		if exists := err == nil; exists != (relPath == selected) {
+			t.Errorf("file %s extracted=%v", relPath, exists)
+		}
+	}
+}
+
+func TestUploaderReuseSegments(t *testing.T) {
+	var (
+		pathNode = "test_data/node1"
+		ctx      = context.Background()
+		class    = "Article"
+	)
+	base, err := getShard(pathNode, "cT9eTErXgmTX")
+	if err != nil {
+		t.Fatal(err)
+	}
+	zipShard(t, ctx, pathNode, &base)
+	base.Chunk = 3
+
+	// one segment changed and one was stored by an older backup
+	segments := segmentFiles(base.Files)
+	changed, older := segments[0], segments[1]
+	base.Segments[changed].Checksum++
+	base.Segments[older].BackupID = "older"
+	base.Segments[older].Chunk = 7
+
+	backend := newFakeBackend()
+	backend.On("SourceDataPath").Return(pathNode)
+	u := newUploader(nil, nodeStore{objStore{backend, "incr/node1"}}, "incr", nil, nil).
+		withBase(&backup.BackupDescriptor{
+			ID:      "base",
+			Classes: []backup.ClassDescriptor{{Name: class, Shards: []*backup.ShardDescriptor{&base}}},
+		})
+
+	sd, err := getShard(pathNode, "cT9eTErXgmTX")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := u.reuseSegments(class, &sd); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := len(base.Files) - len(segments) + 1; len(sd.Files) != n {
+		t.Fatalf("number of files to upload got=%d want=%d", len(sd.Files), n)
+	}
+	if segs := segmentFiles(sd.Files); len(segs) != 1 || segs[0] != changed {
+		t.Fatalf("segments to upload got=%v want=[%s]", segs, changed)
+	}
+	if got := len(sd.Segments); got != len(segments)-1 {
+		t.Fatalf("number of references got=%d want=%d", got, len(segments)-1)
+	}
+	for relPath, seg := range sd.Segments {
+		wantID, wantChunk := "base", int32(3)
+		if relPath == older {
+			wantID, wantChunk = "older", 7
+		}
+		if seg.BackupID != wantID || seg.Chunk != wantChunk {
+			t.Errorf("reference %s got=%s/%d want=%s/%d", relPath, seg.BackupID, seg.Chunk, wantID, wantChunk)
+		}
+	}
+
+	refs := segmentReferences(&backup.ClassDescriptor{Name: class, Shards: []*backup.ShardDescriptor{&sd}})
+	if len(refs) != 2 {
+		t.Fatalf("number of referenced chunks got=%d want=2", len(refs))
+	}
+	if files := refs[segmentReference{"older", 7}]; len(files) != 1 {
+		t.Errorf("files referenced from older backup got=%v", files)
+	}
+}
+
 func TestZipLevel(t *testing.T) {
 	tests := []struct {
 		in  int
@@ -158,6 +274,33 @@ func TestZipConfig(t *testing.T) {
 	}
 }
 
+func segmentFiles(relPaths []string) []string {
+	segments := make([]string, 0, len(relPaths))
+	for _, relPath := range relPaths {
+		if isSegmentFile(relPath) {
+			segments = append(segments, relPath)
+		}
+	}
+	return segments
+}
+
+// zipShard compresses sd and returns the compressed chunk
+func zipShard(t *testing.T, ctx context.Context, src string, sd *backup.ShardDescriptor) *bytes.Buffer {
+	buf := bytes.NewBuffer(make([]byte, 0, 1000_000))
+	z, rc := NewZip(src, 0)
+	go func() {
+		if _, err := z.WriteShard(ctx, sd); err != nil {
+			t.Errorf("compress: %v", err)
+		}
+		z.Close()
+	}()
+	if _, err := io.Copy(buf, rc); err != nil {
+		t.Fatal("copy to buffer", err)
+	}
+	rc.Close()
+	return buf
+}
+
 func getShard(src, shardName string) (sd backup.ShardDescriptor, err error) {
 	sd.Name = shardName
 	err = filepath.Walk(src, func(fPath string, fi os.FileInfo, err error) error {
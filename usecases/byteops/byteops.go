@@ -199,6 +199,45 @@ func Float32ToByteVector(floats []float32) []byte {
 	return vector
 }
 
+// Float32ToByteMultiVector encodes a multi vector as the uint16 length of its
+// vectors followed by the concatenated vectors
+func Float32ToByteMultiVector(vectors [][]float32) []byte {
+	var dims int
+	if len(vectors) > 0 {
+		dims = len(vectors[0])
+	}
+	out := make([]byte, uint16Len, uint16Len+len(vectors)*dims*uint32Len)
+	binary.LittleEndian.PutUint16(out, uint16(dims))
+	for _, vector := range vectors {
+		out = append(out, Float32ToByteVector(vector)...)
+	}
+	return out
+}
+
+func Float32FromByteMultiVector(vector []byte) ([][]float32, error) {
+	if len(vector) < uint16Len {
+		return nil, errors.New("multi vector is too short")
+	}
+	dims := int(binary.LittleEndian.Uint16(vector))
+	if dims == 0 {
+		// an empty multi vector is encoded without a vector length
+		if len(vector) > uint16Len {
+			return nil, errors.New("multi vector has vectors but no vector length")
+		}
+		return [][]float32{}, nil
+	}
+	floats := Float32FromByteVector(vector[uint16Len:])
+	if len(floats)%dims != 0 || len(vector[uint16Len:])%uint32Len != 0 {
+		return nil, errors.New("multi vector length is not a multiple of its vector length")
+	}
+
+	vectors := make([][]float32, len(floats)/dims)
+	for i := range vectors {
+		vectors[i] = floats[i*dims : (i+1)*dims]
+	}
+	return vectors, nil
+}
+
 func Float64ToByteVector(floats []float64) []byte {
 	vector := make([]byte, len(floats)*uint64Len)
 	for i := 0; i < len(floats); i++ {
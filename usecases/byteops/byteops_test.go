@@ -231,6 +231,43 @@ func TestFloat32FromByteVector(t *testing.T) {
 	})
 }
 
+func TestFloat32ByteMultiVector(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		vectors := [][]float32{{1.1, 2.2}, {3.3, 4.4}, {5.5, 6.6}}
+		bytes := Float32ToByteMultiVector(vectors)
+		assert.Len(t, bytes, 2+6*4)
+
+		out, err := Float32FromByteMultiVector(bytes)
+		require.Nil(t, err)
+		assert.Equal(t, vectors, out)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		bytes := Float32ToByteMultiVector([][]float32{})
+		assert.Len(t, bytes, 2)
+
+		out, err := Float32FromByteMultiVector(bytes)
+		require.Nil(t, err)
+		assert.Equal(t, [][]float32{}, out)
+	})
+
+	t.Run("vectors without a vector length", func(t *testing.T) {
+		_, err := Float32FromByteMultiVector([]byte{0, 0, 1, 2, 3, 4})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("invalid length", func(t *testing.T) {
+		bytes := Float32ToByteMultiVector([][]float32{{1.1, 2.2}})
+		_, err := Float32FromByteMultiVector(bytes[:len(bytes)-4])
+		assert.NotNil(t, err)
+	})
+
+	t.Run("too short", func(t *testing.T) {
+		_, err := Float32FromByteMultiVector([]byte{2})
+		assert.NotNil(t, err)
+	})
+}
+
 func TestFloat64ToByteVector(t *testing.T) {
 	t.Run("empty array", func(t *testing.T) {
 		bytes := Float32ToByteVector([]float32{})
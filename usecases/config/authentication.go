@@ -65,4 +65,12 @@ type APIKey struct {
 	Enabled     bool     `json:"enabled" yaml:"enabled"`
 	Users       []string `json:"users" yaml:"users"`
 	AllowedKeys []string `json:"allowed_keys" yaml:"allowed_keys"`
+	// Dynamic enables API keys which are managed through the REST API and
+	// stored in RAFT. The static keys above become optional.
+	Dynamic bool `json:"dynamic" yaml:"dynamic"`
+}
+
+// DynamicEnabled returns whether API keys can be managed at runtime
+func (a APIKey) DynamicEnabled() bool {
+	return a.Enabled && a.Dynamic
 }
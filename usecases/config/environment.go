@@ -150,6 +150,8 @@ func FromEnv(config *Config) error {
 			keys := strings.Split(keysString, ",")
 			config.Authentication.APIKey.Users = keys
 		}
+
+		config.Authentication.APIKey.Dynamic = entcfg.Enabled(os.Getenv("AUTHENTICATION_APIKEY_DYNAMIC_ENABLED"))
 	}
 
 	if entcfg.Enabled(os.Getenv("AUTHORIZATION_ADMINLIST_ENABLED")) {
@@ -176,6 +178,18 @@ func FromEnv(config *Config) error {
 		}
 	}
 
+	if entcfg.Enabled(os.Getenv("AUTHORIZATION_RBAC_ENABLED")) {
+		config.Authorization.RBAC.Enabled = true
+
+		if users, ok := os.LookupEnv("AUTHORIZATION_RBAC_ROOT_USERS"); ok {
+			config.Authorization.RBAC.RootUsers = strings.Split(users, ",")
+		}
+
+		if groups, ok := os.LookupEnv("AUTHORIZATION_RBAC_ROOT_GROUPS"); ok {
+			config.Authorization.RBAC.RootGroups = strings.Split(groups, ",")
+		}
+	}
+
 	config.Profiling.Disabled = entcfg.Enabled(os.Getenv("GO_PROFILING_DISABLE"))
 
 	if !config.Authentication.AnyAuthMethodSelected() {
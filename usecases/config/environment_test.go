@@ -703,6 +703,16 @@ func TestEnvironmentAuthentication(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:         "Valid dynamic API Key",
+			auth_env_var: []string{"AUTHENTICATION_APIKEY_ENABLED", "AUTHENTICATION_APIKEY_DYNAMIC_ENABLED"},
+			expected: Authentication{
+				APIKey: APIKey{
+					Enabled: true,
+					Dynamic: true,
+				},
+			},
+		},
 		{
 			name:         "Valid Anonymous Access",
 			auth_env_var: []string{"AUTHENTICATION_ANONYMOUS_ACCESS_ENABLED"},
@@ -733,8 +743,8 @@ func TestEnvironmentAuthentication(t *testing.T) {
 	}
 	for _, tt := range factors {
 		t.Run(tt.name, func(t *testing.T) {
-			if len(tt.auth_env_var) == 1 {
-				t.Setenv(tt.auth_env_var[0], "true")
+			for _, envVar := range tt.auth_env_var {
+				t.Setenv(envVar, "true")
 			}
 			conf := Config{}
 			err := FromEnv(&conf)
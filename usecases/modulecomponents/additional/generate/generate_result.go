@@ -114,7 +114,7 @@ func (p *GenerateProvider) generatePerSearchResult(ctx context.Context,
 			sem <- struct{}{}
 			defer wg.Done()
 			defer func() { <-sem }()
-			generateResult, err := client.GenerateSingleResult(ctx, textProperties, prompt, settings, debug, cfg)
+			generateResult, err := p.generateSingle(ctx, client, in[i].ID, textProperties, prompt, settings, debug, cfg)
 			p.setIndividualResult(in, i, generateResult, err)
 		}, p.logger)
 	}
@@ -135,7 +135,7 @@ func (p *GenerateProvider) generateForAllSearchResults(ctx context.Context,
 	for _, res := range in {
 		propertiesForAllDocs = append(propertiesForAllDocs, p.getTextProperties(res, properties))
 	}
-	generateResult, err := client.GenerateAllResults(ctx, propertiesForAllDocs, task, settings, debug, cfg)
+	generateResult, err := p.generateAll(ctx, client, propertiesForAllDocs, task, settings, debug, cfg)
 	p.setCombinedResult(in, 0, generateResult, err)
 	return in, nil
 }
@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generate
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+// StreamChunk is a piece of a generative result which is streamed while the
+// result is generated
+type StreamChunk struct {
+	// ID of the object the chunk was generated for, empty for the grouped result
+	ID      strfmt.UUID
+	Grouped bool
+	Text    string
+}
+
+// StreamFn receives the chunks of all generative results of a query. Results
+// for different objects are generated concurrently, so it must be safe for
+// concurrent use.
+type StreamFn func(chunk StreamChunk) error
+
+type streamContextKey struct{}
+
+// WithStream returns a context which makes the generate provider stream the
+// generated results to fn in addition to adding them to the search results
+func WithStream(ctx context.Context, fn StreamFn) context.Context {
+	return context.WithValue(ctx, streamContextKey{}, fn)
+}
+
+func streamFromContext(ctx context.Context) StreamFn {
+	fn, _ := ctx.Value(streamContextKey{}).(StreamFn)
+	return fn
+}
+
+// generateSingle generates the result for one object. If the query is
+// streamed, clients which support streaming stream the result in chunks, the
+// results of all other clients are sent as a single chunk.
+func (p *GenerateProvider) generateSingle(ctx context.Context,
+	client modulecapabilities.GenerativeClient, id strfmt.UUID,
+	textProperties map[string]string, prompt string, settings interface{}, debug bool, cfg moduletools.ClassConfig,
+) (*modulecapabilities.GenerateResponse, error) {
+	stream := streamFromContext(ctx)
+	if stream == nil {
+		return client.GenerateSingleResult(ctx, textProperties, prompt, settings, debug, cfg)
+	}
+
+	onChunk := func(chunk string) error {
+		return stream(StreamChunk{ID: id, Text: chunk})
+	}
+	if streaming, ok := client.(modulecapabilities.StreamingGenerativeClient); ok {
+		return streaming.GenerateSingleResultStream(ctx, textProperties, prompt, settings, debug, cfg, onChunk)
+	}
+	res, err := client.GenerateSingleResult(ctx, textProperties, prompt, settings, debug, cfg)
+	return sendWhole(res, err, onChunk)
+}
+
+// generateAll generates the grouped result, see generateSingle
+func (p *GenerateProvider) generateAll(ctx context.Context,
+	client modulecapabilities.GenerativeClient,
+	textProperties []map[string]string, task string, settings interface{}, debug bool, cfg moduletools.ClassConfig,
+) (*modulecapabilities.GenerateResponse, error) {
+	stream := streamFromContext(ctx)
+	if stream == nil {
+		return client.GenerateAllResults(ctx, textProperties, task, settings, debug, cfg)
+	}
+
+	onChunk := func(chunk string) error {
+		return stream(StreamChunk{Grouped: true, Text: chunk})
+	}
+	if streaming, ok := client.(modulecapabilities.StreamingGenerativeClient); ok {
+		return streaming.GenerateAllResultsStream(ctx, textProperties, task, settings, debug, cfg, onChunk)
+	}
+	res, err := client.GenerateAllResults(ctx, textProperties, task, settings, debug, cfg)
+	return sendWhole(res, err, onChunk)
+}
+
+// sendWhole sends the complete result of a client which doesn't support
+// streaming as a single chunk
+func sendWhole(res *modulecapabilities.GenerateResponse, err error,
+	onChunk modulecapabilities.GenerateChunkFn,
+) (*modulecapabilities.GenerateResponse, error) {
+	if err != nil || res == nil || res.Result == nil {
+		return res, err
+	}
+	if err := onChunk(*res.Result); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
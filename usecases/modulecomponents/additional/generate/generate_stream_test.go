@@ -0,0 +1,181 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generate
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestAdditionalAnswerProviderStream(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	newResults := func() []search.Result {
+		return []search.Result{
+			{ID: "uuid-1", Schema: map[string]interface{}{"content": "first"}},
+			{ID: "uuid-2", Schema: map[string]interface{}{"content": "second"}},
+		}
+	}
+	newProvider := func(client modulecapabilities.GenerativeClient) *GenerateProvider {
+		return NewGeneric(map[string]modulecapabilities.GenerativeProperty{"openai": {Client: client}}, "openai", logger)
+	}
+	limit := 2
+
+	t.Run("streams single results of a streaming client", func(t *testing.T) {
+		sink := &chunkSink{}
+		ctx := WithStream(context.Background(), sink.add)
+		prompt := "summarize {content}"
+		in := newResults()
+
+		_, err := newProvider(&fakeStreamingClient{}).AdditionalPropertyFn(ctx, in, &Params{Prompt: &prompt},
+			&limit, map[string]interface{}{}, nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"summarize ", "first"}, sink.textFor("uuid-1"))
+		assert.Equal(t, []string{"summarize ", "second"}, sink.textFor("uuid-2"))
+		generate := in[0].AdditionalProperties["generate"].(map[string]interface{})
+		assert.Equal(t, "summarize first", *generate["singleResult"].(*string))
+	})
+
+	t.Run("streams the grouped result of a streaming client", func(t *testing.T) {
+		sink := &chunkSink{}
+		ctx := WithStream(context.Background(), sink.add)
+		task := "a grouped task"
+		in := newResults()
+
+		_, err := newProvider(&fakeStreamingClient{}).AdditionalPropertyFn(ctx, in, &Params{Task: &task},
+			&limit, map[string]interface{}{}, nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"a ", "grouped ", "task"}, sink.grouped())
+		generate := in[0].AdditionalProperties["generate"].(map[string]interface{})
+		assert.Equal(t, task, *generate["groupedResult"].(*string))
+	})
+
+	t.Run("sends the whole result of other clients as one chunk", func(t *testing.T) {
+		sink := &chunkSink{}
+		ctx := WithStream(context.Background(), sink.add)
+		task := "a grouped task"
+
+		_, err := newProvider(&fakeClient{}).AdditionalPropertyFn(ctx, newResults(), &Params{Task: &task},
+			&limit, map[string]interface{}{}, nil)
+		require.Nil(t, err)
+
+		assert.Equal(t, []string{"a grouped task"}, sink.grouped())
+	})
+
+	t.Run("doesn't stream without a stream in the context", func(t *testing.T) {
+		task := "a grouped task"
+		client := &fakeStreamingClient{}
+		in := newResults()
+
+		_, err := newProvider(client).AdditionalPropertyFn(context.Background(), in, &Params{Task: &task},
+			&limit, map[string]interface{}{}, nil)
+		require.Nil(t, err)
+
+		assert.False(t, client.streamed)
+		generate := in[0].AdditionalProperties["generate"].(map[string]interface{})
+		assert.Equal(t, task, *generate["groupedResult"].(*string))
+	})
+
+	t.Run("reports errors of the stream", func(t *testing.T) {
+		ctx := WithStream(context.Background(), func(chunk StreamChunk) error {
+			return errors.New("client gone")
+		})
+		task := "a grouped task"
+		in := newResults()
+
+		_, err := newProvider(&fakeStreamingClient{}).AdditionalPropertyFn(ctx, in, &Params{Task: &task},
+			&limit, map[string]interface{}{}, nil)
+		require.Nil(t, err)
+
+		generate := in[0].AdditionalProperties["generate"].(map[string]interface{})
+		assert.EqualError(t, generate["error"].(error), "client gone")
+	})
+}
+
+type chunkSink struct {
+	sync.Mutex
+	chunks []StreamChunk
+}
+
+func (s *chunkSink) add(chunk StreamChunk) error {
+	s.Lock()
+	defer s.Unlock()
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func (s *chunkSink) textFor(id strfmt.UUID) []string {
+	var text []string
+	for _, chunk := range s.chunks {
+		if chunk.ID == id && !chunk.Grouped {
+			text = append(text, chunk.Text)
+		}
+	}
+	return text
+}
+
+func (s *chunkSink) grouped() []string {
+	var text []string
+	for _, chunk := range s.chunks {
+		if chunk.Grouped {
+			text = append(text, chunk.Text)
+		}
+	}
+	return text
+}
+
+// fakeStreamingClient streams the prompt with the properties filled in word
+// by word
+type fakeStreamingClient struct {
+	fakeClient
+	streamed bool
+}
+
+func (c *fakeStreamingClient) GenerateSingleResultStream(ctx context.Context, textProperties map[string]string, prompt string, settings interface{}, debug bool, cfg moduletools.ClassConfig, onChunk modulecapabilities.GenerateChunkFn) (*modulecapabilities.GenerateResponse, error) {
+	keys := make([]string, 0, len(textProperties))
+	for key := range textProperties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		prompt = strings.ReplaceAll(prompt, "{"+key+"}", textProperties[key])
+	}
+	return c.GenerateStream(ctx, cfg, prompt, settings, debug, onChunk)
+}
+
+func (c *fakeStreamingClient) GenerateAllResultsStream(ctx context.Context, textProperties []map[string]string, task string, settings interface{}, debug bool, cfg moduletools.ClassConfig, onChunk modulecapabilities.GenerateChunkFn) (*modulecapabilities.GenerateResponse, error) {
+	return c.GenerateStream(ctx, cfg, task, settings, debug, onChunk)
+}
+
+func (c *fakeStreamingClient) GenerateStream(ctx context.Context, cfg moduletools.ClassConfig, prompt string, settings interface{}, debug bool, onChunk modulecapabilities.GenerateChunkFn) (*modulecapabilities.GenerateResponse, error) {
+	c.streamed = true
+	words := strings.SplitAfter(prompt, " ")
+	for _, word := range words {
+		if err := onChunk(word); err != nil {
+			return nil, err
+		}
+	}
+	return &modulecapabilities.GenerateResponse{Result: &prompt}, nil
+}
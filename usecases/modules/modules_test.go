@@ -547,3 +547,11 @@ func (m *dummyBackupModuleWithAltNames) PutObject(ctx context.Context, backupID,
 func (m *dummyBackupModuleWithAltNames) Initialize(ctx context.Context, backupID string) error {
 	return nil
 }
+
+func (m *dummyBackupModuleWithAltNames) AllBackupIDs(ctx context.Context) ([]string, error) {
+	return nil, nil
+}
+
+func (m *dummyBackupModuleWithAltNames) Delete(ctx context.Context, backupID string) error {
+	return nil
+}
@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package nodes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func Test_Manager_Authorization(t *testing.T) {
+	type testCase struct {
+		name             string
+		call             func(m *Manager, principal *models.Principal) error
+		expectedVerb     string
+		expectedResource string
+	}
+
+	tests := []testCase{
+		{
+			name: "GetNodeStatus",
+			call: func(m *Manager, principal *models.Principal) error {
+				_, err := m.GetNodeStatus(context.Background(), principal, "", "")
+				return err
+			},
+			expectedVerb:     "list",
+			expectedResource: "nodes",
+		},
+		{
+			name: "GetNodeStatistics",
+			call: func(m *Manager, principal *models.Principal) error {
+				_, err := m.GetNodeStatistics(context.Background(), principal)
+				return err
+			},
+			expectedVerb:     "list",
+			expectedResource: "cluster",
+		},
+		{
+			name: "RequantizeShard",
+			call: func(m *Manager, principal *models.Principal) error {
+				return m.RequantizeShard(context.Background(), principal, "foo", "shard1", "")
+			},
+			expectedVerb:     "update",
+			expectedResource: "schema/Foo/shards/shard1",
+		},
+		{
+			name: "GetVectorIndexHealth",
+			call: func(m *Manager, principal *models.Principal) error {
+				_, err := m.GetVectorIndexHealth(context.Background(), principal, "foo", "shard1", "", 10)
+				return err
+			},
+			expectedVerb:     "get",
+			expectedResource: "schema/Foo/shards/shard1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, _ := test.NewNullLogger()
+			principal := &models.Principal{}
+			authorizer := &authDenier{}
+			db := &fakeDB{}
+			manager := NewManager(logger, authorizer, db, nil)
+
+			err := tt.call(manager, principal)
+			require.Len(t, authorizer.calls, 1, "authorizer must be called")
+			assert.Equal(t, errors.New("just a test fake"), err,
+				"execution must abort with authorizer error")
+			assert.Equal(t, authorizeCall{principal, tt.expectedVerb, tt.expectedResource},
+				authorizer.calls[0], "correct parameters must have been used on authorizer")
+			assert.Zero(t, db.calls, "db must not be called")
+		})
+	}
+}
+
+type authorizeCall struct {
+	principal *models.Principal
+	verb      string
+	resource  string
+}
+
+type authDenier struct {
+	calls []authorizeCall
+}
+
+func (a *authDenier) Authorize(principal *models.Principal, verb, resource string) error {
+	a.calls = append(a.calls, authorizeCall{principal, verb, resource})
+	return errors.New("just a test fake")
+}
+
+type fakeDB struct {
+	calls int
+}
+
+func (f *fakeDB) GetNodeStatus(ctx context.Context, className, verbosity string) ([]*models.NodeStatus, error) {
+	f.calls++
+	return nil, nil
+}
+
+func (f *fakeDB) GetNodeStatistics(ctx context.Context) ([]*models.Statistics, error) {
+	f.calls++
+	return nil, nil
+}
+
+func (f *fakeDB) RequantizeShard(ctx context.Context, className, shardName, targetVector string) error {
+	f.calls++
+	return nil
+}
+
+func (f *fakeDB) GetVectorIndexHealth(ctx context.Context, className, shardName, targetVector string,
+	recallSamples int,
+) (*models.VectorIndexHealth, error) {
+	f.calls++
+	return nil, nil
+}
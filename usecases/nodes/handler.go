@@ -13,10 +13,12 @@ package nodes
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 )
 
@@ -29,6 +31,9 @@ type authorizer interface {
 type db interface {
 	GetNodeStatus(ctx context.Context, className, verbosity string) ([]*models.NodeStatus, error)
 	GetNodeStatistics(ctx context.Context) ([]*models.Statistics, error)
+	RequantizeShard(ctx context.Context, className, shardName, targetVector string) error
+	GetVectorIndexHealth(ctx context.Context, className, shardName, targetVector string,
+		recallSamples int) (*models.VectorIndexHealth, error)
 }
 
 type Manager struct {
@@ -69,3 +74,29 @@ func (m *Manager) GetNodeStatistics(ctx context.Context,
 	}
 	return m.db.GetNodeStatistics(ctxWithTimeout)
 }
+
+// RequantizeShard starts the requantization of the vector index of the local
+// replica of a shard. It requires the permission to update the shard.
+func (m *Manager) RequantizeShard(ctx context.Context, principal *models.Principal,
+	className, shardName, targetVector string,
+) error {
+	err := m.authorizer.Authorize(principal, "update",
+		fmt.Sprintf("schema/%s/shards/%s", schema.UppercaseClassName(className), shardName))
+	if err != nil {
+		return err
+	}
+	return m.db.RequantizeShard(ctx, className, shardName, targetVector)
+}
+
+// GetVectorIndexHealth reports on the vector index of the local replica of a
+// shard. It requires the permission to read the shard.
+func (m *Manager) GetVectorIndexHealth(ctx context.Context, principal *models.Principal,
+	className, shardName, targetVector string, recallSamples int,
+) (*models.VectorIndexHealth, error) {
+	err := m.authorizer.Authorize(principal, "get",
+		fmt.Sprintf("schema/%s/shards/%s", schema.UppercaseClassName(className), shardName))
+	if err != nil {
+		return nil, err
+	}
+	return m.db.GetVectorIndexHealth(ctx, className, shardName, targetVector, recallSamples)
+}
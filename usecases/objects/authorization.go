@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// objectPath returns the resource used to authorize operations on a single
+// object. The deprecated endpoints without a class can't be scoped and use
+// the id only.
+func objectPath(class, tenant string, id strfmt.UUID) string {
+	if class == "" {
+		return fmt.Sprintf("objects/%s", id)
+	}
+	return fmt.Sprintf("%s/%s", authorization.ObjectsPath(class, tenant), id)
+}
+
+// batchPath returns the resource used to authorize a batch operation on the
+// objects of a class. A batch without a class is rejected during validation,
+// but is authorized on all objects until then.
+func batchPath(class, tenant string) string {
+	if class == "" {
+		return "objects"
+	}
+	return authorization.ObjectsPath(class, tenant)
+}
+
+// newObjectPath returns the resource used to authorize adding an object
+func newObjectPath(object *models.Object) string {
+	if object == nil || object.Class == "" {
+		return "objects"
+	}
+	return authorization.ObjectsPath(object.Class, object.Tenant)
+}
+
+// authorizePaths authorizes the verb on every distinct resource once
+func authorizePaths(authorizer authorizer, principal *models.Principal, verb string, paths []string) error {
+	seen := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		if _, ok := seen[path]; ok {
+			continue
+		}
+		seen[path] = struct{}{}
+		if err := authorizer.Authorize(principal, verb, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// authorizeFilter authorizes listing the objects of the classes that are
+// reached through references in the filter, as they are read to find the
+// matching objects. The tenant only applies to classes with multi-tenancy
+// enabled.
+func (b *BatchManager) authorizeFilter(principal *models.Principal,
+	filter *filters.LocalFilter, class, tenant string,
+) error {
+	if filter == nil || filter.Root == nil {
+		return nil
+	}
+
+	var paths []string
+	var walk func(clause filters.Clause)
+	walk = func(clause filters.Clause) {
+		for path := clause.On; path != nil; path = path.Child {
+			if path.Class == "" || path.Class.String() == class {
+				continue
+			}
+			if tenant != "" && b.schemaManager.MultiTenancy(path.Class.String()).Enabled {
+				paths = append(paths, authorization.ObjectsPath(path.Class.String(), tenant))
+			} else {
+				paths = append(paths, authorization.ObjectsPath(path.Class.String(), ""))
+			}
+		}
+		for _, operand := range clause.Operands {
+			walk(operand)
+		}
+	}
+	walk(*filter.Root)
+
+	return authorizePaths(b.authorizer, principal, "list", paths)
+}
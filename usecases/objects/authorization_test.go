@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/go-openapi/strfmt"
@@ -23,6 +24,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/config"
 )
@@ -62,7 +64,7 @@ func Test_Kinds_Authorization(t *testing.T) {
 			methodName:       "DeleteObject",
 			additionalArgs:   []interface{}{"class", strfmt.UUID("foo")},
 			expectedVerb:     "delete",
-			expectedResource: "objects/class/foo",
+			expectedResource: "objects/Class/foo",
 		},
 		{ // deprecated by the one above
 			methodName:       "DeleteObject",
@@ -74,7 +76,7 @@ func Test_Kinds_Authorization(t *testing.T) {
 			methodName:       "UpdateObject",
 			additionalArgs:   []interface{}{"class", strfmt.UUID("foo"), (*models.Object)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "objects/class/foo",
+			expectedResource: "objects/Class/foo",
 		},
 		{ // deprecated by the one above
 			methodName:       "UpdateObject",
@@ -89,7 +91,7 @@ func Test_Kinds_Authorization(t *testing.T) {
 				(*additional.ReplicationProperties)(nil),
 			},
 			expectedVerb:     "update",
-			expectedResource: "objects/class/foo",
+			expectedResource: "objects/Class/foo",
 		},
 		{
 			methodName:       "GetObjectsClass",
@@ -107,7 +109,7 @@ func Test_Kinds_Authorization(t *testing.T) {
 			methodName:       "HeadObject",
 			additionalArgs:   []interface{}{"class", strfmt.UUID("foo")},
 			expectedVerb:     "head",
-			expectedResource: "objects/class/foo",
+			expectedResource: "objects/Class/foo",
 		},
 		{ // deprecated by the one above
 			methodName:       "HeadObject",
@@ -136,7 +138,7 @@ func Test_Kinds_Authorization(t *testing.T) {
 			methodName:       "AddObjectReference",
 			additionalArgs:   []interface{}{AddReferenceInput{Class: "class", ID: strfmt.UUID("foo"), Property: "some prop"}, (*models.SingleRef)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "objects/class/foo",
+			expectedResource: "objects/Class/foo",
 		},
 		{
 			methodName:       "DeleteObjectReference",
@@ -148,7 +150,7 @@ func Test_Kinds_Authorization(t *testing.T) {
 			methodName:       "UpdateObjectReferences",
 			additionalArgs:   []interface{}{&PutReferenceInput{Class: "class", ID: strfmt.UUID("foo"), Property: "some prop"}},
 			expectedVerb:     "update",
-			expectedResource: "objects/class/foo",
+			expectedResource: "objects/Class/foo",
 		},
 	}
 
@@ -209,45 +211,49 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 		{
 			methodName: "AddObjects",
 			additionalArgs: []interface{}{
-				[]*models.Object{},
+				[]*models.Object{{Class: "article", Tenant: "tenant1"}, {Class: "Other"}},
 				[]*string{},
 				&additional.ReplicationProperties{},
 			},
 			expectedVerb:     "create",
-			expectedResource: "batch/objects",
+			expectedResource: "objects/Article/tenant1",
 		},
 
 		{
 			methodName: "AddReferences",
 			additionalArgs: []interface{}{
-				[]*models.BatchReference{},
+				[]*models.BatchReference{{
+					From:   "weaviate://localhost/Article/d18c8e5e-a339-4c15-8af6-56b0cfe33ce7/ref",
+					To:     "weaviate://localhost/d18c8e5e-a339-4c15-8af6-56b0cfe33ce8",
+					Tenant: "tenant1",
+				}},
 				&additional.ReplicationProperties{},
 			},
 			expectedVerb:     "update",
-			expectedResource: "batch/*",
+			expectedResource: "objects/Article/tenant1/d18c8e5e-a339-4c15-8af6-56b0cfe33ce7",
 		},
 
 		{
 			methodName: "DeleteObjects",
 			additionalArgs: []interface{}{
-				&models.BatchDeleteMatch{},
+				&models.BatchDeleteMatch{Class: "Article"},
 				(*bool)(nil),
 				(*string)(nil),
 				&additional.ReplicationProperties{},
-				"",
+				"tenant1",
 			},
 			expectedVerb:     "delete",
-			expectedResource: "batch/objects",
+			expectedResource: "objects/Article/tenant1",
 		},
 		{
 			methodName: "DeleteObjectsFromGRPC",
 			additionalArgs: []interface{}{
-				BatchDeleteParams{},
+				BatchDeleteParams{ClassName: "Article"},
 				&additional.ReplicationProperties{},
 				"",
 			},
 			expectedVerb:     "delete",
-			expectedResource: "batch/objects",
+			expectedResource: "objects/Article",
 		},
 	}
 
@@ -286,6 +292,81 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 	})
 }
 
+func Test_BatchKinds_AuthorizeClasses(t *testing.T) {
+	principal := &models.Principal{}
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		call      func(*BatchManager) error
+		resources []string
+	}{
+		{
+			name: "add objects of several classes and tenants",
+			call: func(m *BatchManager) error {
+				_, err := m.AddObjects(ctx, principal, []*models.Object{
+					{Class: "article", Tenant: "tenant1"},
+					{Class: "Article", Tenant: "tenant1"},
+					{Class: "Article", Tenant: "tenant2"},
+					{Class: "Secret"},
+				}, nil, nil)
+				return err
+			},
+			resources: []string{"objects/Article/tenant1", "objects/Article/tenant2", "objects/Secret"},
+		},
+		{
+			name: "add references to objects of several classes",
+			call: func(m *BatchManager) error {
+				_, err := m.AddReferences(ctx, principal, []*models.BatchReference{
+					{From: "weaviate://localhost/Article/d18c8e5e-a339-4c15-8af6-56b0cfe33ce7/ref", Tenant: "tenant1"},
+					{From: "not a beacon"},
+					{From: "weaviate://localhost/Secret/d18c8e5e-a339-4c15-8af6-56b0cfe33ce8/ref"},
+				}, nil)
+				return err
+			},
+			resources: []string{
+				"objects/Article/tenant1/d18c8e5e-a339-4c15-8af6-56b0cfe33ce7",
+				"objects/Secret/d18c8e5e-a339-4c15-8af6-56b0cfe33ce8",
+			},
+		},
+		{
+			name: "delete with a filter through a reference",
+			call: func(m *BatchManager) error {
+				_, err := m.DeleteObjectsFromGRPC(ctx, principal, BatchDeleteParams{
+					ClassName: "Article",
+					Filters: &filters.LocalFilter{Root: &filters.Clause{
+						Operator: filters.OperatorEqual,
+						On: &filters.Path{
+							Class: "Article", Property: "ref",
+							Child: &filters.Path{Class: "Secret", Property: "name"},
+						},
+					}},
+				}, nil, "")
+				return err
+			},
+			resources: []string{"objects/Article", "objects/Secret"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			authorizer := &authRecorder{deny: "objects/Secret"}
+			manager := NewBatchManager(&fakeVectorRepo{}, getFakeModulesProvider(), &fakeLocks{},
+				&fakeSchemaManager{}, &config.WeaviateConfig{}, logger, authorizer, nil)
+
+			err := test.call(manager)
+			assert.Equal(t, errors.New("just a test fake"), err)
+
+			resources := make([]string, len(authorizer.calls))
+			for i, call := range authorizer.calls {
+				resources[i] = call.resource
+			}
+			assert.Equal(t, test.resources, resources)
+		})
+	}
+}
+
 type authorizeCall struct {
 	principal *models.Principal
 	verb      string
@@ -301,6 +382,20 @@ func (a *authDenier) Authorize(principal *models.Principal, verb, resource strin
 	return errors.New("just a test fake")
 }
 
+// authRecorder records all calls and denies a resource and the ones below it
+type authRecorder struct {
+	calls []authorizeCall
+	deny  string
+}
+
+func (a *authRecorder) Authorize(principal *models.Principal, verb, resource string) error {
+	a.calls = append(a.calls, authorizeCall{principal, verb, resource})
+	if resource == a.deny || strings.HasPrefix(resource, a.deny+"/") {
+		return errors.New("just a test fake")
+	}
+	return nil
+}
+
 // inspired by https://stackoverflow.com/a/33008200
 func callFuncByName(manager interface{}, funcName string, params ...interface{}) (out []reflect.Value, err error) {
 	managerValue := reflect.ValueOf(manager)
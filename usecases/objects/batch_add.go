@@ -29,7 +29,11 @@ var errEmptyObjects = NewErrInvalidUserInput("invalid param 'objects': cannot be
 func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Principal,
 	objects []*models.Object, fields []*string, repl *additional.ReplicationProperties,
 ) (BatchObjects, error) {
-	err := b.authorizer.Authorize(principal, "create", "batch/objects")
+	paths := make([]string, len(objects))
+	for i, object := range objects {
+		paths[i] = newObjectPath(object)
+	}
+	err := authorizePaths(b.authorizer, principal, "create", paths)
 	if err != nil {
 		return nil, err
 	}
@@ -30,7 +30,11 @@ func (b *BatchManager) DeleteObjects(ctx context.Context, principal *models.Prin
 	match *models.BatchDeleteMatch, dryRun *bool, output *string,
 	repl *additional.ReplicationProperties, tenant string,
 ) (*BatchDeleteResponse, error) {
-	err := b.authorizer.Authorize(principal, "delete", "batch/objects")
+	class := ""
+	if match != nil {
+		class = match.Class
+	}
+	err := b.authorizer.Authorize(principal, "delete", batchPath(class, tenant))
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +60,11 @@ func (b *BatchManager) DeleteObjectsFromGRPC(ctx context.Context, principal *mod
 	params BatchDeleteParams,
 	repl *additional.ReplicationProperties, tenant string,
 ) (BatchDeleteResult, error) {
-	err := b.authorizer.Authorize(principal, "delete", "batch/objects")
+	err := b.authorizer.Authorize(principal, "delete", batchPath(params.ClassName.String(), tenant))
+	if err != nil {
+		return BatchDeleteResult{}, err
+	}
+	err = b.authorizeFilter(principal, params.Filters, params.ClassName.String(), tenant)
 	if err != nil {
 		return BatchDeleteResult{}, err
 	}
@@ -81,6 +89,9 @@ func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Prin
 	if err != nil {
 		return nil, NewErrInvalidUserInput("validate: %v", err)
 	}
+	if err := b.authorizeFilter(principal, params.Filters, params.ClassName.String(), tenant); err != nil {
+		return nil, err
+	}
 
 	// Ensure that the local schema has caught up to the version we used to validate
 	if err := b.schemaManager.WaitForUpdate(ctx, schemaVersion); err != nil {
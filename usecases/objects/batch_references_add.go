@@ -31,7 +31,18 @@ import (
 func (b *BatchManager) AddReferences(ctx context.Context, principal *models.Principal,
 	refs []*models.BatchReference, repl *additional.ReplicationProperties,
 ) (BatchReferences, error) {
-	err := b.authorizer.Authorize(principal, "update", "batch/*")
+	// a reference is added to its source object, sources that can't be parsed
+	// are rejected during validation
+	paths := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		if ref == nil {
+			continue
+		}
+		if source, err := crossref.ParseSource(string(ref.From)); err == nil {
+			paths = append(paths, objectPath(source.Class.String(), ref.Tenant, source.TargetID))
+		}
+	}
+	err := authorizePaths(b.authorizer, principal, "update", paths)
 	if err != nil {
 		return nil, err
 	}
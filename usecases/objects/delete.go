@@ -31,10 +31,7 @@ func (m *Manager) DeleteObject(ctx context.Context,
 	principal *models.Principal, class string, id strfmt.UUID,
 	repl *additional.ReplicationProperties, tenant string,
 ) error {
-	path := fmt.Sprintf("objects/%s/%s", class, id)
-	if class == "" {
-		path = fmt.Sprintf("objects/%s", id)
-	}
+	path := objectPath(class, tenant, id)
 	err := m.authorizer.Authorize(principal, "delete", path)
 	if err != nil {
 		return err
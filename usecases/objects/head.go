@@ -13,7 +13,6 @@ package objects
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -24,10 +23,7 @@ import (
 func (m *Manager) HeadObject(ctx context.Context, principal *models.Principal, class string,
 	id strfmt.UUID, repl *additional.ReplicationProperties, tenant string,
 ) (bool, *Error) {
-	path := fmt.Sprintf("objects/%s", id)
-	if class != "" {
-		path = fmt.Sprintf("objects/%s/%s", class, id)
-	}
+	path := objectPath(class, tenant, id)
 	if err := m.authorizer.Authorize(principal, "head", path); err != nil {
 		return false, &Error{path, StatusForbidden, err}
 	}
@@ -29,11 +29,11 @@ func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
 	class string, id strfmt.UUID, updates *models.Object,
 	repl *additional.ReplicationProperties,
 ) (*models.Object, error) {
-	path := fmt.Sprintf("objects/%s/%s", class, id)
-	if class == "" {
-		path = fmt.Sprintf("objects/%s", id)
+	tenant := ""
+	if updates != nil {
+		tenant = updates.Tenant
 	}
-	err := m.authorizer.Authorize(principal, "update", path)
+	err := m.authorizer.Authorize(principal, "update", objectPath(class, tenant, id))
 	if err != nil {
 		return nil, err
 	}
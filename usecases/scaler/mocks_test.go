@@ -21,6 +21,8 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/mock"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/replica/hashtree"
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
@@ -70,6 +72,7 @@ func (f *fakeFactory) Scaler(dataPath string) *Scaler {
 		nodeResolver,
 		f.Source,
 		f.Client,
+		f.Client,
 		f.logger,
 		dataPath)
 	scaler.SetSchemaReader(&f.ShardingState)
@@ -77,8 +80,9 @@ func (f *fakeFactory) Scaler(dataPath string) *Scaler {
 }
 
 type fakeShardingState struct {
-	LocalNode string
-	M         map[string][]string
+	LocalNode     string
+	M             map[string][]string
+	AsyncDisabled bool
 }
 
 func (f *fakeShardingState) CopyShardingState(class string) *sharding.State {
@@ -94,6 +98,13 @@ func (f *fakeShardingState) CopyShardingState(class string) *sharding.State {
 	return &state
 }
 
+func (f *fakeShardingState) ReadOnlyClass(class string) *models.Class {
+	return &models.Class{
+		Class:             class,
+		ReplicationConfig: &models.ReplicationConfig{AsyncEnabled: !f.AsyncDisabled},
+	}
+}
+
 // func newShardingState(nShard, rf int, localNode string) fakeShardingState {
 // 	m := make(map[string][]string)
 // 	for i := 0; i < nShard; i++ {
@@ -188,3 +199,10 @@ func (f *fakeClient) IncreaseReplicationFactor(ctx context.Context,
 	args := f.Called(ctx, host, class, dist)
 	return args.Error(0)
 }
+
+func (f *fakeClient) HashTreeLevel(ctx context.Context,
+	host, index, shard string, level int, discriminant *hashtree.Bitset,
+) ([]hashtree.Digest, error) {
+	args := f.Called(ctx, host, index, shard, level, discriminant)
+	return args.Get(0).([]hashtree.Digest), args.Error(1)
+}
@@ -22,6 +22,7 @@ import (
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/usecases/replica/hashtree"
 )
 
 // client the client interface is used to communicate with remote nodes
@@ -42,6 +43,12 @@ type client interface {
 	IncreaseReplicationFactor(ctx context.Context, host, class string, dist ShardDist) error
 }
 
+// replicaClient is used to compare shard replicas on remote nodes
+type replicaClient interface {
+	HashTreeLevel(ctx context.Context, host, index, shard string, level int,
+		discriminant *hashtree.Bitset) (digests []hashtree.Digest, err error)
+}
+
 // rsync synchronizes shards with remote nodes
 type rsync struct {
 	client          client
@@ -22,6 +22,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/replica/hashtree"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"github.com/weaviate/weaviate/usecases/sharding/config"
 )
@@ -32,35 +34,42 @@ import (
 // We could concurrently sync same files to different nodes  while avoiding overlapping
 //
 // 2. To fail fast, we might consider creating all shards at once and re-initialize them in the final step
-//
-// 3. implement scaler.scaleIn
 
 var (
 	// ErrUnresolvedName cannot resolve the host address of a node
 	ErrUnresolvedName = errors.New("cannot resolve node name")
-	_NUMCPU           = runtime.NumCPU()
+	// ErrReplicasOutOfSync replicas of a shard differ, scaling in could lose data
+	ErrReplicasOutOfSync = errors.New("replicas are not in sync")
+	// ErrAsyncReplicationDisabled replicas can't be compared without the
+	// hashtrees maintained by async replication
+	ErrAsyncReplicationDisabled = errors.New("async replication is not enabled")
+	_NUMCPU                     = runtime.NumCPU()
 )
 
 // Scaler scales out/in class replicas.
 //
-// It scales out a class by replicating its shards on new replicas
+// It scales out a class by replicating its shards on new replicas.
+// It scales in a class by removing replicas once it made sure that the
+// removed replicas do not hold any data the remaining ones are missing.
 type Scaler struct {
 	schemaReader    SchemaReader
 	cluster         cluster
-	source          BackUpper // data source
-	client          client    // client for remote nodes
+	source          BackUpper     // data source
+	client          client        // client for remote nodes
+	replicas        replicaClient // client for comparing remote replicas
 	logger          logrus.FieldLogger
 	persistenceRoot string
 }
 
 // New returns a new instance of Scaler
 func New(cl cluster, source BackUpper,
-	c client, logger logrus.FieldLogger, persistenceRoot string,
+	c client, rc replicaClient, logger logrus.FieldLogger, persistenceRoot string,
 ) *Scaler {
 	return &Scaler{
 		cluster:         cl,
 		source:          source,
 		client:          c,
+		replicas:        rc,
 		logger:          logger,
 		persistenceRoot: persistenceRoot,
 	}
@@ -87,6 +96,7 @@ type cluster interface {
 // SchemaReader is used by the scaler to get and update sharding states
 type SchemaReader interface {
 	CopyShardingState(class string) *sharding.State
+	ReadOnlyClass(class string) *models.Class
 }
 
 func (s *Scaler) SetSchemaReader(sr SchemaReader) {
@@ -113,7 +123,7 @@ func (s *Scaler) Scale(ctx context.Context, className string,
 	}
 
 	if newReplFactor < prevReplFactor {
-		return s.scaleIn(ctx, className, updated)
+		return s.scaleIn(ctx, className, ssBefore, updated, newReplFactor)
 	}
 
 	return nil, nil
@@ -215,8 +225,120 @@ func (s *Scaler) LocalScaleOut(ctx context.Context,
 	return rsync.Push(ctx, bak.Shards, dist, className, s.logger)
 }
 
-func (s *Scaler) scaleIn(ctx context.Context, className string,
-	updated config.Config,
+// scaleIn removes class shards from replicas (nodes):
+//
+// * It calculates new sharding state, dropping replicas per shard
+// * It verifies that all replicas of a shard are in sync, which requires
+// async replication to be enabled for the class
+//
+// The local data of the removed replicas is not deleted here. This happens on
+// each node once the returned sharding state has been applied through the
+// schema store, as only then no more traffic is routed to the removed replicas.
+func (s *Scaler) scaleIn(ctx context.Context, className string, ssBefore *sharding.State,
+	updated config.Config, replFactor int64,
 ) (*sharding.State, error) {
-	return nil, errors.Errorf("scaling in not supported yet")
+	class := s.schemaReader.ReadOnlyClass(className)
+	if class == nil || class.ReplicationConfig == nil || !class.ReplicationConfig.AsyncEnabled {
+		return nil, fmt.Errorf("%w for class %q: it is required to verify "+
+			"that replicas are in sync before removing them", ErrAsyncReplicationDisabled, className)
+	}
+
+	ssAfter := ssBefore.DeepCopy()
+	ssAfter.Config = updated
+
+	for name, shard := range ssAfter.Physical {
+		if err := shard.AdjustReplicas(int(replFactor), s.cluster); err != nil {
+			return nil, err
+		}
+		ssAfter.Physical[name] = shard
+	}
+
+	g, ctx := enterrors.NewErrorGroupWithContextWrapper(s.logger, ctx)
+	g.SetLimit(_NUMCPU * 2)
+	for name := range ssBefore.Physical {
+		name := name
+		before := ssBefore.Physical[name].BelongsToNodes
+		after := ssAfter.Physical[name].BelongsToNodes
+		g.Go(func() error {
+			return s.verifyReplicas(ctx, className, name, before, after)
+		}, name)
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return &ssAfter, nil
+}
+
+// verifyReplicas makes sure that no data gets lost by removing replicas from a
+// shard. Every replica which is part of the before state is compared to the
+// first remaining replica. Removed replicas which are no longer part of the
+// cluster can't be compared and are skipped.
+func (s *Scaler) verifyReplicas(ctx context.Context, className, shardName string,
+	before, after []string,
+) error {
+	removed := make(map[string]bool)
+	for _, node := range difference(before, after) {
+		removed[node] = true
+	}
+	if len(removed) == 0 || len(after) == 0 {
+		return nil
+	}
+
+	candidates := make(map[string]bool)
+	for _, node := range s.cluster.Candidates() {
+		candidates[node] = true
+	}
+
+	refHost, ok := s.cluster.NodeHostname(after[0])
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnresolvedName, after[0])
+	}
+
+	for _, node := range before {
+		if node == after[0] {
+			continue
+		}
+		if removed[node] && !candidates[node] {
+			s.logger.WithField("action", "scale_in").
+				WithField("class", className).
+				WithField("shard", shardName).
+				WithField("node", node).
+				Warn("removed replica is not part of the cluster, skipping verification")
+			continue
+		}
+		host, ok := s.cluster.NodeHostname(node)
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrUnresolvedName, node)
+		}
+		if err := s.compareReplicas(ctx, className, shardName, refHost, host); err != nil {
+			return fmt.Errorf("shard %q: nodes %q and %q: %w", shardName, after[0], node, err)
+		}
+	}
+	return nil
+}
+
+// compareReplicas compares the hashtree roots of a shard on two hosts. Equal
+// roots mean that both replicas hold the same objects. Hashtrees are only
+// maintained if async replication is enabled for the class.
+func (s *Scaler) compareReplicas(ctx context.Context, className, shardName, host1, host2 string) error {
+	discriminant := hashtree.NewBitset(hashtree.NodesCount(1))
+	discriminant.Set(0) // compare root level only
+
+	digests1, err := s.replicas.HashTreeLevel(ctx, host1, className, shardName, 0, discriminant)
+	if err != nil {
+		return fmt.Errorf("hashtree of %q: %w", host1, err)
+	}
+	digests2, err := s.replicas.HashTreeLevel(ctx, host2, className, shardName, 0, discriminant)
+	if err != nil {
+		return fmt.Errorf("hashtree of %q: %w", host2, err)
+	}
+	if len(digests1) == 0 || len(digests2) == 0 {
+		return fmt.Errorf("empty hashtree root")
+	}
+
+	if hashtree.LevelDiff(0, discriminant, digests1, digests2) > 0 {
+		return ErrReplicasOutOfSync
+	}
+	return nil
 }
@@ -19,7 +19,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/usecases/replica/hashtree"
 	"github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
@@ -40,12 +42,72 @@ func TestScalerScale(t *testing.T) {
 		_, err := scaler.Scale(ctx, "C", old, 2, 2)
 		assert.Nil(t, err)
 	})
-	t.Run("ScaleInNotSupported", func(t *testing.T) {
-		scaler := newFakeFactory().Scaler("")
-		old := config.Config{}
-		_, err := scaler.Scale(ctx, "C", old, 2, 1)
-		assert.NotNil(t, err)
-		assert.Contains(t, err.Error(), "not supported")
+}
+
+func TestScalerScaleIn(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		cls     = "C"
+		old     = config.Config{}
+		digestA = []hashtree.Digest{{1, 2}}
+		digestB = []hashtree.Digest{{3, 4}}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("HashTreeLevel", anyVal, "H3", cls, "S3", 0, anyVal).Return(digestA, nil)
+		f.Client.On("HashTreeLevel", anyVal, "H4", cls, "S3", 0, anyVal).Return(digestA, nil)
+		scaler := f.Scaler("")
+		ss, err := scaler.Scale(ctx, cls, old, 2, 1)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"N1"}, ss.Physical["S1"].BelongsToNodes)
+		assert.Equal(t, []string{"N3"}, ss.Physical["S3"].BelongsToNodes)
+	})
+
+	t.Run("ReplicasOutOfSync", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("HashTreeLevel", anyVal, "H3", cls, "S3", 0, anyVal).Return(digestA, nil)
+		f.Client.On("HashTreeLevel", anyVal, "H4", cls, "S3", 0, anyVal).Return(digestB, nil)
+		scaler := f.Scaler("")
+		_, err := scaler.Scale(ctx, cls, old, 2, 1)
+		assert.ErrorIs(t, err, ErrReplicasOutOfSync)
+	})
+
+	t.Run("HashTreeUnavailable", func(t *testing.T) {
+		f := newFakeFactory()
+		f.Client.On("HashTreeLevel", anyVal, "H3", cls, "S3", 0, anyVal).Return([]hashtree.Digest(nil), errAny)
+		f.Client.On("HashTreeLevel", anyVal, "H4", cls, "S3", 0, anyVal).Return(digestA, nil)
+		scaler := f.Scaler("")
+		_, err := scaler.Scale(ctx, cls, old, 2, 1)
+		assert.ErrorIs(t, err, errAny)
+	})
+
+	t.Run("RemovedNodeLeftCluster", func(t *testing.T) {
+		f := newFakeFactory()
+		delete(f.NodeHostMap, "N4")
+		scaler := f.Scaler("")
+		ss, err := scaler.Scale(ctx, cls, old, 2, 1)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"N3"}, ss.Physical["S3"].BelongsToNodes)
+		f.Client.AssertNotCalled(t, "HashTreeLevel")
+	})
+
+	t.Run("AsyncReplicationDisabled", func(t *testing.T) {
+		f := newFakeFactory()
+		f.ShardingState.AsyncDisabled = true
+		scaler := f.Scaler("")
+		_, err := scaler.Scale(ctx, cls, old, 2, 1)
+		assert.ErrorIs(t, err, ErrAsyncReplicationDisabled)
+		f.Client.AssertNotCalled(t, "HashTreeLevel")
+	})
+
+	t.Run("KeepsAvailableReplica", func(t *testing.T) {
+		f := newFakeFactory()
+		delete(f.NodeHostMap, "N3")
+		scaler := f.Scaler("")
+		ss, err := scaler.Scale(ctx, cls, old, 2, 1)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"N4"}, ss.Physical["S3"].BelongsToNodes)
 	})
 }
 
@@ -49,68 +49,68 @@ func Test_Schema_Authorization(t *testing.T) {
 		{
 			methodName:       "GetClass",
 			additionalArgs:   []interface{}{"classname"},
-			expectedVerb:     "list",
-			expectedResource: "schema/*",
+			expectedVerb:     "get",
+			expectedResource: "schema/Classname",
 		},
 		{
 			methodName:       "GetConsistentClass",
 			additionalArgs:   []interface{}{"classname", false},
-			expectedVerb:     "list",
-			expectedResource: "schema/*",
+			expectedVerb:     "get",
+			expectedResource: "schema/Classname",
 		},
 		{
 			methodName:       "GetCachedClass",
 			additionalArgs:   []interface{}{"classname"},
-			expectedVerb:     "list",
-			expectedResource: "schema/*",
+			expectedVerb:     "get",
+			expectedResource: "schema/Classname",
 		},
 		{
 			methodName:       "AddClass",
-			additionalArgs:   []interface{}{&models.Class{}},
+			additionalArgs:   []interface{}{&models.Class{Class: "someName"}},
 			expectedVerb:     "create",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/SomeName",
 		},
 		{
 			methodName:       "UpdateClass",
-			additionalArgs:   []interface{}{"somename", &models.Class{}},
+			additionalArgs:   []interface{}{"somename", &models.Class{Class: "somename"}},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/Somename",
 		},
 		{
 			methodName:       "DeleteClass",
 			additionalArgs:   []interface{}{"somename"},
 			expectedVerb:     "delete",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/Somename",
 		},
 		{
 			methodName:       "AddClassProperty",
-			additionalArgs:   []interface{}{&models.Class{}, false, &models.Property{}},
+			additionalArgs:   []interface{}{&models.Class{Class: "somename"}, false, &models.Property{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/Somename",
 		},
 		{
 			methodName:       "DeleteClassProperty",
 			additionalArgs:   []interface{}{"somename", "someprop"},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/Somename",
 		},
 		{
 			methodName:       "UpdateShardStatus",
 			additionalArgs:   []interface{}{"className", "shardName", "targetStatus"},
 			expectedVerb:     "update",
-			expectedResource: "schema/className/shards/shardName",
+			expectedResource: "schema/ClassName/shards/shardName",
 		},
 		{
 			methodName:       "ShardsStatus",
 			additionalArgs:   []interface{}{"className", "tenant"},
 			expectedVerb:     "list",
-			expectedResource: "schema/className/shards",
+			expectedResource: "schema/ClassName/shards",
 		},
 		{
 			methodName:       "AddTenants",
 			additionalArgs:   []interface{}{"className", []*models.Tenant{{Name: "P1"}}},
 			expectedVerb:     "update",
-			expectedResource: tenantsPath,
+			expectedResource: "schema/ClassName/tenants/P1",
 		},
 		{
 			methodName: "UpdateTenants",
@@ -118,31 +118,31 @@ func Test_Schema_Authorization(t *testing.T) {
 				{Name: "P1", ActivityStatus: models.TenantActivityStatusHOT},
 			}},
 			expectedVerb:     "update",
-			expectedResource: tenantsPath,
+			expectedResource: "schema/ClassName/tenants/P1",
 		},
 		{
 			methodName:       "DeleteTenants",
 			additionalArgs:   []interface{}{"className", []string{"P1"}},
 			expectedVerb:     "delete",
-			expectedResource: tenantsPath,
+			expectedResource: "schema/ClassName/tenants/P1",
 		},
 		{
 			methodName:       "GetTenants",
 			additionalArgs:   []interface{}{"className"},
 			expectedVerb:     "get",
-			expectedResource: tenantsPath,
+			expectedResource: "schema/ClassName/tenants",
 		},
 		{
 			methodName:       "GetConsistentTenants",
 			additionalArgs:   []interface{}{"className", false, []string{}},
 			expectedVerb:     "get",
-			expectedResource: tenantsPath,
+			expectedResource: "schema/ClassName/tenants",
 		},
 		{
 			methodName:       "ConsistentTenantExists",
 			additionalArgs:   []interface{}{"className", false, "P1"},
 			expectedVerb:     "get",
-			expectedResource: tenantsPath,
+			expectedResource: "schema/ClassName/tenants/P1",
 		},
 	}
 
@@ -202,6 +202,33 @@ func Test_Schema_Authorization(t *testing.T) {
 	})
 }
 
+func Test_Schema_Authorization_UpdateClass(t *testing.T) {
+	principal := &models.Principal{}
+
+	t.Run("class name is uppercased in the resource", func(t *testing.T) {
+		authorizer := &authDenier{}
+		handler, _ := newTestHandlerWithCustomAuthorizer(t, &fakeDB{}, authorizer)
+
+		for _, name := range []string{"myClass", "MyClass"} {
+			handler.UpdateClass(context.Background(), principal, name, &models.Class{Class: name})
+		}
+
+		require.Len(t, authorizer.calls, 2)
+		for _, call := range authorizer.calls {
+			assert.Equal(t, authorizeCall{principal, "update", "schema/MyClass"}, call)
+		}
+	})
+
+	t.Run("class in body must match the authorized class", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+
+		err := handler.UpdateClass(context.Background(), principal, "A", &models.Class{Class: "B"})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "class name is immutable")
+		fakeSchemaManager.AssertNotCalled(t, "UpdateClass", mock.Anything, mock.Anything)
+	})
+}
+
 type authorizeCall struct {
 	principal *models.Principal
 	verb      string
@@ -20,10 +20,12 @@ import (
 	"strings"
 
 	entcfg "github.com/weaviate/weaviate/entities/config"
+	"github.com/weaviate/weaviate/entities/objectttl"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stemmer"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/classcache"
@@ -39,7 +41,7 @@ import (
 )
 
 func (h *Handler) GetClass(ctx context.Context, principal *models.Principal, name string) (*models.Class, error) {
-	if err := h.Authorizer.Authorize(principal, "list", "schema/*"); err != nil {
+	if err := h.Authorizer.Authorize(principal, "get", classPath(name)); err != nil {
 		return nil, err
 	}
 	cl := h.schemaReader.ReadOnlyClass(name)
@@ -49,7 +51,7 @@ func (h *Handler) GetClass(ctx context.Context, principal *models.Principal, nam
 func (h *Handler) GetConsistentClass(ctx context.Context, principal *models.Principal,
 	name string, consistency bool,
 ) (*models.Class, uint64, error) {
-	if err := h.Authorizer.Authorize(principal, "list", "schema/*"); err != nil {
+	if err := h.Authorizer.Authorize(principal, "get", classPath(name)); err != nil {
 		return nil, 0, err
 	}
 	if consistency {
@@ -63,8 +65,10 @@ func (h *Handler) GetConsistentClass(ctx context.Context, principal *models.Prin
 func (h *Handler) GetCachedClass(ctxWithClassCache context.Context,
 	principal *models.Principal, names ...string,
 ) (map[string]versioned.Class, error) {
-	if err := h.Authorizer.Authorize(principal, "list", "schema/*"); err != nil {
-		return nil, err
+	for _, name := range names {
+		if err := h.Authorizer.Authorize(principal, "get", classPath(name)); err != nil {
+			return nil, err
+		}
 	}
 
 	return classcache.ClassesFromContext(ctxWithClassCache, func(names ...string) (map[string]versioned.Class, error) {
@@ -97,7 +101,7 @@ func (h *Handler) GetCachedClass(ctxWithClassCache context.Context,
 func (h *Handler) AddClass(ctx context.Context, principal *models.Principal,
 	cls *models.Class,
 ) (*models.Class, uint64, error) {
-	err := h.Authorizer.Authorize(principal, "create", "schema/objects")
+	err := h.Authorizer.Authorize(principal, "create", classPath(cls.Class))
 	if err != nil {
 		return nil, 0, err
 	}
@@ -190,7 +194,7 @@ func (h *Handler) RestoreClass(ctx context.Context, d *backup.ClassDescriptor, m
 
 // DeleteClass from the schema
 func (h *Handler) DeleteClass(ctx context.Context, principal *models.Principal, class string) error {
-	err := h.Authorizer.Authorize(principal, "delete", "schema/objects")
+	err := h.Authorizer.Authorize(principal, "delete", classPath(class))
 	if err != nil {
 		return err
 	}
@@ -202,11 +206,18 @@ func (h *Handler) DeleteClass(ctx context.Context, principal *models.Principal,
 func (h *Handler) UpdateClass(ctx context.Context, principal *models.Principal,
 	className string, updated *models.Class,
 ) error {
-	err := h.Authorizer.Authorize(principal, "update", "schema/objects")
+	err := h.Authorizer.Authorize(principal, "update", classPath(className))
 	if err != nil || updated == nil {
 		return err
 	}
 
+	// the class in the body is the one which gets updated, it must be the one
+	// the permission was checked for
+	if schema.UppercaseClassName(updated.Class) != schema.UppercaseClassName(className) {
+		return errors.Errorf("class name is immutable: attempted change from %q to %q",
+			className, updated.Class)
+	}
+
 	// make sure unset optionals on 'updated' don't lead to an error, as all
 	// optionals would have been set with defaults on the initial already
 	h.setClassDefaults(updated)
@@ -513,6 +524,10 @@ func (h *Handler) validateProperty(
 			return err
 		}
 
+		if err := h.validatePropertyTextAnalyzer(property); err != nil {
+			return err
+		}
+
 		if err := h.validatePropModuleConfig(class, property); err != nil {
 			return err
 		}
@@ -576,6 +591,10 @@ func (h *Handler) validateCanAddClass(
 		return err
 	}
 
+	if err := objectttl.ValidateConfig(class); err != nil {
+		return err
+	}
+
 	// all is fine!
 	return nil
 }
@@ -664,6 +683,47 @@ func (h *Handler) validatePropertyIndexing(prop *models.Property) error {
 			}
 		}
 	}
+	if prop.IndexPositions != nil && *prop.IndexPositions {
+		switch dataType {
+		case schema.DataTypeString, schema.DataTypeStringArray, schema.DataTypeText, schema.DataTypeTextArray:
+			if prop.IndexSearchable != nil && !*prop.IndexSearchable {
+				return fmt.Errorf("`indexPositions` requires `indexSearchable` to be enabled")
+			}
+		default:
+			return fmt.Errorf("`indexPositions` is allowed only for text/text[] data types. " +
+				"For other data types set false or leave empty")
+		}
+	}
+
+	return nil
+}
+
+func (h *Handler) validatePropertyTextAnalyzer(prop *models.Property) error {
+	if prop.TextAnalyzer == nil {
+		return nil
+	}
+
+	switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
+	case schema.DataTypeString, schema.DataTypeStringArray, schema.DataTypeText, schema.DataTypeTextArray:
+	default:
+		return fmt.Errorf("`textAnalyzer` is allowed only for text/text[] data types")
+	}
+
+	cfg := prop.TextAnalyzer
+	if prop.Tokenization == models.PropertyTokenizationTrigram && (cfg.StopwordPreset != "" || cfg.Stemmer != "") {
+		return fmt.Errorf("`textAnalyzer.stopwordPreset` and `textAnalyzer.stemmer` are not supported " +
+			"for trigram tokenization")
+	}
+	if cfg.StopwordPreset != "" {
+		if _, ok := stopwords.Presets[cfg.StopwordPreset]; !ok {
+			return fmt.Errorf("`textAnalyzer.stopwordPreset` %q not known", cfg.StopwordPreset)
+		}
+	}
+	if cfg.Stemmer != "" {
+		if _, err := stemmer.Get(cfg.Stemmer); err != nil {
+			return fmt.Errorf("`textAnalyzer.stemmer`: %w", err)
+		}
+	}
 
 	return nil
 }
@@ -717,7 +777,8 @@ func (h *Handler) validateVectorizer(vectorizer string) error {
 
 func (h *Handler) validateVectorIndexType(vectorIndexType string) error {
 	switch vectorIndexType {
-	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC:
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC,
+		vectorindex.VectorIndexTypeDISKANN, vectorindex.VectorIndexTypeSPARSE:
 		return nil
 	default:
 		return errors.Errorf("unrecognized or unsupported vectorIndexType %q",
@@ -1032,7 +1032,7 @@ func Test_UpdateClass(t *testing.T) {
 		fakeSchemaManager.On("ReadOnlyClass", "WrongClass", mock.Anything).Return(nil)
 		fakeSchemaManager.On("UpdateClass", mock.Anything, mock.Anything).Return(ErrNotFound)
 
-		err := handler.UpdateClass(context.Background(), nil, "WrongClass", &models.Class{})
+		err := handler.UpdateClass(context.Background(), nil, "WrongClass", &models.Class{Class: "WrongClass"})
 		require.NotNil(t, err)
 		assert.Equal(t, ErrNotFound, err)
 		fakeSchemaManager.AssertExpectations(t)
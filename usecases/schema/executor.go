@@ -109,6 +109,14 @@ func (e *executor) UpdateClass(req api.UpdateClassRequest) error {
 		return fmt.Errorf("update replication config: %w", err)
 	}
 
+	// the sharding state is only part of the request if the replication factor
+	// changed. After scaling in, replicas removed from this node must be deleted
+	if req.State != nil {
+		if err := e.migrator.DropRemovedReplicas(ctx, className, req.State); err != nil {
+			return fmt.Errorf("drop removed replicas: %w", err)
+		}
+	}
+
 	return nil
 }
 
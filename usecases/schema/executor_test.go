@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 var (
@@ -95,6 +96,29 @@ func TestExecutor(t *testing.T) {
 		assert.Nil(t, x.UpdateClass(api.UpdateClassRequest{Class: cls}))
 	})
 
+	t.Run("UpdateIndexWithShardingState", func(t *testing.T) {
+		migrator := &fakeMigrator{}
+		ss := &sharding.State{}
+		migrator.On("UpdateVectorIndexConfig", Anything, "A", Anything).Return(nil)
+		migrator.On("UpdateInvertedIndexConfig", Anything, "A", Anything).Return(nil)
+		migrator.On("DropRemovedReplicas", Anything, "A", ss).Return(nil)
+
+		x := newMockExecutor(migrator, store)
+		assert.Nil(t, x.UpdateClass(api.UpdateClassRequest{Class: cls, State: ss}))
+		migrator.AssertCalled(t, "DropRemovedReplicas", Anything, "A", ss)
+	})
+
+	t.Run("DropRemovedReplicas", func(t *testing.T) {
+		migrator := &fakeMigrator{}
+		ss := &sharding.State{}
+		migrator.On("UpdateVectorIndexConfig", Anything, "A", Anything).Return(nil)
+		migrator.On("UpdateInvertedIndexConfig", Anything, "A", Anything).Return(nil)
+		migrator.On("DropRemovedReplicas", Anything, "A", ss).Return(ErrAny)
+
+		x := newMockExecutor(migrator, store)
+		assert.ErrorIs(t, x.UpdateClass(api.UpdateClassRequest{Class: cls, State: ss}), ErrAny)
+	})
+
 	t.Run("UpdateVectorIndexConfig", func(t *testing.T) {
 		migrator := &fakeMigrator{}
 		migrator.On("UpdateVectorIndexConfig", Anything, "A", Anything).Return(ErrAny)
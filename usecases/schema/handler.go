@@ -186,6 +186,14 @@ func (h *Handler) GetConsistentSchema(principal *models.Principal, consistency b
 	}
 }
 
+// classPath is the resource used to authorize operations on a single class,
+// its shards and tenants are authorized on sub-resources of it. The class name
+// is uppercased like it is before the class is looked up, so that "article"
+// can't bypass a permission on "Article".
+func classPath(class string) string {
+	return "schema/" + schema.UppercaseClassName(class)
+}
+
 // GetSchemaSkipAuth can never be used as a response to a user request as it
 // could leak the schema to an unauthorized user, is intended to be used for
 // non-user triggered processes, such as regular updates / maintenance / etc
@@ -210,7 +218,7 @@ func (h *Handler) UpdateShardStatus(ctx context.Context,
 	principal *models.Principal, class, shard, status string,
 ) (uint64, error) {
 	err := h.Authorizer.Authorize(principal, "update",
-		fmt.Sprintf("schema/%s/shards/%s", class, shard))
+		fmt.Sprintf("%s/shards/%s", classPath(class), shard))
 	if err != nil {
 		return 0, err
 	}
@@ -221,7 +229,7 @@ func (h *Handler) UpdateShardStatus(ctx context.Context,
 func (h *Handler) ShardsStatus(ctx context.Context,
 	principal *models.Principal, class, tenant string,
 ) (models.ShardStatusList, error) {
-	err := h.Authorizer.Authorize(principal, "list", fmt.Sprintf("schema/%s/shards", class))
+	err := h.Authorizer.Authorize(principal, "list", fmt.Sprintf("%s/shards", classPath(class)))
 	if err != nil {
 		return nil, err
 	}
@@ -336,6 +336,11 @@ func (f *fakeMigrator) UpdateReplicationConfig(ctx context.Context, className st
 	return nil
 }
 
+func (f *fakeMigrator) DropRemovedReplicas(ctx context.Context, className string, shardingState *sharding.State) error {
+	args := f.Called(ctx, className, shardingState)
+	return args.Error(0)
+}
+
 func (f *fakeMigrator) WaitForStartup(ctx context.Context) error {
 	args := f.Called(ctx)
 	return args.Error(0)
@@ -61,6 +61,7 @@ type Migrator interface {
 		updated *models.InvertedIndexConfig) error
 	UpdateReplicationConfig(ctx context.Context, className string,
 		updated *models.ReplicationConfig) error
+	DropRemovedReplicas(ctx context.Context, className string, shardingState *sharding.State) error
 	WaitForStartup(context.Context) error
 	Shutdown(context.Context) error
 }
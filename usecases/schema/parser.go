@@ -18,9 +18,12 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/objectttl"
 	"github.com/weaviate/weaviate/entities/schema"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/config"
 	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
 )
@@ -65,6 +68,17 @@ func (m *Parser) parseVectorIndexConfig(class *models.Class,
 		if err != nil {
 			return err
 		}
+		if hnsw.IsMultivector(parsed) {
+			return fmt.Errorf("multi vectors are only supported for named vectors")
+		}
+		if parsed.IndexType() == vectorindex.VectorIndexTypeSPARSE {
+			return fmt.Errorf("sparse vectors are only supported for named vectors")
+		}
+		if precision, err := common.StoragePrecisionOf(parsed); err != nil {
+			return err
+		} else if precision != common.PrecisionFloat32 {
+			return fmt.Errorf("storagePrecision is only supported for named vectors")
+		}
 		class.VectorIndexConfig = parsed
 		return nil
 	}
@@ -100,16 +114,32 @@ func (m *Parser) parseTargetVectorsVectorIndexConfig(class *models.Class) error
 		if err != nil {
 			return fmt.Errorf("parse vector config for %s: %w", targetVector, err)
 		}
+		if hnsw.IsMultivector(parsed) && !isVectorizerNone(vectorConfig.Vectorizer) {
+			return fmt.Errorf("parse vector config for %s: multi vectors can only be used without a vectorizer", targetVector)
+		}
+		if parsed.IndexType() == vectorindex.VectorIndexTypeSPARSE && !isVectorizerNone(vectorConfig.Vectorizer) {
+			return fmt.Errorf("parse vector config for %s: sparse vectors can only be used without a vectorizer", targetVector)
+		}
 		vectorConfig.VectorIndexConfig = parsed
 		class.VectorConfig[targetVector] = vectorConfig
 	}
 	return nil
 }
 
+func isVectorizerNone(vectorizer interface{}) bool {
+	vm, ok := vectorizer.(map[string]interface{})
+	if !ok || len(vm) != 1 {
+		return false
+	}
+	_, ok = vm[config.VectorizerModuleNone]
+	return ok
+}
+
 func (m *Parser) parseGivenVectorIndexConfig(vectorIndexType string,
 	vectorIndexConfig interface{},
 ) (schemaConfig.VectorIndexConfig, error) {
-	if vectorIndexType != vectorindex.VectorIndexTypeHNSW && vectorIndexType != vectorindex.VectorIndexTypeFLAT && vectorIndexType != vectorindex.VectorIndexTypeDYNAMIC {
+	if vectorIndexType != vectorindex.VectorIndexTypeHNSW && vectorIndexType != vectorindex.VectorIndexTypeFLAT && vectorIndexType != vectorindex.VectorIndexTypeDYNAMIC &&
+		vectorIndexType != vectorindex.VectorIndexTypeDISKANN && vectorIndexType != vectorindex.VectorIndexTypeSPARSE {
 		return nil, errors.Errorf(
 			"parse vector index config: unsupported vector index type: %q",
 			vectorIndexType)
@@ -166,6 +196,10 @@ func (p *Parser) ParseClassUpdate(class, update *models.Class) (*models.Class, e
 		return nil, fmt.Errorf("validate sharding config: %w", err)
 	}
 
+	if err := objectttl.ValidateConfig(update); err != nil {
+		return nil, err
+	}
+
 	if !reflect.DeepEqual(class.Properties, update.Properties) {
 		return nil, errors.Errorf(
 			"properties cannot be updated through updating the class. Use the add " +
@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/fakes"
+)
+
+func TestParser_StoragePrecision(t *testing.T) {
+	parseHnsw := func(in interface{}, vectorIndexType string) (schemaConfig.VectorIndexConfig, error) {
+		return hnsw.ParseAndValidateConfig(in)
+	}
+	parser := NewParser(fakes.NewFakeClusterState(), parseHnsw, &fakeValidator{})
+	vectorIndexConfig := map[string]interface{}{"storagePrecision": "float16"}
+
+	t.Run("legacy vector", func(t *testing.T) {
+		class := &models.Class{
+			Class:             "LegacyVector",
+			VectorIndexType:   "hnsw",
+			VectorIndexConfig: vectorIndexConfig,
+		}
+		err := parser.ParseClass(class)
+		assert.ErrorContains(t, err, "storagePrecision is only supported for named vectors")
+	})
+
+	t.Run("named vector", func(t *testing.T) {
+		class := &models.Class{
+			Class: "NamedVector",
+			VectorConfig: map[string]models.VectorConfig{
+				"vec": {
+					Vectorizer:        map[string]interface{}{"none": map[string]interface{}{}},
+					VectorIndexType:   "hnsw",
+					VectorIndexConfig: vectorIndexConfig,
+				},
+			},
+		}
+		require.Nil(t, parser.ParseClass(class))
+		parsed, ok := class.VectorConfig["vec"].VectorIndexConfig.(hnsw.UserConfig)
+		require.True(t, ok)
+		assert.Equal(t, "float16", parsed.StoragePrecision)
+	})
+}
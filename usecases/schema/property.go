@@ -26,7 +26,11 @@ import (
 func (h *Handler) AddClassProperty(ctx context.Context, principal *models.Principal,
 	class *models.Class, merge bool, newProps ...*models.Property,
 ) (*models.Class, uint64, error) {
-	err := h.Authorizer.Authorize(principal, "update", "schema/objects")
+	className := ""
+	if class != nil {
+		className = class.Class
+	}
+	err := h.Authorizer.Authorize(principal, "update", classPath(className))
 	if err != nil {
 		return nil, 0, err
 	}
@@ -86,7 +90,7 @@ func (h *Handler) AddClassProperty(ctx context.Context, principal *models.Princi
 func (h *Handler) DeleteClassProperty(ctx context.Context, principal *models.Principal,
 	class string, property string,
 ) error {
-	err := h.Authorizer.Authorize(principal, "update", "schema/objects")
+	err := h.Authorizer.Authorize(principal, "update", classPath(class))
 	if err != nil {
 		return err
 	}
@@ -715,6 +715,110 @@ func Test_Validation_PropertyIndexing(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("validates indexPositions", func(t *testing.T) {
+		testCases := []struct {
+			name               string
+			dataType           schema.DataType
+			indexSearchable    *bool
+			indexPositions     *bool
+			expectedErrContain string
+		}{
+			{name: "text", dataType: schema.DataTypeText, indexPositions: &vTrue},
+			{name: "text[]", dataType: schema.DataTypeTextArray, indexPositions: &vTrue},
+			{name: "text searchable", dataType: schema.DataTypeText, indexSearchable: &vTrue, indexPositions: &vTrue},
+			{
+				name: "text not searchable", dataType: schema.DataTypeText, indexSearchable: &vFalse, indexPositions: &vTrue,
+				expectedErrContain: "`indexPositions` requires `indexSearchable` to be enabled",
+			},
+			{name: "text not searchable without positions", dataType: schema.DataTypeText, indexSearchable: &vFalse, indexPositions: &vFalse},
+			{
+				name: "int", dataType: schema.DataTypeInt, indexPositions: &vTrue,
+				expectedErrContain: "`indexPositions` is allowed only for text/text[] data types",
+			},
+			{name: "int without positions", dataType: schema.DataTypeInt, indexPositions: &vFalse},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := handler.validatePropertyIndexing(&models.Property{
+					Name:            "prop",
+					DataType:        tc.dataType.PropString(),
+					IndexSearchable: tc.indexSearchable,
+					IndexPositions:  tc.indexPositions,
+				})
+
+				if tc.expectedErrContain == "" {
+					require.NoError(t, err)
+				} else {
+					assert.ErrorContains(t, err, tc.expectedErrContain)
+				}
+			})
+		}
+	})
+}
+
+func TestHandler_ValidatePropertyTextAnalyzer(t *testing.T) {
+	handler, _ := newTestHandler(t, &fakeDB{})
+
+	testCases := []struct {
+		name               string
+		dataType           schema.DataType
+		tokenization       string
+		textAnalyzer       *models.TextAnalyzerConfig
+		expectedErrContain string
+	}{
+		{name: "no analyzer", dataType: schema.DataTypeInt},
+		{
+			name: "text", dataType: schema.DataTypeText, tokenization: models.PropertyTokenizationWord,
+			textAnalyzer: &models.TextAnalyzerConfig{StopwordPreset: "de", Stemmer: "de", ASCIIFold: true},
+		},
+		{
+			name: "text[]", dataType: schema.DataTypeTextArray, tokenization: models.PropertyTokenizationWhitespace,
+			textAnalyzer: &models.TextAnalyzerConfig{Lowercase: true, Stemmer: "fr"},
+		},
+		{
+			name: "trigram folding", dataType: schema.DataTypeText, tokenization: models.PropertyTokenizationTrigram,
+			textAnalyzer: &models.TextAnalyzerConfig{ASCIIFold: true},
+		},
+		{
+			name: "trigram stemming", dataType: schema.DataTypeText, tokenization: models.PropertyTokenizationTrigram,
+			textAnalyzer:       &models.TextAnalyzerConfig{Stemmer: "en"},
+			expectedErrContain: "not supported for trigram tokenization",
+		},
+		{
+			name: "int", dataType: schema.DataTypeInt,
+			textAnalyzer:       &models.TextAnalyzerConfig{ASCIIFold: true},
+			expectedErrContain: "`textAnalyzer` is allowed only for text/text[] data types",
+		},
+		{
+			name: "unknown stopword preset", dataType: schema.DataTypeText, tokenization: models.PropertyTokenizationWord,
+			textAnalyzer:       &models.TextAnalyzerConfig{StopwordPreset: "xx"},
+			expectedErrContain: "`textAnalyzer.stopwordPreset` \"xx\" not known",
+		},
+		{
+			name: "unknown stemmer", dataType: schema.DataTypeText, tokenization: models.PropertyTokenizationWord,
+			textAnalyzer:       &models.TextAnalyzerConfig{Stemmer: "xx"},
+			expectedErrContain: "no stemmer for language \"xx\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := handler.validatePropertyTextAnalyzer(&models.Property{
+				Name:         "prop",
+				DataType:     tc.dataType.PropString(),
+				Tokenization: tc.tokenization,
+				TextAnalyzer: tc.textAnalyzer,
+			})
+
+			if tc.expectedErrContain == "" {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedErrContain)
+			}
+		})
+	}
 }
 
 type fakePropertyDataType struct {
@@ -34,8 +34,34 @@ const (
 	ErrMsgMaxAllowedTenants = "maximum number of tenants allowed to be updated simultaneously is 100. Please reduce the number of tenants in your request and try again"
 )
 
-// tenantsPath is the main path used for authorization
-const tenantsPath = "schema/tenants"
+// tenantsPath is used to authorize operations on all tenants of a class
+func tenantsPath(class string) string {
+	return classPath(class) + "/tenants"
+}
+
+// authorizeTenants authorizes the verb on each of the tenants, or on all
+// tenants of the class if none are passed
+func (h *Handler) authorizeTenants(principal *models.Principal, verb, class string, tenants ...string) error {
+	if len(tenants) == 0 {
+		return h.Authorizer.Authorize(principal, verb, tenantsPath(class))
+	}
+	for _, tenant := range tenants {
+		if err := h.Authorizer.Authorize(principal, verb, tenantsPath(class)+"/"+tenant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func tenantNames(tenants []*models.Tenant) []string {
+	names := make([]string, 0, len(tenants))
+	for _, tenant := range tenants {
+		if tenant != nil {
+			names = append(names, tenant.Name)
+		}
+	}
+	return names
+}
 
 // AddTenants is used to add new tenants to a class
 // Class must exist and has partitioning enabled
@@ -44,7 +70,7 @@ func (h *Handler) AddTenants(ctx context.Context,
 	class string,
 	tenants []*models.Tenant,
 ) (uint64, error) {
-	if err := h.Authorizer.Authorize(principal, "update", tenantsPath); err != nil {
+	if err := h.authorizeTenants(principal, "update", class, tenantNames(tenants)...); err != nil {
 		return 0, err
 	}
 
@@ -144,7 +170,7 @@ func (h *Handler) validateActivityStatuses(tenants []*models.Tenant,
 func (h *Handler) UpdateTenants(ctx context.Context, principal *models.Principal,
 	class string, tenants []*models.Tenant,
 ) ([]*models.Tenant, error) {
-	if err := h.Authorizer.Authorize(principal, "update", tenantsPath); err != nil {
+	if err := h.authorizeTenants(principal, "update", class, tenantNames(tenants)...); err != nil {
 		return nil, err
 	}
 
@@ -188,7 +214,7 @@ func (h *Handler) UpdateTenants(ctx context.Context, principal *models.Principal
 //
 // Class must exist and has partitioning enabled
 func (h *Handler) DeleteTenants(ctx context.Context, principal *models.Principal, class string, tenants []string) error {
-	if err := h.Authorizer.Authorize(principal, "delete", tenantsPath); err != nil {
+	if err := h.authorizeTenants(principal, "delete", class, tenants...); err != nil {
 		return err
 	}
 	for i, name := range tenants {
@@ -209,14 +235,14 @@ func (h *Handler) DeleteTenants(ctx context.Context, principal *models.Principal
 //
 // Class must exist and has partitioning enabled
 func (h *Handler) GetTenants(ctx context.Context, principal *models.Principal, class string) ([]*models.Tenant, error) {
-	if err := h.Authorizer.Authorize(principal, "get", tenantsPath); err != nil {
+	if err := h.authorizeTenants(principal, "get", class); err != nil {
 		return nil, err
 	}
 	return h.getTenants(class)
 }
 
 func (h *Handler) GetConsistentTenants(ctx context.Context, principal *models.Principal, class string, consistency bool, tenants []string) ([]*models.Tenant, error) {
-	if err := h.Authorizer.Authorize(principal, "get", tenantsPath); err != nil {
+	if err := h.authorizeTenants(principal, "get", class, tenants...); err != nil {
 		return nil, err
 	}
 
@@ -270,7 +296,7 @@ func (h *Handler) multiTenancy(class string) (clusterSchema.ClassInfo, error) {
 //
 // Class must exist and has partitioning enabled
 func (h *Handler) ConsistentTenantExists(ctx context.Context, principal *models.Principal, class string, consistency bool, tenant string) error {
-	if err := h.Authorizer.Authorize(principal, "get", tenantsPath); err != nil {
+	if err := h.authorizeTenants(principal, "get", class, tenant); err != nil {
 		return err
 	}
 
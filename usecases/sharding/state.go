@@ -96,7 +96,7 @@ func (p *Physical) AdjustReplicas(count int, nodes nodes) error {
 		}
 	}
 	if count < len(p.BelongsToNodes) { // less replicas wanted
-		p.BelongsToNodes = p.BelongsToNodes[:count]
+		p.BelongsToNodes = p.shrinkReplicas(count, nodes.Candidates())
 		return nil
 	}
 
@@ -119,6 +119,35 @@ func (p *Physical) AdjustReplicas(count int, nodes nodes) error {
 	return nil
 }
 
+// shrinkReplicas returns the first count replicas, giving precedence to
+// replicas which are still cluster candidates. This way replicas on nodes
+// which have left the cluster are dropped first.
+func (p *Physical) shrinkReplicas(count int, candidates []string) []string {
+	isCandidate := make(map[string]bool, len(candidates))
+	for _, n := range candidates {
+		isCandidate[n] = true
+	}
+
+	kept := make([]string, 0, count)
+	for _, n := range p.BelongsToNodes {
+		if len(kept) == count {
+			break
+		}
+		if isCandidate[n] {
+			kept = append(kept, n)
+		}
+	}
+	for _, n := range p.BelongsToNodes {
+		if len(kept) == count {
+			break
+		}
+		if !isCandidate[n] {
+			kept = append(kept, n)
+		}
+	}
+	return kept
+}
+
 func (p *Physical) ActivityStatus() string {
 	return schema.ActivityStatus(p.Status)
 }
@@ -220,6 +220,20 @@ func TestAdjustReplicas(t *testing.T) {
 		assert.ElementsMatch(t, []string{"N1", "N2"}, shard.BelongsToNodes)
 	})
 
+	t.Run("3->2 drops unavailable node first", func(t *testing.T) {
+		nodes := fakeNodes{nodes: []string{"N1", "N3"}}
+		shard := Physical{BelongsToNodes: []string{"N1", "N2", "N3"}}
+		require.Nil(t, shard.AdjustReplicas(2, nodes))
+		assert.Equal(t, []string{"N1", "N3"}, shard.BelongsToNodes)
+	})
+
+	t.Run("3->1 keeps first replica", func(t *testing.T) {
+		nodes := fakeNodes{nodes: []string{"N1", "N2", "N3"}}
+		shard := Physical{BelongsToNodes: []string{"N2", "N3", "N1"}}
+		require.Nil(t, shard.AdjustReplicas(1, nodes))
+		assert.Equal(t, []string{"N2"}, shard.BelongsToNodes)
+	})
+
 	t.Run("Min", func(t *testing.T) {
 		nodes := fakeNodes{nodes: []string{"N1", "N2", "N3"}}
 		shard := Physical{BelongsToNodes: []string{"N1", "N2", "N3"}}
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// authorizeClasses authorizes listing the objects of every class a query
+// touches. The tenant only applies to the classes which have multi-tenancy
+// enabled, as references from a tenant can point to a class without tenants.
+func (t *Traverser) authorizeClasses(principal *models.Principal, tenant string, classes []string) error {
+	seen := make(map[string]struct{}, len(classes))
+	for _, class := range classes {
+		class = schema.UppercaseClassName(class)
+		if _, ok := seen[class]; ok {
+			continue
+		}
+		seen[class] = struct{}{}
+
+		path := authorization.ObjectsPath(class, "")
+		if tenant != "" {
+			if c := t.schemaGetter.ReadOnlyClass(class); c == nil || schema.MultiTenancyEnabled(c) {
+				path = authorization.ObjectsPath(class, tenant)
+			}
+		}
+		if err := t.authorizer.Authorize(principal, "list", path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// selectedClasses returns the classes that are reached through the
+// references in the selected properties
+func selectedClasses(props search.SelectProperties) []string {
+	var classes []string
+	for _, prop := range props {
+		for _, ref := range prop.Refs {
+			classes = append(classes, ref.ClassName)
+			classes = append(classes, selectedClasses(ref.RefProperties)...)
+		}
+	}
+	return classes
+}
+
+// filteredClasses returns the classes that are reached through the paths of
+// the filter clauses
+func filteredClasses(filter *filters.LocalFilter) []string {
+	if filter == nil || filter.Root == nil {
+		return nil
+	}
+	return clauseClasses(*filter.Root)
+}
+
+func clauseClasses(clause filters.Clause) []string {
+	var classes []string
+	for path := clause.On; path != nil; path = path.Child {
+		if path.Class != "" {
+			classes = append(classes, string(path.Class))
+		}
+	}
+	for _, operand := range clause.Operands {
+		classes = append(classes, clauseClasses(operand)...)
+	}
+	return classes
+}
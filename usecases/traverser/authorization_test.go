@@ -23,7 +23,10 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -41,23 +44,23 @@ func Test_Traverser_Authorization(t *testing.T) {
 	tests := []testCase{
 		{
 			methodName:       "GetClass",
-			additionalArgs:   []interface{}{dto.GetParams{}},
-			expectedVerb:     "get",
-			expectedResource: "traversal/*",
+			additionalArgs:   []interface{}{dto.GetParams{ClassName: "Article"}},
+			expectedVerb:     "list",
+			expectedResource: "objects/Article",
 		},
 
 		{
 			methodName:       "Aggregate",
-			additionalArgs:   []interface{}{&aggregation.Params{}},
-			expectedVerb:     "get",
-			expectedResource: "traversal/*",
+			additionalArgs:   []interface{}{&aggregation.Params{ClassName: "Article"}},
+			expectedVerb:     "list",
+			expectedResource: "objects/Article",
 		},
 
 		{
 			methodName:       "Explore",
 			additionalArgs:   []interface{}{ExploreParams{}},
-			expectedVerb:     "get",
-			expectedResource: "traversal/*",
+			expectedVerb:     "list",
+			expectedResource: "objects/Article",
 		},
 	}
 
@@ -80,7 +83,7 @@ func Test_Traverser_Authorization(t *testing.T) {
 			authorizer := &authDenier{}
 			vectorRepo := &fakeVectorRepo{}
 			explorer := &fakeExplorer{}
-			schemaGetter := &fakeSchemaGetter{}
+			schemaGetter := newFakeSchemaGetter("Article")
 
 			manager := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
 				vectorRepo, explorer, schemaGetter, nil, nil, -1)
@@ -97,6 +100,132 @@ func Test_Traverser_Authorization(t *testing.T) {
 	})
 }
 
+func Test_Traverser_AuthorizeClasses(t *testing.T) {
+	principal := &models.Principal{}
+	logger, _ := test.NewNullLogger()
+	schemaGetter := &fakeSchemaGetter{schema: schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{Class: "Article", MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true}},
+			{Class: "Author", MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true}},
+			{Class: "Category"},
+			{Class: "Secret"},
+		},
+	}}}
+
+	refTo := func(class string, props ...search.SelectProperty) search.SelectProperty {
+		return search.SelectProperty{Name: "ref", Refs: []search.SelectClass{
+			{ClassName: class, RefProperties: props},
+		}}
+	}
+	filterOn := func(classes ...schema.ClassName) *filters.LocalFilter {
+		var path *filters.Path
+		for i := len(classes) - 1; i >= 0; i-- {
+			path = &filters.Path{Class: classes[i], Property: "ref", Child: path}
+		}
+		return &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{{Operator: filters.OperatorEqual, On: path}},
+		}}
+	}
+
+	tests := []struct {
+		name      string
+		call      func(*Traverser) error
+		resources []string
+		allowed   bool
+	}{
+		{
+			name: "get with references and filters",
+			call: func(tr *Traverser) error {
+				_, err := tr.GetClass(context.Background(), principal, dto.GetParams{
+					ClassName:  "Article",
+					Tenant:     "tenant1",
+					Properties: search.SelectProperties{refTo("Author", refTo("Category")), refTo("Category")},
+					Filters:    filterOn("Article", "Author"),
+				})
+				return err
+			},
+			resources: []string{"objects/Article/tenant1", "objects/Author/tenant1", "objects/Category"},
+			allowed:   true,
+		},
+		{
+			name: "get with a reference to a denied class",
+			call: func(tr *Traverser) error {
+				_, err := tr.GetClass(context.Background(), principal, dto.GetParams{
+					ClassName:  "Category",
+					Properties: search.SelectProperties{refTo("Author", refTo("Secret"))},
+				})
+				return err
+			},
+			resources: []string{"objects/Category", "objects/Author", "objects/Secret"},
+		},
+		{
+			name: "get with a filter on a denied class",
+			call: func(tr *Traverser) error {
+				_, err := tr.GetClass(context.Background(), principal, dto.GetParams{
+					ClassName: "Category",
+					Filters:   filterOn("Category", "Secret"),
+				})
+				return err
+			},
+			resources: []string{"objects/Category", "objects/Secret"},
+		},
+		{
+			name: "get with lowercase class names",
+			call: func(tr *Traverser) error {
+				_, err := tr.GetClass(context.Background(), principal, dto.GetParams{
+					ClassName:  "article",
+					Tenant:     "tenant1",
+					Properties: search.SelectProperties{refTo("secret")},
+				})
+				return err
+			},
+			resources: []string{"objects/Article/tenant1", "objects/Secret"},
+		},
+		{
+			name: "aggregate with a filter on a denied class",
+			call: func(tr *Traverser) error {
+				_, err := tr.Aggregate(context.Background(), principal, &aggregation.Params{
+					ClassName: "Category",
+					Filters:   filterOn("Category", "Secret"),
+				})
+				return err
+			},
+			resources: []string{"objects/Category", "objects/Secret"},
+		},
+		{
+			name: "explore on every class",
+			call: func(tr *Traverser) error {
+				_, err := tr.Explore(context.Background(), principal, ExploreParams{})
+				return err
+			},
+			resources: []string{"objects/Article", "objects/Author", "objects/Category", "objects/Secret"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			authorizer := &authRecorder{deny: "objects/Secret"}
+			traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger, authorizer,
+				&fakeVectorRepo{}, &fakeExplorer{}, schemaGetter, nil, nil, -1)
+
+			err := test.call(traverser)
+			if test.allowed {
+				assert.Nil(t, err)
+			} else {
+				assert.Equal(t, errors.New("just a test fake"), err)
+			}
+
+			resources := make([]string, len(authorizer.calls))
+			for i, call := range authorizer.calls {
+				assert.Equal(t, "list", call.verb)
+				resources[i] = call.resource
+			}
+			assert.Equal(t, test.resources, resources)
+		})
+	}
+}
+
 type authorizeCall struct {
 	principal *models.Principal
 	verb      string
@@ -112,6 +241,20 @@ func (a *authDenier) Authorize(principal *models.Principal, verb, resource strin
 	return errors.New("just a test fake")
 }
 
+// authRecorder records all calls and denies a single resource
+type authRecorder struct {
+	calls []authorizeCall
+	deny  string
+}
+
+func (a *authRecorder) Authorize(principal *models.Principal, verb, resource string) error {
+	a.calls = append(a.calls, authorizeCall{principal, verb, resource})
+	if resource == a.deny {
+		return errors.New("just a test fake")
+	}
+	return nil
+}
+
 // inspired by https://stackoverflow.com/a/33008200
 func callFuncByName(manager interface{}, funcName string, params ...interface{}) (out []reflect.Value, err error) {
 	managerValue := reflect.ValueOf(manager)
@@ -144,6 +144,24 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return nil, errors.Wrap(err, "cursor api: invalid 'after' parameter")
 	}
 
+	if params.NearSparseVector != nil {
+		if params.KeywordRanking != nil {
+			return nil, errors.Errorf("conflict: both nearSparseVector and keyword-based (bm25) arguments present, choose one")
+		}
+		// sparse vector searches share the keyword search path, their postings
+		// are scored by the shards like the ones of the inverted index
+		params.KeywordRanking = &searchparams.KeywordRanking{
+			Type:         "sparse",
+			SparseVector: params.NearSparseVector,
+		}
+	}
+
+	if params.AdditionalProperties.Highlights != nil {
+		if err := e.prepareHighlights(&params); err != nil {
+			return nil, errors.Wrap(err, "invalid 'highlights' parameter")
+		}
+	}
+
 	if params.KeywordRanking != nil {
 		res, err := e.getClassKeywordBased(ctx, params)
 		if err != nil {
@@ -172,10 +190,18 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 		return nil, errors.Errorf("conflict: both near<Media> and keyword-based (bm25) arguments present, choose one")
 	}
 
-	if len(params.KeywordRanking.Query) == 0 {
+	if params.KeywordRanking.Type == "sparse" {
+		if err := e.validateNearSparseVector(params.ClassName, params.KeywordRanking.SparseVector); err != nil {
+			return nil, err
+		}
+	} else if len(params.KeywordRanking.Query) == 0 {
 		return nil, errors.Errorf("keyword search (bm25) must have query set")
 	}
 
+	if err := params.KeywordRanking.Fuzzy.Validate(); err != nil {
+		return nil, errors.Wrap(err, "keyword search (bm25)")
+	}
+
 	if len(params.AdditionalProperties.ModuleParams) > 0 {
 		// if a module-specific additional prop is set, assume it needs the vector
 		// present for backward-compatibility. This could be improved by actually
@@ -408,6 +434,12 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context, input []searc
 		return nil, err
 	}
 
+	if params.AdditionalProperties.Highlights != nil {
+		if err := e.addHighlights(results, params); err != nil {
+			return nil, errors.Wrap(err, "highlights")
+		}
+	}
+
 	if params.GroupBy != nil {
 		for _, result := range results {
 			wrapper := map[string]interface{}{}
@@ -493,9 +525,13 @@ func (e *Explorer) searchResultsToGetResponseWithType(ctx context.Context, input
 		}
 
 		if len(params.AdditionalProperties.Vectors) > 0 {
-			vectors := make(map[string][]float32)
+			vectors := make(map[string]interface{})
 			for _, targetVector := range params.AdditionalProperties.Vectors {
-				vectors[targetVector] = res.Vectors[targetVector]
+				if multiVector, ok := res.MultiVectors[targetVector]; ok {
+					vectors[targetVector] = multiVector
+				} else {
+					vectors[targetVector] = []float32(res.Vectors[targetVector])
+				}
 			}
 			additionalProperties["vectors"] = vectors
 		}
@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// highlightQuery returns the keyword query the results are highlighted for
+func highlightQuery(params dto.GetParams) (query string, properties []string,
+	fuzziness *searchparams.Fuzziness, err error,
+) {
+	switch {
+	case params.KeywordRanking != nil && params.KeywordRanking.Type == "bm25":
+		return params.KeywordRanking.Query, params.KeywordRanking.Properties,
+			params.KeywordRanking.Fuzzy, nil
+	case params.HybridSearch != nil && params.HybridSearch.NearSparseVectorParams == nil:
+		return params.HybridSearch.Query, params.HybridSearch.Properties, nil, nil
+	default:
+		return "", nil, nil, errors.New("highlights are only available for bm25 and hybrid searches")
+	}
+}
+
+// prepareHighlights validates the highlight parameters and makes sure that
+// the highlighted properties are read, even if they were not selected
+func (e *Explorer) prepareHighlights(params *dto.GetParams) error {
+	if err := params.AdditionalProperties.Highlights.Validate(); err != nil {
+		return err
+	}
+	if params.GroupBy != nil {
+		return errors.New("highlights can not be combined with groupBy")
+	}
+	_, propNames, _, err := highlightQuery(*params)
+	if err != nil {
+		return err
+	}
+
+	class := e.schemaGetter.ReadOnlyClass(params.ClassName)
+	if class == nil {
+		return fmt.Errorf("class %q not found in schema", params.ClassName)
+	}
+	if len(propNames) == 0 {
+		for _, prop := range class.Properties {
+			if inverted.HasSearchableIndex(prop) {
+				propNames = append(propNames, prop.Name)
+			}
+		}
+	}
+
+	selected := make(search.SelectProperties, len(params.Properties), len(params.Properties)+len(propNames))
+	copy(selected, params.Properties)
+	for _, propName := range propNames {
+		propName = strings.Split(propName, "^")[0]
+		if selected.FindProperty(propName) == nil {
+			selected = append(selected, search.SelectProperty{Name: propName, IsPrimitive: true})
+		}
+	}
+	params.Properties = selected
+	params.AdditionalProperties.NoProps = false
+	return nil
+}
+
+// addHighlights adds the highlights of the searched text properties to the
+// additional properties of the results
+func (e *Explorer) addHighlights(results []search.Result, params dto.GetParams) error {
+	query, propNames, fuzziness, err := highlightQuery(params)
+	if err != nil {
+		return err
+	}
+	class := e.schemaGetter.ReadOnlyClass(params.ClassName)
+	if class == nil {
+		return fmt.Errorf("class %q not found in schema", params.ClassName)
+	}
+
+	highlighter, err := inverted.NewHighlighter(class, propNames, query, fuzziness,
+		*params.AdditionalProperties.Highlights)
+	if err != nil {
+		return err
+	}
+
+	for _, res := range results {
+		schema, ok := res.Schema.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		additionalProperties, ok := schema["_additional"].(map[string]interface{})
+		if !ok {
+			additionalProperties = map[string]interface{}{}
+			schema["_additional"] = additionalProperties
+		}
+		additionalProperties["highlights"] = highlighter.Highlight(schema)
+	}
+	return nil
+}
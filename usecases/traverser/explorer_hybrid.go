@@ -27,12 +27,26 @@ import (
 	"github.com/weaviate/weaviate/usecases/traverser/hybrid"
 )
 
-// Do a bm25 search.  The results will be used in the hybrid algorithm
+// Do a bm25 search, or a sparse vector search if a sparse vector is given.
+// The results will be used in the hybrid algorithm
 func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*search.Result, string, error) {
-	params.KeywordRanking = &searchparams.KeywordRanking{
-		Query:      params.HybridSearch.Query,
-		Type:       "bm25",
-		Properties: params.HybridSearch.Properties,
+	name := "keyword,bm25"
+	if sparseParams := params.HybridSearch.NearSparseVectorParams; sparseParams != nil {
+		if err := e.validateNearSparseVector(params.ClassName, sparseParams); err != nil {
+			return nil, "", err
+		}
+		params.KeywordRanking = &searchparams.KeywordRanking{
+			Type:         "sparse",
+			SparseVector: sparseParams,
+		}
+		name = "keyword,sparse"
+	} else {
+		params.KeywordRanking = &searchparams.KeywordRanking{
+			Query:      params.HybridSearch.Query,
+			Type:       "bm25",
+			Properties: params.HybridSearch.Properties,
+			Slop:       params.HybridSearch.Slop,
+		}
 	}
 
 	params.Group = nil
@@ -65,7 +79,7 @@ func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*se
 		out[i] = &sr
 	}
 
-	return out, "keyword,bm25", nil
+	return out, name, nil
 }
 
 // Do a nearvector search.  The results will be used in the hybrid algorithm
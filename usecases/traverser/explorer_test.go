@@ -679,6 +679,159 @@ func Test_Explorer_GetClass(t *testing.T) {
 		})
 	})
 
+	t.Run("when nearSparseVector is set", func(t *testing.T) {
+		newExplorer := func(search *fakeVectorSearcher) *Explorer {
+			log, _ := test.NewNullLogger()
+			explorer := NewExplorer(search, log, getFakeModulesProvider(), &fakeMetrics{}, defaultConfig)
+			explorer.SetSchemaGetter(&fakeSchemaGetter{
+				schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+					{
+						Class: "BestClass",
+						VectorConfig: map[string]models.VectorConfig{
+							"dense":  {VectorIndexType: "hnsw"},
+							"splade": {VectorIndexType: "sparse"},
+						},
+					},
+				}}},
+			})
+			return explorer
+		}
+
+		t.Run("the sparse target vector is used by default", func(t *testing.T) {
+			params := dto.GetParams{
+				ClassName:        "BestClass",
+				Pagination:       &filters.Pagination{Limit: 100},
+				NearSparseVector: &searchparams.NearSparseVector{Indices: []uint32{1, 7}, Values: []float32{0.5, 1}},
+			}
+
+			searchResults := []search.Result{
+				{ID: "id1", Schema: map[string]interface{}{"name": "Foo"}},
+			}
+
+			search := &fakeVectorSearcher{}
+			expectedParamsToSearch := params
+			expectedParamsToSearch.NearSparseVector = &searchparams.NearSparseVector{
+				Indices: []uint32{1, 7}, Values: []float32{0.5, 1}, TargetVector: "splade",
+			}
+			expectedParamsToSearch.KeywordRanking = &searchparams.KeywordRanking{
+				Type:         "sparse",
+				SparseVector: expectedParamsToSearch.NearSparseVector,
+			}
+			search.
+				On("Search", expectedParamsToSearch).
+				Return(searchResults, nil)
+
+			res, err := newExplorer(search).GetClass(context.Background(), params)
+			require.Nil(t, err)
+			search.AssertExpectations(t)
+			require.Len(t, res, 1)
+		})
+
+		t.Run("a dense target vector is rejected", func(t *testing.T) {
+			params := dto.GetParams{
+				ClassName:  "BestClass",
+				Pagination: &filters.Pagination{Limit: 100},
+				NearSparseVector: &searchparams.NearSparseVector{
+					Indices: []uint32{1}, Values: []float32{0.5}, TargetVector: "dense",
+				},
+			}
+
+			_, err := newExplorer(&fakeVectorSearcher{}).GetClass(context.Background(), params)
+			assert.EqualError(t, err, `target vector "dense" of class BestClass is not a sparse vector`)
+		})
+
+		t.Run("an invalid sparse vector is rejected", func(t *testing.T) {
+			params := dto.GetParams{
+				ClassName:        "BestClass",
+				Pagination:       &filters.Pagination{Limit: 100},
+				NearSparseVector: &searchparams.NearSparseVector{Indices: []uint32{1, 2}, Values: []float32{0.5}},
+			}
+
+			_, err := newExplorer(&fakeVectorSearcher{}).GetClass(context.Background(), params)
+			assert.EqualError(t, err, "sparse vector has 2 indices but 1 values")
+		})
+
+		t.Run("bm25 can't be combined with it", func(t *testing.T) {
+			params := dto.GetParams{
+				ClassName:        "BestClass",
+				Pagination:       &filters.Pagination{Limit: 100},
+				NearSparseVector: &searchparams.NearSparseVector{Indices: []uint32{1}, Values: []float32{0.5}},
+				KeywordRanking:   &searchparams.KeywordRanking{Type: "bm25", Query: "foo"},
+			}
+
+			_, err := newExplorer(&fakeVectorSearcher{}).GetClass(context.Background(), params)
+			assert.NotNil(t, err)
+		})
+	})
+
+	t.Run("when highlights are requested", func(t *testing.T) {
+		newExplorer := func(search *fakeVectorSearcher) *Explorer {
+			log, _ := test.NewNullLogger()
+			explorer := NewExplorer(search, log, getFakeModulesProvider(), &fakeMetrics{}, defaultConfig)
+			explorer.SetSchemaGetter(&fakeSchemaGetter{
+				schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+					{
+						Class: "BestClass",
+						Properties: []*models.Property{
+							{Name: "title", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWord},
+							{Name: "count", DataType: schema.DataTypeInt.PropString()},
+						},
+					},
+				}}},
+			})
+			return explorer
+		}
+
+		t.Run("the searched properties are read and highlighted", func(t *testing.T) {
+			params := dto.GetParams{
+				ClassName:      "BestClass",
+				Pagination:     &filters.Pagination{Limit: 100},
+				Properties:     search.SelectProperties{{Name: "count", IsPrimitive: true}},
+				KeywordRanking: &searchparams.KeywordRanking{Type: "bm25", Query: "apple"},
+				AdditionalProperties: additional.Properties{
+					Highlights: &additional.HighlightParams{},
+					NoProps:    true,
+				},
+			}
+
+			searchResults := []search.Result{
+				{ID: "id1", Schema: map[string]interface{}{"title": "Apple pie", "count": 1}},
+				{ID: "id2", Schema: map[string]interface{}{"title": "Pear", "count": 2}},
+			}
+
+			searcher := &fakeVectorSearcher{}
+			expectedParamsToSearch := params
+			expectedParamsToSearch.Properties = append(params.Properties,
+				search.SelectProperty{Name: "title", IsPrimitive: true})
+			expectedParamsToSearch.AdditionalProperties.NoProps = false
+			searcher.
+				On("Search", expectedParamsToSearch).
+				Return(searchResults, nil)
+
+			res, err := newExplorer(searcher).GetClass(context.Background(), params)
+			require.Nil(t, err)
+			searcher.AssertExpectations(t)
+			require.Len(t, res, 2)
+			assert.Equal(t, []additional.Highlight{{Property: "title", Fragments: []string{"<em>Apple</em> pie"}}},
+				res[0].(map[string]interface{})["_additional"].(map[string]interface{})["highlights"])
+			assert.Empty(t, res[1].(map[string]interface{})["_additional"].(map[string]interface{})["highlights"])
+		})
+
+		t.Run("they are rejected for vector searches", func(t *testing.T) {
+			params := dto.GetParams{
+				ClassName:  "BestClass",
+				Pagination: &filters.Pagination{Limit: 100},
+				NearVector: &searchparams.NearVector{
+					VectorPerTarget: map[string][]float32{"": {0.8, 0.2, 0.7}},
+				},
+				AdditionalProperties: additional.Properties{Highlights: &additional.HighlightParams{}},
+			}
+
+			_, err := newExplorer(&fakeVectorSearcher{}).GetClass(context.Background(), params)
+			assert.EqualError(t, err, "invalid 'highlights' parameter: highlights are only available for bm25 and hybrid searches")
+		})
+	})
+
 	t.Run("near vector with group", func(t *testing.T) {
 		params := dto.GetParams{
 			ClassName:  "BestClass",
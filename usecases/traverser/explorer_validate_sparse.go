@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+// validateNearSparseVector checks the query and makes sure it targets a
+// sparse vector of the class. If no target vector is set and the class has
+// exactly one sparse vector, that one is used.
+func (e *Explorer) validateNearSparseVector(className string, params *searchparams.NearSparseVector) error {
+	if params == nil {
+		return fmt.Errorf("sparse search must have a sparse vector set")
+	}
+	if len(params.Indices) == 0 {
+		return fmt.Errorf("sparse search must have a non-empty sparse vector")
+	}
+	if err := (sparse.Vector{Indices: params.Indices, Values: params.Values}).Validate(); err != nil {
+		return err
+	}
+
+	class := e.schemaGetter.ReadOnlyClass(className)
+	if class == nil {
+		return fmt.Errorf("class not found in schema: %q", className)
+	}
+
+	var sparseTargets []string
+	for name, cfg := range class.VectorConfig {
+		if cfg.VectorIndexType == vectorindex.VectorIndexTypeSPARSE {
+			sparseTargets = append(sparseTargets, name)
+		}
+	}
+	sort.Strings(sparseTargets)
+
+	if params.TargetVector == "" {
+		if len(sparseTargets) != 1 {
+			return fmt.Errorf("class %s has %d sparse vectors, targetVector must be set to one of %v",
+				className, len(sparseTargets), sparseTargets)
+		}
+		params.TargetVector = sparseTargets[0]
+		return nil
+	}
+
+	for _, name := range sparseTargets {
+		if name == params.TargetVector {
+			return nil
+		}
+	}
+	return fmt.Errorf("target vector %q of class %s is not a sparse vector", params.TargetVector, className)
+}
@@ -30,7 +30,8 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	t.metrics.QueriesAggregateInc(params.ClassName.String())
 	defer t.metrics.QueriesAggregateDec(params.ClassName.String())
 
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	classes := append([]string{params.ClassName.String()}, filteredClasses(params.Filters)...)
+	err := t.authorizeClasses(principal, params.Tenant, classes)
 	if err != nil {
 		return nil, err
 	}
@@ -27,7 +27,14 @@ func (t *Traverser) Explore(ctx context.Context,
 		params.Limit = 20
 	}
 
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	// a cross-class search runs on every class
+	var classes []string
+	if s := t.schemaGetter.GetSchemaSkipAuth(); s.Objects != nil {
+		for _, class := range s.Objects.Classes {
+			classes = append(classes, class.Class)
+		}
+	}
+	err := t.authorizeClasses(principal, "", classes)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +39,9 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	defer t.metrics.QueriesGetDec(params.ClassName)
 	defer t.metrics.QueriesObserveDuration(params.ClassName, before.UnixMilli())
 
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	classes := append([]string{params.ClassName}, selectedClasses(params.Properties)...)
+	classes = append(classes, filteredClasses(params.Filters)...)
+	err := t.authorizeClasses(principal, params.Tenant, classes)
 	if err != nil {
 		return nil, err
 	}
@@ -51,10 +53,12 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	defer unlock()
 
 	certainty := ExtractCertaintyFromParams(params)
-	if certainty != 0 || params.AdditionalProperties.Certainty {
+	if certainty != 0 || params.AdditionalProperties.Certainty ||
+		(params.NearVector != nil && params.NearVector.DistanceMetric != "") {
 		// if certainty is provided as input, we must ensure
 		// that the vector index is configured to use cosine
-		// distance
+		// distance. A per-query distance metric is validated
+		// before the query is sent to the shards.
 		if err := t.validateGetDistanceParams(params); err != nil {
 			return nil, err
 		}
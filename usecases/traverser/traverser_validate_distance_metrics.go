@@ -19,7 +19,9 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
 )
 
@@ -109,6 +111,21 @@ func (t *Traverser) validateGetDistanceParams(params dto.GetParams) error {
 	}
 
 	targetVectors := t.targetVectorParamHelper.GetTargetVectorsFromParams(params)
+
+	// a per-query distance metric takes precedence over the one configured
+	// on the vector index
+	if params.NearVector != nil && params.NearVector.DistanceMetric != "" {
+		metric := params.NearVector.DistanceMetric
+		if err := validateDistanceMetricOverride(class, targetVectors, metric); err != nil {
+			return err
+		}
+		withCertainty := ExtractCertaintyFromParams(params) != 0 || params.AdditionalProperties.Certainty
+		if withCertainty && metric != common.DistanceCosine {
+			return certaintyUnsupportedError(metric)
+		}
+		return nil
+	}
+
 	if err := configvalidation.CheckCertaintyCompatibility(class, targetVectors); err != nil {
 		return err
 	}
@@ -116,6 +133,32 @@ func (t *Traverser) validateGetDistanceParams(params dto.GetParams) error {
 	return nil
 }
 
+// validateDistanceMetricOverride ensures that the distance metric is known and
+// that all target vectors are searched by brute force, which is required to
+// search with a distance the index wasn't built with. Flat indexes configured
+// with cosine read the vectors of the objects for any other distance.
+func validateDistanceMetricOverride(class *models.Class, targetVectors []string, metric string) error {
+	switch metric {
+	case common.DistanceCosine, common.DistanceDot, common.DistanceL2Squared,
+		common.DistanceManhattan, common.DistanceHamming:
+	default:
+		return errors.Errorf("unrecognized distance metric %q, choose one of "+
+			"[\"cosine\", \"dot\", \"l2-squared\", \"manhattan\", \"hamming\"]", metric)
+	}
+
+	vectorConfigs, err := schemaConfig.TypeAssertVectorIndex(class, targetVectors)
+	if err != nil {
+		return err
+	}
+	for _, vectorConfig := range vectorConfigs {
+		if indexType := vectorConfig.IndexType(); indexType != vectorindex.VectorIndexTypeFLAT {
+			return errors.Errorf("the distance metric can only be overridden on flat "+
+				"indexes, class '%s' uses a %s index", class.Class, indexType)
+		}
+	}
+	return nil
+}
+
 func (t *Traverser) extractTargetVectors(params ExploreParams) []string {
 	if params.NearVector != nil {
 		return params.NearVector.TargetVectors
@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestValidateGetDistanceParams_DistanceMetricOverride(t *testing.T) {
+	traverser := &Traverser{
+		schemaGetter: &fakeSchemaGetter{schema: schema.Schema{Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             "FlatClass",
+					VectorIndexType:   "flat",
+					VectorIndexConfig: flat.UserConfig{Distance: "cosine"},
+				},
+				{
+					Class:             "HnswClass",
+					VectorIndexType:   "hnsw",
+					VectorIndexConfig: hnsw.UserConfig{Distance: "cosine"},
+				},
+				{
+					Class: "NamedVectorClass",
+					VectorConfig: map[string]models.VectorConfig{
+						"flat": {
+							VectorIndexType:   "flat",
+							VectorIndexConfig: flat.UserConfig{Distance: "l2-squared"},
+						},
+						"hnsw": {
+							VectorIndexType:   "hnsw",
+							VectorIndexConfig: hnsw.UserConfig{Distance: "l2-squared"},
+						},
+					},
+				},
+			},
+		}}},
+		targetVectorParamHelper: NewTargetParamHelper(),
+	}
+
+	tests := []struct {
+		name          string
+		className     string
+		nearVector    searchparams.NearVector
+		withCertainty bool
+		expectedErr   string
+	}{
+		{
+			name:       "l2-squared on a cosine flat index",
+			className:  "FlatClass",
+			nearVector: searchparams.NearVector{DistanceMetric: "l2-squared"},
+		},
+		{
+			name:          "cosine with certainty",
+			className:     "FlatClass",
+			nearVector:    searchparams.NearVector{DistanceMetric: "cosine"},
+			withCertainty: true,
+		},
+		{
+			name:          "dot with certainty",
+			className:     "FlatClass",
+			nearVector:    searchparams.NearVector{DistanceMetric: "dot"},
+			withCertainty: true,
+			expectedErr:   "can't compute and return certainty",
+		},
+		{
+			name:        "unknown distance metric",
+			className:   "FlatClass",
+			nearVector:  searchparams.NearVector{DistanceMetric: "euclidean"},
+			expectedErr: "unrecognized distance metric \"euclidean\"",
+		},
+		{
+			name:        "hnsw index",
+			className:   "HnswClass",
+			nearVector:  searchparams.NearVector{DistanceMetric: "dot"},
+			expectedErr: "can only be overridden on flat indexes, class 'HnswClass' uses a hnsw index",
+		},
+		{
+			name:      "flat target vector",
+			className: "NamedVectorClass",
+			nearVector: searchparams.NearVector{
+				DistanceMetric: "dot",
+				TargetVectors:  []string{"flat"},
+			},
+		},
+		{
+			name:      "hnsw target vector",
+			className: "NamedVectorClass",
+			nearVector: searchparams.NearVector{
+				DistanceMetric: "dot",
+				TargetVectors:  []string{"flat", "hnsw"},
+			},
+			expectedErr: "can only be overridden on flat indexes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nearVector := tt.nearVector
+			err := traverser.validateGetDistanceParams(dto.GetParams{
+				ClassName:            tt.className,
+				NearVector:           &nearVector,
+				AdditionalProperties: additional.Properties{Certainty: tt.withCertainty},
+			})
+			if tt.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}